@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/sei-protocol/sei-chain/admin/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *service) GetOCCContention(_ context.Context, req *types.GetOCCContentionRequest) (*types.GetOCCContentionResponse, error) {
+	if s.occProfiler == nil {
+		return nil, status.Error(codes.Unavailable, "the OCC conflict profiler is disabled; set occ_profiler.enabled in app.toml")
+	}
+	report := s.occProfiler.Report(int(req.Limit), req.Contract)
+	resp := &types.GetOCCContentionResponse{
+		FromHeight: report.FromHeight,
+		ToHeight:   report.ToHeight,
+		Blocks:     int64(report.Blocks),
+		Txs:        int64(report.Txs),
+		Reruns:     int64(report.Reruns),
+		Conflicts:  int64(report.Conflicts),
+	}
+	for _, k := range report.Keys {
+		resp.Keys = append(resp.Keys, types.OCCKeyContention{
+			Store:     k.Store,
+			Key:       k.Key,
+			Contract:  k.Contract,
+			Slot:      k.Slot,
+			Conflicts: int64(k.Conflicts),
+		})
+	}
+	for _, c := range report.Contracts {
+		resp.Contracts = append(resp.Contracts, types.OCCContractContention{
+			Contract:  c.Contract,
+			Conflicts: int64(c.Conflicts),
+			Keys:      int64(c.Keys),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/admin/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetOCCContention_Unavailable(t *testing.T) {
+	_, err := newService().GetOCCContention(context.Background(), &types.GetOCCContentionRequest{})
+	require.Equal(t, codes.Unavailable, grpcCode(err))
+}
+
+func TestGetOCCContention(t *testing.T) {
+	profiler := tasks.NewConflictProfiler(10, nil)
+	profiler.RecordBlock(context.Background(), 7, 20, 3, []tasks.KeyConflict{
+		{Store: "evm", Key: "03aa01", Contract: "0xAA", Slot: "0x01", Conflicts: 2},
+		{Store: "bank", Key: "02bb", Conflicts: 1},
+	})
+	svc := &service{occProfiler: profiler}
+
+	resp, err := svc.GetOCCContention(context.Background(), &types.GetOCCContentionRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, &types.GetOCCContentionResponse{
+		FromHeight: 7,
+		ToHeight:   7,
+		Blocks:     1,
+		Txs:        20,
+		Reruns:     3,
+		Conflicts:  3,
+		Keys: []types.OCCKeyContention{
+			{Store: "evm", Key: "03aa01", Contract: "0xAA", Slot: "0x01", Conflicts: 2},
+		},
+		Contracts: []types.OCCContractContention{{Contract: "0xAA", Conflicts: 2, Keys: 1}},
+	}, resp)
+}
@@ -5,21 +5,40 @@ import (
 	"net"
 
 	"github.com/sei-protocol/sei-chain/admin/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
 	"github.com/sei-protocol/seilog"
 	"google.golang.org/grpc"
 )
 
 var logger = seilog.NewLogger("admin")
 
+// Option configures the node state the admin service can report on.
+type Option func(*service)
+
+// WithStateSize serves GetStateSize from the given provider.
+func WithStateSize(p statesize.Provider) Option {
+	return func(s *service) { s.stateSize = p }
+}
+
+// WithOCCProfiler serves GetOCCContention from the given profiler.
+func WithOCCProfiler(p *tasks.ConflictProfiler) Option {
+	return func(s *service) { s.occProfiler = p }
+}
+
 // StartServer creates and starts a dedicated admin gRPC server on the given
 // loopback address. Returns the server so the caller can stop it on shutdown.
-func StartServer(address string) (*grpc.Server, error) {
+func StartServer(address string, opts ...Option) (*grpc.Server, error) {
 	if err := validateLoopback(address); err != nil {
 		return nil, err
 	}
 
+	svc := &service{}
+	for _, opt := range opts {
+		opt(svc)
+	}
 	grpcSrv := grpc.NewServer()
-	types.RegisterAdminServiceServer(grpcSrv, &service{})
+	types.RegisterAdminServiceServer(grpcSrv, svc)
 
 	listener, err := net.Listen("tcp", address)
 	if err != nil {
@@ -7,6 +7,8 @@ import (
 	"strings"
 
 	"github.com/sei-protocol/sei-chain/admin/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
 	"github.com/sei-protocol/seilog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,6 +16,11 @@ import (
 
 type service struct {
 	types.UnimplementedAdminServiceServer
+
+	// stateSize serves GetStateSize; nil when the node was started without one.
+	stateSize statesize.Provider
+	// occProfiler serves GetOCCContention; nil when profiling is disabled.
+	occProfiler *tasks.ConflictProfiler
 }
 
 func (s *service) SetLogLevel(_ context.Context, req *types.SetLogLevelRequest) (*types.SetLogLevelResponse, error) {
@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/sei-protocol/sei-chain/admin/types"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *service) GetStateSize(_ context.Context, req *types.GetStateSizeRequest) (*types.GetStateSizeResponse, error) {
+	if s.stateSize == nil {
+		return nil, status.Error(codes.Unavailable, "state size tracking is not available on this node")
+	}
+	report, err := s.stateSize.StateSizeReport()
+	if errors.Is(err, statesize.ErrDisabled) {
+		return nil, status.Error(codes.FailedPrecondition, "state size tracking is disabled; set sc-state-size-enable in app.toml")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "read state sizes: %v", err)
+	}
+	if req.Module != "" {
+		_, inSC := report.SC[req.Module]
+		_, inSSWrites := report.SSWrites[req.Module]
+		if !inSC && !inSSWrites {
+			return nil, status.Errorf(codes.NotFound, "no state sizes recorded for module %q", req.Module)
+		}
+	}
+
+	resp := &types.GetStateSizeResponse{
+		Version:         report.Version,
+		ScComplete:      report.SCComplete,
+		BaselineVersion: report.BaselineVersion,
+		Sc:              moduleStateSizes(report.SC, req.Module, req.IncludePrefixes),
+		SsWritesSince:   report.SSWritesSince,
+		SsWrites:        moduleStateSizes(report.SSWrites, req.Module, req.IncludePrefixes),
+	}
+	stores := make([]string, 0, len(report.BaselineErrors))
+	for store := range report.BaselineErrors {
+		stores = append(stores, store)
+	}
+	sort.Strings(stores)
+	for _, store := range stores {
+		resp.BaselineErrors = append(resp.BaselineErrors, types.StateSizeBaselineError{
+			Store: store,
+			Error: report.BaselineErrors[store],
+		})
+	}
+
+	if req.HistoryLimit > 0 {
+		history, err := s.stateSize.StateSizeHistory(int(req.HistoryLimit))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "read state growth history: %v", err)
+		}
+		for _, snapshot := range history {
+			resp.History = append(resp.History, growthSnapshot(snapshot, req.Module))
+		}
+	}
+	return resp, nil
+}
+
+// moduleStateSizes converts a size map to its response form in module order, keeping only module
+// when it is set.
+func moduleStateSizes(sizes map[string]statesize.ModuleSizes, module string, withPrefixes bool) []types.ModuleStateSize {
+	out := make([]types.ModuleStateSize, 0, len(sizes))
+	for _, name := range statesize.Modules(sizes) {
+		if module != "" && name != module {
+			continue
+		}
+		m := sizes[name]
+		entry := types.ModuleStateSize{Module: name, Total: stateSize(m.Total)}
+		if withPrefixes {
+			prefixes := make([]string, 0, len(m.Prefixes))
+			for prefix := range m.Prefixes {
+				prefixes = append(prefixes, prefix)
+			}
+			sort.Strings(prefixes)
+			for _, prefix := range prefixes {
+				entry.Prefixes = append(entry.Prefixes, types.PrefixStateSize{
+					Prefix: prefix,
+					Size_:  stateSize(m.Prefixes[prefix]),
+				})
+			}
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+func growthSnapshot(snapshot statesize.GrowthSnapshot, module string) types.StateGrowthSnapshot {
+	out := types.StateGrowthSnapshot{
+		FromVersion: snapshot.FromVersion,
+		Version:     snapshot.Version,
+		TimeUnix:    snapshot.Time.Unix(),
+		ScComplete:  snapshot.SCComplete,
+	}
+	names := make([]string, 0, len(snapshot.Modules))
+	for name := range snapshot.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if module != "" && name != module {
+			continue
+		}
+		out.Modules = append(out.Modules, types.ModuleStateGrowth{
+			Module: name,
+			Total:  stateSize(snapshot.Modules[name]),
+			Growth: stateSize(snapshot.Growth[name]),
+		})
+	}
+	for _, c := range snapshot.TopContributors {
+		if module != "" && c.Module != module {
+			continue
+		}
+		out.TopContributors = append(out.TopContributors, types.StateGrowthContributor{
+			Module: c.Module,
+			Name:   c.Name,
+			Growth: stateSize(c.Growth),
+		})
+	}
+	return out
+}
+
+func stateSize(s statesize.Sizes) types.StateSize {
+	return types.StateSize{Keys: s.Keys, Bytes: s.Bytes}
+}
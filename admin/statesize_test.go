@@ -0,0 +1,104 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/admin/types"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+type fakeStateSize struct {
+	report  statesize.Report
+	history []statesize.GrowthSnapshot
+	err     error
+}
+
+func (f *fakeStateSize) StateSizeReport() (statesize.Report, error) { return f.report, f.err }
+
+func (f *fakeStateSize) StateSizeHistory(limit int) ([]statesize.GrowthSnapshot, error) {
+	if limit < len(f.history) {
+		return f.history[:limit], f.err
+	}
+	return f.history, f.err
+}
+
+func newStateSizeService() *service {
+	return &service{stateSize: &fakeStateSize{
+		report: statesize.Report{
+			Version:    10,
+			SCComplete: true,
+			SC: map[string]statesize.ModuleSizes{
+				"bank": {Total: statesize.Sizes{Keys: 2, Bytes: 20}, Prefixes: map[string]statesize.Sizes{
+					"02": {Keys: 1, Bytes: 5}, "01": {Keys: 1, Bytes: 15},
+				}},
+				"evm": {Total: statesize.Sizes{Keys: 1, Bytes: 60}},
+			},
+			SSWritesSince: 4,
+			SSWrites: map[string]statesize.ModuleSizes{
+				"bank": {Total: statesize.Sizes{Keys: 7, Bytes: 70}},
+			},
+		},
+		history: []statesize.GrowthSnapshot{{
+			FromVersion: 5, Version: 10, Time: time.Unix(1000, 0), SCComplete: true,
+			Modules: map[string]statesize.Sizes{"bank": {Keys: 2, Bytes: 20}, "evm": {Keys: 1, Bytes: 60}},
+			Growth:  map[string]statesize.Sizes{"evm": {Keys: 1, Bytes: 60}},
+			TopContributors: []statesize.Contributor{
+				{Module: "evm", Name: "0xaa", Growth: statesize.Sizes{Keys: 1, Bytes: 60}},
+			},
+		}},
+	}}
+}
+
+func TestGetStateSize_Unavailable(t *testing.T) {
+	_, err := newService().GetStateSize(context.Background(), &types.GetStateSizeRequest{})
+	require.Equal(t, codes.Unavailable, grpcCode(err))
+}
+
+func TestGetStateSize_Disabled(t *testing.T) {
+	svc := &service{stateSize: &fakeStateSize{err: statesize.ErrDisabled}}
+	_, err := svc.GetStateSize(context.Background(), &types.GetStateSizeRequest{})
+	require.Equal(t, codes.FailedPrecondition, grpcCode(err))
+}
+
+func TestGetStateSize_AllModules(t *testing.T) {
+	resp, err := newStateSizeService().GetStateSize(context.Background(), &types.GetStateSizeRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), resp.Version)
+	require.True(t, resp.ScComplete)
+	require.Len(t, resp.Sc, 2)
+	require.Equal(t, "bank", resp.Sc[0].Module)
+	require.Equal(t, types.StateSize{Keys: 2, Bytes: 20}, resp.Sc[0].Total)
+	require.Empty(t, resp.Sc[0].Prefixes, "prefixes are only included on request")
+	require.Equal(t, int64(4), resp.SsWritesSince)
+	require.Equal(t, types.StateSize{Keys: 7, Bytes: 70}, resp.SsWrites[0].Total)
+	require.Empty(t, resp.History, "history is only included on request")
+}
+
+func TestGetStateSize_OneModuleWithPrefixesAndHistory(t *testing.T) {
+	resp, err := newStateSizeService().GetStateSize(context.Background(), &types.GetStateSizeRequest{
+		Module:          "bank",
+		IncludePrefixes: true,
+		HistoryLimit:    5,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Sc, 1)
+	require.Equal(t, []types.PrefixStateSize{
+		{Prefix: "01", Size_: types.StateSize{Keys: 1, Bytes: 15}},
+		{Prefix: "02", Size_: types.StateSize{Keys: 1, Bytes: 5}},
+	}, resp.Sc[0].Prefixes)
+
+	require.Len(t, resp.History, 1)
+	snapshot := resp.History[0]
+	require.Equal(t, int64(1000), snapshot.TimeUnix)
+	require.Equal(t, []types.ModuleStateGrowth{{Module: "bank", Total: types.StateSize{Keys: 2, Bytes: 20}}}, snapshot.Modules)
+	require.Empty(t, snapshot.TopContributors, "the evm contributor is filtered out")
+}
+
+func TestGetStateSize_UnknownModule(t *testing.T) {
+	_, err := newStateSizeService().GetStateSize(context.Background(), &types.GetStateSizeRequest{Module: "nope"})
+	require.Equal(t, codes.NotFound, grpcCode(err))
+}
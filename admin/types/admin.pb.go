@@ -380,586 +380,4536 @@ func (m *LoggerInfo) GetLevel() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*SetLogLevelRequest)(nil), "seiprotocol.seichain.admin.v0.SetLogLevelRequest")
-	proto.RegisterType((*SetLogLevelResponse)(nil), "seiprotocol.seichain.admin.v0.SetLogLevelResponse")
-	proto.RegisterType((*GetLogLevelRequest)(nil), "seiprotocol.seichain.admin.v0.GetLogLevelRequest")
-	proto.RegisterType((*GetLogLevelResponse)(nil), "seiprotocol.seichain.admin.v0.GetLogLevelResponse")
-	proto.RegisterType((*ListLoggersRequest)(nil), "seiprotocol.seichain.admin.v0.ListLoggersRequest")
-	proto.RegisterType((*ListLoggersResponse)(nil), "seiprotocol.seichain.admin.v0.ListLoggersResponse")
-	proto.RegisterType((*LoggerInfo)(nil), "seiprotocol.seichain.admin.v0.LoggerInfo")
+type GetStateSizeRequest struct {
+	// module limits the response to one module (optional).
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// include_prefixes adds each module's split by the first byte of the key.
+	IncludePrefixes bool `protobuf:"varint,2,opt,name=include_prefixes,json=includePrefixes,proto3" json:"include_prefixes,omitempty"`
+	// history_limit is how many of the most recent growth snapshots to return, newest first.
+	HistoryLimit uint32 `protobuf:"varint,3,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
 }
 
-func init() { proto.RegisterFile("sei/admin/v0/admin.proto", fileDescriptor_d831d27bce99c92f) }
-
-var fileDescriptor_d831d27bce99c92f = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0xcf, 0xd2, 0x40,
-	0x10, 0xc6, 0xdb, 0xf7, 0x0f, 0xe8, 0xe0, 0x69, 0x21, 0xa6, 0x21, 0xb1, 0x92, 0x3d, 0x61, 0x02,
-	0x2d, 0x62, 0xe2, 0x5d, 0x34, 0x69, 0x48, 0x38, 0x95, 0x9b, 0x27, 0x4b, 0x99, 0x96, 0x4d, 0x4a,
-	0xb7, 0x76, 0x97, 0x46, 0xbf, 0x85, 0x1f, 0x8b, 0x23, 0x47, 0x4f, 0xc6, 0xc0, 0x77, 0xf0, 0x6c,
-	0xda, 0x6d, 0x15, 0x02, 0xbc, 0xa4, 0xb7, 0x79, 0xba, 0x33, 0xcf, 0x6f, 0xb6, 0x4f, 0x0b, 0x86,
-	0x40, 0x66, 0x7b, 0xcb, 0x35, 0x8b, 0xed, 0x6c, 0xa4, 0x0a, 0x2b, 0x49, 0xb9, 0xe4, 0xe4, 0x95,
-	0x40, 0x56, 0x54, 0x3e, 0x8f, 0x2c, 0x81, 0xcc, 0x5f, 0x79, 0x2c, 0xb6, 0x54, 0x47, 0x36, 0xea,
-	0x76, 0x42, 0x1e, 0xf2, 0xe2, 0xdc, 0xce, 0x2b, 0x35, 0x44, 0x3f, 0x01, 0x99, 0xa3, 0x9c, 0xf1,
-	0x70, 0x86, 0x19, 0x46, 0x2e, 0x7e, 0xdd, 0xa0, 0x90, 0xc4, 0x80, 0x66, 0xe2, 0x49, 0x89, 0x69,
-	0x6c, 0xe8, 0x3d, 0xbd, 0xff, 0xdc, 0xad, 0x24, 0xe9, 0xc0, 0x63, 0x94, 0x77, 0x1a, 0x77, 0xc5,
-	0x73, 0x25, 0xa8, 0x07, 0xed, 0x13, 0x17, 0x91, 0xf0, 0x58, 0x60, 0x5d, 0x1b, 0xd2, 0x85, 0x67,
-	0x5e, 0x10, 0xa0, 0x2f, 0x71, 0x69, 0xdc, 0xf7, 0xf4, 0xfe, 0xa3, 0xfb, 0x4f, 0xd3, 0x01, 0x10,
-	0xe7, 0x7c, 0xd1, 0x97, 0xd0, 0x88, 0x78, 0x18, 0x62, 0x5a, 0x02, 0x4a, 0x45, 0x3f, 0x42, 0xdb,
-	0xb9, 0xb0, 0xd0, 0x95, 0xf6, 0x2b, 0xb7, 0x1a, 0x00, 0x99, 0x31, 0x91, 0xbb, 0x84, 0x98, 0x8a,
-	0x23, 0x64, 0x92, 0x62, 0xc0, 0xbe, 0x55, 0x1e, 0x4a, 0xd1, 0x2f, 0xd0, 0x3e, 0xe9, 0x2e, 0x91,
-	0x53, 0x68, 0x2a, 0x88, 0x30, 0xf4, 0xde, 0x7d, 0xbf, 0x35, 0x7e, 0x63, 0x3d, 0x99, 0x93, 0xa5,
-	0x0c, 0xa6, 0x71, 0xc0, 0x27, 0x0f, 0xdb, 0x5f, 0xaf, 0x35, 0xb7, 0x9a, 0xa7, 0xef, 0x01, 0xfe,
-	0x1f, 0x12, 0x02, 0x0f, 0xb1, 0xb7, 0xc6, 0x72, 0x8b, 0xa2, 0xbe, 0x7c, 0x8f, 0xf1, 0x9f, 0x3b,
-	0x78, 0xf1, 0x21, 0xb7, 0x9f, 0x63, 0x9a, 0x31, 0x1f, 0x49, 0x06, 0xad, 0xa3, 0xb8, 0xc8, 0xdb,
-	0x1b, 0x1b, 0x9d, 0x7f, 0x20, 0xdd, 0x71, 0x9d, 0x11, 0xf5, 0x26, 0xa8, 0x96, 0x73, 0x9d, 0x1a,
-	0x5c, 0xa7, 0x3e, 0xd7, 0xb9, 0xc6, 0x3d, 0x8a, 0xe6, 0x26, 0xf7, 0x3c, 0xf4, 0x9b, 0xdc, 0x0b,
-	0xc9, 0x53, 0x6d, 0xe2, 0x6c, 0xf7, 0xa6, 0xbe, 0xdb, 0x9b, 0xfa, 0xef, 0xbd, 0xa9, 0xff, 0x38,
-	0x98, 0xda, 0xee, 0x60, 0x6a, 0x3f, 0x0f, 0xa6, 0xf6, 0x79, 0x18, 0x32, 0xb9, 0xda, 0x2c, 0x2c,
-	0x9f, 0xaf, 0x6d, 0x81, 0x6c, 0x58, 0x59, 0x17, 0xa2, 0xf0, 0x2e, 0xff, 0x71, 0xf9, 0x3d, 0x41,
-	0xb1, 0x68, 0x14, 0xe7, 0xef, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x02, 0x27, 0xb5, 0x0d, 0xfd,
-	0x03, 0x00, 0x00,
+func (m *GetStateSizeRequest) Reset()         { *m = GetStateSizeRequest{} }
+func (m *GetStateSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateSizeRequest) ProtoMessage()    {}
+func (*GetStateSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{7}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// AdminServiceClient is the client API for AdminService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AdminServiceClient interface {
-	// SetLogLevel changes the log level for loggers matching a pattern.
-	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
-	// GetLogLevel returns the current log level for a specific logger.
-	GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error)
-	// ListLoggers returns all registered loggers and their current levels.
-	ListLoggers(ctx context.Context, in *ListLoggersRequest, opts ...grpc.CallOption) (*ListLoggersResponse, error)
+func (m *GetStateSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type adminServiceClient struct {
-	cc grpc1.ClientConn
+func (m *GetStateSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStateSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewAdminServiceClient(cc grpc1.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
+func (m *GetStateSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateSizeRequest.Merge(m, src)
+}
+func (m *GetStateSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateSizeRequest.DiscardUnknown(m)
 }
 
-func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
-	out := new(SetLogLevelResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/SetLogLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetStateSizeRequest proto.InternalMessageInfo
+
+func (m *GetStateSizeRequest) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return out, nil
+	return ""
 }
 
-func (c *adminServiceClient) GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error) {
-	out := new(GetLogLevelResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/GetLogLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetStateSizeRequest) GetIncludePrefixes() bool {
+	if m != nil {
+		return m.IncludePrefixes
 	}
-	return out, nil
+	return false
 }
 
-func (c *adminServiceClient) ListLoggers(ctx context.Context, in *ListLoggersRequest, opts ...grpc.CallOption) (*ListLoggersResponse, error) {
-	out := new(ListLoggersResponse)
-	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/ListLoggers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetStateSizeRequest) GetHistoryLimit() uint32 {
+	if m != nil {
+		return m.HistoryLimit
 	}
-	return out, nil
+	return 0
 }
 
-// AdminServiceServer is the server API for AdminService service.
-type AdminServiceServer interface {
-	// SetLogLevel changes the log level for loggers matching a pattern.
-	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
-	// GetLogLevel returns the current log level for a specific logger.
-	GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error)
-	// ListLoggers returns all registered loggers and their current levels.
-	ListLoggers(context.Context, *ListLoggersRequest) (*ListLoggersResponse, error)
+type GetStateSizeResponse struct {
+	// version is the latest committed version the sizes are at.
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// sc_complete is false while the SC baseline is still being counted; until then sc holds only the
+	// change since baseline_version.
+	ScComplete      bool  `protobuf:"varint,2,opt,name=sc_complete,json=scComplete,proto3" json:"sc_complete,omitempty"`
+	BaselineVersion int64 `protobuf:"varint,3,opt,name=baseline_version,json=baselineVersion,proto3" json:"baseline_version,omitempty"`
+	// baseline_errors names the stores whose baseline could not be counted.
+	BaselineErrors []StateSizeBaselineError `protobuf:"bytes,4,rep,name=baseline_errors,json=baselineErrors,proto3" json:"baseline_errors"`
+	// sc is the live state per module.
+	Sc []ModuleStateSize `protobuf:"bytes,5,rep,name=sc,proto3" json:"sc"`
+	// ss_writes_since is the version state store write counting started after.
+	SsWritesSince int64 `protobuf:"varint,6,opt,name=ss_writes_since,json=ssWritesSince,proto3" json:"ss_writes_since,omitempty"`
+	// ss_writes is the write volume to the state store per module since ss_writes_since, one key per set
+	// or delete. It is not the size of the state store, which keeps every version written.
+	SsWrites []ModuleStateSize     `protobuf:"bytes,7,rep,name=ss_writes,json=ssWrites,proto3" json:"ss_writes"`
+	History  []StateGrowthSnapshot `protobuf:"bytes,8,rep,name=history,proto3" json:"history"`
 }
 
-// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedAdminServiceServer struct {
+func (m *GetStateSizeResponse) Reset()         { *m = GetStateSizeResponse{} }
+func (m *GetStateSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateSizeResponse) ProtoMessage()    {}
+func (*GetStateSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{8}
 }
-
-func (*UnimplementedAdminServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+func (m *GetStateSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAdminServiceServer) GetLogLevel(ctx context.Context, req *GetLogLevelRequest) (*GetLogLevelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+func (m *GetStateSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetStateSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAdminServiceServer) ListLoggers(ctx context.Context, req *ListLoggersRequest) (*ListLoggersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLoggers not implemented")
+func (m *GetStateSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateSizeResponse.Merge(m, src)
 }
-
-func RegisterAdminServiceServer(s grpc1.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+func (m *GetStateSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetStateSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateSizeResponse.DiscardUnknown(m)
 }
 
-func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLogLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/SetLogLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+var xxx_messageInfo_GetStateSizeResponse proto.InternalMessageInfo
+
+func (m *GetStateSizeResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _AdminService_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLogLevelRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).GetLogLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/GetLogLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetLogLevel(ctx, req.(*GetLogLevelRequest))
+func (m *GetStateSizeResponse) GetScComplete() bool {
+	if m != nil {
+		return m.ScComplete
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _AdminService_ListLoggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListLoggersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetStateSizeResponse) GetBaselineVersion() int64 {
+	if m != nil {
+		return m.BaselineVersion
 	}
-	if interceptor == nil {
-		return srv.(AdminServiceServer).ListLoggers(ctx, in)
+	return 0
+}
+
+func (m *GetStateSizeResponse) GetBaselineErrors() []StateSizeBaselineError {
+	if m != nil {
+		return m.BaselineErrors
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/ListLoggers",
+	return nil
+}
+
+func (m *GetStateSizeResponse) GetSc() []ModuleStateSize {
+	if m != nil {
+		return m.Sc
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListLoggers(ctx, req.(*ListLoggersRequest))
+	return nil
+}
+
+func (m *GetStateSizeResponse) GetSsWritesSince() int64 {
+	if m != nil {
+		return m.SsWritesSince
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _AdminService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "seiprotocol.seichain.admin.v0.AdminService",
-	HandlerType: (*AdminServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetLogLevel",
-			Handler:    _AdminService_SetLogLevel_Handler,
-		},
-		{
-			MethodName: "GetLogLevel",
-			Handler:    _AdminService_GetLogLevel_Handler,
-		},
-		{
-			MethodName: "ListLoggers",
-			Handler:    _AdminService_ListLoggers_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "sei/admin/v0/admin.proto",
+func (m *GetStateSizeResponse) GetSsWrites() []ModuleStateSize {
+	if m != nil {
+		return m.SsWrites
+	}
+	return nil
 }
 
-func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetStateSizeResponse) GetHistory() []StateGrowthSnapshot {
+	if m != nil {
+		return m.History
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StateSize struct {
+	Keys int64 `protobuf:"varint,1,opt,name=keys,proto3" json:"keys,omitempty"`
+	// bytes counts key plus value bytes.
+	Bytes int64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
 }
 
-func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x12
+func (m *StateSize) Reset()         { *m = StateSize{} }
+func (m *StateSize) String() string { return proto.CompactTextString(m) }
+func (*StateSize) ProtoMessage()    {}
+func (*StateSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{9}
+}
+func (m *StateSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Pattern) > 0 {
-		i -= len(m.Pattern)
-		copy(dAtA[i:], m.Pattern)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Pattern)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *StateSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSize.Merge(m, src)
+}
+func (m *StateSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSize proto.InternalMessageInfo
+
+func (m *StateSize) GetKeys() int64 {
+	if m != nil {
+		return m.Keys
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *SetLogLevelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateSize) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ModuleStateSize struct {
+	Module   string            `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	Total    StateSize         `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	Prefixes []PrefixStateSize `protobuf:"bytes,3,rep,name=prefixes,proto3" json:"prefixes"`
 }
 
-func (m *SetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Affected != 0 {
-		i = encodeVarintAdmin(dAtA, i, uint64(m.Affected))
-		i--
-		dAtA[i] = 0x18
+func (m *ModuleStateSize) Reset()         { *m = ModuleStateSize{} }
+func (m *ModuleStateSize) String() string { return proto.CompactTextString(m) }
+func (*ModuleStateSize) ProtoMessage()    {}
+func (*ModuleStateSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{10}
+}
+func (m *ModuleStateSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleStateSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleStateSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ModuleStateSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleStateSize.Merge(m, src)
+}
+func (m *ModuleStateSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleStateSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleStateSize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleStateSize proto.InternalMessageInfo
+
+func (m *ModuleStateSize) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	if len(m.Pattern) > 0 {
-		i -= len(m.Pattern)
-		copy(dAtA[i:], m.Pattern)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Pattern)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ModuleStateSize) GetTotal() StateSize {
+	if m != nil {
+		return m.Total
 	}
-	return len(dAtA) - i, nil
+	return StateSize{}
 }
 
-func (m *GetLogLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ModuleStateSize) GetPrefixes() []PrefixStateSize {
+	if m != nil {
+		return m.Prefixes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PrefixStateSize struct {
+	// prefix is the first byte of the key in upper-case hex.
+	Prefix string    `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Size_  StateSize `protobuf:"bytes,2,opt,name=size,proto3" json:"size"`
 }
 
-func (m *GetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logger) > 0 {
-		i -= len(m.Logger)
-		copy(dAtA[i:], m.Logger)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Logger)))
-		i--
-		dAtA[i] = 0xa
+func (m *PrefixStateSize) Reset()         { *m = PrefixStateSize{} }
+func (m *PrefixStateSize) String() string { return proto.CompactTextString(m) }
+func (*PrefixStateSize) ProtoMessage()    {}
+func (*PrefixStateSize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{11}
+}
+func (m *PrefixStateSize) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrefixStateSize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrefixStateSize.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PrefixStateSize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrefixStateSize.Merge(m, src)
+}
+func (m *PrefixStateSize) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrefixStateSize) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrefixStateSize.DiscardUnknown(m)
 }
 
-func (m *GetLogLevelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PrefixStateSize proto.InternalMessageInfo
+
+func (m *PrefixStateSize) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PrefixStateSize) GetSize_() StateSize {
+	if m != nil {
+		return m.Size_
+	}
+	return StateSize{}
 }
 
-func (m *GetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Logger) > 0 {
-		i -= len(m.Logger)
-		copy(dAtA[i:], m.Logger)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Logger)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type StateSizeBaselineError struct {
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *ListLoggersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateSizeBaselineError) Reset()         { *m = StateSizeBaselineError{} }
+func (m *StateSizeBaselineError) String() string { return proto.CompactTextString(m) }
+func (*StateSizeBaselineError) ProtoMessage()    {}
+func (*StateSizeBaselineError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{12}
+}
+func (m *StateSizeBaselineError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSizeBaselineError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateSizeBaselineError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ListLoggersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateSizeBaselineError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSizeBaselineError.Merge(m, src)
+}
+func (m *StateSizeBaselineError) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSizeBaselineError) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSizeBaselineError.DiscardUnknown(m)
 }
 
-func (m *ListLoggersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prefix) > 0 {
-		i -= len(m.Prefix)
-		copy(dAtA[i:], m.Prefix)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Prefix)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_StateSizeBaselineError proto.InternalMessageInfo
+
+func (m *StateSizeBaselineError) GetStore() string {
+	if m != nil {
+		return m.Store
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListLoggersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StateSizeBaselineError) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListLoggersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type StateGrowthSnapshot struct {
+	// The snapshot covers the versions after from_version up to and including version.
+	FromVersion int64 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	Version     int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	TimeUnix    int64 `protobuf:"varint,3,opt,name=time_unix,json=timeUnix,proto3" json:"time_unix,omitempty"`
+	// sc_complete is whether the module totals include the SC baseline.
+	ScComplete      bool                     `protobuf:"varint,4,opt,name=sc_complete,json=scComplete,proto3" json:"sc_complete,omitempty"`
+	Modules         []ModuleStateGrowth      `protobuf:"bytes,5,rep,name=modules,proto3" json:"modules"`
+	TopContributors []StateGrowthContributor `protobuf:"bytes,6,rep,name=top_contributors,json=topContributors,proto3" json:"top_contributors"`
 }
 
-func (m *ListLoggersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Loggers) > 0 {
-		for iNdEx := len(m.Loggers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Loggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAdmin(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *StateGrowthSnapshot) Reset()         { *m = StateGrowthSnapshot{} }
+func (m *StateGrowthSnapshot) String() string { return proto.CompactTextString(m) }
+func (*StateGrowthSnapshot) ProtoMessage()    {}
+func (*StateGrowthSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{13}
+}
+func (m *StateGrowthSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateGrowthSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateGrowthSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *StateGrowthSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateGrowthSnapshot.Merge(m, src)
+}
+func (m *StateGrowthSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateGrowthSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateGrowthSnapshot.DiscardUnknown(m)
 }
 
-func (m *LoggerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StateGrowthSnapshot proto.InternalMessageInfo
+
+func (m *StateGrowthSnapshot) GetFromVersion() int64 {
+	if m != nil {
+		return m.FromVersion
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LoggerInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StateGrowthSnapshot) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *LoggerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Level) > 0 {
-		i -= len(m.Level)
-		copy(dAtA[i:], m.Level)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
-		i--
-		dAtA[i] = 0x12
+func (m *StateGrowthSnapshot) GetTimeUnix() int64 {
+	if m != nil {
+		return m.TimeUnix
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *StateGrowthSnapshot) GetScComplete() bool {
+	if m != nil {
+		return m.ScComplete
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StateGrowthSnapshot) GetModules() []ModuleStateGrowth {
+	if m != nil {
+		return m.Modules
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *SetLogLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *StateGrowthSnapshot) GetTopContributors() []StateGrowthContributor {
+	if m != nil {
+		return m.TopContributors
 	}
-	var l int
-	_ = l
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return nil
+}
+
+type ModuleStateGrowth struct {
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// total is the module's SC size at version.
+	Total StateSize `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	// growth is its SC change over the snapshot.
+	Growth StateSize `protobuf:"bytes,3,opt,name=growth,proto3" json:"growth"`
+}
+
+func (m *ModuleStateGrowth) Reset()         { *m = ModuleStateGrowth{} }
+func (m *ModuleStateGrowth) String() string { return proto.CompactTextString(m) }
+func (*ModuleStateGrowth) ProtoMessage()    {}
+func (*ModuleStateGrowth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{14}
+}
+func (m *ModuleStateGrowth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleStateGrowth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleStateGrowth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+}
+func (m *ModuleStateGrowth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleStateGrowth.Merge(m, src)
+}
+func (m *ModuleStateGrowth) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleStateGrowth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleStateGrowth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleStateGrowth proto.InternalMessageInfo
+
+func (m *ModuleStateGrowth) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return n
+	return ""
 }
 
-func (m *SetLogLevelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModuleStateGrowth) GetTotal() StateSize {
+	if m != nil {
+		return m.Total
 	}
-	var l int
-	_ = l
-	l = len(m.Pattern)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return StateSize{}
+}
+
+func (m *ModuleStateGrowth) GetGrowth() StateSize {
+	if m != nil {
+		return m.Growth
 	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return StateSize{}
+}
+
+type StateGrowthContributor struct {
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// name is the contract address for EVM storage slots and the key prefix otherwise.
+	Name   string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Growth StateSize `protobuf:"bytes,3,opt,name=growth,proto3" json:"growth"`
+}
+
+func (m *StateGrowthContributor) Reset()         { *m = StateGrowthContributor{} }
+func (m *StateGrowthContributor) String() string { return proto.CompactTextString(m) }
+func (*StateGrowthContributor) ProtoMessage()    {}
+func (*StateGrowthContributor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{15}
+}
+func (m *StateGrowthContributor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateGrowthContributor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateGrowthContributor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Affected != 0 {
-		n += 1 + sovAdmin(uint64(m.Affected))
+}
+func (m *StateGrowthContributor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateGrowthContributor.Merge(m, src)
+}
+func (m *StateGrowthContributor) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateGrowthContributor) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateGrowthContributor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateGrowthContributor proto.InternalMessageInfo
+
+func (m *StateGrowthContributor) GetModule() string {
+	if m != nil {
+		return m.Module
 	}
-	return n
+	return ""
 }
 
-func (m *GetLogLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StateGrowthContributor) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	l = len(m.Logger)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return ""
+}
+
+func (m *StateGrowthContributor) GetGrowth() StateSize {
+	if m != nil {
+		return m.Growth
 	}
-	return n
+	return StateSize{}
 }
 
-func (m *GetLogLevelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type GetOCCContentionRequest struct {
+	// limit caps the number of keys and contracts returned (optional).
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// contract limits the keys to one contract's storage (optional).
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *GetOCCContentionRequest) Reset()         { *m = GetOCCContentionRequest{} }
+func (m *GetOCCContentionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOCCContentionRequest) ProtoMessage()    {}
+func (*GetOCCContentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{16}
+}
+func (m *GetOCCContentionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOCCContentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOCCContentionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Logger)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+}
+func (m *GetOCCContentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOCCContentionRequest.Merge(m, src)
+}
+func (m *GetOCCContentionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOCCContentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOCCContentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOCCContentionRequest proto.InternalMessageInfo
+
+func (m *GetOCCContentionRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	return 0
+}
+
+func (m *GetOCCContentionRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
 	}
-	return n
+	return ""
 }
 
-func (m *ListLoggersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type GetOCCContentionResponse struct {
+	// The window covers the blocks from from_height to to_height.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	Blocks     int64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Txs        int64 `protobuf:"varint,4,opt,name=txs,proto3" json:"txs,omitempty"`
+	// reruns is the number of transaction reruns in the window.
+	Reruns int64 `protobuf:"varint,5,opt,name=reruns,proto3" json:"reruns,omitempty"`
+	// conflicts is the number of validation failures per conflicting key, summed over the window.
+	Conflicts int64                   `protobuf:"varint,6,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Keys      []OCCKeyContention      `protobuf:"bytes,7,rep,name=keys,proto3" json:"keys"`
+	Contracts []OCCContractContention `protobuf:"bytes,8,rep,name=contracts,proto3" json:"contracts"`
+}
+
+func (m *GetOCCContentionResponse) Reset()         { *m = GetOCCContentionResponse{} }
+func (m *GetOCCContentionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOCCContentionResponse) ProtoMessage()    {}
+func (*GetOCCContentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{17}
+}
+func (m *GetOCCContentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOCCContentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOCCContentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Prefix)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+}
+func (m *GetOCCContentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOCCContentionResponse.Merge(m, src)
+}
+func (m *GetOCCContentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOCCContentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOCCContentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOCCContentionResponse proto.InternalMessageInfo
+
+func (m *GetOCCContentionResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return n
+	return 0
 }
 
-func (m *ListLoggersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetOCCContentionResponse) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
 	}
-	var l int
-	_ = l
-	if len(m.Loggers) > 0 {
-		for _, e := range m.Loggers {
-			l = e.Size()
-			n += 1 + l + sovAdmin(uint64(l))
+	return 0
+}
+
+func (m *GetOCCContentionResponse) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *GetOCCContentionResponse) GetTxs() int64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *GetOCCContentionResponse) GetReruns() int64 {
+	if m != nil {
+		return m.Reruns
+	}
+	return 0
+}
+
+func (m *GetOCCContentionResponse) GetConflicts() int64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *GetOCCContentionResponse) GetKeys() []OCCKeyContention {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *GetOCCContentionResponse) GetContracts() []OCCContractContention {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+type OCCKeyContention struct {
+	Store string `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	// key is the raw store key in hex.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// contract and slot are set for EVM contract storage.
+	Contract  string `protobuf:"bytes,3,opt,name=contract,proto3" json:"contract,omitempty"`
+	Slot      string `protobuf:"bytes,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	Conflicts int64  `protobuf:"varint,5,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (m *OCCKeyContention) Reset()         { *m = OCCKeyContention{} }
+func (m *OCCKeyContention) String() string { return proto.CompactTextString(m) }
+func (*OCCKeyContention) ProtoMessage()    {}
+func (*OCCKeyContention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{18}
+}
+func (m *OCCKeyContention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCCKeyContention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCCKeyContention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCCKeyContention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCCKeyContention.Merge(m, src)
+}
+func (m *OCCKeyContention) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCCKeyContention) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCCKeyContention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCCKeyContention proto.InternalMessageInfo
+
+func (m *OCCKeyContention) GetStore() string {
+	if m != nil {
+		return m.Store
+	}
+	return ""
+}
+
+func (m *OCCKeyContention) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *OCCKeyContention) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *OCCKeyContention) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *OCCKeyContention) GetConflicts() int64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+type OCCContractContention struct {
+	Contract  string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Conflicts int64  `protobuf:"varint,2,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	// keys is the number of distinct storage slots of the contract that conflicted.
+	Keys int64 `protobuf:"varint,3,opt,name=keys,proto3" json:"keys,omitempty"`
+}
+
+func (m *OCCContractContention) Reset()         { *m = OCCContractContention{} }
+func (m *OCCContractContention) String() string { return proto.CompactTextString(m) }
+func (*OCCContractContention) ProtoMessage()    {}
+func (*OCCContractContention) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d831d27bce99c92f, []int{19}
+}
+func (m *OCCContractContention) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCCContractContention) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OCCContractContention.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OCCContractContention) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCCContractContention.Merge(m, src)
+}
+func (m *OCCContractContention) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCCContractContention) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCCContractContention.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCCContractContention proto.InternalMessageInfo
+
+func (m *OCCContractContention) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *OCCContractContention) GetConflicts() int64 {
+	if m != nil {
+		return m.Conflicts
+	}
+	return 0
+}
+
+func (m *OCCContractContention) GetKeys() int64 {
+	if m != nil {
+		return m.Keys
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*SetLogLevelRequest)(nil), "seiprotocol.seichain.admin.v0.SetLogLevelRequest")
+	proto.RegisterType((*SetLogLevelResponse)(nil), "seiprotocol.seichain.admin.v0.SetLogLevelResponse")
+	proto.RegisterType((*GetLogLevelRequest)(nil), "seiprotocol.seichain.admin.v0.GetLogLevelRequest")
+	proto.RegisterType((*GetLogLevelResponse)(nil), "seiprotocol.seichain.admin.v0.GetLogLevelResponse")
+	proto.RegisterType((*ListLoggersRequest)(nil), "seiprotocol.seichain.admin.v0.ListLoggersRequest")
+	proto.RegisterType((*ListLoggersResponse)(nil), "seiprotocol.seichain.admin.v0.ListLoggersResponse")
+	proto.RegisterType((*LoggerInfo)(nil), "seiprotocol.seichain.admin.v0.LoggerInfo")
+	proto.RegisterType((*GetStateSizeRequest)(nil), "seiprotocol.seichain.admin.v0.GetStateSizeRequest")
+	proto.RegisterType((*GetStateSizeResponse)(nil), "seiprotocol.seichain.admin.v0.GetStateSizeResponse")
+	proto.RegisterType((*StateSize)(nil), "seiprotocol.seichain.admin.v0.StateSize")
+	proto.RegisterType((*ModuleStateSize)(nil), "seiprotocol.seichain.admin.v0.ModuleStateSize")
+	proto.RegisterType((*PrefixStateSize)(nil), "seiprotocol.seichain.admin.v0.PrefixStateSize")
+	proto.RegisterType((*StateSizeBaselineError)(nil), "seiprotocol.seichain.admin.v0.StateSizeBaselineError")
+	proto.RegisterType((*StateGrowthSnapshot)(nil), "seiprotocol.seichain.admin.v0.StateGrowthSnapshot")
+	proto.RegisterType((*ModuleStateGrowth)(nil), "seiprotocol.seichain.admin.v0.ModuleStateGrowth")
+	proto.RegisterType((*StateGrowthContributor)(nil), "seiprotocol.seichain.admin.v0.StateGrowthContributor")
+	proto.RegisterType((*GetOCCContentionRequest)(nil), "seiprotocol.seichain.admin.v0.GetOCCContentionRequest")
+	proto.RegisterType((*GetOCCContentionResponse)(nil), "seiprotocol.seichain.admin.v0.GetOCCContentionResponse")
+	proto.RegisterType((*OCCKeyContention)(nil), "seiprotocol.seichain.admin.v0.OCCKeyContention")
+	proto.RegisterType((*OCCContractContention)(nil), "seiprotocol.seichain.admin.v0.OCCContractContention")
+}
+
+func init() { proto.RegisterFile("sei/admin/v0/admin.proto", fileDescriptor_d831d27bce99c92f) }
+
+var fileDescriptor_d831d27bce99c92f = []byte{
+	// 1142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0xb5, 0x57, 0x4b, 0x8f, 0x1b, 0x45,
+	0x10, 0x5e, 0x7b, 0xbc, 0x7e, 0x94, 0x77, 0xf1, 0xd2, 0xbb, 0x84, 0x91, 0x81, 0x04, 0x06, 0x09,
+	0x6d, 0x24, 0x62, 0x2f, 0x0e, 0x09, 0x67, 0xd6, 0x0b, 0x26, 0xca, 0x22, 0x96, 0xb1, 0x08, 0x88,
+	0x8b, 0x19, 0x8f, 0xdb, 0xf6, 0x28, 0xe3, 0x69, 0x33, 0xdd, 0x76, 0x62, 0x24, 0x8e, 0x48, 0x1c,
+	0x38, 0xf0, 0x77, 0x38, 0x72, 0x8b, 0x38, 0xe5, 0xc8, 0x05, 0x84, 0xe0, 0x47, 0x20, 0x6e, 0xf4,
+	0xd3, 0xaf, 0xb1, 0xb1, 0x0d, 0xe4, 0x30, 0x52, 0x57, 0x75, 0xf7, 0x57, 0xaf, 0xaf, 0xab, 0x7b,
+	0xc0, 0xa6, 0x38, 0xa8, 0x7a, 0x9d, 0x41, 0x10, 0x55, 0xc7, 0x67, 0x6a, 0x50, 0x19, 0xc6, 0x84,
+	0x11, 0xf4, 0x0a, 0x9f, 0x91, 0x23, 0x9f, 0x84, 0x15, 0x3e, 0xf6, 0xfb, 0x1e, 0x9f, 0x53, 0x2b,
+	0xc6, 0x67, 0xe5, 0x93, 0x1e, 0xe9, 0x11, 0x39, 0x5f, 0x15, 0x23, 0xb5, 0xc9, 0xb9, 0x00, 0xd4,
+	0xc4, 0xec, 0x92, 0xf4, 0x2e, 0xf1, 0x18, 0x87, 0x2e, 0xfe, 0x72, 0x84, 0x29, 0x43, 0x36, 0xe4,
+	0x86, 0x1e, 0x63, 0x38, 0x8e, 0xec, 0xd4, 0xab, 0xa9, 0xd3, 0x82, 0x6b, 0x44, 0x74, 0x02, 0xfb,
+	0xa1, 0x58, 0x69, 0xa7, 0xa5, 0x5e, 0x09, 0x8e, 0x07, 0xc7, 0x0b, 0x28, 0x74, 0x48, 0x22, 0x8a,
+	0x77, 0x85, 0x41, 0x65, 0xc8, 0x7b, 0xdd, 0x2e, 0xf6, 0x19, 0xee, 0xd8, 0x16, 0x9f, 0xd8, 0x77,
+	0xa7, 0xb2, 0xf3, 0x26, 0xa0, 0x46, 0xd2, 0xd1, 0x6b, 0x90, 0x0d, 0x49, 0xaf, 0x87, 0x63, 0x6d,
+	0x40, 0x4b, 0x4e, 0x1d, 0x8e, 0x1b, 0x2b, 0x1c, 0x5a, 0xb3, 0x7c, 0x4d, 0x54, 0xdc, 0xe4, 0x65,
+	0x40, 0x05, 0x0a, 0x5f, 0x43, 0xe7, 0x4c, 0x0e, 0x63, 0xdc, 0x0d, 0x1e, 0x1b, 0x0c, 0x25, 0x39,
+	0x5f, 0xc0, 0xf1, 0xc2, 0x6a, 0x6d, 0xf2, 0x1e, 0xe4, 0x94, 0x11, 0xca, 0xd7, 0x5b, 0xa7, 0xc5,
+	0xda, 0xcd, 0xca, 0x3f, 0xd6, 0xa9, 0xa2, 0x00, 0xee, 0x45, 0x5d, 0x72, 0x9e, 0x79, 0xf2, 0xeb,
+	0x8d, 0x3d, 0xd7, 0xec, 0x77, 0xee, 0x02, 0xcc, 0x26, 0x11, 0x82, 0x4c, 0xe4, 0x0d, 0xb0, 0xf6,
+	0x42, 0x8e, 0xd7, 0xc4, 0xf1, 0xb5, 0x4c, 0x46, 0x93, 0x79, 0x0c, 0x37, 0x83, 0xaf, 0xf0, 0x5c,
+	0x20, 0x03, 0xd2, 0x19, 0x85, 0x06, 0x42, 0x4b, 0xe8, 0x26, 0x1c, 0x05, 0x91, 0x1f, 0x8e, 0x3a,
+	0xb8, 0xa5, 0x42, 0xc3, 0x54, 0xe2, 0xe5, 0xdd, 0x92, 0xd6, 0x5f, 0x69, 0x35, 0x7a, 0x1d, 0x0e,
+	0xfb, 0x3c, 0x66, 0x12, 0x4f, 0x5a, 0x61, 0x30, 0x08, 0x98, 0xac, 0xda, 0xa1, 0x7b, 0xa0, 0x95,
+	0x97, 0x42, 0xe7, 0xfc, 0x69, 0xc1, 0xc9, 0xa2, 0xfd, 0x19, 0x3d, 0xc6, 0x3c, 0xae, 0x80, 0x28,
+	0x7a, 0x58, 0xae, 0x11, 0xd1, 0x0d, 0x28, 0x52, 0xbf, 0xe5, 0x93, 0xc1, 0x30, 0xc4, 0x0c, 0x6b,
+	0xeb, 0x40, 0xfd, 0xba, 0xd6, 0x08, 0x1f, 0xdb, 0x1e, 0xc5, 0x61, 0x10, 0xe1, 0x96, 0xc1, 0xb0,
+	0x24, 0x46, 0xc9, 0xe8, 0x1f, 0x68, 0xac, 0x0e, 0x4c, 0x55, 0x2d, 0x1c, 0xc7, 0x84, 0x17, 0x22,
+	0x23, 0x0b, 0x71, 0x67, 0x43, 0x21, 0xa6, 0x0e, 0x9f, 0xeb, 0xed, 0xef, 0x89, 0xdd, 0xba, 0x28,
+	0xcf, 0xb5, 0xe7, 0x95, 0x14, 0x5d, 0x40, 0x9a, 0xfa, 0xf6, 0xbe, 0x04, 0xae, 0x6c, 0x00, 0xfe,
+	0x50, 0xe6, 0x79, 0x06, 0xaf, 0x10, 0xf9, 0x7e, 0xf4, 0x06, 0x94, 0x28, 0x6d, 0x3d, 0x8a, 0x03,
+	0x86, 0x69, 0x8b, 0xf2, 0x6c, 0x63, 0x3b, 0x2b, 0xa3, 0x3a, 0xa4, 0xf4, 0x53, 0xa9, 0x6d, 0x0a,
+	0x25, 0xfa, 0x18, 0x0a, 0xd3, 0x75, 0x76, 0xee, 0x3f, 0x18, 0xcd, 0x1b, 0x5c, 0xe4, 0x42, 0x4e,
+	0x57, 0xcd, 0xce, 0x4b, 0xc0, 0xda, 0x36, 0xe9, 0x69, 0xc4, 0xe4, 0x11, 0xeb, 0x37, 0x23, 0x6f,
+	0x48, 0xfb, 0x84, 0x19, 0xc2, 0x6a, 0x20, 0xe7, 0x0e, 0x14, 0xa6, 0x06, 0x05, 0x5f, 0x1f, 0xe2,
+	0x09, 0xd5, 0xa5, 0x96, 0x63, 0xc1, 0xd7, 0xf6, 0x84, 0x69, 0x7e, 0x59, 0xae, 0x12, 0x9c, 0x1f,
+	0x53, 0x50, 0x5a, 0x72, 0x77, 0x2d, 0x59, 0x2f, 0x60, 0x9f, 0x11, 0xe6, 0x29, 0xc6, 0x17, 0x6b,
+	0xa7, 0x5b, 0xd7, 0x54, 0xb9, 0xaa, 0x36, 0xa3, 0x2b, 0xc8, 0x4f, 0xa9, 0x6e, 0x6d, 0x95, 0x4e,
+	0x75, 0x04, 0x12, 0xe9, 0x34, 0x28, 0xce, 0x00, 0x4a, 0x4b, 0x4b, 0xd6, 0x35, 0x0e, 0x74, 0x0e,
+	0x19, 0xca, 0xe7, 0xff, 0x65, 0x04, 0x72, 0x2f, 0x6f, 0xe3, 0xd7, 0x56, 0xd3, 0x55, 0xa4, 0x58,
+	0x14, 0xc3, 0xe4, 0x4d, 0x09, 0x42, 0x2b, 0xcf, 0x82, 0x69, 0x14, 0x52, 0x70, 0x7e, 0x4a, 0xf3,
+	0x3e, 0x9e, 0x2c, 0x2b, 0x7a, 0x0d, 0x0e, 0xba, 0x31, 0x19, 0xb4, 0x16, 0x4f, 0x6b, 0x51, 0xe8,
+	0xcc, 0x29, 0x9b, 0x3b, 0xcb, 0xe9, 0xc5, 0xb3, 0xfc, 0x12, 0x14, 0x58, 0x30, 0xc0, 0xad, 0x51,
+	0xc4, 0x23, 0x57, 0x67, 0x34, 0x2f, 0x14, 0x9f, 0x70, 0x79, 0xf9, 0xa0, 0x67, 0x12, 0x07, 0xfd,
+	0x0a, 0x72, 0xaa, 0xd2, 0x54, 0x1f, 0xae, 0xb3, 0xed, 0x79, 0xae, 0xa2, 0x30, 0xa4, 0xd4, 0x30,
+	0xa8, 0x0b, 0x47, 0x8c, 0x0c, 0xb9, 0xcd, 0x88, 0xc5, 0x41, 0x7b, 0xc4, 0x44, 0x43, 0xc8, 0x6e,
+	0xdf, 0x10, 0x14, 0x68, 0x7d, 0xb6, 0x5b, 0xe3, 0x97, 0x38, 0xe8, 0x9c, 0x96, 0x3a, 0x3f, 0xa4,
+	0xe0, 0xf9, 0x84, 0x33, 0xcf, 0x98, 0xc7, 0xef, 0x43, 0xb6, 0x27, 0xed, 0xc8, 0x44, 0xef, 0x0e,
+	0xa3, 0x77, 0x3b, 0xdf, 0xa5, 0x34, 0x9f, 0x12, 0xd1, 0xae, 0x0d, 0xc0, 0x5c, 0x47, 0xe9, 0xb9,
+	0xeb, 0xe8, 0xff, 0x72, 0xe7, 0x3e, 0xbc, 0xc8, 0x2f, 0x90, 0x8f, 0xea, 0x75, 0xe1, 0x08, 0x8e,
+	0x18, 0xa7, 0x95, 0xb9, 0xc4, 0xc4, 0x8d, 0x27, 0x6f, 0x9e, 0x94, 0xbc, 0x79, 0x94, 0x20, 0x1e,
+	0x12, 0xb2, 0xbe, 0x9e, 0xcf, 0xb4, 0x43, 0x53, 0xd9, 0xf9, 0x25, 0x0d, 0x76, 0x12, 0x4d, 0x5f,
+	0x49, 0x9c, 0x8f, 0x92, 0xe9, 0x7d, 0x1c, 0xf4, 0xfa, 0x4c, 0x13, 0x1d, 0x84, 0xea, 0x03, 0xa9,
+	0x91, 0x6c, 0x26, 0x66, 0x3a, 0xad, 0xd9, 0x4c, 0xf4, 0x24, 0xcf, 0x4d, 0x3b, 0x24, 0xfe, 0x43,
+	0xaa, 0x79, 0xae, 0x25, 0x74, 0x04, 0x16, 0x7b, 0x4c, 0x25, 0xbb, 0x2d, 0x57, 0x0c, 0xc5, 0xca,
+	0x18, 0xc7, 0xa3, 0x48, 0xb0, 0x5a, 0xae, 0x54, 0x12, 0x7a, 0x19, 0x0a, 0xdc, 0xd1, 0x6e, 0x18,
+	0xf8, 0x8c, 0xea, 0xd6, 0x3f, 0x53, 0xf0, 0xb7, 0x84, 0x6a, 0xa1, 0xaa, 0xe3, 0x57, 0x37, 0x64,
+	0x93, 0x47, 0x78, 0x1f, 0x4f, 0x66, 0x41, 0x9a, 0x86, 0x21, 0x3b, 0xef, 0x67, 0xd2, 0x90, 0xcc,
+	0x08, 0xd5, 0x0d, 0xff, 0xed, 0xcd, 0x78, 0x75, 0xbd, 0x25, 0x01, 0x3a, 0x03, 0x73, 0xbe, 0x4d,
+	0xc1, 0xd1, 0xb2, 0xe9, 0x35, 0x5d, 0x88, 0xe7, 0x85, 0x3b, 0xa3, 0x2b, 0x24, 0x86, 0x0b, 0x85,
+	0xb3, 0x16, 0x0b, 0x27, 0x18, 0x46, 0x43, 0xc2, 0x64, 0x1a, 0x39, 0xc3, 0xc4, 0x78, 0x31, 0x5f,
+	0xfb, 0x4b, 0xf9, 0x72, 0x30, 0xbc, 0xb0, 0xd2, 0xe9, 0x05, 0x33, 0xa9, 0x25, 0x33, 0x0b, 0x90,
+	0xe9, 0xe5, 0x12, 0x98, 0x5b, 0xcc, 0x9a, 0xdd, 0x62, 0xb5, 0xbf, 0x32, 0x70, 0xf0, 0xae, 0xc8,
+	0x52, 0x13, 0xc7, 0xe3, 0x80, 0x5f, 0xcf, 0x63, 0x28, 0xce, 0x3d, 0x87, 0xd1, 0x5b, 0x9b, 0x68,
+	0x9f, 0x78, 0xd7, 0x96, 0x6b, 0xbb, 0x6c, 0x51, 0xdc, 0x75, 0xf6, 0x84, 0xdd, 0xc6, 0x0e, 0x76,
+	0x1b, 0xbb, 0xdb, 0x6d, 0xac, 0xb3, 0x3b, 0xf7, 0xf4, 0xdd, 0x68, 0x37, 0xf9, 0xa8, 0xde, 0x68,
+	0x77, 0xc5, 0xcb, 0x9a, 0xdb, 0x9d, 0xc0, 0xc1, 0xfc, 0xc3, 0x12, 0x6d, 0xe1, 0xfd, 0xf2, 0x2b,
+	0xb8, 0x7c, 0x7b, 0xa7, 0x3d, 0x53, 0xd3, 0xdf, 0x70, 0x96, 0x2f, 0x77, 0x11, 0x74, 0x77, 0x33,
+	0xd6, 0xaa, 0x26, 0x56, 0x7e, 0x67, 0xe7, 0x7d, 0xc6, 0x8f, 0xf3, 0xc6, 0x93, 0xdf, 0xaf, 0xa7,
+	0x9e, 0xf2, 0xef, 0x37, 0xfe, 0x7d, 0xff, 0xc7, 0xf5, 0xbd, 0xa7, 0xfc, 0xfb, 0x99, 0x7f, 0x9f,
+	0xdf, 0xea, 0x05, 0xac, 0x3f, 0x6a, 0x57, 0xf8, 0xe5, 0x5a, 0xe5, 0x90, 0xb7, 0x0c, 0xbe, 0x14,
+	0xa4, 0x01, 0xfd, 0x1b, 0xc9, 0x26, 0x43, 0x4c, 0xdb, 0x59, 0x39, 0x7f, 0xfb, 0x6f, 0x54, 0xbd,
+	0xc2, 0xf4, 0x60, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// SetLogLevel changes the log level for loggers matching a pattern.
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// GetLogLevel returns the current log level for a specific logger.
+	GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error)
+	// ListLoggers returns all registered loggers and their current levels.
+	ListLoggers(ctx context.Context, in *ListLoggersRequest, opts ...grpc.CallOption) (*ListLoggersResponse, error)
+	// GetStateSize returns the per-module state sizes maintained at every commit, and recent growth
+	// snapshots. Requires state-commit.sc-state-size-enable.
+	GetStateSize(ctx context.Context, in *GetStateSizeRequest, opts ...grpc.CallOption) (*GetStateSizeResponse, error)
+	// GetOCCContention returns the keys behind OCC validation failures over the profiler's window of
+	// recent blocks, attributed to contracts for EVM storage. Requires occ_profiler.enabled.
+	GetOCCContention(ctx context.Context, in *GetOCCContentionRequest, opts ...grpc.CallOption) (*GetOCCContentionResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewAdminServiceClient(cc grpc1.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetLogLevel(ctx context.Context, in *GetLogLevelRequest, opts ...grpc.CallOption) (*GetLogLevelResponse, error) {
+	out := new(GetLogLevelResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/GetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListLoggers(ctx context.Context, in *ListLoggersRequest, opts ...grpc.CallOption) (*ListLoggersResponse, error) {
+	out := new(ListLoggersResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/ListLoggers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetStateSize(ctx context.Context, in *GetStateSizeRequest, opts ...grpc.CallOption) (*GetStateSizeResponse, error) {
+	out := new(GetStateSizeResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/GetStateSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetOCCContention(ctx context.Context, in *GetOCCContentionRequest, opts ...grpc.CallOption) (*GetOCCContentionResponse, error) {
+	out := new(GetOCCContentionResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.admin.v0.AdminService/GetOCCContention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	// SetLogLevel changes the log level for loggers matching a pattern.
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// GetLogLevel returns the current log level for a specific logger.
+	GetLogLevel(context.Context, *GetLogLevelRequest) (*GetLogLevelResponse, error)
+	// ListLoggers returns all registered loggers and their current levels.
+	ListLoggers(context.Context, *ListLoggersRequest) (*ListLoggersResponse, error)
+	// GetStateSize returns the per-module state sizes maintained at every commit, and recent growth
+	// snapshots. Requires state-commit.sc-state-size-enable.
+	GetStateSize(context.Context, *GetStateSizeRequest) (*GetStateSizeResponse, error)
+	// GetOCCContention returns the keys behind OCC validation failures over the profiler's window of
+	// recent blocks, attributed to contracts for EVM storage. Requires occ_profiler.enabled.
+	GetOCCContention(context.Context, *GetOCCContentionRequest) (*GetOCCContentionResponse, error)
+}
+
+// UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (*UnimplementedAdminServiceServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetLogLevel(ctx context.Context, req *GetLogLevelRequest) (*GetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevel not implemented")
+}
+func (*UnimplementedAdminServiceServer) ListLoggers(ctx context.Context, req *ListLoggersRequest) (*ListLoggersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoggers not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetStateSize(ctx context.Context, req *GetStateSizeRequest) (*GetStateSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateSize not implemented")
+}
+func (*UnimplementedAdminServiceServer) GetOCCContention(ctx context.Context, req *GetOCCContentionRequest) (*GetOCCContentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOCCContention not implemented")
+}
+
+func RegisterAdminServiceServer(s grpc1.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/GetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetLogLevel(ctx, req.(*GetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListLoggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoggersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListLoggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/ListLoggers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListLoggers(ctx, req.(*ListLoggersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetStateSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetStateSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/GetStateSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetStateSize(ctx, req.(*GetStateSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetOCCContention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOCCContentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetOCCContention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.admin.v0.AdminService/GetOCCContention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetOCCContention(ctx, req.(*GetOCCContentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "seiprotocol.seichain.admin.v0.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetLogLevel",
+			Handler:    _AdminService_GetLogLevel_Handler,
+		},
+		{
+			MethodName: "ListLoggers",
+			Handler:    _AdminService_ListLoggers_Handler,
+		},
+		{
+			MethodName: "GetStateSize",
+			Handler:    _AdminService_GetStateSize_Handler,
+		},
+		{
+			MethodName: "GetOCCContention",
+			Handler:    _AdminService_GetOCCContention_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "sei/admin/v0/admin.proto",
+}
+
+func (m *SetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetLogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Affected != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Affected))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLogLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLogLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLogLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logger) > 0 {
+		i -= len(m.Logger)
+		copy(dAtA[i:], m.Logger)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Logger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLogLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLogLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLogLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Logger) > 0 {
+		i -= len(m.Logger)
+		copy(dAtA[i:], m.Logger)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Logger)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLoggersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLoggersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLoggersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListLoggersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListLoggersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListLoggersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Loggers) > 0 {
+		for iNdEx := len(m.Loggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LoggerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoggerInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LoggerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStateSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStateSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryLimit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.HistoryLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IncludePrefixes {
+		i--
+		if m.IncludePrefixes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetStateSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetStateSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetStateSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SsWrites) > 0 {
+		for iNdEx := len(m.SsWrites) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SsWrites[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SsWritesSince != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.SsWritesSince))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Sc) > 0 {
+		for iNdEx := len(m.Sc) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sc[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BaselineErrors) > 0 {
+		for iNdEx := len(m.BaselineErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaselineErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BaselineVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BaselineVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScComplete {
+		i--
+		if m.ScComplete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Keys != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleStateSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleStateSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleStateSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Prefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrefixStateSize) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrefixStateSize) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrefixStateSize) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Size_.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateSizeBaselineError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateSizeBaselineError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateSizeBaselineError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateGrowthSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateGrowthSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateGrowthSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopContributors) > 0 {
+		for iNdEx := len(m.TopContributors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TopContributors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Modules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ScComplete {
+		i--
+		if m.ScComplete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimeUnix != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.TimeUnix))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleStateGrowth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleStateGrowth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleStateGrowth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Growth.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateGrowthContributor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateGrowthContributor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateGrowthContributor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Growth.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAdmin(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOCCContentionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOCCContentionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOCCContentionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetOCCContentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOCCContentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOCCContentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Conflicts != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Reruns != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Reruns))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Txs != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCCKeyContention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCCKeyContention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCCKeyContention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Conflicts != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Slot) > 0 {
+		i -= len(m.Slot)
+		copy(dAtA[i:], m.Slot)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Slot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Store) > 0 {
+		i -= len(m.Store)
+		copy(dAtA[i:], m.Store)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Store)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OCCContractContention) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCCContractContention) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCCContractContention) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keys != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Keys))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Conflicts != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Conflicts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *SetLogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Affected != 0 {
+		n += 1 + sovAdmin(uint64(m.Affected))
+	}
+	return n
+}
+
+func (m *GetLogLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Logger)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLogLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Logger)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLoggersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *ListLoggersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loggers) > 0 {
+		for _, e := range m.Loggers {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LoggerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetStateSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.IncludePrefixes {
+		n += 2
+	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovAdmin(uint64(m.HistoryLimit))
+	}
+	return n
+}
+
+func (m *GetStateSizeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	if m.ScComplete {
+		n += 2
+	}
+	if m.BaselineVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.BaselineVersion))
+	}
+	if len(m.BaselineErrors) > 0 {
+		for _, e := range m.BaselineErrors {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Sc) > 0 {
+		for _, e := range m.Sc {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.SsWritesSince != 0 {
+		n += 1 + sovAdmin(uint64(m.SsWritesSince))
+	}
+	if len(m.SsWrites) > 0 {
+		for _, e := range m.SsWrites {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keys != 0 {
+		n += 1 + sovAdmin(uint64(m.Keys))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovAdmin(uint64(m.Bytes))
+	}
+	return n
+}
+
+func (m *ModuleStateSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	if len(m.Prefixes) > 0 {
+		for _, e := range m.Prefixes {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrefixStateSize) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Size_.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *StateSizeBaselineError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *StateGrowthSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromVersion != 0 {
+		n += 1 + sovAdmin(uint64(m.FromVersion))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAdmin(uint64(m.Version))
+	}
+	if m.TimeUnix != 0 {
+		n += 1 + sovAdmin(uint64(m.TimeUnix))
+	}
+	if m.ScComplete {
+		n += 2
+	}
+	if len(m.Modules) > 0 {
+		for _, e := range m.Modules {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.TopContributors) > 0 {
+		for _, e := range m.TopContributors {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleStateGrowth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	l = m.Growth.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *StateGrowthContributor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = m.Growth.Size()
+	n += 1 + l + sovAdmin(uint64(l))
+	return n
+}
+
+func (m *GetOCCContentionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovAdmin(uint64(m.Limit))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
+func (m *GetOCCContentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovAdmin(uint64(m.ToHeight))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovAdmin(uint64(m.Blocks))
+	}
+	if m.Txs != 0 {
+		n += 1 + sovAdmin(uint64(m.Txs))
+	}
+	if m.Reruns != 0 {
+		n += 1 + sovAdmin(uint64(m.Reruns))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovAdmin(uint64(m.Conflicts))
+	}
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OCCKeyContention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Store)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Slot)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovAdmin(uint64(m.Conflicts))
+	}
+	return n
+}
+
+func (m *OCCContractContention) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Conflicts != 0 {
+		n += 1 + sovAdmin(uint64(m.Conflicts))
+	}
+	if m.Keys != 0 {
+		n += 1 + sovAdmin(uint64(m.Keys))
+	}
+	return n
+}
+
+func sovAdmin(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAdmin(x uint64) (n int) {
+	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+			}
+			m.Affected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Affected |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLogLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLogLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLogLevelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLogLevelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListLoggersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListLoggersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListLoggersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListLoggersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListLoggersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListLoggersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loggers = append(m.Loggers, LoggerInfo{})
+			if err := m.Loggers[len(m.Loggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoggerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoggerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoggerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStateSizeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateSizeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateSizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePrefixes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludePrefixes = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			m.HistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetStateSizeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetStateSizeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetStateSizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScComplete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScComplete = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineVersion", wireType)
+			}
+			m.BaselineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaselineVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaselineErrors = append(m.BaselineErrors, StateSizeBaselineError{})
+			if err := m.BaselineErrors[len(m.BaselineErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sc = append(m.Sc, ModuleStateSize{})
+			if err := m.Sc[len(m.Sc)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SsWritesSince", wireType)
+			}
+			m.SsWritesSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SsWritesSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SsWrites", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SsWrites = append(m.SsWrites, ModuleStateSize{})
+			if err := m.SsWrites[len(m.SsWrites)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, StateGrowthSnapshot{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleStateSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleStateSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleStateSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, PrefixStateSize{})
+			if err := m.Prefixes[len(m.Prefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrefixStateSize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrefixStateSize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrefixStateSize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateSizeBaselineError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateSizeBaselineError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateSizeBaselineError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Store = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateGrowthSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateGrowthSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateGrowthSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUnix", wireType)
+			}
+			m.TimeUnix = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeUnix |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScComplete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScComplete = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, ModuleStateGrowth{})
+			if err := m.Modules[len(m.Modules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopContributors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopContributors = append(m.TopContributors, StateGrowthContributor{})
+			if err := m.TopContributors[len(m.TopContributors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleStateGrowth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleStateGrowth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleStateGrowth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Growth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Growth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *LoggerInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
-	}
-	l = len(m.Level)
-	if l > 0 {
-		n += 1 + l + sovAdmin(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAdmin(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAdmin(x uint64) (n int) {
-	return sovAdmin(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
+func (m *StateGrowthContributor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -982,15 +4932,15 @@ func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetLogLevelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateGrowthContributor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateGrowthContributor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1018,11 +4968,11 @@ func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1050,7 +5000,40 @@ func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Growth", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Growth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1073,7 +5056,7 @@ func (m *SetLogLevelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOCCContentionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1096,15 +5079,34 @@ func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetLogLevelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOCCContentionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOCCContentionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1132,13 +5134,177 @@ func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetOCCContentionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetOCCContentionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetOCCContentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reruns", wireType)
+			}
+			m.Reruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reruns |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
+			}
+			m.Conflicts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Conflicts |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1148,29 +5314,31 @@ func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.Keys = append(m.Keys, OCCKeyContention{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Affected", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
 			}
-			m.Affected = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1180,11 +5348,26 @@ func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Affected |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, OCCContractContention{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1206,7 +5389,7 @@ func (m *SetLogLevelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLogLevelRequest) Unmarshal(dAtA []byte) error {
+func (m *OCCKeyContention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1229,15 +5412,15 @@ func (m *GetLogLevelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogLevelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCCKeyContention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCCKeyContention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1265,61 +5448,11 @@ func (m *GetLogLevelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logger = string(dAtA[iNdEx:postIndex])
+			m.Store = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLogLevelResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogLevelResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1347,11 +5480,11 @@ func (m *GetLogLevelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logger = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1379,61 +5512,11 @@ func (m *GetLogLevelResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListLoggersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListLoggersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLoggersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1461,63 +5544,13 @@ func (m *ListLoggersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			m.Slot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListLoggersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListLoggersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListLoggersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Loggers", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			var msglen int
+			m.Conflicts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1527,26 +5560,11 @@ func (m *ListLoggersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Conflicts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Loggers = append(m.Loggers, LoggerInfo{})
-			if err := m.Loggers[len(m.Loggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1568,7 +5586,7 @@ func (m *ListLoggersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoggerInfo) Unmarshal(dAtA []byte) error {
+func (m *OCCContractContention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1591,15 +5609,15 @@ func (m *LoggerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoggerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCCContractContention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoggerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCCContractContention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1627,13 +5645,13 @@ func (m *LoggerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflicts", wireType)
 			}
-			var stringLen uint64
+			m.Conflicts = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -1643,24 +5661,30 @@ func (m *LoggerInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Conflicts |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAdmin
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Keys = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keys |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Level = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -284,7 +284,7 @@ func (app *App) DeliverTxBatch(ctx sdk.Context, req sdk.DeliverTxBatchRequest) (
 	}
 
 	// avoid overhead for empty batches
-	scheduler := tasks.NewScheduler(app.ConcurrencyWorkers(), app.TracingInfo, app.DeliverTx)
+	scheduler := tasks.NewScheduler(app.ConcurrencyWorkers(), app.TracingInfo, app.DeliverTx, tasks.WithConflictPredictor(app.ConflictPredictor()), tasks.WithConflictProfiler(app.ConflictProfiler()))
 	txRes, err := scheduler.ProcessAll(ctx, req.TxEntries)
 	if err != nil {
 		logger.Error("error while processing scheduler", "err", err)
@@ -315,6 +315,11 @@ func (app *App) Commit(ctx context.Context) (res *abci.ResponseCommit, err error
 		if n, ok := app.blockHeaderNotifier.Get(); ok {
 			n.PublishStashed()
 		}
+		// Blob sidecars are staged by EndBlock, which also runs for blocks
+		// that are never committed; store them only once their block is.
+		if err := app.EvmKeeper.BlobSidecarStore().PutFinalized(); err != nil {
+			logger.Error("failed to store blob sidecars", "err", err)
+		}
 	}
 	return res, err
 }
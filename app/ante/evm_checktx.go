@@ -7,7 +7,6 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -39,7 +38,7 @@ func EvmCheckTxAnte(
 	ek *evmkeeper.Keeper,
 ) (returnCtx sdk.Context, returnErr error) {
 	chainID := ek.ChainID(ctx)
-	if err := EvmStatelessChecks(ctx, tx, chainID); err != nil {
+	if err := EvmStatelessChecks(ctx, tx, chainID, ek.BlobTxsEnabled(ctx)); err != nil {
 		return ctx, err
 	}
 	msg := tx.GetMsgs()[0].(*evmtypes.MsgEVMTransaction)
@@ -50,6 +49,9 @@ func EvmCheckTxAnte(
 		return HandleAssociateTx(ctx, ek, atx, true)
 	}
 	etx := ethtypes.NewTx(txData.AsEthereumData())
+	if err := evmante.ValidateBlobTx(ctx, ek, etx); err != nil {
+		return ctx, err
+	}
 	evmAddr, seiAddr, seiPubkey, version, err := CheckAndDecodeSignature(ctx, txData, chainID, false)
 	if err != nil {
 		return ctx, err
@@ -75,7 +77,9 @@ func EvmCheckTxAnte(
 	return DecorateContext(ctx, ek, tx, txData, etx, evmAddr, seiAddr), nil
 }
 
-func EvmStatelessChecks(ctx sdk.Context, tx sdk.Tx, chainID *big.Int) error {
+// EvmStatelessChecks runs the checks that need no account state. Blob transactions are
+// rejected here while blobTxsEnabled is false.
+func EvmStatelessChecks(ctx sdk.Context, tx sdk.Tx, chainID *big.Int, blobTxsEnabled bool) error {
 	if err := evmante.ValidateNoCosmosTxFields(tx); err != nil {
 		return err
 	}
@@ -118,7 +122,7 @@ func EvmStatelessChecks(ctx sdk.Context, tx sdk.Tx, chainID *big.Int) error {
 		return core.ErrIntrinsicGas
 	}
 
-	if etx.Type() == ethtypes.BlobTxType {
+	if etx.Type() == ethtypes.BlobTxType && !blobTxsEnabled {
 		return sdkerrors.ErrUnsupportedTxType
 	}
 
@@ -292,9 +296,7 @@ func EvmCheckAndChargeFees(ctx sdk.Context, sender common.Address, ek *evmkeeper
 	}
 	ethCfg := evmtypes.DefaultChainConfig().EthereumConfig(ek.ChainID(ctx))
 	if version >= derived.Cancun && len(txData.GetBlobHashes()) > 0 {
-		// For now we are simply assuming excessive blob gas is 0. In the future we might change it to be
-		// dynamic based on prior block usage.
-		if txData.GetBlobFeeCap().Cmp(eip4844.CalcBlobFee(ethCfg, &ethtypes.Header{Time: uint64(ctx.BlockTime().Unix())})) < 0 { // nolint:gosec
+		if txData.GetBlobFeeCap().Cmp(ek.GetBlobBaseFee(ctx)) < 0 {
 			return nil, sdkerrors.ErrInsufficientFee
 		}
 	}
@@ -48,6 +48,6 @@ func TestEvmStatelessChecksRejectsEmptySetCodeAuthList(t *testing.T) {
 	msg, err := evmtypes.NewMsgEVMTransaction(setCodeTx)
 	require.NoError(t, err)
 
-	err = EvmStatelessChecks(sdk.Context{}, evmStatelessCheckTx{msgs: []sdk.Msg{msg}}, big.NewInt(1))
+	err = EvmStatelessChecks(sdk.Context{}, evmStatelessCheckTx{msgs: []sdk.Msg{msg}}, big.NewInt(1), false)
 	require.ErrorContains(t, err, "auth list cannot be empty")
 }
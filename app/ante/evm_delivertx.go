@@ -10,6 +10,7 @@ import (
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
 	upgradekeeper "github.com/sei-protocol/sei-chain/sei-cosmos/x/upgrade/keeper"
+	evmante "github.com/sei-protocol/sei-chain/x/evm/ante"
 	"github.com/sei-protocol/sei-chain/x/evm/derived"
 	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
 	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
@@ -25,7 +26,7 @@ func EvmDeliverTxAnte(
 ) (returnCtx sdk.Context, returnErr error) {
 	ctx = ctx.WithDeliverTxCallback(func(sdk.Context) {})
 	chainID := ek.ChainID(ctx)
-	if err := EvmStatelessChecks(ctx, tx, chainID); err != nil {
+	if err := EvmStatelessChecks(ctx, tx, chainID, ek.BlobTxsEnabled(ctx)); err != nil {
 		return ctx, err
 	}
 	msg := tx.GetMsgs()[0].(*evmtypes.MsgEVMTransaction)
@@ -35,6 +36,9 @@ func EvmDeliverTxAnte(
 		return HandleAssociateTx(ctx, ek, atx, false)
 	}
 	etx := ethtypes.NewTx(txData.AsEthereumData())
+	if err := evmante.ValidateBlobTx(ctx, ek, etx); err != nil {
+		return ctx, err
+	}
 	evmAddr, seiAddr, version, err := EvmDeliverHandleSignatures(ctx, ek, txData, chainID, msg)
 	if err != nil {
 		return ctx, err
@@ -99,7 +103,13 @@ func EvmDeliverChargeFees(ctx sdk.Context, ek *evmkeeper.Keeper, upgradeKeeper *
 	if err != nil {
 		return err
 	}
-	return ek.AddAnteSurplus(ctx, etx.Hash(), surplus)
+	if err := ek.AddAnteSurplus(ctx, etx.Hash(), surplus); err != nil {
+		return err
+	}
+	if etx.Type() == ethtypes.BlobTxType {
+		return ek.RecordBlobTx(ctx, etx)
+	}
+	return nil
 }
 
 func DecorateNonceCallback(ctx sdk.Context, ek *evmkeeper.Keeper, evmAddr common.Address, txNonce uint64) sdk.Context {
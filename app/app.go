@@ -95,6 +95,7 @@ import (
 	upgradekeeper "github.com/sei-protocol/sei-chain/sei-cosmos/x/upgrade/keeper"
 	upgradetypes "github.com/sei-protocol/sei-chain/sei-cosmos/x/upgrade/types"
 	seidb "github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
 	"github.com/sei-protocol/seilog"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
@@ -149,6 +150,7 @@ import (
 	"github.com/sei-protocol/sei-chain/x/evm/querier"
 	"github.com/sei-protocol/sei-chain/x/evm/replay"
 	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
 	"github.com/sei-protocol/sei-chain/x/mint"
 	mintclient "github.com/sei-protocol/sei-chain/x/mint/client/cli"
 	mintkeeper "github.com/sei-protocol/sei-chain/x/mint/keeper"
@@ -757,6 +759,13 @@ func New(
 			}
 		}
 	}
+	if app.evmRPCConfig.BlobSidecarRetention > 0 {
+		sidecarStore, dbErr := evmkeeper.NewBlobSidecarStore(homePath, app.evmRPCConfig.BlobSidecarRetention)
+		if dbErr != nil {
+			panic(fmt.Sprintf("failed to open blob sidecar store: %s", dbErr))
+		}
+		app.EvmKeeper.SetBlobSidecarStore(sidecarStore)
+	}
 	app.adminConfig, err = admin.ReadConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error reading admin config due to %s", err))
@@ -776,12 +785,14 @@ func New(
 		panic(fmt.Sprintf("error reading eth block test config due to %s", err))
 	}
 	app.EvmKeeper.EthBlockTestConfig = ethBlockTestConfig
-	if ethReplayConfig.Enabled {
+	// an offline replay has no endpoint and sets its own block source
+	if ethReplayConfig.Enabled && ethReplayConfig.EthRPC != "" {
 		rpcclient, err := ethrpc.Dial(ethReplayConfig.EthRPC)
 		if err != nil {
 			panic(fmt.Sprintf("error dialing %s due to %s", ethReplayConfig.EthRPC, err))
 		}
 		app.EvmKeeper.EthClient = ethclient.NewClient(rpcclient)
+		app.EvmKeeper.ReplayBlocks = replay.NewRPCBlockSource(app.EvmKeeper.EthClient)
 	}
 
 	app.GigaEvmKeeper = *gigaevmkeeper.NewKeeper(keys[evmtypes.StoreKey],
@@ -828,6 +839,22 @@ func New(
 	}
 	app.genesisImportConfig = genesisImportConfig
 
+	occPredictionConfig, err := ReadOCCPredictionConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error reading occ prediction config due to %s", err))
+	}
+	conflictPredictor, err := NewOCCConflictPredictor(occPredictionConfig)
+	if err != nil {
+		panic(fmt.Sprintf("error reading occ prediction hot keys due to %s", err))
+	}
+	app.SetConflictPredictor(conflictPredictor)
+
+	occProfilerConfig, err := ReadOCCProfilerConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error reading occ profiler config due to %s", err))
+	}
+	app.SetConflictProfiler(NewOCCConflictProfiler(occProfilerConfig))
+
 	epochModule := epochmodule.NewAppModule(appCodec, app.EpochKeeper, app.AccountKeeper, app.BankKeeper)
 
 	// register the proposal types
@@ -1107,6 +1134,10 @@ func (app *App) HandleClose() error {
 	if ts := app.EvmKeeper.TraceSnapshotStore(); ts != nil {
 		ts.Close()
 	}
+	if err := app.EvmKeeper.BlobSidecarStore().Close(); err != nil {
+		logger.Error("failed to close blob sidecar store", "err", err)
+		errs = append(errs, fmt.Errorf("failed to close blob sidecar store: %w", err))
+	}
 
 	// Close receipt store
 	if app.receiptStore != nil {
@@ -1384,6 +1415,7 @@ func (app *App) FinalizeBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock)
 			appMetrics.optimisticProcessing.Add(ctx.Context(), 1,
 				otelmetric.WithAttributes(attribute.Bool("enabled", true)))
 			app.SetProcessProposalStateToCommit()
+			app.EvmKeeper.BlobSidecarStore().Finalize(req.Hash)
 			if app.EvmKeeper.EthReplayConfig.Enabled || app.EvmKeeper.EthBlockTestConfig.Enabled {
 				return &abci.ResponseFinalizeBlock{}, nil
 			}
@@ -1415,6 +1447,7 @@ func (app *App) FinalizeBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock)
 	}
 
 	app.SetDeliverStateToCommit()
+	app.EvmKeeper.BlobSidecarStore().Finalize(req.Hash)
 	if app.EvmKeeper.EthReplayConfig.Enabled || app.EvmKeeper.EthBlockTestConfig.Enabled {
 		return &abci.ResponseFinalizeBlock{}, nil
 	}
@@ -1509,8 +1542,10 @@ func (app *App) ProcessTxsSynchronousGiga(ctx sdk.Context, txs [][]byte, typedTx
 		// Matches V2's recover behavior in legacyabci/deliver_tx.go.
 		var result *abci.ExecTxResult
 		var execErr error
-		// fallbackToV2: store-iteration panic; re-run this tx via v2 to match v2.
-		var fallbackToV2 bool
+		// Params are only read for blob txs, which giga rejects like v2 until they are enabled.
+		blobTxsEnabled := isBlobTx(evmMsg) && app.EvmKeeper.BlobTxsEnabled(ctx)
+		// fallbackToV2: store-iteration panic or enabled blob tx; re-run this tx via v2 to match v2.
+		fallbackToV2, fallbackReason := blobTxsEnabled, gigaFallbackBlobTx
 		// IIFE (immediately-invoked function) to scope defer/recover to this tx only,
 		// allowing the loop to continue processing subsequent transactions after a panic.
 		func() {
@@ -1527,7 +1562,7 @@ func (app *App) ProcessTxsSynchronousGiga(ctx sdk.Context, txs [][]byte, typedTx
 					}
 					// Store-iteration panic: giga can't handle this tx; fall back to v2 (mirrors makeGigaDeliverTx).
 					if err, ok := r.(error); ok && errors.Is(err, gigastore.ErrIteratorUnsupported) {
-						fallbackToV2 = true
+						fallbackToV2, fallbackReason = true, gigaFallbackStoreIterator
 						return
 					}
 					// For other panics (e.g., nil deref from malformed protobuf), log and return ErrPanic
@@ -1539,10 +1574,13 @@ func (app *App) ProcessTxsSynchronousGiga(ctx sdk.Context, txs [][]byte, typedTx
 					}
 				}
 			}()
+			if fallbackToV2 {
+				return
+			}
 
 			// Validate Cosmos SDK envelope (memo, timeoutHeight, signerInfos, etc.)
 			// This prevents consensus divergence if a malicious proposer includes invalid envelope fields.
-			if err := appante.EvmStatelessChecks(ctx, typedTxs[i], cache.chainID); err != nil {
+			if err := appante.EvmStatelessChecks(ctx, typedTxs[i], cache.chainID, blobTxsEnabled); err != nil {
 				codespace, code, log := sdkerrors.ABCIInfo(err, false)
 				result = &abci.ExecTxResult{
 					Codespace: codespace,
@@ -1555,12 +1593,12 @@ func (app *App) ProcessTxsSynchronousGiga(ctx sdk.Context, txs [][]byte, typedTx
 			result, execErr = app.executeEVMTxWithGigaExecutor(ctx, evmMsg, cache)
 		}()
 
-		// Store-iteration panic: re-run via v2 so the result matches v2 exactly.
+		// Store-iteration panic or enabled blob tx: re-run via v2 so the result matches v2 exactly.
 		if fallbackToV2 {
 			utilmetrics.IncrGigaFallbackToV2Counter() // TODO(PLT-327): remove once app_giga_fallback_to_v2_total verified
 			appMetrics.gigaFallback.Add(ctx.Context(), 1,
 				otelmetric.WithAttributes(
-					attribute.String("reason", gigaFallbackStoreIterator),
+					attribute.String("reason", fallbackReason),
 					attribute.String("scope", "tx")))
 			res := app.DeliverTxWithResult(ctx, tx, typedTxs[i])
 			txResults[i] = res
@@ -1932,9 +1970,22 @@ const (
 	gigaFallbackSelfDestruct      = "self_destruct"
 	gigaFallbackInvalidPrecompile = "invalid_precompile"
 	gigaFallbackStoreIterator     = "store_iterator"
+	gigaFallbackBlobTx            = "blob_tx"
 	gigaFallbackOther             = "other"
 )
 
+// isBlobTx reports whether msg carries an EIP-4844 blob transaction. Blob gas
+// accounting and sidecar checks live in the v2 ante handler, so once blob
+// transactions are enabled giga hands them to v2.
+func isBlobTx(msg *evmtypes.MsgEVMTransaction) bool {
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return false
+	}
+	_, ok := txData.(*ethtx.BlobTx)
+	return ok
+}
+
 // gigaFallbackReason labels the app_giga_fallback_to_v2 metric with which
 // abort sentinel routed a tx to v2, so operators can tell a validation-failure
 // wave from balance-migration churn.
@@ -2225,10 +2276,20 @@ func (app *App) makeGigaDeliverTx(cache *gigaBlockCache) func(sdk.Context, abci.
 		if evmMsg == nil {
 			return abci.ResponseDeliverTx{Code: 1, Log: "not an EVM transaction"}
 		}
+		// Params are only read for blob txs, which giga rejects like v2 until they are enabled.
+		blobTxsEnabled := isBlobTx(evmMsg) && app.EvmKeeper.BlobTxsEnabled(ctx)
+		if blobTxsEnabled {
+			return abci.ResponseDeliverTx{
+				Code:      gigautils.GigaAbortCode,
+				Codespace: gigautils.GigaAbortCodespace,
+				Info:      gigaFallbackBlobTx,
+				Log:       "giga executor abort: blob tx, fall back to v2",
+			}
+		}
 
 		// Validate Cosmos SDK envelope (memo, timeoutHeight, signerInfos, etc.)
 		// This prevents consensus divergence if a malicious proposer includes invalid envelope fields.
-		if err := appante.EvmStatelessChecks(ctx, tx, cache.chainID); err != nil {
+		if err := appante.EvmStatelessChecks(ctx, tx, cache.chainID, blobTxsEnabled); err != nil {
 			codespace, code, log := sdkerrors.ABCIInfo(err, false)
 			return abci.ResponseDeliverTx{
 				Codespace: codespace,
@@ -2689,7 +2750,14 @@ func (app *App) RegisterLocalServices(node client.LocalClient, txConfig client.T
 	}
 
 	if app.adminConfig.Enabled {
-		srv, err := admin.StartServer(app.adminConfig.Address)
+		var adminOpts []admin.Option
+		if provider, ok := app.CommitMultiStore().(statesize.Provider); ok {
+			adminOpts = append(adminOpts, admin.WithStateSize(provider))
+		}
+		if profiler := app.ConflictProfiler(); profiler != nil {
+			adminOpts = append(adminOpts, admin.WithOCCProfiler(profiler))
+		}
+		srv, err := admin.StartServer(app.adminConfig.Address, adminOpts...)
 		if err != nil {
 			panic(fmt.Sprintf("failed to start admin server: %s", err))
 		}
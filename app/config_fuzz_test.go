@@ -65,7 +65,7 @@ var scKeys = []configtest.KeySpec{
 	{Key: FlagSCSnapshotWriteRateMBps, Path: "MemIAVLConfig.SnapshotWriteRateMBps", Cast: configtest.CastInt},
 	{
 		Key: FlagSCFlatKVReadWriteMetrics, Path: "FlatKVConfig.EnableReadWriteMetrics", Cast: configtest.CastBool,
-		Why: "the only [state-commit.flatkv] key the production store reads",
+		Why: "one of the two [state-commit.flatkv] keys the production store reads",
 	},
 	{Key: FlagSCHistoricalProofMaxInFlight, Path: "HistoricalProofMaxInFlight", Cast: configtest.CastInt},
 	{Key: FlagSCHistoricalProofRateLimit, Path: "HistoricalProofRateLimit", Cast: configtest.CastFloat64},
@@ -83,10 +83,28 @@ var scKeys = []configtest.KeySpec{
 		Key: FlagSCHashLoggerMaxDiskSize, Path: "HashLogger.MaxDiskSize", Cast: configtest.CastUint,
 		Why: "0 is a meaningful value (disk cap disabled) and is taken verbatim",
 	},
+	{
+		Key: FlagSCFlatKVHistoryWindow, Path: "FlatKVConfig.HistoryWindow", Cast: configtest.CastInt64,
+		Why: "default 0 (history disabled); guarded so an absent key keeps it off",
+	},
+	{
+		Key: FlagSCStateSizeEnable, Path: "StateSize.Enable", Cast: configtest.CastBool,
+		Why: "default false; each committed write costs an extra read while it is on",
+	},
+	{Key: FlagSCStateSizeDirectory, Path: "StateSize.Directory", Cast: configtest.CastString},
+	{
+		Key: FlagSCStateSizeGrowthInterval, Path: "StateSize.GrowthInterval", Cast: configtest.CastUint,
+		Why: "0 is a meaningful value (growth snapshots disabled) and is taken verbatim",
+	},
+	{
+		Key: FlagSCStateSizeGrowthRetain, Path: "StateSize.GrowthRetain", Cast: configtest.CastUint,
+		Why: "0 is a meaningful value (keep every snapshot) and is taken verbatim",
+	},
+	{Key: FlagSCStateSizeTopContributors, Path: "StateSize.TopContributors", Cast: configtest.CastUint},
 }
 
 // ssKeys is the [state-store] read-site manifest. Every row is unchecked;
-// SnapshotEnable is guarded while the legacy rows remain unguarded.
+// SnapshotEnable and MigrateToBackend are guarded while the legacy rows remain unguarded.
 //
 // StateStoreConfig also carries KeepLastVersion and UseDefaultComparer, which are
 // absent here because parseSSConfigs reads neither: they hold their in-code
@@ -120,6 +138,10 @@ var ssKeys = []configtest.KeySpec{
 	{Key: FlagEVMSSDirectory, Path: "EVMDBDirectory", Cast: configtest.CastString, Unguarded: true},
 	{Key: FlagEVMSSSeparateDBs, Path: "SeparateEVMSubDBs", Cast: configtest.CastBool, Unguarded: true},
 	{Key: FlagEVMSSSplit, Path: "EVMSplit", Cast: configtest.CastBool, Unguarded: true},
+	{
+		Key: FlagSSMigrateToBackend, Path: "MigrateToBackend", Cast: configtest.CastString,
+		Why: "guarded so app.toml files created before backend migration never start one",
+	},
 }
 
 // lightInvarianceKeys is the [light_invariance] manifest: one key, guarded and checked.
@@ -241,12 +263,14 @@ func FuzzParseSCConfigs(f *testing.F) {
 	seeds.AddRow(uint(13), fuzzing.KindString, "not-a-bool", int64(0), false) // unchecked: resolves false, no error
 	seeds.AddRow(uint(15), fuzzing.KindInt64, "", int64(0), false)            // explicit 0 taken verbatim
 
-	// Two rows default to their cast's zero, which is also what the malformed seed resolves
-	// to on an unchecked read, so neither of the per-row seeds above moves the field off the
+	// Four rows default to their cast's zero, which is also what the malformed seed resolves
+	// to on an unchecked read, so none of the per-row seeds above moves the field off the
 	// value an absent key produces. Each gets one value that converts to something else,
 	// which is what holds the reader to the key name rather than only to the cast.
 	seeds.AddRow(uint(9), fuzzing.KindBool, "", int64(0), true)         // flatkv read/write metrics on; the default is off
 	seeds.AddRow(uint(15), fuzzing.KindInt64, "", int64(100000), false) // block-count retention on; the default is 0, meaning disabled
+	seeds.AddRow(uint(17), fuzzing.KindInt64, "", int64(1000), false)   // flatkv history window on; the default is 0, meaning disabled
+	seeds.AddRow(uint(18), fuzzing.KindBool, "", int64(0), true)        // state size accounting on; the default is off
 
 	configtest.CheckEveryRowHasADiscriminatingSeed(f, "state-commit", readSC, scKeys, seeds,
 		scKeysWithTargetsOfTheirOwn...)
@@ -283,6 +307,7 @@ func FuzzParseSSConfigs(f *testing.F) {
 	seeds.AddRow(uint(6), fuzzing.KindString, "/var/lib/sei/ss", int64(0), false)
 	seeds.AddRow(uint(8), fuzzing.KindBool, "", int64(0), true) // explicit snapshot opt-in; the default is off
 	seeds.AddRow(uint(11), fuzzing.KindBoolString, "", int64(0), true)
+	seeds.AddRow(uint(12), fuzzing.KindString, "rocksdb", int64(0), false) // migrate to rocksdb; the default is no migration
 
 	// The clobber cuts both ways for the four rows below. Because the section is unguarded,
 	// an absent key resolves them to their cast's zero, and so does the malformed seed on an
@@ -652,13 +677,13 @@ func TestDefaultsMatchTheRecordedValues(t *testing.T) {
 // eleven keys are read by both, sc-write-mode and flatkv.enable-read-write-metrics among them.
 // What makes the assertion untrue is the four keys only GetConfig reads, because "every field
 // of this struct is named by scKeys" has to claim those four fields are unread. Making it pass
-// would take 62 exemptions against this 17-row manifest, four of them making that claim.
+// would take 62 exemptions against this 18-row manifest, four of them making that claim.
 //
 // Fifty-seven of the 62 sit under FlatKVConfig, so the move to reach for is exempting the
 // subtree in one line. It does not work and should not: coveredElsewhere matches a whole Dump
 // path, so "FlatKVConfig" exempts nothing, and a prefix form would give up exactly what the
 // check is for — a new state-commit.flatkv.* key added to parseSCConfigs would go unflagged,
-// and parseSCConfigs already reads one of them. The shape that would work is per reader:
+// and parseSCConfigs already reads two of them. The shape that would work is per reader:
 // CheckManifestCoversEveryField takes defaults as an any, so it can be pointed at FlatKVConfig
 // alone inside sei-cosmos/server/config with that reader's five flatkv keys as rows, leaving 53
 // exemptions that each say truthfully that the field carries no configuration key. Unbuilt.
@@ -1,11 +1,14 @@
 package app
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -22,6 +25,8 @@ import (
 	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
 	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
 	"github.com/sei-protocol/sei-chain/utils"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/replay"
 	"github.com/sei-protocol/sei-chain/x/evm/state"
 	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
@@ -58,41 +63,18 @@ func Replay(a *App) {
 			continue
 		}
 		logger.Info("replaying block height", "height", h+initHeight)
-		if h+initHeight >= 19426587 && evmtypes.DefaultChainConfig().CancunTime < 0 {
-			logger.Error("Reaching Cancun upgrade height. Turn on Cancun by setting CancunTime in x/evm/types/config.go:DefaultChainConfig() to 0")
-			break
-		} else if h+initHeight < 19426587 && evmtypes.DefaultChainConfig().CancunTime >= 0 {
-			logger.Error("Haven't reached Cancun upgrade height. Turn off Cancun by setting CancunTime in x/evm/types/config.go:DefaultChainConfig() to -1")
+		if msg := cancunMismatch(h + initHeight); msg != "" {
+			logger.Error(msg)
 			break
 		}
 		b, err := a.EvmKeeper.EthClient.BlockByNumber(context.Background(), big.NewInt(h+initHeight))
 		if err != nil {
 			panic(err)
 		}
-		a.EvmKeeper.ReplayBlock = b
-		hash := make([]byte, 8)
-		binary.BigEndian.PutUint64(hash, uint64(h)) //nolint:gosec
-		_, err = a.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{
-			Txs:               utils.Map(b.Txs, func(tx *ethtypes.Transaction) []byte { return encodeTx(tx, a.GetTxConfig()) }),
-			DecidedLastCommit: abci.CommitInfo{Votes: []abci.VoteInfo{}},
-			Hash:              hash,
-			Header: &tmproto.Header{
-				ChainID: a.ChainID,
-				Height:  h,
-				Time:    time.Now(),
-			},
-		})
+		ctx, err := executeReplayBlock(a, h, b)
 		if err != nil {
 			panic(err)
 		}
-		ctx := a.GetContextForDeliverTx([]byte{})
-		s := state.NewDBImpl(ctx, &a.EvmKeeper, false)
-		for _, w := range b.Withdrawals() {
-			amount := new(big.Int).SetUint64(w.Amount)
-			amount = amount.Mul(amount, big.NewInt(params.GWei))
-			s.AddBalance(w.Address, uint256.MustFromBig(amount), tracing.BalanceIncreaseWithdrawal)
-		}
-		_, _ = s.Finalize()
 		for _, tx := range b.Txs {
 			logger.Info("verifying tx", "tx-hash", tx.Hash())
 			if tx.To() != nil {
@@ -111,6 +93,160 @@ func Replay(a *App) {
 	}
 }
 
+// ReplayOffline replays the blocks src holds without reaching the network, and writes every account field whose
+// replayed value differs from post to report. The pre-state is pre when it is set, and is otherwise read from
+// eth_data_dir as Replay reads it. Blocks are replayed from the one after the pre-state to the last one src holds.
+func ReplayOffline(a *App, src replay.BlockSource, pre, post ethtypes.GenesisAlloc, report *replay.Report) error {
+	gendoc, err := tmtypes.GenesisDocFromFile(filepath.Join(DefaultNodeHome, "config/genesis.json"))
+	if err != nil {
+		return err
+	}
+	if _, err := a.InitChain(&abci.RequestInitChain{
+		Time:          time.Now(),
+		ChainId:       gendoc.ChainID,
+		AppStateBytes: gendoc.AppState,
+	}); err != nil {
+		return err
+	}
+	return replayOffline(a, src, pre, post, report)
+}
+
+// replayOffline is ReplayOffline on an app whose chain is initialized.
+func replayOffline(a *App, src replay.BlockSource, pre, post ethtypes.GenesisAlloc, report *replay.Report) error {
+	a.EvmKeeper.ReplayBlocks = src
+	ctx := a.GetContextForDeliverTx([]byte{})
+	for addr, account := range pre {
+		if err := setEthAccount(ctx, a, addr, account); err != nil {
+			return err
+		}
+	}
+	initHeight := a.EvmKeeper.GetReplayInitialHeight(ctx)
+	latest, err := src.LatestBlockNumber(context.Background())
+	if err != nil {
+		return err
+	}
+	var replayed uint64
+	for h := int64(1); uint64(h+initHeight) <= latest; h++ { //nolint:gosec
+		number := uint64(h + initHeight) //nolint:gosec
+		// a seeded pre-state is not mainnet, so the mainnet Cancun height does not apply to it
+		if pre == nil {
+			if msg := cancunMismatch(h + initHeight); msg != "" {
+				return errors.New(msg)
+			}
+		}
+		b, err := src.BlockByNumber(context.Background(), number)
+		if err != nil {
+			return err
+		}
+		logger.Info("replaying block height", "height", number)
+		if _, err := executeReplayBlock(a, h, b); err != nil {
+			return fmt.Errorf("failed to replay block %d: %w", number, err)
+		}
+		if _, err := a.Commit(context.Background()); err != nil {
+			return err
+		}
+		replayed++
+	}
+
+	ctx = a.GetCheckCtx()
+	addrs := make([]common.Address, 0, len(post))
+	for addr := range post {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	accounts := replayedAccounts{ctx: ctx, k: &a.EvmKeeper}
+	for _, addr := range addrs {
+		for _, m := range replay.CompareAccount(accounts, addr, post[addr]) {
+			if err := report.Add(uint64(initHeight)+replayed, m); err != nil { //nolint:gosec
+				return err
+			}
+		}
+	}
+	return report.Finish(replayed)
+}
+
+// cancunMismatch returns why replaying the mainnet block at number needs CancunTime changed, if it does.
+func cancunMismatch(number int64) string {
+	if number >= 19426587 && evmtypes.DefaultChainConfig().CancunTime < 0 {
+		return "Reaching Cancun upgrade height. Turn on Cancun by setting CancunTime in x/evm/types/config.go:DefaultChainConfig() to 0"
+	} else if number < 19426587 && evmtypes.DefaultChainConfig().CancunTime >= 0 {
+		return "Haven't reached Cancun upgrade height. Turn off Cancun by setting CancunTime in x/evm/types/config.go:DefaultChainConfig() to -1"
+	}
+	return ""
+}
+
+// executeReplayBlock finalizes the eth block b as height h and credits its withdrawals, without committing.
+func executeReplayBlock(a *App, h int64, b *ethtypes.Block) (sdk.Context, error) {
+	a.EvmKeeper.ReplayBlock = b
+	hash := make([]byte, 8)
+	binary.BigEndian.PutUint64(hash, uint64(h)) //nolint:gosec
+	_, err := a.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{
+		Txs:               utils.Map(b.Txs, func(tx *ethtypes.Transaction) []byte { return encodeTx(tx, a.GetTxConfig()) }),
+		DecidedLastCommit: abci.CommitInfo{Votes: []abci.VoteInfo{}},
+		Hash:              hash,
+		Header: &tmproto.Header{
+			ChainID: a.ChainID,
+			Height:  h,
+			Time:    time.Now(),
+		},
+	})
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	ctx := a.GetContextForDeliverTx([]byte{})
+	s := state.NewDBImpl(ctx, &a.EvmKeeper, false)
+	for _, w := range b.Withdrawals() {
+		amount := new(big.Int).SetUint64(w.Amount)
+		amount = amount.Mul(amount, big.NewInt(params.GWei))
+		s.AddBalance(w.Address, uint256.MustFromBig(amount), tracing.BalanceIncreaseWithdrawal)
+	}
+	_, _ = s.Finalize()
+	return ctx, nil
+}
+
+// setEthAccount seeds an eth account of a block test or replay pre-state.
+func setEthAccount(ctx sdk.Context, a *App, addr common.Address, account ethtypes.Account) error {
+	usei, wei := state.SplitUseiWeiAmount(account.Balance)
+	seiAddr := a.EvmKeeper.GetSeiAddressOrDefault(ctx, addr)
+	if err := a.EvmKeeper.BankKeeper().AddCoins(ctx, seiAddr, sdk.NewCoins(sdk.NewCoin("usei", usei)), true); err != nil {
+		return err
+	}
+	if err := a.EvmKeeper.BankKeeper().AddWei(ctx, seiAddr, wei); err != nil {
+		return err
+	}
+	a.EvmKeeper.SetNonce(ctx, addr, account.Nonce)
+	a.EvmKeeper.SetCode(ctx, addr, account.Code)
+	for key, value := range account.Storage {
+		a.EvmKeeper.SetState(ctx, addr, key, value)
+	}
+	return nil
+}
+
+// replayedAccounts reads replayed accounts the way VerifyBalance and VerifyAccount do.
+type replayedAccounts struct {
+	ctx sdk.Context
+	k   *evmkeeper.Keeper
+}
+
+func (r replayedAccounts) GetBalance(addr common.Address) *big.Int {
+	seiAddr := r.k.GetSeiAddressOrDefault(r.ctx, addr)
+	usei := r.k.BankKeeper().GetBalance(r.ctx, seiAddr, "usei").Amount
+	wei := r.k.BankKeeper().GetWeiBalance(r.ctx, seiAddr)
+	return usei.Mul(sdk.NewInt(1_000_000_000_000)).Add(wei).BigInt()
+}
+
+func (r replayedAccounts) GetNonce(addr common.Address) uint64 {
+	return r.k.GetNonce(r.ctx, addr)
+}
+
+func (r replayedAccounts) GetCode(addr common.Address) []byte {
+	return r.k.GetCode(r.ctx, addr)
+}
+
+func (r replayedAccounts) GetState(addr common.Address, slot common.Hash) common.Hash {
+	return r.k.GetState(r.ctx, addr, slot)
+}
+
 func BlockTest(a *App, bt *ethtests.BlockTest) {
 	a.EvmKeeper.BlockTest = bt
 	a.EvmKeeper.EthBlockTestConfig.Enabled = true
@@ -142,21 +278,9 @@ func BlockTest(a *App, bt *ethtests.BlockTest) {
 		a.EvmKeeper.SetCurrBaseFeePerGas(a.GetContextForDeliverTx([]byte{}), sdk.ZeroDec())
 	}
 	for addr, genesisAccount := range a.EvmKeeper.BlockTest.Json.Pre {
-		usei, wei := state.SplitUseiWeiAmount(genesisAccount.Balance)
-		seiAddr := a.EvmKeeper.GetSeiAddressOrDefault(a.GetContextForDeliverTx([]byte{}), addr)
-		err := a.EvmKeeper.BankKeeper().AddCoins(a.GetContextForDeliverTx([]byte{}), seiAddr, sdk.NewCoins(sdk.NewCoin("usei", usei)), true)
-		if err != nil {
+		if err := setEthAccount(a.GetContextForDeliverTx([]byte{}), a, addr, genesisAccount); err != nil {
 			panic(err)
 		}
-		err = a.EvmKeeper.BankKeeper().AddWei(a.GetContextForDeliverTx([]byte{}), a.EvmKeeper.GetSeiAddressOrDefault(a.GetContextForDeliverTx([]byte{}), addr), wei)
-		if err != nil {
-			panic(err)
-		}
-		a.EvmKeeper.SetNonce(a.GetContextForDeliverTx([]byte{}), addr, genesisAccount.Nonce)
-		a.EvmKeeper.SetCode(a.GetContextForDeliverTx([]byte{}), addr, genesisAccount.Code)
-		for key, value := range genesisAccount.Storage {
-			a.EvmKeeper.SetState(a.GetContextForDeliverTx([]byte{}), addr, key, value)
-		}
 		params := a.EvmKeeper.GetParams(a.GetContextForDeliverTx([]byte{}))
 		params.MinimumFeePerGas = sdk.NewDecFromInt(sdk.NewInt(0))
 		a.EvmKeeper.SetParams(a.GetContextForDeliverTx([]byte{}), params)
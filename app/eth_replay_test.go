@@ -0,0 +1,108 @@
+package app
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/evm/replay"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// writeTransferFixture writes an ethtests blockchain test whose only block moves value from a funded sender to
+// recipient. The fixture's post-state is post plus the sender's expected account.
+func writeTransferFixture(t *testing.T, path string, recipient common.Address, value *big.Int, post ethtypes.GenesisAlloc) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	// replay executes as Ethereum mainnet
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(1))
+	tx := ethtypes.MustSignNewTx(key, signer, &ethtypes.LegacyTx{
+		Nonce:    0,
+		GasPrice: big.NewInt(100 * params.GWei),
+		Gas:      params.TxGas,
+		To:       &recipient,
+		Value:    value,
+	})
+	header := &ethtypes.Header{
+		Number:     big.NewInt(1),
+		GasLimit:   30_000_000,
+		Time:       1,
+		Difficulty: big.NewInt(0),
+		BaseFee:    big.NewInt(params.GWei),
+	}
+	block := ethtypes.NewBlock(header, &ethtypes.Body{Transactions: []*ethtypes.Transaction{tx}}, nil, trie.NewStackTrie(nil))
+	bz, err := rlp.EncodeToBytes(block)
+	require.NoError(t, err)
+
+	funds := big.NewInt(5 * params.Ether)
+	fee := big.NewInt(int64(100 * params.GWei * params.TxGas))
+	post[sender] = ethtypes.Account{
+		Balance: new(big.Int).Sub(new(big.Int).Sub(funds, value), fee),
+		Nonce:   1,
+	}
+	fixture, err := json.Marshal(map[string]any{
+		"transfer.json::transfer": map[string]any{
+			"network":   "Prague",
+			"blocks":    []map[string]string{{"rlp": hexutil.Encode(bz)}},
+			"pre":       ethtypes.GenesisAlloc{sender: {Balance: funds}},
+			"postState": post,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, fixture, 0o600))
+}
+
+func TestReplayOfflineFixture(t *testing.T) {
+	recipient := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	untouched := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	writeTransferFixture(t, path, recipient, big.NewInt(params.Ether), ethtypes.GenesisAlloc{
+		recipient: {Balance: big.NewInt(params.Ether)},
+		// the one field the replay must report
+		untouched: {Balance: big.NewInt(1)},
+	})
+
+	bt, err := replay.LoadFixture(path, "transfer")
+	require.NoError(t, err)
+	cancunTime, pragueTime, err := replay.FixtureForks(bt.Json.Network)
+	require.NoError(t, err)
+	prevCancun, prevPrague := evmtypes.CancunTime, evmtypes.PragueTime
+	t.Cleanup(func() { evmtypes.CancunTime, evmtypes.PragueTime = prevCancun, prevPrague })
+	evmtypes.CancunTime, evmtypes.PragueTime = cancunTime, pragueTime
+	src, err := replay.NewFixtureBlockSource(bt)
+	require.NoError(t, err)
+
+	a := Setup(t, false, false, false)
+	a.EvmKeeper.EthReplayConfig = replay.Config{Enabled: true}
+	var out bytes.Buffer
+	report := replay.NewReport(&out)
+	require.NoError(t, replayOffline(a, src, bt.Json.Pre, bt.Json.Post, report))
+
+	require.Equal(t, 1, report.Mismatches(), out.String())
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var mismatch struct {
+		Block uint64 `json:"block"`
+		replay.Mismatch
+	}
+	require.NoError(t, json.Unmarshal(lines[0], &mismatch))
+	require.Equal(t, uint64(1), mismatch.Block)
+	require.Equal(t, untouched, mismatch.Address)
+	require.Equal(t, replay.FieldBalance, mismatch.Field)
+	require.Equal(t, "1", mismatch.Expected)
+	require.Equal(t, "0", mismatch.Actual)
+	require.Contains(t, string(lines[1]), `"blocks":1`)
+}
@@ -0,0 +1,160 @@
+package app
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	servertypes "github.com/sei-protocol/sei-chain/sei-cosmos/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	authtypes "github.com/sei-protocol/sei-chain/sei-cosmos/x/auth/types"
+	banktypes "github.com/sei-protocol/sei-chain/sei-cosmos/x/bank/types"
+	evmkeeper "github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
+)
+
+// OCCPredictionConfig configures conflict prediction for the OCC scheduler.
+type OCCPredictionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// HotKeys are "<store>/<hex key>" keys, as logged by the scheduler's key
+	// conflict log, that are always treated as contended.
+	HotKeys []string `mapstructure:"hot_keys"`
+}
+
+var DefaultOCCPredictionConfig = OCCPredictionConfig{}
+
+const (
+	flagOCCPredictionEnabled = "occ_prediction.enabled"
+	flagOCCPredictionHotKeys = "occ_prediction.hot_keys"
+)
+
+// erc20BalanceSlotGuesses is the number of leading storage slots tried as the
+// balances mapping of an ERC20 contract. OpenZeppelin and most hand-written
+// tokens declare it in one of these.
+const erc20BalanceSlotGuesses = 4
+
+var (
+	erc20TransferSelector     = []byte{0xa9, 0x05, 0x9c, 0xbb}
+	erc20TransferFromSelector = []byte{0x23, 0xb8, 0x72, 0xdd}
+)
+
+// feeCollectorConflictKeys are the scheduler conflict keys of the fee
+// collector's base denom and wei balances. Every EVM transaction proposes
+// them, so configuring either as a hot key sequences EVM transactions on it.
+var feeCollectorConflictKeys = func() []string {
+	addr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	return []string{
+		banktypes.StoreKey + "/" + string(banktypes.CreatePrefixedAccountStoreKey(addr, []byte(evmkeeper.BaseDenom))),
+		banktypes.StoreKey + "/" + string(append(append([]byte{}, banktypes.WeiBalancesPrefix...), addr...)),
+	}
+}()
+
+func ReadOCCPredictionConfig(opts servertypes.AppOptions) (OCCPredictionConfig, error) {
+	cfg := DefaultOCCPredictionConfig // copy
+	var err error
+	if v := opts.Get(flagOCCPredictionEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagOCCPredictionHotKeys); v != nil {
+		if cfg.HotKeys, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// NewOCCConflictPredictor returns the predictor described by cfg, or nil when
+// prediction is disabled.
+func NewOCCConflictPredictor(cfg OCCPredictionConfig) (*tasks.ConflictPredictor, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	hotKeys := make([]string, 0, len(cfg.HotKeys))
+	for _, s := range cfg.HotKeys {
+		key, err := tasks.ParseHotKey(s)
+		if err != nil {
+			return nil, err
+		}
+		hotKeys = append(hotKeys, key)
+	}
+	return tasks.NewConflictPredictor(evmTxAccess, hotKeys), nil
+}
+
+// evmTxAccess declares the storage slots of an EVM transaction's access list
+// and proposes the fee collector balances and, for ERC20 transfers, the
+// balance slots the transfer is likely to move. Candidates only sequence
+// transactions once they are configured as hot keys or the conflict history
+// shows the key is actually contended.
+func evmTxAccess(tx sdk.Tx) tasks.TxAccess {
+	msg := evmtypes.GetEVMTransactionMessage(tx)
+	if msg == nil {
+		return tasks.TxAccess{}
+	}
+	txData, err := evmtypes.UnpackTxData(msg.Data)
+	if err != nil {
+		return tasks.TxAccess{}
+	}
+	if _, ok := txData.(*ethtx.AssociateTx); ok {
+		return tasks.TxAccess{}
+	}
+	access := tasks.TxAccess{Candidates: append([]string{}, feeCollectorConflictKeys...)}
+	for _, tuple := range txData.GetAccessList() {
+		for _, slot := range tuple.StorageKeys {
+			access.Declared = append(access.Declared, evmStorageConflictKey(tuple.Address, slot))
+		}
+	}
+	if to := txData.GetTo(); to != nil {
+		for _, holder := range erc20TransferHolders(txData.GetData()) {
+			for slot := range erc20BalanceSlotGuesses {
+				access.Candidates = append(access.Candidates, evmStorageConflictKey(*to, erc20BalanceSlot(holder, slot)))
+			}
+		}
+	}
+	return access
+}
+
+// erc20TransferHolders returns the balance holders named in the calldata of an
+// ERC20 transfer or transferFrom. The sender of a transfer is not known before
+// signature recovery and is left out.
+func erc20TransferHolders(data []byte) []common.Address {
+	if len(data) < 4 {
+		return nil
+	}
+	args := data[4:]
+	var holders int
+	switch {
+	case bytes.Equal(data[:4], erc20TransferSelector):
+		holders = 1
+	case bytes.Equal(data[:4], erc20TransferFromSelector):
+		holders = 2
+	default:
+		return nil
+	}
+	if len(args) < holders*common.HashLength {
+		return nil
+	}
+	addrs := make([]common.Address, holders)
+	for i := range addrs {
+		addrs[i] = common.BytesToAddress(args[i*common.HashLength : (i+1)*common.HashLength])
+	}
+	return addrs
+}
+
+// erc20BalanceSlot is the storage slot of holder in a mapping declared at slot.
+func erc20BalanceSlot(holder common.Address, slot int) common.Hash {
+	var slotKey common.Hash
+	slotKey[common.HashLength-1] = byte(slot)
+	return crypto.Keccak256Hash(common.LeftPadBytes(holder.Bytes(), common.HashLength), slotKey[:])
+}
+
+// evmStorageConflictKey is the scheduler conflict key of a contract storage
+// slot in the evm store.
+func evmStorageConflictKey(addr common.Address, slot common.Hash) string {
+	return evmtypes.StoreKey + "/" + string(append(evmtypes.StateKey(addr), slot[:]...))
+}
@@ -0,0 +1,92 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	authtypes "github.com/sei-protocol/sei-chain/sei-cosmos/x/auth/types"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types/ethtx"
+)
+
+type occPredictionTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (tx occPredictionTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+type occPredictionOpts map[string]any
+
+func (o occPredictionOpts) Get(key string) any { return o[key] }
+
+func TestNewOCCConflictPredictor(t *testing.T) {
+	cfg, err := ReadOCCPredictionConfig(occPredictionOpts{})
+	require.NoError(t, err)
+	p, err := NewOCCConflictPredictor(cfg)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	cfg, err = ReadOCCPredictionConfig(occPredictionOpts{
+		flagOCCPredictionEnabled: "true",
+		flagOCCPredictionHotKeys: []string{"bank/0a0b"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, OCCPredictionConfig{Enabled: true, HotKeys: []string{"bank/0a0b"}}, cfg)
+	p, err = NewOCCConflictPredictor(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, p)
+
+	cfg.HotKeys = []string{"bank/zz"}
+	_, err = NewOCCConflictPredictor(cfg)
+	require.Error(t, err)
+}
+
+func TestERC20TransferHolders(t *testing.T) {
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	word := func(addr common.Address) []byte { return common.LeftPadBytes(addr.Bytes(), common.HashLength) }
+	amount := make([]byte, common.HashLength)
+
+	transfer := append(append(append([]byte{}, erc20TransferSelector...), word(to)...), amount...)
+	require.Equal(t, []common.Address{to}, erc20TransferHolders(transfer))
+
+	transferFrom := append(append(append(append([]byte{}, erc20TransferFromSelector...), word(from)...), word(to)...), amount...)
+	require.Equal(t, []common.Address{from, to}, erc20TransferHolders(transferFrom))
+
+	require.Nil(t, erc20TransferHolders(transfer[:20]))
+	require.Nil(t, erc20TransferHolders([]byte{0xde, 0xad, 0xbe, 0xef}))
+}
+
+func TestEVMStorageConflictKeyMatchesStoreLayout(t *testing.T) {
+	addr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	slot := common.HexToHash("0x01")
+	key := evmStorageConflictKey(addr, slot)
+	// The scheduler reports conflicts on the evm store as 0x03 || address || slot.
+	parsed, err := tasks.ParseHotKey("evm/03" + common.Bytes2Hex(addr.Bytes()) + common.Bytes2Hex(slot.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, parsed, key)
+}
+
+func TestEVMTxAccessFeeCollectorKeys(t *testing.T) {
+	msg, err := evmtypes.NewMsgEVMTransaction(&ethtx.DynamicFeeTx{})
+	require.NoError(t, err)
+	access := evmTxAccess(occPredictionTx{msgs: []sdk.Msg{msg}})
+	require.Empty(t, access.Declared)
+	require.Equal(t, feeCollectorConflictKeys, access.Candidates)
+
+	// The keys match the hot keys an operator copies from the conflict log.
+	addr := common.Bytes2Hex(authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	balance, err := tasks.ParseHotKey("bank/0214" + addr + common.Bytes2Hex([]byte("usei")))
+	require.NoError(t, err)
+	wei, err := tasks.ParseHotKey("bank/04" + addr)
+	require.NoError(t, err)
+	require.Equal(t, []string{balance, wei}, feeCollectorConflictKeys)
+
+	// Non EVM transactions propose nothing.
+	require.Equal(t, tasks.TxAccess{}, evmTxAccess(occPredictionTx{}))
+}
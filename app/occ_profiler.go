@@ -0,0 +1,70 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	servertypes "github.com/sei-protocol/sei-chain/sei-cosmos/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// OCCProfilerConfig configures the OCC conflict profiler.
+type OCCProfilerConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// WindowBlocks is the number of most recent blocks the profiler aggregates.
+	WindowBlocks int `mapstructure:"window_blocks"`
+}
+
+var DefaultOCCProfilerConfig = OCCProfilerConfig{
+	WindowBlocks: 100,
+}
+
+const (
+	flagOCCProfilerEnabled      = "occ_profiler.enabled"
+	flagOCCProfilerWindowBlocks = "occ_profiler.window_blocks"
+)
+
+func ReadOCCProfilerConfig(opts servertypes.AppOptions) (OCCProfilerConfig, error) {
+	cfg := DefaultOCCProfilerConfig // copy
+	var err error
+	if v := opts.Get(flagOCCProfilerEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagOCCProfilerWindowBlocks); v != nil {
+		if cfg.WindowBlocks, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if cfg.WindowBlocks < 1 {
+		return cfg, fmt.Errorf("%s must be positive, got %d", flagOCCProfilerWindowBlocks, cfg.WindowBlocks)
+	}
+	return cfg, nil
+}
+
+// NewOCCConflictProfiler returns the profiler described by cfg, or nil when
+// profiling is disabled.
+func NewOCCConflictProfiler(cfg OCCProfilerConfig) *tasks.ConflictProfiler {
+	if !cfg.Enabled {
+		return nil
+	}
+	return tasks.NewConflictProfiler(cfg.WindowBlocks, attributeEVMStorageConflict)
+}
+
+// attributeEVMStorageConflict attributes keys of the evm store's contract
+// storage, StateKey(address) || slot, to their contract.
+func attributeEVMStorageConflict(storeName string, key []byte) (contract, slot string, ok bool) {
+	if storeName != evmtypes.StoreKey || len(key) != len(evmtypes.StateKeyPrefix)+common.AddressLength+common.HashLength {
+		return "", "", false
+	}
+	if !bytes.HasPrefix(key, evmtypes.StateKeyPrefix) {
+		return "", "", false
+	}
+	key = key[len(evmtypes.StateKeyPrefix):]
+	return common.BytesToAddress(key[:common.AddressLength]).Hex(), common.BytesToHash(key[common.AddressLength:]).Hex(), true
+}
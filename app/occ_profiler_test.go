@@ -0,0 +1,42 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOCCProfilerConfig(t *testing.T) {
+	cfg, err := ReadOCCProfilerConfig(occPredictionOpts{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultOCCProfilerConfig, cfg)
+	require.Nil(t, NewOCCConflictProfiler(cfg))
+
+	cfg, err = ReadOCCProfilerConfig(occPredictionOpts{
+		flagOCCProfilerEnabled:      true,
+		flagOCCProfilerWindowBlocks: "20",
+	})
+	require.NoError(t, err)
+	require.Equal(t, OCCProfilerConfig{Enabled: true, WindowBlocks: 20}, cfg)
+	require.NotNil(t, NewOCCConflictProfiler(cfg))
+
+	_, err = ReadOCCProfilerConfig(occPredictionOpts{flagOCCProfilerWindowBlocks: 0})
+	require.Error(t, err)
+}
+
+func TestAttributeEVMStorageConflict(t *testing.T) {
+	addr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	slot := common.HexToHash("0x01")
+	key := evmStorageConflictKey(addr, slot)
+
+	contract, gotSlot, ok := attributeEVMStorageConflict("evm", []byte(key[len("evm/"):]))
+	require.True(t, ok)
+	require.Equal(t, addr.Hex(), contract)
+	require.Equal(t, slot.Hex(), gotSlot)
+
+	_, _, ok = attributeEVMStorageConflict("bank", []byte(key[len("evm/"):]))
+	require.False(t, ok)
+	_, _, ok = attributeEVMStorageConflict("evm", append([]byte{0x07}, addr.Bytes()...))
+	require.False(t, ok)
+}
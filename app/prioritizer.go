@@ -4,8 +4,6 @@ import (
 	"math"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
-	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/sei-protocol/sei-chain/app/antedecorators"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
@@ -111,12 +109,9 @@ func (s *SeiTxPrioritizer) getEvmTxPriority(ctx sdk.Context, evmTx *evmtypes.Msg
 	}
 	// Check blob hashes for sanity. If EVM version is Cancun or later, and the
 	// transaction contains at least one blob, we need to make sure the transaction
-	// carries a non-zero blob fee cap.
+	// carries a blob fee cap covering the current blob base fee.
 	if evmTx.Derived != nil && evmTx.Derived.Version >= derived.Cancun && len(txData.GetBlobHashes()) > 0 {
-		// For now we are simply assuming excessive blob gas is 0. In the future we might change it to be
-		// dynamic based on prior block usage.
-		chainConfig := evmtypes.DefaultChainConfig().EthereumConfig(s.evmKeeper.ChainID(ctx))
-		if txData.GetBlobFeeCap().Cmp(eip4844.CalcBlobFee(chainConfig, &ethtypes.Header{Time: uint64(ctx.BlockTime().Unix())})) < 0 { //nolint:gosec
+		if txData.GetBlobFeeCap().Cmp(s.evmKeeper.GetBlobBaseFee(ctx)) < 0 {
 			return 0, sdkerrors.ErrInsufficientFee
 		}
 	}
@@ -0,0 +1,53 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/rootmulti"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	seidbproto "github.com/sei-protocol/sei-chain/sei-db/proto"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+)
+
+// BlockReexecution is what re-executing a block produced.
+type BlockReexecution struct {
+	// ChangeSets are the changes the block would have committed, as the commit store receives them.
+	ChangeSets []*seidbproto.NamedChangeSet
+	TxResults  []*abci.ExecTxResult
+}
+
+// ReexecuteBlock re-executes a stored block on the state store's view of the height before it and returns what
+// the block would have committed. Nothing is committed, so blocks can be re-executed in any order. When txs is
+// below the block's transaction count only the first txs transactions are executed, still between BeginBlock and
+// EndBlock, to narrow a divergence down to a transaction. Blob sidecars the block carries are only staged, as for any
+// block that is not finalized, and never stored.
+func (app *App) ReexecuteBlock(req *abci.RequestFinalizeBlock, txs int) (*BlockReexecution, error) {
+	rs, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("re-executing blocks requires the SeiDB commit store, got %T", app.CommitMultiStore())
+	}
+	ms, recorder, err := rs.ReplayMultiStore(req.Header.Height - 1)
+	if err != nil {
+		return nil, err
+	}
+	// The same context FinalizeBlock hands the finalize blocker.
+	ctx := sdk.NewContext(ms, *req.Header, false).WithHeaderHash(req.Hash)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	blockTxs := req.Txs
+	if txs >= 0 && txs < len(blockTxs) {
+		blockTxs = blockTxs[:txs]
+	}
+	bpreq := &BlockProcessRequest{
+		Hash:                req.Hash,
+		ByzantineValidators: req.ByzantineValidators,
+		Height:              req.Header.Height,
+		Time:                req.Header.Time,
+	}
+	_, txResults, _, err := app.ProcessBlock(ctx, blockTxs, bpreq, req.DecidedLastCommit, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	ms.Write()
+	return &BlockReexecution{ChangeSets: recorder.ChangeSets(), TxResults: txResults}, nil
+}
@@ -31,6 +31,7 @@ const (
 	FlagSCWriteMode                  = "state-commit.sc-write-mode"
 	FlagSCWriteModeEnableAuto        = "state-commit.sc-write-mode-enable-auto"
 	FlagSCFlatKVReadWriteMetrics     = "state-commit.flatkv.enable-read-write-metrics"
+	FlagSCFlatKVHistoryWindow        = "state-commit.flatkv.history-window"
 
 	// Hash logger configs (per-block hash logging; enabled by default)
 	FlagSCHashLoggerEnable         = "state-commit.sc-hash-logger-enable"
@@ -39,6 +40,13 @@ const (
 	FlagSCHashLoggerTargetFileSize = "state-commit.sc-hash-logger-target-file-size"
 	FlagSCHashLoggerMaxDiskSize    = "state-commit.sc-hash-logger-max-disk-size"
 
+	// State size accounting configs (per-module sizes and growth snapshots; disabled by default)
+	FlagSCStateSizeEnable          = "state-commit.sc-state-size-enable"
+	FlagSCStateSizeDirectory       = "state-commit.sc-state-size-directory"
+	FlagSCStateSizeGrowthInterval  = "state-commit.sc-state-size-growth-interval"
+	FlagSCStateSizeGrowthRetain    = "state-commit.sc-state-size-growth-retain"
+	FlagSCStateSizeTopContributors = "state-commit.sc-state-size-top-contributors"
+
 	// SS Store configs
 	FlagSSEnable            = "state-store.ss-enable"
 	FlagSSDirectory         = "state-store.ss-db-directory"
@@ -49,6 +57,7 @@ const (
 	FlagSSImportNumWorkers  = "state-store.ss-import-num-workers"
 	FlagSSReadWriteMetrics  = "state-store.ss-enable-read-write-metrics"
 	FlagSSSnapshotEnable    = "state-store.ss-snapshot-enable"
+	FlagSSMigrateToBackend  = "state-store.ss-migrate-to-backend"
 
 	// EVM SS optimization (embedded in SS config, controlled via write/read mode)
 	FlagEVMSSDirectory   = "state-store.evm-ss-db-directory"
@@ -132,6 +141,9 @@ func parseSCConfigs(appOpts servertypes.AppOptions) config.StateCommitConfig {
 	if v := appOpts.Get(FlagSCFlatKVReadWriteMetrics); v != nil {
 		scConfig.FlatKVConfig.EnableReadWriteMetrics = cast.ToBool(v)
 	}
+	if v := appOpts.Get(FlagSCFlatKVHistoryWindow); v != nil {
+		scConfig.FlatKVConfig.HistoryWindow = cast.ToInt64(v)
+	}
 
 	// sc-write-mode-enable-auto (default true) decides whether the node may run
 	// in auto. An ABSENT key keeps the default (true): nodes provisioned by
@@ -191,6 +203,24 @@ func parseSCConfigs(appOpts servertypes.AppOptions) config.StateCommitConfig {
 	// distinguishable. Sourced from the node build version, not from app.toml.
 	scConfig.HashLogger.Version = version.Version
 
+	// State size accounting. Guarded so an app.toml written before it existed keeps the defaults;
+	// every value, including 0, is otherwise taken verbatim.
+	if v := appOpts.Get(FlagSCStateSizeEnable); v != nil {
+		scConfig.StateSize.Enable = cast.ToBool(v)
+	}
+	if v := appOpts.Get(FlagSCStateSizeDirectory); v != nil {
+		scConfig.StateSize.Directory = cast.ToString(v)
+	}
+	if v := appOpts.Get(FlagSCStateSizeGrowthInterval); v != nil {
+		scConfig.StateSize.GrowthInterval = cast.ToUint(v)
+	}
+	if v := appOpts.Get(FlagSCStateSizeGrowthRetain); v != nil {
+		scConfig.StateSize.GrowthRetain = cast.ToUint(v)
+	}
+	if v := appOpts.Get(FlagSCStateSizeTopContributors); v != nil {
+		scConfig.StateSize.TopContributors = cast.ToUint(v)
+	}
+
 	return scConfig
 }
 
@@ -210,6 +240,9 @@ func parseSSConfigs(appOpts servertypes.AppOptions) config.StateStoreConfig {
 	if v := appOpts.Get(FlagSSSnapshotEnable); v != nil {
 		ssConfig.SnapshotEnable = cast.ToBool(v)
 	}
+	if v := appOpts.Get(FlagSSMigrateToBackend); v != nil {
+		ssConfig.MigrateToBackend = cast.ToString(v)
+	}
 
 	// EVM optimization fields (embedded in SS config)
 	ssConfig.EVMDBDirectory = cast.ToString(appOpts.Get(FlagEVMSSDirectory))
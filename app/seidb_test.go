@@ -39,6 +39,8 @@ func (t TestSeiDBAppOpts) Get(s string) interface{} {
 		return defaultSCConfig.MemIAVLConfig.SnapshotWriteRateMBps
 	case FlagSCFlatKVReadWriteMetrics:
 		return defaultSCConfig.FlatKVConfig.EnableReadWriteMetrics
+	case FlagSCFlatKVHistoryWindow:
+		return defaultSCConfig.FlatKVConfig.HistoryWindow
 	case FlagSSEnable:
 		return defaultSSConfig.Enable
 	case FlagSSBackend:
@@ -118,6 +120,15 @@ func TestParseSCConfigs_FlatKVReadWriteMetrics(t *testing.T) {
 	assert.True(t, scConfig.FlatKVConfig.EnableReadWriteMetrics)
 }
 
+func TestParseSCConfigs_FlatKVHistoryWindow(t *testing.T) {
+	scConfig := parseSCConfigs(mapAppOpts{
+		FlagSCEnable:              true,
+		FlagSCFlatKVHistoryWindow: 1000,
+	})
+
+	assert.Equal(t, int64(1000), scConfig.FlatKVConfig.HistoryWindow)
+}
+
 func TestParseSCConfigs_DoesNotAlignFlatKV(t *testing.T) {
 	// parseSCConfigs is a raw parse: memIAVL takes the flag values while FlatKV
 	// keeps its own in-code defaults. The FlatKV<-memIAVL alignment happens later
@@ -213,6 +224,20 @@ func TestParseSSConfigs_ReadWriteMetrics(t *testing.T) {
 	assert.True(t, ssConfig.EnableReadWriteMetrics)
 }
 
+func TestParseSSConfigs_MigrateToBackend(t *testing.T) {
+	ssConfig := parseSSConfigs(mapAppOpts{
+		FlagSSEnable:           true,
+		FlagSSBackend:          "pebbledb",
+		FlagSSMigrateToBackend: "rocksdb",
+	})
+	assert.Equal(t, "pebbledb", ssConfig.Backend)
+	assert.Equal(t, "rocksdb", ssConfig.MigrateToBackend)
+
+	// An app.toml rendered before the key existed starts no migration.
+	ssConfig = parseSSConfigs(mapAppOpts{FlagSSEnable: true})
+	assert.Empty(t, ssConfig.MigrateToBackend)
+}
+
 // TestSetupSeiDB_StateSyncSnapshotWithoutSSDoesNotPanic guards the removal of
 // the old validateConfigs check, which panicked whenever a state-sync snapshot
 // interval was configured (> 0) while SC was enabled but SS was disabled.
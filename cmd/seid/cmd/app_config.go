@@ -33,6 +33,8 @@ type CustomAppConfig struct {
 	ETHBlockTest    blocktest.Config               `mapstructure:"eth_block_test"`
 	EvmQuery        querier.Config                 `mapstructure:"evm_query"`
 	LightInvariance seiapp.LightInvarianceConfig   `mapstructure:"light_invariance"`
+	OCCPrediction   seiapp.OCCPredictionConfig     `mapstructure:"occ_prediction"`
+	OCCProfiler     seiapp.OCCProfilerConfig       `mapstructure:"occ_profiler"`
 	Admin           admin.Config                   `mapstructure:"admin_server"`
 }
 
@@ -53,6 +55,8 @@ func NewCustomAppConfig(baseConfig *srvconfig.Config, evmConfig evmrpcconfig.Con
 		ETHBlockTest:    blocktest.DefaultConfig,
 		EvmQuery:        querier.DefaultConfig,
 		LightInvariance: seiapp.DefaultLightInvarianceConfig,
+		OCCPrediction:   seiapp.DefaultOCCPredictionConfig,
+		OCCProfiler:     seiapp.DefaultOCCProfilerConfig,
 		Admin:           admin.DefaultConfig,
 	}
 }
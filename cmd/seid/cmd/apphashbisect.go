@@ -0,0 +1,359 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/baseapp"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/server"
+	storetypes "github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/rootmulti"
+	seidbproto "github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	tmcfg "github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	tmexport "github.com/sei-protocol/sei-chain/sei-tendermint/export"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+	"github.com/sei-protocol/sei-chain/sei-wasmd/x/wasm"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/app"
+)
+
+// AppHashBisectCmd re-executes stored blocks against the node's archive and locates the first state divergence
+// from the hash log.
+func AppHashBisectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apphash-bisect",
+		Short: "Re-execute stored blocks and locate the transaction and store key where state diverges from the hash log",
+		Long: `Re-execute a range of stored blocks in-process and compare the changes each block makes, per module,
+against the changeset hashes recorded in the hash log.
+
+Each block is read from the node's block store and executed on the state store's view of the height before it,
+so the node must be stopped and its state store must retain every height from --from minus one. Nothing is
+committed. At the first height whose changes differ from the hash log, the command prints the diverging modules,
+the keys whose re-executed value differs from the state store's value at that height, and the first transaction
+whose result differs from the stored result. It then bisects the block's transactions for the one that sets the
+first diverging key to its re-executed value, assuming no later transaction sets the key back.
+
+Keys that only the recorded execution wrote cannot be listed, since the state store does not keep a block's
+changeset. Hash logs written before module changeset columns existed only identify the diverging height.
+
+Blocks are executed by the app directly rather than through the inprocess test harness: the harness only brings
+up fresh networks from a generated genesis and cannot open an existing archive.`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			from, err := cmd.Flags().GetInt64("from")
+			if err != nil {
+				return fmt.Errorf("failed to get from height: %w", err)
+			}
+			to, err := cmd.Flags().GetInt64("to")
+			if err != nil {
+				return fmt.Errorf("failed to get to height: %w", err)
+			}
+			if to == 0 {
+				to = from
+			}
+			if from <= 1 || to < from {
+				return fmt.Errorf("invalid height range [%d, %d]: from must be above 1 and not above to", from, to)
+			}
+			hashLogDir, err := cmd.Flags().GetString("hash-log-dir")
+			if err != nil {
+				return fmt.Errorf("failed to get hash log directory: %w", err)
+			}
+
+			blockStoreDB, err := tmcfg.DefaultDBProvider(&tmcfg.DBContext{ID: "blockstore", Config: config})
+			if err != nil {
+				return err
+			}
+			defer func() { _ = blockStoreDB.Close() }()
+			blockStore := tmexport.NewBlockStore(blockStoreDB)
+			stateDB, err := tmcfg.DefaultDBProvider(&tmcfg.DBContext{ID: "state", Config: config})
+			if err != nil {
+				return err
+			}
+			defer func() { _ = stateDB.Close() }()
+			stateStore := tmexport.NewStore(stateDB)
+			tmState, err := stateStore.Load()
+			if err != nil {
+				return fmt.Errorf("failed to load consensus state: %w", err)
+			}
+			if to > blockStore.Height() {
+				return fmt.Errorf("height %d is above the block store's latest height %d", to, blockStore.Height())
+			}
+
+			a := app.New(
+				dbm.NewMemDB(),
+				nil,
+				true,
+				map[int64]bool{},
+				config.RootDir,
+				0,
+				true,
+				config,
+				app.MakeEncodingConfig(),
+				app.GetWasmEnabledProposals(),
+				serverCtx.Viper,
+				[]wasm.Option{},
+				app.EmptyAppOptions,
+				baseapp.SetPruning(storetypes.NewPruningOptionsFromString(storetypes.PruningOptionNothing)),
+			)
+			a.ChainID = tmState.ChainID
+			rs, ok := a.CommitMultiStore().(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("re-executing blocks requires the SeiDB commit store, got %T", a.CommitMultiStore())
+			}
+			if hashLogDir == "" {
+				hashLogDir = rs.HashLogDir()
+			}
+
+			b := &appHashBisector{
+				out:        cmd.OutOrStdout(),
+				hashLogDir: hashLogDir,
+				loadRequest: func(height int64) (*abci.RequestFinalizeBlock, error) {
+					block := blockStore.LoadBlock(height)
+					if block == nil {
+						return nil, fmt.Errorf("block %d is not in the block store", height)
+					}
+					return &abci.RequestFinalizeBlock{
+						Txs:                 block.Txs.ToSliceOfBytes(),
+						DecidedLastCommit:   tmexport.BuildLastCommitInfo(block, stateStore, tmState.InitialHeight),
+						ByzantineValidators: block.Evidence.ToABCI(),
+						Hash:                block.Hash(),
+						Header:              block.Header.ToProto(),
+					}, nil
+				},
+				loadResponses: stateStore.LoadFinalizeBlockResponses,
+				reexecute:     a.ReexecuteBlock,
+				archive:       rs.CacheMultiStoreWithVersion,
+				storeKey:      a.GetKey,
+			}
+			return b.run(from, to)
+		},
+	}
+
+	cmd.Flags().Int64("from", 0, "First height to re-execute (required)")
+	cmd.Flags().Int64("to", 0, "Last height to re-execute (default: --from)")
+	cmd.Flags().String("hash-log-dir", "", "Hash log to compare against (default: the node's own hash log)")
+	_ = cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+// appHashBisector re-executes blocks and narrows down where they diverge from the hash log.
+type appHashBisector struct {
+	out        io.Writer
+	hashLogDir string
+
+	loadRequest   func(height int64) (*abci.RequestFinalizeBlock, error)
+	loadResponses func(height int64) (*abci.ResponseFinalizeBlock, error)
+	// reexecute executes the first txs transactions of a block, see app.App.ReexecuteBlock.
+	reexecute func(req *abci.RequestFinalizeBlock, txs int) (*app.BlockReexecution, error)
+	// archive returns the state store's view of a height.
+	archive  func(height int64) (storetypes.CacheMultiStore, error)
+	storeKey func(name string) *storetypes.KVStoreKey
+}
+
+// divergingKey is a key whose re-executed value differs from the state store's value at the block's height.
+type divergingKey struct {
+	store      string
+	key        []byte
+	reexecuted []byte
+	archived   []byte
+}
+
+func (b *appHashBisector) run(from, to int64) error {
+	for height := from; height <= to; height++ {
+		records, err := hashlog.ReadHashForBlock(b.hashLogDir, uint64(height)) //nolint:gosec // heights are positive
+		if err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			fmt.Fprintf(b.out, "height %d: no hash log record, skipped\n", height)
+			continue
+		}
+		req, err := b.loadRequest(height)
+		if err != nil {
+			return err
+		}
+		res, err := b.reexecute(req, len(req.Txs))
+		if err != nil {
+			return fmt.Errorf("failed to re-execute block %d: %w", height, err)
+		}
+		// A rolled back height has a record per execution; the state store holds the last one.
+		divergence := hashlog.CompareChangeset(records[len(records)-1], res.ChangeSets)
+		if !divergence.Diverged() {
+			fmt.Fprintf(b.out, "height %d: ok\n", height)
+			continue
+		}
+		if err := b.bisect(req, res, divergence); err != nil {
+			return err
+		}
+		return fmt.Errorf("state diverges from the hash log at height %d", height)
+	}
+	return nil
+}
+
+func (b *appHashBisector) bisect(req *abci.RequestFinalizeBlock, res *app.BlockReexecution, divergence hashlog.ChangesetDivergence) error {
+	height := req.Header.Height
+	fmt.Fprintf(b.out, "height %d: changeset diverges from the hash log\n", height)
+	modules := divergence.Modules
+	if len(modules) == 0 {
+		// Only the aggregate hash was recorded, so every store the block wrote is a suspect.
+		for _, ncs := range res.ChangeSets {
+			modules = append(modules, ncs.Name)
+		}
+		fmt.Fprintf(b.out, "  stores written: %s\n", strings.Join(modules, ", "))
+	} else {
+		fmt.Fprintf(b.out, "  diverging stores: %s\n", strings.Join(modules, ", "))
+	}
+
+	b.printFirstDivergingTxResult(height, req, res)
+
+	keys, err := b.divergingKeys(height, res.ChangeSets, modules)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		fmt.Fprintf(b.out, "  no key written by the re-execution differs from the state store\n")
+		return nil
+	}
+	for _, k := range keys {
+		fmt.Fprintf(b.out, "  %s/%X: re-executed %s, state store %s\n", k.store, k.key, formatValue(k.reexecuted), formatValue(k.archived))
+	}
+
+	first := keys[0]
+	txs, err := b.bisectTxs(req, first)
+	if err != nil {
+		return err
+	}
+	if txs == 0 {
+		fmt.Fprintf(b.out, "  %s/%X is set to its re-executed value outside of transactions (BeginBlock or EndBlock)\n", first.store, first.key)
+		return nil
+	}
+	fmt.Fprintf(b.out, "  %s/%X is set to its re-executed value by transaction %d (%X)\n",
+		first.store, first.key, txs-1, tmtypes.Tx(req.Txs[txs-1]).Hash())
+	return nil
+}
+
+// printFirstDivergingTxResult prints the first transaction whose deterministic result differs from the stored
+// FinalizeBlock response, if the node kept it.
+func (b *appHashBisector) printFirstDivergingTxResult(height int64, req *abci.RequestFinalizeBlock, res *app.BlockReexecution) {
+	stored, err := b.loadResponses(height)
+	if err != nil || stored == nil {
+		fmt.Fprintf(b.out, "  stored transaction results unavailable\n")
+		return
+	}
+	for i, want := range stored.TxResults {
+		if i >= len(res.TxResults) {
+			break
+		}
+		got := res.TxResults[i]
+		if got.Code == want.Code && got.GasWanted == want.GasWanted && got.GasUsed == want.GasUsed && bytes.Equal(got.Data, want.Data) {
+			continue
+		}
+		fmt.Fprintf(b.out, "  transaction %d (%X) result differs: re-executed code %d gas %d, stored code %d gas %d\n",
+			i, tmtypes.Tx(req.Txs[i]).Hash(), got.Code, got.GasUsed, want.Code, want.GasUsed)
+		return
+	}
+	fmt.Fprintf(b.out, "  all transaction results match the stored results\n")
+}
+
+// divergingKeys returns the keys of the given stores that the re-execution wrote and whose value differs from
+// the state store's value at height.
+func (b *appHashBisector) divergingKeys(height int64, changeSets []*seidbproto.NamedChangeSet, modules []string) ([]divergingKey, error) {
+	archive, err := b.archive(height)
+	if err != nil {
+		return nil, err
+	}
+	suspects := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		suspects[module] = true
+	}
+	var keys []divergingKey
+	for _, ncs := range changeSets {
+		if !suspects[ncs.Name] {
+			continue
+		}
+		storeKey := b.storeKey(ncs.Name)
+		if storeKey == nil {
+			return nil, fmt.Errorf("unknown store %q", ncs.Name)
+		}
+		store := archive.GetKVStore(storeKey)
+		for key, value := range latestValues(ncs.Changeset.Pairs) {
+			archived := store.Get([]byte(key))
+			if !bytes.Equal(value, archived) {
+				keys = append(keys, divergingKey{store: ncs.Name, key: []byte(key), reexecuted: value, archived: archived})
+			}
+		}
+	}
+	sortDivergingKeys(keys)
+	return keys, nil
+}
+
+// bisectTxs returns the smallest number of leading transactions whose execution sets k to its re-executed value.
+func (b *appHashBisector) bisectTxs(req *abci.RequestFinalizeBlock, k divergingKey) (int, error) {
+	before, err := b.archive(req.Header.Height - 1)
+	if err != nil {
+		return 0, err
+	}
+	initial := before.GetKVStore(b.storeKey(k.store)).Get(k.key)
+	lo, hi := 0, len(req.Txs)
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		res, err := b.reexecute(req, mid)
+		if err != nil {
+			return 0, fmt.Errorf("failed to re-execute %d transactions of block %d: %w", mid, req.Header.Height, err)
+		}
+		value := initial
+		for _, ncs := range res.ChangeSets {
+			if ncs.Name != k.store {
+				continue
+			}
+			if v, ok := latestValues(ncs.Changeset.Pairs)[string(k.key)]; ok {
+				value = v
+			}
+		}
+		if bytes.Equal(value, k.reexecuted) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// latestValues returns the value each key of a changeset ends up with; nil for a deleted key.
+func latestValues(pairs []*seidbproto.KVPair) map[string][]byte {
+	values := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		if pair.Delete {
+			values[string(pair.Key)] = nil
+		} else {
+			values[string(pair.Key)] = pair.Value
+		}
+	}
+	return values
+}
+
+func sortDivergingKeys(keys []divergingKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].store != keys[j].store {
+			return keys[i].store < keys[j].store
+		}
+		return bytes.Compare(keys[i].key, keys[j].key) < 0
+	})
+}
+
+func formatValue(value []byte) string {
+	if value == nil {
+		return "<none>"
+	}
+	return fmt.Sprintf("%X", value)
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/rootmulti"
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	seidbproto "github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+
+	"github.com/sei-protocol/sei-chain/app"
+)
+
+// bisectFixture is an archive holding heights 1 and 2 of a "bank" store, and a hash log recording height 2.
+type bisectFixture struct {
+	store      *rootmulti.Store
+	bank       *storetypes.KVStoreKey
+	hashLogDir string
+	req        *abci.RequestFinalizeBlock
+}
+
+// The recorded execution of height 2 sets a to 1 and c to 1.
+var recordedChangeSets = []*seidbproto.NamedChangeSet{{
+	Name: "bank",
+	Changeset: seidbproto.ChangeSet{Pairs: []*seidbproto.KVPair{
+		{Key: []byte("a"), Value: []byte("1")},
+		{Key: []byte("c"), Value: []byte("1")},
+	}},
+}}
+
+func newBisectFixture(t *testing.T) *bisectFixture {
+	scCfg := config.DefaultStateCommitConfig()
+	scCfg.Enable = true
+	ssCfg := config.DefaultStateStoreConfig()
+	ssCfg.Enable = true
+	store := rootmulti.NewStore(t.TempDir(), scCfg, ssCfg, []string{})
+	t.Cleanup(func() { _ = store.Close() })
+	bank := storetypes.NewKVStoreKey("bank")
+	store.MountStoreWithDB(bank, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	kv := store.GetStoreByName("bank").(storetypes.KVStore)
+	kv.Set([]byte("a"), []byte("0"))
+	store.Commit(true)
+	for _, pair := range recordedChangeSets[0].Changeset.Pairs {
+		kv.Set(pair.Key, pair.Value)
+	}
+	c2 := store.Commit(true)
+	require.Eventually(t, func() bool {
+		return store.GetStateStore().GetLatestVersion() >= c2.Version
+	}, 10*time.Second, 10*time.Millisecond)
+
+	hashLogDir := t.TempDir()
+	logCfg := hashlog.DefaultHashLoggerConfig(hashLogDir, "v1")
+	logCfg.HashTypes = []string{hashlog.ModuleChangesetHashType("bank")}
+	logger, err := hashlog.NewHashLogger(logCfg)
+	require.NoError(t, err)
+	logger.ReportChangeset(uint64(c2.Version), recordedChangeSets) //nolint:gosec // test height
+	require.NoError(t, logger.Close())
+
+	return &bisectFixture{
+		store:      store,
+		bank:       bank,
+		hashLogDir: hashLogDir,
+		req: &abci.RequestFinalizeBlock{
+			Txs:    [][]byte{{0}, {1}, {2}},
+			Header: &tmproto.Header{Height: c2.Version},
+		},
+	}
+}
+
+// bisector returns a bisector whose re-execution of height 2 runs txWrites[i], if any, as transaction i.
+func (f *bisectFixture) bisector(out *bytes.Buffer, txWrites []*seidbproto.KVPair, stored *abci.ResponseFinalizeBlock) *appHashBisector {
+	return &appHashBisector{
+		out:        out,
+		hashLogDir: f.hashLogDir,
+		loadRequest: func(height int64) (*abci.RequestFinalizeBlock, error) {
+			if height != f.req.Header.Height {
+				return nil, errors.New("block not found")
+			}
+			return f.req, nil
+		},
+		loadResponses: func(int64) (*abci.ResponseFinalizeBlock, error) {
+			if stored == nil {
+				return nil, errors.New("not stored")
+			}
+			return stored, nil
+		},
+		reexecute: func(req *abci.RequestFinalizeBlock, txs int) (*app.BlockReexecution, error) {
+			res := &app.BlockReexecution{}
+			var pairs []*seidbproto.KVPair
+			for i := range min(txs, len(req.Txs)) {
+				if txWrites[i] != nil {
+					pairs = append(pairs, txWrites[i])
+				}
+				res.TxResults = append(res.TxResults, &abci.ExecTxResult{})
+			}
+			if len(pairs) > 0 {
+				res.ChangeSets = []*seidbproto.NamedChangeSet{{Name: "bank", Changeset: seidbproto.ChangeSet{Pairs: pairs}}}
+			}
+			return res, nil
+		},
+		archive: f.store.CacheMultiStoreWithVersion,
+		storeKey: func(name string) *storetypes.KVStoreKey {
+			if name == f.bank.Name() {
+				return f.bank
+			}
+			return nil
+		},
+	}
+}
+
+func TestAppHashBisectMatchingBlock(t *testing.T) {
+	f := newBisectFixture(t)
+	var out bytes.Buffer
+	b := f.bisector(&out, []*seidbproto.KVPair{
+		{Key: []byte("a"), Value: []byte("1")},
+		nil,
+		{Key: []byte("c"), Value: []byte("1")},
+	}, nil)
+	require.NoError(t, b.run(2, 3))
+	require.Equal(t, "height 2: ok\nheight 3: no hash log record, skipped\n", out.String())
+}
+
+func TestAppHashBisectLocatesDivergingTx(t *testing.T) {
+	f := newBisectFixture(t)
+	var out bytes.Buffer
+	// Transaction 1 sets a to 2 instead of 1.
+	b := f.bisector(&out, []*seidbproto.KVPair{
+		{Key: []byte("c"), Value: []byte("1")},
+		{Key: []byte("a"), Value: []byte("2")},
+		nil,
+	}, &abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{}, {Code: 5}, {}}})
+
+	err := b.run(2, 2)
+	require.ErrorContains(t, err, "state diverges from the hash log at height 2")
+	got := out.String()
+	require.Contains(t, got, "height 2: changeset diverges from the hash log\n")
+	require.Contains(t, got, "  diverging stores: bank\n")
+	require.Contains(t, got, fmt.Sprintf("  transaction 1 (%X) result differs", tmtypes.Tx(f.req.Txs[1]).Hash()))
+	require.Contains(t, got, "  bank/61: re-executed 32, state store 31\n")
+	require.NotContains(t, got, "bank/63")
+	require.Contains(t, got, "  bank/61 is set to its re-executed value by transaction 1")
+
+	k := divergingKey{store: "bank", key: []byte("a"), reexecuted: []byte("2"), archived: []byte("1")}
+	txs, err := b.bisectTxs(f.req, k)
+	require.NoError(t, err)
+	require.Equal(t, 2, txs)
+}
@@ -1,21 +1,30 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	//nolint:gosec
 	_ "net/http/pprof"
+	"os"
+	"path/filepath"
 
 	"github.com/sei-protocol/sei-chain/sei-wasmd/x/wasm"
 	wasmkeeper "github.com/sei-protocol/sei-chain/sei-wasmd/x/wasm/keeper"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/sei-protocol/sei-chain/app"
+	gigaconfig "github.com/sei-protocol/sei-chain/giga/executor/config"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/baseapp"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/client/flags"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/server"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store"
 	storetypes "github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/x/evm/replay"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
 )
 
 //nolint:gosec
@@ -23,13 +32,36 @@ func ReplayCmd(defaultNodeHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "ethreplay",
 		Short: "replay EVM transactions",
-		Long:  "replay EVM transactions",
+		Long: `replay EVM transactions
+
+By default blocks are read from, and checked against, the [eth_replay] eth_rpc endpoint. With --blocks-file or
+--fixture the replay is offline: blocks come from a geth export file or an ethtests blockchain test fixture, and
+the replayed state is compared with an expected post-state, writing each balance, nonce, code and storage
+mismatch as a JSON line to --report. A blocks file replays on top of the eth_data_dir chaindata, from the block
+after its head; its post-state is read from --post-state, which is required. Only the RLP files written by
+geth export are read; era and era1 archives are not. A fixture carries its own pre- and post-state, and is
+replayed with the forks of its network, which must be Shanghai, Cancun or Prague.`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			if err := serverCtx.Viper.BindPFlags(cmd.Flags()); err != nil {
 				return err
 			}
+			offline, err := loadOfflineReplay(cmd)
+			if err != nil {
+				return err
+			}
+			if offline != nil {
+				serverCtx.Viper.Set(replay.FlagEnabled, true)
+				serverCtx.Viper.Set(replay.FlagEthRPC, "")
+				if offline.pre != nil {
+					serverCtx.Viper.Set(replay.FlagEthDataDir, "")
+					evmtypes.CancunTime, evmtypes.PragueTime = offline.cancunTime, offline.pragueTime
+				}
+				// keep the replay on the reference EVM path, as blocktest does
+				serverCtx.Viper.Set(gigaconfig.FlagEnabled, false)
+				serverCtx.Viper.Set(gigaconfig.FlagOCCEnabled, false)
+			}
 			go func() {
 				logger.Info("Listening for profiling at http://localhost:6060/debug/pprof/")
 				err := http.ListenAndServe(":6060", nil)
@@ -68,13 +100,101 @@ func ReplayCmd(defaultNodeHome string) *cobra.Command {
 				baseapp.SetMinRetainBlocks(cast.ToUint64(serverCtx.Viper.Get(server.FlagMinRetainBlocks))),
 				baseapp.SetInterBlockCache(cache),
 			)
-			app.Replay(a)
-			return nil
+			if offline == nil {
+				app.Replay(a)
+				return nil
+			}
+			return offline.run(cmd, a)
 		},
 	}
 
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The database home directory")
 	cmd.Flags().String(flags.FlagChainID, "sei-chain", "chain ID")
+	cmd.Flags().String("blocks-file", "", "replay offline from a geth export RLP file (gzip compressed if named .gz); era archives are not supported")
+	cmd.Flags().String("fixture", "", "replay offline from an ethtests blockchain test fixture file")
+	cmd.Flags().String("fixture-name", "", "test to replay from --fixture (default: the file's only test)")
+	cmd.Flags().String("post-state", "", "alloc JSON file of the state expected after the last block of --blocks-file (required with --blocks-file)")
+	cmd.Flags().String("report", "", "file to write the offline replay's mismatch report to (default: stdout)")
 
 	return cmd
 }
+
+// offlineReplay is what an offline replay reads instead of the network.
+type offlineReplay struct {
+	blocks replay.BlockSource
+	// pre is nil when the pre-state is read from eth_data_dir.
+	pre  ethtypes.GenesisAlloc
+	post ethtypes.GenesisAlloc
+	// cancunTime and pragueTime select the forks of a fixture's network, see replay.FixtureForks.
+	cancunTime, pragueTime int64
+}
+
+// loadOfflineReplay loads the inputs the offline flags name, or returns nil if none is set.
+func loadOfflineReplay(cmd *cobra.Command) (*offlineReplay, error) {
+	blocksFile, _ := cmd.Flags().GetString("blocks-file")
+	fixture, _ := cmd.Flags().GetString("fixture")
+	fixtureName, _ := cmd.Flags().GetString("fixture-name")
+	postState, _ := cmd.Flags().GetString("post-state")
+	switch {
+	case blocksFile != "" && fixture != "":
+		return nil, errors.New("--blocks-file and --fixture are mutually exclusive")
+	case fixture != "":
+		if postState != "" {
+			return nil, errors.New("--post-state does not apply to --fixture, which carries its own post-state")
+		}
+		bt, err := replay.LoadFixture(fixture, fixtureName)
+		if err != nil {
+			return nil, err
+		}
+		cancunTime, pragueTime, err := replay.FixtureForks(bt.Json.Network)
+		if err != nil {
+			return nil, err
+		}
+		blocks, err := replay.NewFixtureBlockSource(bt)
+		if err != nil {
+			return nil, err
+		}
+		pre := bt.Json.Pre
+		if pre == nil {
+			pre = ethtypes.GenesisAlloc{}
+		}
+		return &offlineReplay{blocks: blocks, pre: pre, post: bt.Json.Post, cancunTime: cancunTime, pragueTime: pragueTime}, nil
+	case blocksFile != "":
+		if postState == "" {
+			return nil, errors.New("--blocks-file needs --post-state to compare the replayed state with")
+		}
+		blocks, err := replay.NewRLPBlockSource(blocksFile)
+		if err != nil {
+			return nil, err
+		}
+		post, err := replay.LoadAlloc(postState)
+		if err != nil {
+			return nil, err
+		}
+		return &offlineReplay{blocks: blocks, post: post}, nil
+	case postState != "" || fixtureName != "":
+		return nil, errors.New("--post-state and --fixture-name need --blocks-file or --fixture")
+	default:
+		return nil, nil
+	}
+}
+
+func (r *offlineReplay) run(cmd *cobra.Command, a *app.App) error {
+	var w io.Writer = cmd.OutOrStdout()
+	if path, _ := cmd.Flags().GetString("report"); path != "" {
+		f, err := os.Create(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+		w = f
+	}
+	report := replay.NewReport(w)
+	if err := app.ReplayOffline(a, r.blocks, r.pre, r.post, report); err != nil {
+		return err
+	}
+	if n := report.Mismatches(); n > 0 {
+		return fmt.Errorf("replayed state differs from the expected post-state in %d fields", n)
+	}
+	return nil
+}
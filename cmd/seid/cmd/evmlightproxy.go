@@ -0,0 +1,230 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/evmrpc/lightproxy"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/client"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	tmmath "github.com/sei-protocol/sei-chain/sei-tendermint/libs/math"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/light"
+	lrpc "github.com/sei-protocol/sei-chain/sei-tendermint/light/rpc"
+	dbs "github.com/sei-protocol/sei-chain/sei-tendermint/light/store/db"
+	rpchttp "github.com/sei-protocol/sei-chain/sei-tendermint/rpc/client/http"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+	"github.com/sei-protocol/sei-chain/utils"
+	evmconfig "github.com/sei-protocol/sei-chain/x/evm/config"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// EVMLightProxyCmd returns the command running a local EVM JSON-RPC proxy that verifies an untrusted
+// upstream RPC against a Tendermint light client.
+func EVMLightProxyCmd(txConfig client.TxConfig) *cobra.Command {
+	var (
+		listenAddr       string
+		upstream         string
+		primaryAddr      string
+		witnessAddrs     string
+		dir              string
+		trustingPeriod   time.Duration
+		trustedHeight    int64
+		trustedHash      []byte
+		trustLevelStr    string
+		sequential       bool
+		blacklistTTL     time.Duration
+		rejectUnverified bool
+		timeout          time.Duration
+		evmChainID       int64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "evm-light-proxy [chain-id]",
+		Short: "Run a local EVM JSON-RPC proxy verifying an untrusted RPC with a light client",
+		Long: `Run a local EVM JSON-RPC proxy that forwards calls to an untrusted upstream EVM RPC
+and checks the results against block headers verified by a Tendermint light client.
+
+Blocks (eth_getBlockByNumber, eth_getBlockByHash) are checked field by field against
+the verified header. Transactions are checked to be in the verified block they claim,
+with the fields their hash commits to. The status, gas used and logs of receipts are
+checked against the verified block results, and eth_chainId against the chain the
+light client follows. State reads (eth_getBalance, eth_call, ...), fee and gas
+estimates, and receipts of transactions that are not EVM transactions cannot be
+verified; they are forwarded unverified, or rejected with --reject-unverified.
+
+The light client needs a primary Tendermint RPC and a trusted height and hash on
+first start; witnesses are highly recommended. Later starts resume from the
+trusted store in --dir.`,
+		Args: cobra.ExactArgs(1),
+		Example: `seid evm-light-proxy pacific-1 --upstream https://evm-rpc.example.com \
+	-p https://rpc.example.com -w https://rpc2.example.com --height 1000000 --hash 28B97BE9...`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			chainID := args[0]
+			if upstream == "" {
+				return errors.New("--upstream is required")
+			}
+			if primaryAddr == "" {
+				return errors.New("--primary is required")
+			}
+			var witnesses []string
+			if witnessAddrs != "" {
+				witnesses = strings.Split(witnessAddrs, ",")
+			}
+			trustLevel, err := tmmath.ParseFraction(trustLevelStr)
+			if err != nil {
+				return fmt.Errorf("can't parse trust level: %w", err)
+			}
+			verification := light.SkippingVerification(trustLevel)
+			if sequential {
+				verification = light.SequentialVerification()
+			}
+
+			lightDB, err := dbm.NewGoLevelDB("evm-light-proxy-db", dir)
+			if err != nil {
+				return fmt.Errorf("can't create a db: %w", err)
+			}
+			defer func() { _ = lightDB.Close() }()
+
+			ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			lc, err := light.NewHTTPClient(
+				ctx,
+				chainID,
+				light.TrustOptions{Period: trustingPeriod, Height: trustedHeight, Hash: trustedHash},
+				primaryAddr,
+				witnesses,
+				dbs.New(dbm.NewPrefixDB(lightDB, []byte(chainID))),
+				blacklistTTL,
+				verification,
+			)
+			if err != nil {
+				return err
+			}
+			primary, err := rpchttp.NewWithTimeout(primaryAddr, timeout)
+			if err != nil {
+				return fmt.Errorf("failed to create http client for %s: %w", primaryAddr, err)
+			}
+
+			cfg := lightproxy.DefaultConfig(upstream)
+			cfg.ChainID = evmconfig.GetEVMChainID(chainID)
+			if evmChainID > 0 {
+				cfg.ChainID = big.NewInt(evmChainID)
+			}
+			cfg.RejectUnverified = rejectUnverified
+			cfg.Timeout = timeout
+			proxy, err := lightproxy.NewProxy(cfg, lrpc.NewClient(primary, lc), evmTxHashes(txConfig), evmTxResult)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", listenAddr)
+			if err != nil {
+				return err
+			}
+			srv := &http.Server{Handler: proxy, ReadHeaderTimeout: 10 * time.Second}
+			go func() {
+				<-ctx.Done()
+				_ = srv.Close()
+			}()
+			cmd.Printf("Serving verified EVM RPC on %s, forwarding to %s\n", listener.Addr(), upstream)
+			if err := srv.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&listenAddr, "laddr", "localhost:8547", "serve the proxy on the given address")
+	cmd.Flags().StringVar(&upstream, "upstream", "", "the untrusted EVM JSON-RPC URL to forward calls to")
+	cmd.Flags().StringVarP(&primaryAddr, "primary", "p", "", "the Tendermint RPC the light client fetches headers and blocks from")
+	cmd.Flags().StringVarP(&witnessAddrs, "witnesses", "w", "", "Tendermint RPCs to cross-check the primary, comma-separated")
+	cmd.Flags().StringVarP(&dir, "dir", "d", os.ExpandEnv(filepath.Join("$HOME", ".sei-evm-light-proxy")), "the directory of the trusted header store")
+	cmd.Flags().DurationVar(&trustingPeriod, "trusting-period", 168*time.Hour,
+		"trusting period that headers can be verified within. Should be significantly less than the unbonding period")
+	cmd.Flags().Int64Var(&trustedHeight, "height", 1, "trusted header's height")
+	cmd.Flags().BytesHexVar(&trustedHash, "hash", []byte{}, "trusted header's hash")
+	cmd.Flags().StringVar(&trustLevelStr, "trust-level", "1/3", "trust level. Must be between 1/3 and 3/3")
+	cmd.Flags().BoolVar(&sequential, "sequential", false,
+		"sequential verification. Verify all headers sequentially as opposed to using skipping verification")
+	cmd.Flags().DurationVar(&blacklistTTL, "blacklist-ttl", 24*time.Hour, "how long a misbehaving witness stays blacklisted")
+	cmd.Flags().BoolVar(&rejectUnverified, "reject-unverified", false,
+		"reject calls whose results cannot be verified, such as state reads, instead of forwarding them")
+	cmd.Flags().DurationVar(&timeout, "timeout", 30*time.Second, "timeout of a call, including its verification")
+	cmd.Flags().Int64Var(&evmChainID, "evm-chain-id", 0,
+		"the EVM chain ID of the chain, if it is not the one known for its chain ID")
+	return cmd
+}
+
+// evmTxHashes returns the hashes of the EVM transactions a Tendermint transaction carries, as the EVM
+// RPC reports them.
+func evmTxHashes(txConfig client.TxConfig) lightproxy.TxHashesFunc {
+	decode := txConfig.TxDecoder()
+	return func(tx tmtypes.Tx) []common.Hash {
+		sdkTx, err := decode(tx)
+		if err != nil {
+			return nil
+		}
+		var hashes []common.Hash
+		for _, msg := range sdkTx.GetMsgs() {
+			m, ok := msg.(*evmtypes.MsgEVMTransaction)
+			if !ok || m.IsAssociateTx() {
+				continue
+			}
+			ethtx, _ := m.AsTransaction()
+			if ethtx == nil {
+				continue
+			}
+			hashes = append(hashes, ethtx.Hash())
+		}
+		return hashes
+	}
+}
+
+// evmTxResult returns what the result of a Tendermint transaction commits to about the receipt of its
+// EVM transaction: the MsgEVMTransactionResponse that the result data wraps.
+func evmTxResult(res *abci.ExecTxResult) (lightproxy.TxResult, bool) {
+	var data sdk.TxMsgData
+	if err := data.Unmarshal(res.Data); err != nil {
+		return lightproxy.TxResult{}, false
+	}
+	for _, d := range data.Data {
+		if d.MsgType != sdk.MsgTypeURL(&evmtypes.MsgEVMTransaction{}) {
+			continue
+		}
+		var evmRes evmtypes.MsgEVMTransactionResponse
+		if err := evmRes.Unmarshal(d.Data); err != nil {
+			return lightproxy.TxResult{}, false
+		}
+		status := ethtypes.ReceiptStatusSuccessful
+		if res.Code != 0 || evmRes.VmError != "" {
+			status = ethtypes.ReceiptStatusFailed
+		}
+		logs := make([]*ethtypes.Log, len(evmRes.Logs))
+		for i, l := range evmRes.Logs {
+			logs[i] = &ethtypes.Log{Address: common.HexToAddress(l.Address), Topics: utils.Map(l.Topics, common.HexToHash), Data: l.Data}
+		}
+		return lightproxy.TxResult{
+			Hash:    common.HexToHash(evmRes.Hash),
+			Status:  status,
+			GasUsed: evmRes.GasUsed,
+			Logs:    logs,
+		}, true
+	}
+	return lightproxy.TxResult{}, false
+}
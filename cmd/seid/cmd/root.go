@@ -147,6 +147,7 @@ func initRootCmd(
 		config.Cmd(),
 		tools.ToolCmd(),
 		SnapshotCmd(),
+		SnapshotPublishCmd(),
 		LogLevelCmd(),
 	)
 
@@ -172,6 +173,8 @@ func initRootCmd(
 		keys.Commands(app.DefaultNodeHome),
 		ReplayCmd(app.DefaultNodeHome),
 		BlocktestCmd(app.DefaultNodeHome),
+		AppHashBisectCmd(),
+		EVMLightProxyCmd(encodingConfig.TxConfig),
 	)
 }
 
@@ -473,6 +476,33 @@ evm_query_gas_limit = {{ .EvmQuery.GasLimit }}
 
 [light_invariance]
 supply_enabled = {{ .LightInvariance.SupplyEnabled }}
+
+###############################################################################
+###                   OCC Conflict Prediction Configuration                 ###
+###############################################################################
+
+[occ_prediction]
+# Sequence transactions predicted to conflict instead of running them
+# optimistically. Predictions come from EVM access lists, ERC20 transfer
+# calldata and the conflicts of recent blocks.
+enabled = {{ .OCCPrediction.Enabled }}
+
+# Keys always treated as contended, as "<store>/<hex key>" in the format of
+# the "occ scheduler key conflicts" log.
+hot_keys = [{{- range $i, $k := .OCCPrediction.HotKeys }}{{- if $i }}, {{ end }}"{{ $k }}"{{- end }}]
+
+###############################################################################
+###                   OCC Conflict Profiler Configuration                   ###
+###############################################################################
+
+[occ_profiler]
+# Record the keys behind OCC validation failures, attributed to contracts for
+# EVM storage. Reports are served by the admin GetOCCContention call and the
+# debug_occContention EVM RPC.
+enabled = {{ .OCCProfiler.Enabled }}
+
+# Number of most recent blocks the reports cover.
+window_blocks = {{ .OCCProfiler.WindowBlocks }}
 `
 
 	return customAppTemplate, customAppConfig
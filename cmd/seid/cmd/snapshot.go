@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -9,8 +10,10 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-cosmos/client/flags"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/server"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/snapshots"
+	snapshottypes "github.com/sei-protocol/sei-chain/sei-cosmos/snapshots/types"
 	storetypes "github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	tmexport "github.com/sei-protocol/sei-chain/sei-tendermint/export"
 	"github.com/sei-protocol/sei-chain/sei-wasmd/x/wasm"
 	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
@@ -118,3 +121,86 @@ func SnapshotCmd() *cobra.Command {
 
 	return cmd
 }
+
+// SnapshotPublishCmd creates a new command to publish a local snapshot to a snapshot archive,
+// which nodes can state sync from by setting statesync.snapshot-archive. Uploads over HTTP are
+// unsigned, so they need a server accepting anonymous PUTs; to publish to an S3 bucket requiring
+// credentials, publish to a directory and upload it with the S3 tools.
+func SnapshotPublishCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish-snapshot [archive]",
+		Short: "Publish a local snapshot to a snapshot archive (a directory or an http(s) URL accepting unsigned PUTs)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homeDir := serverCtx.Config.RootDir
+
+			height, err := cmd.Flags().GetUint64("height")
+			if err != nil {
+				return fmt.Errorf("failed to get height: %w", err)
+			}
+			format, err := cmd.Flags().GetUint32("format")
+			if err != nil {
+				return fmt.Errorf("failed to get format: %w", err)
+			}
+			snapshotDir, err := cmd.Flags().GetString("snapshot-dir")
+			if err != nil {
+				return fmt.Errorf("failed to get snapshot directory: %w", err)
+			}
+			if snapshotDir == "" {
+				snapshotDir = filepath.Join(homeDir, "data", "snapshots")
+			}
+
+			snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+			if err != nil {
+				return fmt.Errorf("failed to open snapshot metadata: %w", err)
+			}
+			defer func() { _ = snapshotDB.Close() }()
+			snapshotStore, err := snapshots.NewStore(snapshotDB, snapshotDir)
+			if err != nil {
+				return fmt.Errorf("failed to open snapshot store: %w", err)
+			}
+			snapshot, err := snapshotStore.Get(height, format)
+			if err != nil {
+				return fmt.Errorf("failed to get snapshot: %w", err)
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d with format %d not found in %s", height, format, snapshotDir)
+			}
+			abciSnapshot, err := snapshot.ToABCI()
+			if err != nil {
+				return err
+			}
+
+			archive, err := tmexport.OpenSnapshotArchive(args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Publishing snapshot at height %d with %d chunks...\n", height, snapshot.Chunks)
+			_, err = archive.Publish(cmd.Context(), &abciSnapshot, func(index uint32) ([]byte, error) {
+				chunk, err := snapshotStore.LoadChunk(height, format, index)
+				if err != nil {
+					return nil, err
+				}
+				if chunk == nil {
+					return nil, fmt.Errorf("chunk %d not found", index)
+				}
+				defer func() { _ = chunk.Close() }()
+				return io.ReadAll(chunk)
+			})
+			if err != nil {
+				return fmt.Errorf("failed to publish snapshot: %w", err)
+			}
+
+			fmt.Printf("Successfully published snapshot at height %d to %s\n", height, args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64("height", 0, "Height of the snapshot to publish (required)")
+	cmd.Flags().Uint32("format", snapshottypes.CurrentFormat, "Format of the snapshot to publish")
+	cmd.Flags().String("snapshot-dir", "", "Directory the snapshot is stored in (default: <home>/data/snapshots)")
+	_ = cmd.MarkFlagRequired("height")
+
+	return cmd
+}
@@ -239,6 +239,12 @@ type Config struct {
 	TraceBakeUseSnapshot    bool  `mapstructure:"trace_bake_use_snapshot"`
 	TraceBakeSnapshotWindow int64 `mapstructure:"trace_bake_snapshot_window"` // recent snapshots to keep (default 64)
 
+	// BlobSidecarRetention keeps the sidecars of executed blob transactions
+	// at <home>/data/blob_sidecars for this long, serving eth_getBlobSidecars.
+	// 0 disables the store. Only has an effect while the blob_txs_enabled
+	// EVM param is on.
+	BlobSidecarRetention time.Duration `mapstructure:"blob_sidecar_retention"`
+
 	// IPRateLimitRPS is the per-IP sustained request rate in requests/second.
 	// Zero disables the token bucket (no HTTP 429 rejections). When
 	// rate_limiting_enabled is true, the admission middleware still runs: bodies
@@ -366,6 +372,7 @@ var DefaultConfig = Config{
 	TraceBakeWindowBlocks:     0,
 	TraceBakeUseSnapshot:      false,
 	TraceBakeSnapshotWindow:   64,
+	BlobSidecarRetention:      0,
 	IPRateLimitRPS:            200,
 	IPRateLimitBurst:          defaultBatchRequestLimit,
 	RateLimitingEnabled:       false,
@@ -426,6 +433,7 @@ const (
 	flagTraceBakeWindowBlocks        = "evm.trace_bake_window_blocks"
 	flagTraceBakeUseSnapshot         = "evm.trace_bake_use_snapshot"
 	flagTraceBakeSnapshotWindow      = "evm.trace_bake_snapshot_window"
+	flagBlobSidecarRetention         = "evm.blob_sidecar_retention"
 	flagIPRateLimitRPS               = "evm.ip_rate_limit_rps"
 	flagIPRateLimitBurst             = "evm.ip_rate_limit_burst"
 	flagRateLimitingEnabled          = "evm.rate_limiting_enabled"
@@ -681,6 +689,14 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagBlobSidecarRetention); v != nil {
+		if cfg.BlobSidecarRetention, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.BlobSidecarRetention < 0 {
+			return cfg, fmt.Errorf("%s must be >= 0 (0 disables the sidecar store), got %s", flagBlobSidecarRetention, cfg.BlobSidecarRetention)
+		}
+	}
 	if v := opts.Get(flagIPRateLimitRPS); v != nil {
 		if cfg.IPRateLimitRPS, err = cast.ToFloat64E(v); err != nil {
 			return cfg, err
@@ -968,6 +984,11 @@ trace_bake_use_snapshot = {{ .EVM.TraceBakeUseSnapshot }}
 # Number of recent memiavl snapshots to retain for trace baking.
 trace_bake_snapshot_window = {{ .EVM.TraceBakeSnapshotWindow }}
 
+# How long to keep the sidecars of executed blob (EIP-4844) transactions for
+# eth_getBlobSidecars, e.g. "432h". 0 disables the sidecar store. Only matters
+# while the experimental blob_txs_enabled EVM param is on.
+blob_sidecar_retention = "{{ .EVM.BlobSidecarRetention }}"
+
 # ip_rate_limit_rps is the per-IP sustained request rate in requests/second.
 # Set to 0 to disable per-IP throttling (no HTTP 429). Does not bypass the
 # admission middleware; set rate_limiting_enabled = false for a full bypass.
@@ -88,6 +88,7 @@ var evmKeys = []configtest.KeySpec{
 	{Key: "evm.trace_bake_window_blocks", Path: "TraceBakeWindowBlocks", Cast: configtest.CastInt64, Checked: true},
 	{Key: "evm.trace_bake_use_snapshot", Path: "TraceBakeUseSnapshot", Cast: configtest.CastBool, Checked: true},
 	{Key: "evm.trace_bake_snapshot_window", Path: "TraceBakeSnapshotWindow", Cast: configtest.CastInt64, Checked: true},
+	{Key: "evm.blob_sidecar_retention", Path: "BlobSidecarRetention", Cast: configtest.CastDuration, Checked: true},
 	{Key: "evm.ip_rate_limit_rps", Path: "IPRateLimitRPS", Cast: configtest.CastFloat64, Checked: true},
 	{Key: "evm.ip_rate_limit_burst", Path: "IPRateLimitBurst", Cast: configtest.CastInt, Checked: true},
 	{Key: "evm.rate_limiting_enabled", Path: "RateLimitingEnabled", Cast: configtest.CastBool, Checked: true},
@@ -176,7 +176,8 @@ func (o *opts) Get(k string) interface{} {
 		k == "evm.trace_bake_queue_size" ||
 		k == "evm.trace_bake_window_blocks" ||
 		k == "evm.trace_bake_use_snapshot" ||
-		k == "evm.trace_bake_snapshot_window" {
+		k == "evm.trace_bake_snapshot_window" ||
+		k == "evm.blob_sidecar_retention" {
 		return nil
 	}
 	if k == "evm.ip_rate_limit_rps" {
@@ -151,3 +151,6 @@ func (i *InfoAPI) GasPriceHelperForTest(ctx context.Context, baseFee *big.Int, t
 func (i *InfoAPI) CalculateGasUsedRatioForTest(ctx context.Context, blockHeight int64) (float64, error) {
 	return i.calculateGasUsedRatio(ctx, blockHeight)
 }
+
+// CheckTxErrorForTest exposes checkTxError so tests can feed it CheckTx failures.
+var CheckTxErrorForTest = checkTxError
@@ -297,7 +297,63 @@ func (i *InfoAPI) BlobBaseFee(ctx context.Context) (result *hexutil.Big, returnE
 	defer func() {
 		recordMetricsWithError(ctx, "eth_BlobBaseFee", i.connectionType, startTime, returnErr, recover())
 	}()
-	return nil, &ErrEVMNotSupported{Msg: "blobs not supported on this chain"}
+	sdkCtx := i.ctxProvider(LatestCtxHeight)
+	if !i.keeper.BlobTxsEnabled(sdkCtx) {
+		return nil, &ErrEVMNotSupported{Msg: "blobs not supported on this chain"}
+	}
+	return (*hexutil.Big)(i.keeper.GetBlobBaseFee(sdkCtx)), nil
+}
+
+// BlobSidecar is one blob transaction's sidecar as returned by eth_getBlobSidecars.
+type BlobSidecar struct {
+	BlockNumber         hexutil.Uint64  `json:"blockNumber"`
+	TransactionHash     common.Hash     `json:"transactionHash"`
+	BlobVersionedHashes []common.Hash   `json:"blobVersionedHashes"`
+	Blobs               []hexutil.Bytes `json:"blobs"`
+	Commitments         []hexutil.Bytes `json:"commitments"`
+	Proofs              []hexutil.Bytes `json:"proofs"`
+}
+
+// GetBlobSidecars returns the sidecars of the blob transactions in a block, in transaction order.
+// Sidecars are kept by nodes that set [evm].blob_sidecar_retention, and only for that long.
+func (i *InfoAPI) GetBlobSidecars(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []*BlobSidecar, returnErr error) {
+	startTime := time.Now()
+	defer func() {
+		recordMetricsWithError(ctx, "eth_getBlobSidecars", i.connectionType, startTime, returnErr, recover())
+	}()
+	store := i.keeper.BlobSidecarStore()
+	if store == nil {
+		return nil, &ErrEVMNotSupported{Msg: "blob sidecars are not kept by this node"}
+	}
+	blockNumber, err := GetBlockNumberByNrOrHash(ctx, i.tmClient, i.watermarks, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	var height int64
+	if blockNumber != nil {
+		height = *blockNumber
+	} else if height, err = i.latestHeight(ctx); err != nil {
+		return nil, err
+	}
+	sidecars, err := store.GetBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]*BlobSidecar, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		res := &BlobSidecar{
+			BlockNumber:         hexutil.Uint64(height), //nolint:gosec
+			TransactionHash:     sidecar.TxHash,
+			BlobVersionedHashes: sidecar.BlobHashes,
+		}
+		for j := range sidecar.Blobs {
+			res.Blobs = append(res.Blobs, sidecar.Blobs[j][:])
+			res.Commitments = append(res.Commitments, sidecar.Commitments[j][:])
+			res.Proofs = append(res.Proofs, sidecar.Proofs[j][:])
+		}
+		result = append(result, res)
+	}
+	return result, nil
 }
 
 // Syncing implements eth_syncing. It is intentionally registered (not removed): the RPC returns
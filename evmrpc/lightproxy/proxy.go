@@ -0,0 +1,372 @@
+// Package lightproxy implements an EVM JSON-RPC proxy that checks the answers of an untrusted upstream
+// RPC against block headers verified by a Tendermint light client.
+//
+// Sei derives the EVM view of a block from its Tendermint block: the EVM block hash is the Tendermint
+// block hash, the parent hash is the last block ID, the state root is the app hash, the receipts root
+// is the last results hash, and the transactions root is the data hash. An EVM transaction is committed
+// by the bytes of the Tendermint transaction carrying it, and its fields by its hash. So block headers,
+// and the inclusion and contents of a transaction, can be checked against a verified Tendermint block.
+// The status, gas used and logs of a receipt are committed by the result of the Tendermint transaction,
+// which the light client verifies against the last results hash of the next block.
+//
+// State reads such as eth_getBalance and eth_call are committed by the app hash, but through the
+// application's own store proofs rather than an Ethereum state trie, which the upstream RPC does not
+// serve. They, and results that are not committed in EVM terms (such as receipts of transactions that
+// are not EVM transactions), are forwarded unverified, or rejected when Config.RejectUnverified is set.
+package lightproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/coretypes"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+	"github.com/sei-protocol/seilog"
+)
+
+var logger = seilog.NewLogger("evmrpc", "lightproxy")
+
+// Error codes of the proxy's own JSON-RPC errors, in the range reserved for implementation-defined
+// server errors.
+const (
+	// The upstream answer contradicts a verified block.
+	ErrCodeVerificationFailed = -32090
+	// The method's result cannot be verified and Config.RejectUnverified is set.
+	ErrCodeUnverifiable = -32091
+	// The upstream RPC could not be reached or did not answer.
+	ErrCodeUpstream = -32092
+)
+
+// BlockSource returns Tendermint blocks and block results that have been verified against the light
+// client. The light client RPC (sei-tendermint/light/rpc.Client) implements it.
+type BlockSource interface {
+	Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error)
+}
+
+// TxHashesFunc returns the hashes of the EVM transactions carried by a Tendermint transaction. Every
+// transaction is also known by the SHA-256 of its bytes, which is the hash the EVM RPC reports for
+// transactions that are not EVM transactions, so the function need not return it.
+type TxHashesFunc func(tx tmtypes.Tx) []common.Hash
+
+// TxResult is what the result of a Tendermint transaction commits to about the receipt of the EVM
+// transaction it carries.
+type TxResult struct {
+	Hash    common.Hash
+	Status  uint64
+	GasUsed uint64
+	Logs    []*ethtypes.Log
+}
+
+// TxResultFunc returns what a Tendermint transaction result commits to about an EVM receipt, or false
+// if the transaction is not an EVM transaction.
+type TxResultFunc func(res *abci.ExecTxResult) (TxResult, bool)
+
+// Config configures a Proxy.
+type Config struct {
+	// The URL of the untrusted EVM JSON-RPC endpoint.
+	Upstream string
+	// The EVM chain ID of the light client's chain. eth_chainId answers must match it, and transaction
+	// signatures are checked against it.
+	ChainID *big.Int
+	// Reject calls whose results cannot be verified instead of forwarding them unverified.
+	RejectUnverified bool
+	// Timeout of a call to the upstream RPC, including the verification of its result.
+	Timeout time.Duration
+	// The maximum size of a request body.
+	MaxBodyBytes int64
+	// How many verified blocks to keep.
+	BlockCacheSize int
+}
+
+// DefaultConfig returns the default configuration for the given upstream RPC.
+func DefaultConfig(upstream string) Config {
+	return Config{
+		Upstream:       upstream,
+		Timeout:        30 * time.Second,
+		MaxBodyBytes:   5 << 20,
+		BlockCacheSize: 256,
+	}
+}
+
+// Proxy is an http.Handler serving EVM JSON-RPC. It forwards calls to the upstream RPC and verifies the
+// results of the methods listed in verifiers before returning them.
+type Proxy struct {
+	cfg      Config
+	blocks   BlockSource
+	txHashes TxHashesFunc
+	txResult TxResultFunc
+	client   *http.Client
+	verified *lru.Cache[int64, *verifiedBlock]
+	// The verified results of the EVM transactions of a block, by transaction hash.
+	results *lru.Cache[int64, map[common.Hash]TxResult]
+}
+
+// verifiedBlock is what a verified Tendermint block commits to, in EVM terms.
+type verifiedBlock struct {
+	header *tmtypes.Header
+	hash   common.Hash
+	// The hashes of the block's transactions, under both of the names the EVM RPC may use. True for
+	// the hashes of EVM transactions, whose fields the hash commits to.
+	txs map[common.Hash]bool
+}
+
+// NewProxy returns a proxy to cfg.Upstream checking results against the blocks of blocks.
+func NewProxy(cfg Config, blocks BlockSource, txHashes TxHashesFunc, txResult TxResultFunc) (*Proxy, error) {
+	if cfg.Upstream == "" {
+		return nil, errors.New("upstream RPC URL cannot be empty")
+	}
+	if cfg.ChainID == nil {
+		return nil, errors.New("chain ID cannot be empty")
+	}
+	verified, err := lru.New[int64, *verifiedBlock](cfg.BlockCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("block cache: %w", err)
+	}
+	results, err := lru.New[int64, map[common.Hash]TxResult](cfg.BlockCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("block results cache: %w", err)
+	}
+	return &Proxy{
+		cfg:      cfg,
+		blocks:   blocks,
+		txHashes: txHashes,
+		txResult: txResult,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		verified: verified,
+		results:  results,
+	}, nil
+}
+
+type request struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id,omitempty"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func errorResponse(id json.RawMessage, code int, msg string) *response {
+	return &response{JSONRPC: "2.0", ID: id, Error: &rpcError{Code: code, Message: msg}}
+}
+
+// ServeHTTP handles a single or batched JSON-RPC call.
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, p.cfg.MaxBodyBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	ctx, cancel := context.WithTimeout(r.Context(), p.cfg.Timeout)
+	defer cancel()
+
+	body = bytes.TrimSpace(body)
+	var out any
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []*request
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			out = errorResponse(nil, -32700, "parse error: "+err.Error())
+		} else {
+			out = p.handle(ctx, reqs)
+		}
+	} else {
+		var req request
+		if err := json.Unmarshal(body, &req); err != nil {
+			out = errorResponse(nil, -32700, "parse error: "+err.Error())
+		} else {
+			out = p.handle(ctx, []*request{&req})[0]
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(out); err != nil {
+		logger.Debug("failed to write response", "err", err)
+	}
+}
+
+// handle forwards reqs to the upstream RPC in one batch and verifies the results, returning a response
+// per request in request order.
+func (p *Proxy) handle(ctx context.Context, reqs []*request) []*response {
+	resps := make([]*response, len(reqs))
+	var forward []*request
+	var forwardIdx []int
+	for i, req := range reqs {
+		if _, ok := verifiers[req.Method]; !ok && p.cfg.RejectUnverified && !trustless[req.Method] {
+			resps[i] = errorResponse(req.ID, ErrCodeUnverifiable,
+				fmt.Sprintf("%s cannot be verified against block headers", req.Method))
+			continue
+		}
+		forward = append(forward, req)
+		forwardIdx = append(forwardIdx, i)
+	}
+	if len(forward) == 0 {
+		return resps
+	}
+
+	upstream, err := p.forward(ctx, forward)
+	if err != nil {
+		logger.Error("upstream RPC call failed", "err", err)
+		for _, i := range forwardIdx {
+			resps[i] = errorResponse(reqs[i].ID, ErrCodeUpstream, "upstream RPC call failed: "+err.Error())
+		}
+		return resps
+	}
+	for j, i := range forwardIdx {
+		req, resp := reqs[i], upstream[j]
+		if resp.Error == nil {
+			if verify, ok := verifiers[req.Method]; ok {
+				err := verify(ctx, p, req.Params, resp.Result)
+				switch {
+				case errors.Is(err, errUnverifiable):
+					if p.cfg.RejectUnverified {
+						resp = errorResponse(req.ID, ErrCodeUnverifiable, err.Error())
+					}
+				case err != nil:
+					logger.Error("upstream RPC result failed verification", "method", req.Method, "err", err)
+					resp = errorResponse(req.ID, ErrCodeVerificationFailed, "verification failed: "+err.Error())
+				}
+			}
+		}
+		resps[i] = resp
+	}
+	return resps
+}
+
+// forward sends reqs to the upstream RPC as a batch, and returns the responses in request order.
+func (p *Proxy) forward(ctx context.Context, reqs []*request) ([]*response, error) {
+	// Upstream ids are the positions in the batch, so a response can be matched to its request no
+	// matter which ids the caller chose.
+	batch := make([]request, len(reqs))
+	for i, req := range reqs {
+		batch[i] = *req
+		batch[i].JSONRPC = "2.0"
+		batch[i].ID = json.RawMessage(fmt.Sprint(i))
+	}
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.cfg.Upstream, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := p.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = httpResp.Body.Close() }()
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("upstream answered %s", httpResp.Status)
+	}
+	var upstream []*response
+	if err := json.NewDecoder(httpResp.Body).Decode(&upstream); err != nil {
+		return nil, fmt.Errorf("decoding upstream response: %w", err)
+	}
+
+	resps := make([]*response, len(reqs))
+	for _, resp := range upstream {
+		var i int
+		if err := json.Unmarshal(resp.ID, &i); err != nil || i < 0 || i >= len(reqs) || resps[i] != nil {
+			return nil, fmt.Errorf("upstream response has unexpected id %s", resp.ID)
+		}
+		resp.ID = reqs[i].ID
+		resps[i] = resp
+	}
+	for i, resp := range resps {
+		if resp == nil {
+			return nil, fmt.Errorf("upstream sent no response for %s", reqs[i].Method)
+		}
+	}
+	return resps, nil
+}
+
+// block returns the verified block at height.
+func (p *Proxy) block(ctx context.Context, height int64) (*verifiedBlock, error) {
+	if b, ok := p.verified.Get(height); ok {
+		return b, nil
+	}
+	res, err := p.blocks.Block(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("verifying block %d: %w", height, err)
+	}
+	b := &verifiedBlock{
+		header: &res.Block.Header,
+		hash:   common.BytesToHash(res.Block.Hash()),
+		txs:    make(map[common.Hash]bool, len(res.Block.Txs)),
+	}
+	for _, tx := range res.Block.Txs {
+		b.txs[sha256.Sum256(tx)] = false
+	}
+	for _, tx := range res.Block.Txs {
+		for _, h := range p.txHashes(tx) {
+			b.txs[h] = true
+		}
+	}
+	p.verified.Add(height, b)
+	return b, nil
+}
+
+// checkInclusion checks that the transaction txHash is in the verified block at height, whose hash the
+// upstream RPC reported as blockHash, and reports whether it is an EVM transaction.
+func (p *Proxy) checkInclusion(ctx context.Context, height uint64, blockHash, txHash common.Hash) (bool, error) {
+	b, err := p.block(ctx, int64(height)) //nolint:gosec // block heights fit in int64
+	if err != nil {
+		return false, err
+	}
+	if blockHash != b.hash {
+		return false, fmt.Errorf("block %d has hash %s, not %s", height, b.hash, blockHash)
+	}
+	evm, ok := b.txs[txHash]
+	if !ok {
+		return false, fmt.Errorf("transaction %s is not in block %d", txHash, height)
+	}
+	return evm, nil
+}
+
+// blockResults returns the verified results of the EVM transactions of the block at height.
+func (p *Proxy) blockResults(ctx context.Context, height int64) (map[common.Hash]TxResult, error) {
+	if r, ok := p.results.Get(height); ok {
+		return r, nil
+	}
+	res, err := p.blocks.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, fmt.Errorf("verifying results of block %d: %w", height, err)
+	}
+	r := make(map[common.Hash]TxResult, len(res.TxsResults))
+	for _, txr := range res.TxsResults {
+		if tr, ok := p.txResult(txr); ok {
+			r[tr.Hash] = tr
+		}
+	}
+	p.results.Add(height, r)
+	return r, nil
+}
@@ -0,0 +1,362 @@
+package lightproxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/coretypes"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+var chainID = big.NewInt(1329)
+
+// fakeBlocks serves blocks, and the results of their transactions, as if verified by a light client.
+type fakeBlocks map[int64]*tmtypes.Block
+
+func (f fakeBlocks) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	b, ok := f[*height]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", *height)
+	}
+	return &coretypes.ResultBlock{Block: b}, nil
+}
+
+// BlockResults returns a result per transaction carrying the TxResult, JSON-encoded, that txResult
+// gives the transaction.
+func (f fakeBlocks) BlockResults(_ context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	b, ok := f[*height]
+	if !ok {
+		return nil, fmt.Errorf("no block at height %d", *height)
+	}
+	res := &coretypes.ResultBlockResults{Height: *height}
+	for _, tx := range b.Txs {
+		tr, ok := txResults[string(tx)]
+		if !ok {
+			res.TxsResults = append(res.TxsResults, &abci.ExecTxResult{})
+			continue
+		}
+		data, err := json.Marshal(tr)
+		if err != nil {
+			return nil, err
+		}
+		res.TxsResults = append(res.TxsResults, &abci.ExecTxResult{Data: data})
+	}
+	return res, nil
+}
+
+// txResults holds the verified results of the tests' EVM transactions, by Tendermint transaction.
+var txResults = map[string]TxResult{}
+
+func txResult(res *abci.ExecTxResult) (TxResult, bool) {
+	var tr TxResult
+	if err := json.Unmarshal(res.Data, &tr); err != nil {
+		return TxResult{}, false
+	}
+	return tr, true
+}
+
+// txHashes decodes Tendermint transactions that are signed EVM transactions.
+func txHashes(tx tmtypes.Tx) []common.Hash {
+	var ethTx ethtypes.Transaction
+	if err := ethTx.UnmarshalBinary(tx); err != nil {
+		return nil
+	}
+	return []common.Hash{ethTx.Hash()}
+}
+
+// signedTx returns a signed EVM transaction, carried by a Tendermint transaction of its bytes, whose
+// verified result has the given status, gas used and logs.
+func signedTx(t *testing.T, nonce uint64, status uint64, logs ...*ethtypes.Log) (*ethtypes.Transaction, tmtypes.Tx) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	to := common.HexToAddress("0x1234")
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+		ChainID: chainID, Nonce: nonce, GasTipCap: big.NewInt(1), GasFeeCap: big.NewInt(100), Gas: 21000, To: &to, Value: big.NewInt(5),
+	})
+	require.NoError(t, err)
+	bz, err := ethTx.MarshalBinary()
+	require.NoError(t, err)
+	txResults[string(bz)] = TxResult{Hash: ethTx.Hash(), Status: status, GasUsed: 21000, Logs: logs}
+	return ethTx, tmtypes.Tx(bz)
+}
+
+// rpcTxOf renders ethTx the way the EVM RPC does, placed in block b.
+func rpcTxOf(t *testing.T, ethTx *ethtypes.Transaction, b *tmtypes.Block) map[string]any {
+	t.Helper()
+	bz, err := ethTx.MarshalJSON()
+	require.NoError(t, err)
+	var tx map[string]any
+	require.NoError(t, json.Unmarshal(bz, &tx))
+	from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), ethTx)
+	require.NoError(t, err)
+	tx["from"] = from
+	tx["blockHash"] = common.BytesToHash(b.Hash())
+	tx["blockNumber"] = hexutil.Uint64(b.Height) //nolint:gosec
+	return tx
+}
+
+func makeBlock(height int64, txs ...tmtypes.Tx) *tmtypes.Block {
+	b := tmtypes.MakeBlock(height, txs, &tmtypes.Commit{}, nil)
+	b.ValidatorsHash = bytes.Repeat([]byte{1}, 32)
+	b.AppHash = bytes.Repeat([]byte{byte(height)}, 32)
+	b.LastResultsHash = bytes.Repeat([]byte{2}, 32)
+	b.ProposerAddress = bytes.Repeat([]byte{3}, 20)
+	b.Time = time.Unix(1700000000+height, 0)
+	return b
+}
+
+// rpcBlockOf renders b the way the EVM RPC does, listing the given transaction hashes.
+func rpcBlockOf(b *tmtypes.Block, txHashes ...common.Hash) map[string]any {
+	if txHashes == nil {
+		txHashes = []common.Hash{}
+	}
+	return map[string]any{
+		"number":           hexutil.Uint64(b.Height), //nolint:gosec
+		"hash":             common.BytesToHash(b.Hash()),
+		"parentHash":       common.BytesToHash(b.LastBlockID.Hash),
+		"stateRoot":        common.BytesToHash(b.AppHash),
+		"transactionsRoot": common.BytesToHash(b.DataHash),
+		"receiptsRoot":     common.BytesToHash(b.LastResultsHash),
+		"miner":            common.BytesToAddress(b.ProposerAddress),
+		"timestamp":        hexutil.Uint64(b.Time.Unix()), //nolint:gosec
+		"transactions":     txHashes,
+	}
+}
+
+// fakeUpstream answers every call of a batch with the result set for its method.
+func fakeUpstream(t *testing.T, results map[string]any) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var reqs []request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&reqs))
+		resps := make([]map[string]any, len(reqs))
+		for i, req := range reqs {
+			resps[i] = map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": results[req.Method]}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resps))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// call sends a JSON-RPC call to the proxy and returns its response.
+func call(t *testing.T, p *Proxy, method string, params ...any) *response {
+	t.Helper()
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": 7, "method": method, "params": params})
+	require.NoError(t, err)
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	require.Equal(t, http.StatusOK, rec.Code)
+	var resp response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "7", string(resp.ID))
+	return &resp
+}
+
+func newProxy(t *testing.T, upstream map[string]any, rejectUnverified bool, blocks fakeBlocks) *Proxy {
+	t.Helper()
+	cfg := DefaultConfig(fakeUpstream(t, upstream).URL)
+	cfg.ChainID = chainID
+	cfg.RejectUnverified = rejectUnverified
+	p, err := NewProxy(cfg, blocks, txHashes, txResult)
+	require.NoError(t, err)
+	return p
+}
+
+func TestVerifyBlock(t *testing.T) {
+	ethTx, tx := signedTx(t, 0, 1)
+	block := makeBlock(5, tx)
+	blocks := fakeBlocks{5: block}
+
+	good := rpcBlockOf(block, ethTx.Hash())
+	resp := call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": good}, false, blocks), "eth_getBlockByNumber", "0x5", false)
+	require.Nil(t, resp.Error)
+
+	// Asking for another block than the one returned fails.
+	resp = call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": good}, false, blocks), "eth_getBlockByNumber", "0x6", false)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code)
+
+	// So does any header field that differs from the verified header.
+	for _, field := range []string{"hash", "parentHash", "stateRoot", "receiptsRoot", "transactionsRoot"} {
+		bad := rpcBlockOf(block, ethTx.Hash())
+		bad[field] = common.HexToHash("0xbad")
+		resp := call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": bad}, false, blocks), "eth_getBlockByNumber", "latest", false)
+		require.NotNil(t, resp.Error, field)
+		require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code, field)
+	}
+
+	// And a transaction that is not in the block.
+	bad := rpcBlockOf(block, ethTx.Hash(), common.HexToHash("0xbad"))
+	resp = call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": bad}, false, blocks), "eth_getBlockByNumber", "latest", false)
+	require.NotNil(t, resp.Error)
+
+	// A block without EVM transactions reports the empty transactions root.
+	empty := makeBlock(6, tmtypes.Tx("cosmos tx"))
+	rpcEmpty := rpcBlockOf(empty)
+	rpcEmpty["transactionsRoot"] = common.HexToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+	resp = call(t, newProxy(t, map[string]any{"eth_getBlockByHash": rpcEmpty}, false, fakeBlocks{6: empty}),
+		"eth_getBlockByHash", common.BytesToHash(empty.Hash()), false)
+	require.Nil(t, resp.Error)
+
+	// Transactions listed in full must have the fields their hash commits to.
+	full := rpcBlockOf(block)
+	full["transactions"] = []any{rpcTxOf(t, ethTx, block)}
+	resp = call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": full}, false, blocks), "eth_getBlockByNumber", "0x5", true)
+	require.Nil(t, resp.Error)
+	forged := rpcTxOf(t, ethTx, block)
+	forged["value"] = "0x6"
+	full["transactions"] = []any{forged}
+	resp = call(t, newProxy(t, map[string]any{"eth_getBlockByNumber": full}, false, blocks), "eth_getBlockByNumber", "0x5", true)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code)
+}
+
+func TestVerifyTransaction(t *testing.T) {
+	ethTx, tx := signedTx(t, 3, 1)
+	block := makeBlock(7, tx)
+	blocks := fakeBlocks{7: block}
+
+	good := rpcTxOf(t, ethTx, block)
+	resp := call(t, newProxy(t, map[string]any{"eth_getTransactionByHash": good}, true, blocks), "eth_getTransactionByHash", ethTx.Hash())
+	require.Nil(t, resp.Error)
+
+	// Fields that differ from the ones the hash commits to, or another sender, fail.
+	for field, value := range map[string]any{"to": common.HexToAddress("0xbad"), "nonce": "0x4", "input": "0x01", "from": common.HexToAddress("0xbad")} {
+		bad := rpcTxOf(t, ethTx, block)
+		bad[field] = value
+		resp := call(t, newProxy(t, map[string]any{"eth_getTransactionByHash": bad}, false, blocks), "eth_getTransactionByHash", ethTx.Hash())
+		require.NotNil(t, resp.Error, field)
+		require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code, field)
+	}
+
+	// A transaction that is not an EVM transaction has no fields to check.
+	wasmTx := tmtypes.Tx("wasm tx")
+	wasmBlock := makeBlock(8, wasmTx)
+	synthetic := map[string]any{
+		"hash": common.Hash(sha256.Sum256(wasmTx)), "blockHash": common.BytesToHash(wasmBlock.Hash()), "blockNumber": "0x8",
+	}
+	p := newProxy(t, map[string]any{"eth_getTransactionByHash": synthetic}, true, fakeBlocks{8: wasmBlock})
+	resp = call(t, p, "eth_getTransactionByHash", common.Hash(sha256.Sum256(wasmTx)))
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeUnverifiable, resp.Error.Code)
+	p = newProxy(t, map[string]any{"eth_getTransactionByHash": synthetic}, false, fakeBlocks{8: wasmBlock})
+	require.Nil(t, call(t, p, "eth_getTransactionByHash", common.Hash(sha256.Sum256(wasmTx))).Error)
+}
+
+func TestVerifyReceipt(t *testing.T) {
+	log := &ethtypes.Log{Address: common.HexToAddress("0xc0ffee"), Topics: []common.Hash{common.HexToHash("0x1")}, Data: []byte{1}}
+	ethTx, tx := signedTx(t, 0, 1, log)
+	wasmTx := tmtypes.Tx("wasm tx")
+	block := makeBlock(9, tx, wasmTx)
+	blockHash := common.BytesToHash(block.Hash())
+	receipt := func(blockHash, txHash common.Hash) map[string]any {
+		return map[string]any{
+			"blockHash":       blockHash,
+			"blockNumber":     "0x9",
+			"transactionHash": txHash,
+			"status":          "0x1",
+			"gasUsed":         "0x5208",
+			"logs": []map[string]any{{
+				"blockHash": blockHash, "blockNumber": "0x9", "transactionHash": txHash,
+				"address": log.Address, "topics": log.Topics, "data": hexutil.Bytes(log.Data),
+			}},
+		}
+	}
+	verify := func(r map[string]any, rejectUnverified bool, asked common.Hash) *response {
+		p := newProxy(t, map[string]any{"eth_getTransactionReceipt": r}, rejectUnverified, fakeBlocks{9: block})
+		return call(t, p, "eth_getTransactionReceipt", asked)
+	}
+	evmHash := ethTx.Hash()
+
+	require.Nil(t, verify(receipt(blockHash, evmHash), true, evmHash).Error)
+
+	// The status, gas used and logs must be those of the verified result.
+	for field, value := range map[string]any{"status": "0x0", "gasUsed": "0x5209"} {
+		r := receipt(blockHash, evmHash)
+		r[field] = value
+		resp := verify(r, false, evmHash)
+		require.NotNil(t, resp.Error, field)
+		require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code, field)
+	}
+	r := receipt(blockHash, evmHash)
+	r["logs"].([]map[string]any)[0]["data"] = "0x02"
+	resp := verify(r, true, evmHash)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeUnverifiable, resp.Error.Code)
+
+	// The receipt of a transaction that is not an EVM transaction, known by the SHA-256 of its bytes, is
+	// in the block but its contents cannot be verified.
+	wasmHash := common.Hash(sha256.Sum256(wasmTx))
+	resp = verify(receipt(blockHash, wasmHash), true, wasmHash)
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeUnverifiable, resp.Error.Code)
+	require.Nil(t, verify(receipt(blockHash, wasmHash), false, wasmHash).Error)
+
+	// A receipt placing the transaction in a block with another hash, or a transaction the block does
+	// not carry, fails.
+	require.NotNil(t, verify(receipt(common.HexToHash("0xbad"), evmHash), false, evmHash).Error)
+	missing := common.HexToHash("0x1234")
+	require.NotNil(t, verify(receipt(blockHash, missing), false, missing).Error)
+
+	// So does the receipt of another transaction than the one asked for.
+	require.NotNil(t, verify(receipt(blockHash, wasmHash), false, evmHash).Error)
+}
+
+func TestVerifyChainID(t *testing.T) {
+	require.Nil(t, call(t, newProxy(t, map[string]any{"eth_chainId": "0x531"}, true, fakeBlocks{}), "eth_chainId").Error)
+	resp := call(t, newProxy(t, map[string]any{"eth_chainId": "0x1"}, true, fakeBlocks{}), "eth_chainId")
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeVerificationFailed, resp.Error.Code)
+}
+
+func TestRejectUnverified(t *testing.T) {
+	upstream := map[string]any{"eth_getBalance": "0x10", "eth_chainId": "0x531"}
+
+	p := newProxy(t, upstream, false, fakeBlocks{})
+	resp := call(t, p, "eth_getBalance", common.Address{}, "latest")
+	require.Nil(t, resp.Error)
+	require.Equal(t, `"0x10"`, string(resp.Result))
+
+	p = newProxy(t, upstream, true, fakeBlocks{})
+	resp = call(t, p, "eth_getBalance", common.Address{}, "latest")
+	require.NotNil(t, resp.Error)
+	require.Equal(t, ErrCodeUnverifiable, resp.Error.Code)
+	require.Nil(t, call(t, p, "eth_chainId").Error)
+
+	// Fee and gas estimates cannot be verified.
+	for _, method := range []string{"eth_gasPrice", "eth_estimateGas"} {
+		resp := call(t, p, method)
+		require.NotNil(t, resp.Error, method)
+		require.Equal(t, ErrCodeUnverifiable, resp.Error.Code, method)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	p := newProxy(t, map[string]any{"eth_chainId": "0x531", "eth_getBalance": "0x10"}, true, fakeBlocks{})
+	body := []byte(`[{"jsonrpc":"2.0","id":"a","method":"eth_chainId"},{"jsonrpc":"2.0","id":"b","method":"eth_getBalance"}]`)
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	var resps []response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+	require.Len(t, resps, 2)
+	require.Equal(t, `"a"`, string(resps[0].ID))
+	require.Equal(t, `"0x531"`, string(resps[0].Result))
+	require.Equal(t, `"b"`, string(resps[1].ID))
+	require.Equal(t, ErrCodeUnverifiable, resps[1].Error.Code)
+}
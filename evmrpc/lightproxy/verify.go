@@ -0,0 +1,441 @@
+package lightproxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// A verifier checks the result an upstream RPC returned for a call with params. It returns an error
+// wrapping errUnverifiable for a result that cannot be checked.
+type verifier func(ctx context.Context, p *Proxy, params []json.RawMessage, result json.RawMessage) error
+
+// errUnverifiable marks a result that verified blocks do not commit to, such as the receipt of a
+// transaction that is not an EVM transaction. It is forwarded unverified, or rejected when
+// Config.RejectUnverified is set.
+var errUnverifiable = errors.New("result cannot be verified against block headers")
+
+// verifiers lists the methods whose results are checked against verified blocks.
+var verifiers = map[string]verifier{
+	"eth_chainId":                             verifyChainID,
+	"eth_getBlockByNumber":                    verifyBlockByNumber,
+	"eth_getBlockByHash":                      verifyBlockByHash,
+	"eth_getTransactionByHash":                verifyTxByHash,
+	"eth_getTransactionByBlockNumberAndIndex": verifyTxInBlock,
+	"eth_getTransactionByBlockHashAndIndex":   verifyTxInBlock,
+	"eth_getTransactionReceipt":               verifyReceiptByHash,
+	"eth_getBlockReceipts":                    verifyReceipts,
+	"eth_getLogs":                             verifyLogs,
+}
+
+// trustless lists the methods that are forwarded even with Config.RejectUnverified: those whose results
+// a wallet does not rely on for correctness, or checks by other means (a signed transaction cannot be
+// altered, only dropped). Fee and gas estimates are not among them: an inflated one costs the caller.
+var trustless = map[string]bool{
+	"net_version":            true,
+	"web3_clientVersion":     true,
+	"eth_syncing":            true,
+	"eth_blockNumber":        true,
+	"eth_sendRawTransaction": true,
+}
+
+// rpcBlock holds the fields of an EVM RPC block that a verified block commits to.
+type rpcBlock struct {
+	Number           hexutil.Uint64    `json:"number"`
+	Hash             common.Hash       `json:"hash"`
+	ParentHash       common.Hash       `json:"parentHash"`
+	StateRoot        common.Hash       `json:"stateRoot"`
+	TransactionsRoot common.Hash       `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash       `json:"receiptsRoot"`
+	Miner            common.Address    `json:"miner"`
+	Timestamp        hexutil.Uint64    `json:"timestamp"`
+	Transactions     []json.RawMessage `json:"transactions"`
+}
+
+// rpcTx holds the fields of an EVM RPC transaction, receipt or log that place it in a block.
+type rpcTx struct {
+	BlockHash   *common.Hash    `json:"blockHash"`
+	BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+	// Set for transactions.
+	Hash *common.Hash    `json:"hash"`
+	From *common.Address `json:"from"`
+	// Set for receipts and logs.
+	TransactionHash *common.Hash `json:"transactionHash"`
+	// Set for receipts.
+	Status  *hexutil.Uint64 `json:"status"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed"`
+	Logs    []rpcTx         `json:"logs"`
+	// Set for logs.
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+func (t *rpcTx) txHash() (common.Hash, error) {
+	switch {
+	case t.Hash != nil:
+		return *t.Hash, nil
+	case t.TransactionHash != nil:
+		return *t.TransactionHash, nil
+	default:
+		return common.Hash{}, errors.New("missing transaction hash")
+	}
+}
+
+func isNull(result json.RawMessage) bool {
+	return len(result) == 0 || bytes.Equal(result, []byte("null"))
+}
+
+// verifyChainID checks that the upstream RPC serves the light client's chain.
+func verifyChainID(_ context.Context, p *Proxy, _ []json.RawMessage, result json.RawMessage) error {
+	var chainID hexutil.Big
+	if err := json.Unmarshal(result, &chainID); err != nil {
+		return fmt.Errorf("decoding chain ID: %w", err)
+	}
+	if chainID.ToInt().Cmp(p.cfg.ChainID) != 0 {
+		return fmt.Errorf("chain ID is %s, the light client follows %s", chainID.ToInt(), p.cfg.ChainID)
+	}
+	return nil
+}
+
+func verifyBlockByNumber(ctx context.Context, p *Proxy, params []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		// Absence cannot be proven; a block that does not exist yet is not a risk to the caller.
+		return nil
+	}
+	b, err := verifyBlock(ctx, p, result)
+	if err != nil && !errors.Is(err, errUnverifiable) {
+		return err
+	}
+	// A tag such as "latest" resolves to whatever the upstream RPC considers latest; a number must
+	// resolve to that block.
+	if len(params) > 0 {
+		var tag string
+		if err := json.Unmarshal(params[0], &tag); err == nil && strings.HasPrefix(tag, "0x") {
+			want, err := hexutil.DecodeUint64(tag)
+			if err != nil {
+				return fmt.Errorf("invalid block number %q: %w", tag, err)
+			}
+			if uint64(b.Number) != want {
+				return fmt.Errorf("asked for block %d, got block %d", want, b.Number)
+			}
+		}
+	}
+	return err
+}
+
+func verifyBlockByHash(ctx context.Context, p *Proxy, params []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	b, err := verifyBlock(ctx, p, result)
+	if err != nil && !errors.Is(err, errUnverifiable) {
+		return err
+	}
+	if len(params) > 0 {
+		var want common.Hash
+		if err := json.Unmarshal(params[0], &want); err != nil {
+			return fmt.Errorf("invalid block hash: %w", err)
+		}
+		if b.Hash != want {
+			return fmt.Errorf("asked for block %s, got block %s", want, b.Hash)
+		}
+	}
+	return err
+}
+
+// verifyBlock checks an EVM RPC block against the verified block at its height: its header fields, and
+// that every transaction it lists is in the block, with the fields it was committed with.
+// Transactions listed in full that are not EVM transactions cannot be checked; the block is returned
+// with an error wrapping errUnverifiable then.
+func verifyBlock(ctx context.Context, p *Proxy, result json.RawMessage) (*rpcBlock, error) {
+	var b rpcBlock
+	if err := json.Unmarshal(result, &b); err != nil {
+		return nil, fmt.Errorf("decoding block: %w", err)
+	}
+	v, err := p.block(ctx, int64(b.Number)) //nolint:gosec // block heights fit in int64
+	if err != nil {
+		return nil, err
+	}
+	h := v.header
+	checks := []struct {
+		field     string
+		got, want any
+	}{
+		{"hash", b.Hash, v.hash},
+		{"parentHash", b.ParentHash, common.BytesToHash(h.LastBlockID.Hash)},
+		{"stateRoot", b.StateRoot, common.BytesToHash(h.AppHash)},
+		{"receiptsRoot", b.ReceiptsRoot, common.BytesToHash(h.LastResultsHash)},
+		{"miner", b.Miner, common.BytesToAddress(h.ProposerAddress)},
+		{"timestamp", uint64(b.Timestamp), uint64(h.Time.Unix())}, //nolint:gosec // block times are after 1970
+	}
+	for _, c := range checks {
+		if c.got != c.want {
+			return nil, fmt.Errorf("block %d: %s is %v, verified header has %v", b.Number, c.field, c.got, c.want)
+		}
+	}
+	// Blocks without EVM transactions report the empty root rather than the data hash.
+	if dataHash := common.BytesToHash(h.DataHash); b.TransactionsRoot != dataHash &&
+		(len(b.Transactions) > 0 || b.TransactionsRoot != ethtypes.EmptyTxsHash) {
+		return nil, fmt.Errorf("block %d: transactionsRoot is %s, verified header has %s", b.Number, b.TransactionsRoot, dataHash)
+	}
+
+	var unverifiable error
+	for _, raw := range b.Transactions {
+		// Transactions are listed by hash, or in full when asked for.
+		var txHash common.Hash
+		if err := json.Unmarshal(raw, &txHash); err == nil {
+			if _, ok := v.txs[txHash]; !ok {
+				return nil, fmt.Errorf("block %d: transaction %s is not in the verified block", b.Number, txHash)
+			}
+			continue
+		}
+		var tx rpcTx
+		if err := json.Unmarshal(raw, &tx); err != nil {
+			return nil, fmt.Errorf("block %d: decoding transaction: %w", b.Number, err)
+		}
+		if tx.BlockHash == nil || *tx.BlockHash != v.hash || tx.BlockNumber == nil || *tx.BlockNumber != b.Number {
+			return nil, fmt.Errorf("block %d: transaction is placed in another block", b.Number)
+		}
+		if err := verifyFullTx(ctx, p, raw, &tx); errors.Is(err, errUnverifiable) {
+			unverifiable = err
+		} else if err != nil {
+			return nil, fmt.Errorf("block %d: %w", b.Number, err)
+		}
+	}
+	return &b, unverifiable
+}
+
+// verifyTx checks that an EVM RPC transaction, receipt or log is in the block it claims, and returns
+// its transaction hash and whether it is an EVM transaction. Pending transactions are not in any block,
+// and are not checked.
+func verifyTx(ctx context.Context, p *Proxy, tx *rpcTx) (common.Hash, bool, error) {
+	txHash, err := tx.txHash()
+	if err != nil {
+		return common.Hash{}, false, err
+	}
+	if tx.BlockHash == nil || tx.BlockNumber == nil {
+		return txHash, true, nil
+	}
+	evm, err := p.checkInclusion(ctx, uint64(*tx.BlockNumber), *tx.BlockHash, txHash)
+	return txHash, evm, err
+}
+
+// verifyFullTx checks an EVM RPC transaction: that it is in the block it claims, and that its fields
+// are those its hash commits to, signed by the sender it reports.
+func verifyFullTx(ctx context.Context, p *Proxy, raw json.RawMessage, tx *rpcTx) error {
+	txHash, evm, err := verifyTx(ctx, p, tx)
+	if err != nil {
+		return err
+	}
+	if !evm {
+		return fmt.Errorf("%w: transaction %s is not an EVM transaction", errUnverifiable, txHash)
+	}
+	var ethTx ethtypes.Transaction
+	if err := ethTx.UnmarshalJSON(raw); err != nil {
+		return fmt.Errorf("decoding transaction %s: %w", txHash, err)
+	}
+	if ethTx.Hash() != txHash {
+		return fmt.Errorf("fields of transaction %s hash to %s", txHash, ethTx.Hash())
+	}
+	if tx.From != nil {
+		from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(p.cfg.ChainID), &ethTx)
+		if err != nil {
+			return fmt.Errorf("transaction %s: %w", txHash, err)
+		}
+		if from != *tx.From {
+			return fmt.Errorf("transaction %s is from %s, not %s", txHash, from, *tx.From)
+		}
+	}
+	return nil
+}
+
+// verifyHashParam checks that the transaction the upstream RPC returned is the one asked for.
+func verifyHashParam(params []json.RawMessage, got common.Hash) error {
+	if len(params) == 0 {
+		return nil
+	}
+	var want common.Hash
+	if err := json.Unmarshal(params[0], &want); err != nil {
+		return fmt.Errorf("invalid transaction hash: %w", err)
+	}
+	if got != want {
+		return fmt.Errorf("asked for transaction %s, got %s", want, got)
+	}
+	return nil
+}
+
+func verifyTxByHash(ctx context.Context, p *Proxy, params []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	var tx rpcTx
+	if err := json.Unmarshal(result, &tx); err != nil {
+		return fmt.Errorf("decoding transaction: %w", err)
+	}
+	txHash, err := tx.txHash()
+	if err != nil {
+		return err
+	}
+	if err := verifyHashParam(params, txHash); err != nil {
+		return err
+	}
+	return verifyFullTx(ctx, p, result, &tx)
+}
+
+func verifyTxInBlock(ctx context.Context, p *Proxy, _ []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	var tx rpcTx
+	if err := json.Unmarshal(result, &tx); err != nil {
+		return fmt.Errorf("decoding transaction: %w", err)
+	}
+	return verifyFullTx(ctx, p, result, &tx)
+}
+
+// verifyReceipt checks that a receipt is for a transaction in the block it claims, that its status, gas
+// used and logs are those of the verified result of the transaction, and that its logs claim the same
+// transaction.
+func verifyReceipt(ctx context.Context, p *Proxy, r *rpcTx) (common.Hash, error) {
+	txHash, evm, err := verifyTx(ctx, p, r)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	for _, l := range r.Logs {
+		if l.TransactionHash == nil || *l.TransactionHash != txHash ||
+			(r.BlockHash != nil && (l.BlockHash == nil || *l.BlockHash != *r.BlockHash)) {
+			return common.Hash{}, fmt.Errorf("receipt of %s has a log of another transaction", txHash)
+		}
+	}
+	if r.BlockNumber == nil {
+		return common.Hash{}, fmt.Errorf("receipt of %s is not in a block", txHash)
+	}
+	if !evm {
+		return txHash, fmt.Errorf("%w: transaction %s is not an EVM transaction", errUnverifiable, txHash)
+	}
+	res, err := txResult(ctx, p, uint64(*r.BlockNumber), txHash)
+	if err != nil {
+		return txHash, err
+	}
+	if r.Status == nil || uint64(*r.Status) != res.Status {
+		return common.Hash{}, fmt.Errorf("receipt of %s has status %v, the verified result has %d", txHash, r.Status, res.Status)
+	}
+	if r.GasUsed == nil || uint64(*r.GasUsed) != res.GasUsed {
+		return common.Hash{}, fmt.Errorf("receipt of %s has gas used %v, the verified result has %d", txHash, r.GasUsed, res.GasUsed)
+	}
+	for i := range r.Logs {
+		if err := verifyLogContents(&r.Logs[i], res); err != nil {
+			return txHash, err
+		}
+	}
+	return txHash, nil
+}
+
+// txResult returns the verified result of the EVM transaction txHash of the block at height.
+func txResult(ctx context.Context, p *Proxy, height uint64, txHash common.Hash) (TxResult, error) {
+	results, err := p.blockResults(ctx, int64(height)) //nolint:gosec // block heights fit in int64
+	if err != nil {
+		return TxResult{}, err
+	}
+	res, ok := results[txHash]
+	if !ok {
+		// The transaction failed before execution, and its result carries no receipt.
+		return TxResult{}, fmt.Errorf("%w: the result of transaction %s has no receipt", errUnverifiable, txHash)
+	}
+	return res, nil
+}
+
+// verifyLogContents checks that a log is one of those of the verified result res. A log added to the
+// receipt outside of EVM execution is not in the result, and cannot be verified.
+func verifyLogContents(l *rpcTx, res TxResult) error {
+	for _, want := range res.Logs {
+		if l.Address == want.Address && slices.Equal(l.Topics, want.Topics) && bytes.Equal(l.Data, want.Data) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: log of %s is not in the verified result", errUnverifiable, res.Hash)
+}
+
+func verifyReceiptByHash(ctx context.Context, p *Proxy, params []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	var r rpcTx
+	if err := json.Unmarshal(result, &r); err != nil {
+		return fmt.Errorf("decoding receipt: %w", err)
+	}
+	txHash, err := r.txHash()
+	if err != nil {
+		return err
+	}
+	if err := verifyHashParam(params, txHash); err != nil {
+		return err
+	}
+	_, err = verifyReceipt(ctx, p, &r)
+	return err
+}
+
+func verifyReceipts(ctx context.Context, p *Proxy, _ []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	var receipts []rpcTx
+	if err := json.Unmarshal(result, &receipts); err != nil {
+		return fmt.Errorf("decoding receipts: %w", err)
+	}
+	var unverifiable error
+	for i := range receipts {
+		if _, err := verifyReceipt(ctx, p, &receipts[i]); errors.Is(err, errUnverifiable) {
+			unverifiable = err
+		} else if err != nil {
+			return err
+		}
+	}
+	return unverifiable
+}
+
+// verifyLogs checks that every log is of a transaction in the block it claims, and is one of the logs
+// of its verified result. Whether the upstream RPC left logs out cannot be checked.
+func verifyLogs(ctx context.Context, p *Proxy, _ []json.RawMessage, result json.RawMessage) error {
+	if isNull(result) {
+		return nil
+	}
+	var logs []rpcTx
+	if err := json.Unmarshal(result, &logs); err != nil {
+		return fmt.Errorf("decoding logs: %w", err)
+	}
+	var unverifiable error
+	for i := range logs {
+		l := &logs[i]
+		txHash, evm, err := verifyTx(ctx, p, l)
+		if err != nil {
+			return err
+		}
+		if l.BlockNumber == nil {
+			return fmt.Errorf("log of %s is not in a block", txHash)
+		}
+		if !evm {
+			unverifiable = fmt.Errorf("%w: transaction %s is not an EVM transaction", errUnverifiable, txHash)
+			continue
+		}
+		res, err := txResult(ctx, p, uint64(*l.BlockNumber), txHash)
+		if err == nil {
+			err = verifyLogContents(l, res)
+		}
+		if errors.Is(err, errUnverifiable) {
+			unverifiable = err
+		} else if err != nil {
+			return err
+		}
+	}
+	return unverifiable
+}
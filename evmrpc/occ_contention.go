@@ -0,0 +1,95 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+)
+
+// OCCContentionAPI serves the OCC conflict profiler's reports on the debug
+// namespace.
+type OCCContentionAPI struct {
+	profiler       *tasks.ConflictProfiler
+	connectionType ConnectionType
+}
+
+func NewOCCContentionAPI(profiler *tasks.ConflictProfiler, connectionType ConnectionType) *OCCContentionAPI {
+	return &OCCContentionAPI{profiler: profiler, connectionType: connectionType}
+}
+
+type OCCKeyContention struct {
+	Store     string          `json:"store"`
+	Key       string          `json:"key"`
+	Contract  *common.Address `json:"contract,omitempty"`
+	Slot      *common.Hash    `json:"slot,omitempty"`
+	Conflicts int             `json:"conflicts"`
+}
+
+type OCCContractContention struct {
+	Contract  common.Address `json:"contract"`
+	Conflicts int            `json:"conflicts"`
+	Keys      int            `json:"keys"`
+}
+
+type OCCContention struct {
+	FromHeight int64                   `json:"fromHeight"`
+	ToHeight   int64                   `json:"toHeight"`
+	Blocks     int                     `json:"blocks"`
+	Txs        int                     `json:"txs"`
+	Reruns     int                     `json:"reruns"`
+	Conflicts  int                     `json:"conflicts"`
+	Keys       []OCCKeyContention      `json:"keys"`
+	Contracts  []OCCContractContention `json:"contracts"`
+}
+
+// OccContention returns the keys behind OCC validation failures over the
+// profiler's window of recent blocks. contract limits the keys to that
+// contract's storage; limit caps the keys and contracts returned.
+func (a *OCCContentionAPI) OccContention(ctx context.Context, limit *int, contract *common.Address) (result *OCCContention, returnErr error) {
+	startTime := time.Now()
+	defer func() {
+		recordMetricsWithError(ctx, "debug_occContention", a.connectionType, startTime, returnErr, recover())
+	}()
+	if a.profiler == nil {
+		return nil, errors.New("the OCC conflict profiler is disabled; set occ_profiler.enabled in app.toml")
+	}
+	var n int
+	if limit != nil {
+		n = *limit
+	}
+	var filter string
+	if contract != nil {
+		filter = contract.Hex()
+	}
+	report := a.profiler.Report(n, filter)
+	result = &OCCContention{
+		FromHeight: report.FromHeight,
+		ToHeight:   report.ToHeight,
+		Blocks:     report.Blocks,
+		Txs:        report.Txs,
+		Reruns:     report.Reruns,
+		Conflicts:  report.Conflicts,
+		Keys:       make([]OCCKeyContention, 0, len(report.Keys)),
+		Contracts:  make([]OCCContractContention, 0, len(report.Contracts)),
+	}
+	for _, k := range report.Keys {
+		entry := OCCKeyContention{Store: k.Store, Key: k.Key, Conflicts: k.Conflicts}
+		if k.Contract != "" {
+			addr := common.HexToAddress(k.Contract)
+			slot := common.HexToHash(k.Slot)
+			entry.Contract, entry.Slot = &addr, &slot
+		}
+		result.Keys = append(result.Keys, entry)
+	}
+	for _, c := range report.Contracts {
+		result.Contracts = append(result.Contracts, OCCContractContention{
+			Contract:  common.HexToAddress(c.Contract),
+			Conflicts: c.Conflicts,
+			Keys:      c.Keys,
+		})
+	}
+	return result, nil
+}
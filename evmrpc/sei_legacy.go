@@ -45,6 +45,12 @@ var seiLegacyGatedMethods = map[string]struct{}{
 	"sei_getSeiAddress": {},
 }
 
+// seiUngatedMethods are current sei_* methods. They are not part of the deprecated legacy surface,
+// so enabled_legacy_sei_apis does not apply to them.
+var seiUngatedMethods = map[string]struct{}{
+	"sei_getContractStorageUsage": {},
+}
+
 // SeiLegacyAllGatedMethodNames returns every gated sei_* method (sorted). Use when tests need full parity.
 func SeiLegacyAllGatedMethodNames() []string {
 	out := make([]string, 0, len(seiLegacyGatedMethods))
@@ -92,6 +98,9 @@ func seiLegacyMethodDisabledMessage(method string) string {
 }
 
 func seiLegacyIsGatedNamespaceMethod(method string) bool {
+	if _, ok := seiUngatedMethods[strings.TrimSpace(method)]; ok {
+		return false
+	}
 	return strings.HasPrefix(method, "sei_")
 }
 
@@ -89,6 +89,16 @@ func TestSeiLegacyGateError_UnknownSeiNamespaceFailsClosed(t *testing.T) {
 	}
 }
 
+func TestSeiLegacyGateError_CurrentMethodUngated(t *testing.T) {
+	if err := seiLegacyGateError("sei_getContractStorageUsage", BuildSeiLegacyEnabledSet(nil)); err != nil {
+		t.Fatalf("unexpected: %v", err)
+	}
+	s := BuildSeiLegacyEnabledSet([]string{"sei_getContractStorageUsage"})
+	if len(s) != 0 {
+		t.Fatalf("current method should not be listed as legacy, got %v", s)
+	}
+}
+
 func TestSeiLegacyGateError_NilAllowlistUngated(t *testing.T) {
 	err := seiLegacyGateError("sei_removedMethod", nil)
 	if err != nil {
@@ -139,7 +139,7 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.CheckTx.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.CheckTx.Code, "")
+			err = checkTxError(res.CheckTx.Codespace, res.CheckTx.Code, res.CheckTx.Log)
 		}
 	} else {
 		res, broadcastError := s.tmClient.BroadcastTx(ctx, txbz)
@@ -148,12 +148,51 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 		} else if res == nil {
 			err = errors.New("missing broadcast response")
 		} else if res.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.Code, "")
+			err = checkTxError(res.Codespace, res.Code, res.Log)
 		}
 	}
 	return
 }
 
+// errCodeTxRejected is go-ethereum's JSON-RPC code for transactions its pool rejects.
+const errCodeTxRejected = -32000
+
+// BlobTxRejectedError is returned by eth_sendRawTransaction when a blob transaction fails one of
+// the blob checks. Its error data carries a stable reason, such as "blob_txs_disabled", so
+// clients need not parse the message.
+type BlobTxRejectedError struct {
+	Reason string
+	Msg    string
+}
+
+func (e *BlobTxRejectedError) Error() string {
+	return e.Msg
+}
+
+func (e *BlobTxRejectedError) ErrorCode() int {
+	return errCodeTxRejected
+}
+
+func (e *BlobTxRejectedError) ErrorData() interface{} {
+	return map[string]string{"reason": e.Reason}
+}
+
+var (
+	_ rpc.Error     = (*BlobTxRejectedError)(nil)
+	_ rpc.DataError = (*BlobTxRejectedError)(nil)
+)
+
+// checkTxError converts a failed CheckTx result into the error eth_sendRawTransaction returns.
+func checkTxError(codespace string, code uint32, log string) error {
+	if reason, ok := types.BlobRejectionReason(codespace, code); ok {
+		if log == "" {
+			log = reason
+		}
+		return &BlobTxRejectedError{Reason: reason, Msg: log}
+	}
+	return sdkerrors.ABCIError(sdkerrors.RootCodespace, code, "")
+}
+
 func getSender(tx *ethtypes.Transaction, chainID *big.Int) (common.Address, error) {
 	return ethtypes.LatestSignerForChainID(chainID).Sender(tx)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -20,6 +21,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-cosmos/client"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/crypto/hd"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/coretypes"
 	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
@@ -208,3 +210,23 @@ func TestSendRawTransactionUsesGasLimitWhenSimulationDisabled(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, gasLimit, decodedTx.GetGasEstimate())
 }
+
+func TestCheckTxErrorBlobRejection(t *testing.T) {
+	codespace, code, log := sdkerrors.ABCIInfo(sdkerrors.Wrapf(types.ErrBlobTxTooManyBlobs, "have 7, permitted 6"), false)
+	err := evmrpc.CheckTxErrorForTest(codespace, code, log)
+	var rejected *evmrpc.BlobTxRejectedError
+	require.True(t, errors.As(err, &rejected))
+	require.Equal(t, -32000, rejected.ErrorCode())
+	require.Equal(t, map[string]string{"reason": "too_many_blobs"}, rejected.ErrorData())
+	require.Contains(t, err.Error(), "have 7, permitted 6")
+
+	// Disabled blob txs keep the root code they had before blob support.
+	err = evmrpc.CheckTxErrorForTest(sdkerrors.RootCodespace, sdkerrors.ErrUnsupportedTxType.ABCICode(), "")
+	require.True(t, errors.As(err, &rejected))
+	require.Equal(t, map[string]string{"reason": "blob_txs_disabled"}, rejected.ErrorData())
+
+	// Other failures keep the generic error.
+	err = evmrpc.CheckTxErrorForTest(sdkerrors.RootCodespace, sdkerrors.ErrInsufficientFee.ABCICode(), "")
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	require.False(t, errors.As(err, &rejected))
+}
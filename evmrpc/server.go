@@ -168,6 +168,10 @@ func NewEVMHTTPServer(
 			Namespace: "sei",
 			Service:   NewAssociationAPI(tmClient, k, ctxProvider, ConnectionTypeHTTP, watermarks),
 		},
+		{
+			Namespace: "sei",
+			Service:   NewStorageUsageAPI(k, ctxProvider, ConnectionTypeHTTP, watermarks),
+		},
 		{
 			Namespace: "txpool",
 			Service:   NewTxPoolAPI(tmClient, k, ctxProvider, txConfigProvider, &TxPoolConfig{maxNumTxs: int(config.MaxTxPoolTxs)}, ConnectionTypeHTTP), //nolint:gosec
@@ -180,6 +184,10 @@ func NewEVMHTTPServer(
 			Namespace: "debug",
 			Service:   debugAPI,
 		},
+		{
+			Namespace: "debug",
+			Service:   NewOCCContentionAPI(app.ConflictProfiler(), ConnectionTypeHTTP),
+		},
 	}
 	// Test API can only exist on non-live chain IDs.  These APIs instrument certain overrides.
 	if config.EnableTestAPI && !evmCfg.IsLiveChainID(ctx) {
@@ -0,0 +1,55 @@
+package evmrpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+)
+
+type StorageUsageAPI struct {
+	keeper         *keeper.Keeper
+	ctxProvider    func(int64) sdk.Context
+	connectionType ConnectionType
+	watermarks     *WatermarkManager
+}
+
+func NewStorageUsageAPI(k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType, watermarks *WatermarkManager) *StorageUsageAPI {
+	return &StorageUsageAPI{keeper: k, ctxProvider: ctxProvider, connectionType: connectionType, watermarks: watermarks}
+}
+
+type ContractStorageUsage struct {
+	Slots            hexutil.Uint64 `json:"slots"`
+	Bytes            hexutil.Uint64 `json:"bytes"`
+	LastAccessHeight hexutil.Uint64 `json:"lastAccessHeight"`
+	// Complete is false while storage that predates tracking is still being counted.
+	Complete bool `json:"complete"`
+	Dormant  bool `json:"dormant"`
+}
+
+func (a *StorageUsageAPI) GetContractStorageUsage(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (result *ContractStorageUsage, returnErr error) {
+	startTime := time.Now()
+	defer func() {
+		recordMetricsWithError(ctx, "sei_getContractStorageUsage", a.connectionType, startTime, returnErr, recover())
+	}()
+	height, err := a.watermarks.ResolveHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := a.ctxProvider(height)
+	if err := CheckVersion(sdkCtx, a.keeper); err != nil {
+		return nil, err
+	}
+	usage, _ := a.keeper.GetContractStorageUsage(sdkCtx, address)
+	return &ContractStorageUsage{
+		Slots:            hexutil.Uint64(usage.Slots),
+		Bytes:            hexutil.Uint64(usage.Bytes),
+		LastAccessHeight: hexutil.Uint64(usage.LastAccessHeight), //nolint:gosec
+		Complete:         a.keeper.ContractStorageUsageComplete(sdkCtx),
+		Dormant:          a.keeper.IsContractStorageDormant(sdkCtx, usage),
+	}, nil
+}
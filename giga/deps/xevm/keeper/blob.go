@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/sei-protocol/sei-chain/giga/deps/xevm/types"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/utils"
+)
+
+// GetBlobBaseFee returns the price of one unit of blob gas in the current block. The excess blob
+// gas is maintained by x/evm's EndBlock; blob transactions themselves always run on v2.
+func (k *Keeper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	bz := k.GetKVStore(ctx).Get(types.ExcessBlobGasKey)
+	if bz == nil {
+		return utils.Big1
+	}
+	excess := binary.BigEndian.Uint64(bz)
+	ethCfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	return eip4844.CalcBlobFee(ethCfg, &ethtypes.Header{Time: uint64(ctx.BlockTime().Unix()), ExcessBlobGas: &excess}) //nolint:gosec
+}
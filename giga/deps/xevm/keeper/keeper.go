@@ -299,7 +299,7 @@ func (k *Keeper) GetVMBlockContext(ctx sdk.Context, gp core.GasPool) (*vm.BlockC
 		Time:        uint64(ctx.BlockHeader().Time.Unix()), //nolint:gosec
 		Difficulty:  utils.Big0,                            // only needed for PoW
 		BaseFee:     baseFee,
-		BlobBaseFee: utils.Big1, // Cancun not enabled
+		BlobBaseFee: k.GetBlobBaseFee(ctx),
 		Random:      &rh,
 	}, nil
 }
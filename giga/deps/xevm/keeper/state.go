@@ -17,11 +17,42 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, hash common.Hash
 
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, val common.Hash) {
 	store := k.PrefixStore(ctx, types.StateKey(addr))
+	// The old value is only read when usage accounting needs it, to keep it out of the read set.
+	checkpoint := k.storageUsageCheckpoint(ctx)
+	covered := checkpoint != nil && storageUsageCovered(checkpoint, addr, key[:])
+	var old, newVal []byte
+	if covered {
+		old = store.Get(key[:])
+	}
 	if val == (common.Hash{}) {
 		store.Delete(key[:])
-		return
+	} else {
+		newVal = val[:]
+		store.Set(key[:], newVal)
+	}
+	if checkpoint != nil {
+		k.recordStorageWrite(ctx, addr, covered, old, newVal)
+	}
+}
+
+// DeleteAllState deletes every storage slot of addr, accounting for them in the contract's storage
+// usage.
+func (k *Keeper) DeleteAllState(ctx sdk.Context, addr common.Address) {
+	if checkpoint := k.storageUsageCheckpoint(ctx); checkpoint != nil {
+		store := k.PrefixStore(ctx, types.StateKey(addr))
+		iter := store.Iterator(nil, nil)
+		var slots [][]byte
+		for ; iter.Valid(); iter.Next() {
+			slots = append(slots, append([]byte(nil), iter.Key()...))
+		}
+		_ = iter.Close()
+		for _, slot := range slots {
+			if old := store.Get(slot); old != nil {
+				k.recordStorageWrite(ctx, addr, storageUsageCovered(checkpoint, addr, slot), old, nil)
+			}
+		}
 	}
-	store.Set(key[:], val[:])
+	k.PurgePrefix(ctx, types.StateKey(addr))
 }
 
 func (k *Keeper) IterateState(ctx sdk.Context, cb func(addr common.Address, key common.Hash, val common.Hash) bool) {
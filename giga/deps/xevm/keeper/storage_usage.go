@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/prefix"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// Transaction-time half of x/evm's contract storage usage accounting: x/evm folds these
+// per-transaction deltas at EndBlock, so they are recorded with its keys and encoding.
+
+// storageUsageCheckpoint returns the backfill checkpoint, nil while usage tracking is off. It is
+// written only at EndBlock, so reading it adds no conflicts between transactions.
+func (k *Keeper) storageUsageCheckpoint(ctx sdk.Context) []byte {
+	return k.GetKVStore(ctx).Get(evmtypes.ContractStorageUsageBackfillKey)
+}
+
+// storageUsageCovered reports whether the backfill has counted slot of addr, and so whether changes
+// to it must be accounted from the value it held.
+func storageUsageCovered(checkpoint []byte, addr common.Address, slot []byte) bool {
+	return evmtypes.StorageUsageCovered(checkpoint, append(addr.Bytes(), slot...))
+}
+
+func (k *Keeper) addStorageUsageDelta(ctx sdk.Context, addr common.Address, d evmtypes.StorageUsageDelta) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), evmtypes.StorageUsageDeltaPrefix)
+	key := evmtypes.StorageUsageDeltaKey(ctx.TxIndex(), addr)
+	store.Set(key, evmtypes.UnmarshalStorageUsageDelta(store.Get(key)).Add(d).Marshal())
+}
+
+// recordStorageWrite accounts for a write to a storage slot of addr while usage tracking is on. A slot
+// the backfill has covered changes from old to val (nil meaning absent); the write counts as an access
+// even when it leaves the slot unchanged.
+func (k *Keeper) recordStorageWrite(ctx sdk.Context, addr common.Address, covered bool, old, val []byte) {
+	k.touchContractStorage(ctx, addr)
+	if !covered {
+		return
+	}
+	if d := evmtypes.StorageWriteDelta(old, val); !d.IsZero() {
+		k.addStorageUsageDelta(ctx, addr, d)
+	}
+}
+
+// TouchContractStorage marks addr's storage as accessed by the current transaction, so that the
+// contract's last access height moves to this block. It does nothing while usage tracking is off.
+func (k *Keeper) TouchContractStorage(ctx sdk.Context, addr common.Address) {
+	if k.storageUsageCheckpoint(ctx) == nil {
+		return
+	}
+	k.touchContractStorage(ctx, addr)
+}
+
+func (k *Keeper) touchContractStorage(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), evmtypes.StorageUsageDeltaPrefix)
+	key := evmtypes.StorageUsageDeltaKey(ctx.TxIndex(), addr)
+	if !store.Has(key) {
+		store.Set(key, evmtypes.StorageUsageDelta{}.Marshal())
+	}
+}
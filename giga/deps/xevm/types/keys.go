@@ -63,6 +63,15 @@ var (
 	EvmOnlyBlockBloomPrefix         = []byte{0x1d}
 	ZeroStorageCleanupCheckpointKey = []byte{0x1e}
 	BlockHashPrefix                 = []byte{0x20}
+
+	// 0x21-0x23 hold contract storage usage; the keeper records it with x/evm's keys and encoding
+	// (see x/evm/types/storage_usage.go).
+
+	// EvmOnlyBalancePrefix holds EVM-only execution balances in FlatKV (see
+	// giga/evmonly/flatkvstate); the keeper does not read or write it.
+	EvmOnlyBalancePrefix = []byte{0x24}
+
+	ExcessBlobGasKey = []byte{0x25}
 )
 
 var (
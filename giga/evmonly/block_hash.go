@@ -0,0 +1,111 @@
+package evmonly
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// MaxBlockHashHistory is the number of recent block hashes visible to the
+// BLOCKHASH opcode.
+const MaxBlockHashHistory = 256
+
+// BlockHashSource resolves historical block hashes for the BLOCKHASH opcode.
+// BlockHash must be safe for concurrent calls because speculative
+// transactions of a block resolve hashes in parallel.
+type BlockHashSource interface {
+	BlockHash(number uint64) (common.Hash, bool)
+}
+
+// BlockHashSourceFunc adapts a function to BlockHashSource.
+type BlockHashSourceFunc func(number uint64) (common.Hash, bool)
+
+func (f BlockHashSourceFunc) BlockHash(number uint64) (common.Hash, bool) {
+	return f(number)
+}
+
+// BlockHashRing keeps the hashes of the last MaxBlockHashHistory block
+// numbers. Recording a number overwrites the entry of the number
+// MaxBlockHashHistory below it.
+type BlockHashRing struct {
+	mu      sync.RWMutex
+	entries [MaxBlockHashHistory]blockHashEntry
+}
+
+type blockHashEntry struct {
+	number uint64
+	hash   common.Hash
+	set    bool
+}
+
+var _ BlockHashSource = (*BlockHashRing)(nil)
+
+func NewBlockHashRing() *BlockHashRing {
+	return &BlockHashRing{}
+}
+
+// Record stores hash as the hash of block number. A zero hash is ignored.
+func (r *BlockHashRing) Record(number uint64, hash common.Hash) {
+	if hash == (common.Hash{}) {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries[number%MaxBlockHashHistory] = blockHashEntry{number: number, hash: hash, set: true}
+}
+
+func (r *BlockHashRing) BlockHash(number uint64) (common.Hash, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry := r.entries[number%MaxBlockHashHistory]
+	if !entry.set || entry.number != number {
+		return common.Hash{}, false
+	}
+	return entry.hash, true
+}
+
+// BlockMetaStore is the part of the Tendermint block store read by
+// NewBlockStoreHashSource.
+type BlockMetaStore interface {
+	LoadBlockMeta(height int64) *tmtypes.BlockMeta
+}
+
+// NewBlockStoreHashSource resolves block hashes from the block IDs of a
+// Tendermint block store, which is what the keeper-backed path records for
+// BLOCKHASH. The store must be safe for concurrent reads.
+func NewBlockStoreHashSource(store BlockMetaStore) BlockHashSource {
+	return BlockHashSourceFunc(func(number uint64) (common.Hash, bool) {
+		if number > uint64(1<<63-1) {
+			return common.Hash{}, false
+		}
+		meta := store.LoadBlockMeta(int64(number)) //nolint:gosec // bounded above
+		if meta == nil || len(meta.BlockID.Hash) == 0 {
+			return common.Hash{}, false
+		}
+		return common.BytesToHash(meta.BlockID.Hash), true
+	})
+}
+
+// blockHashFunc builds the BLOCKHASH callback of a block. The parent hash and
+// the block's own hash come from the block context; older hashes are looked
+// up in sources, in order. Unknown hashes resolve to zero, as on Ethereum.
+func blockHashFunc(ctx BlockContext, sources ...BlockHashSource) func(uint64) common.Hash {
+	return func(n uint64) common.Hash {
+		switch {
+		case ctx.Number > 0 && n == ctx.Number-1 && ctx.ParentHash != (common.Hash{}):
+			return ctx.ParentHash
+		case n >= ctx.Number:
+			return common.Hash{}
+		}
+		for _, src := range sources {
+			if src == nil {
+				continue
+			}
+			if hash, ok := src.BlockHash(n); ok {
+				return hash
+			}
+		}
+		return common.Hash{}
+	}
+}
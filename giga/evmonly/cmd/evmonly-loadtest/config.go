@@ -42,6 +42,8 @@ const (
 	resultSinkFile                  = "file"
 	resultSinkChangeSet             = "changeset"
 	resultSinkReceipts              = "receipts"
+	stateBackendMemory              = "memory"
+	stateBackendFlatKV              = "flatkv"
 )
 
 type config struct {
@@ -55,12 +57,16 @@ type config struct {
 	executorWorkers        int
 	reportInterval         time.Duration
 	metricsAddr            string
+	adminAddr              string
 	resultSink             string
 	resultPoolSize         int
 	persistDir             string
 	persistSync            bool
 	persistBufferSize      int
 	persistQueueSize       int
+	stateBackend           string
+	stateDir               string
+	pipeline               bool
 	cpuProfile             string
 	heapProfile            string
 	traceProfile           string
@@ -139,12 +145,16 @@ func parseConfig(args []string) (config, error) {
 	fs.IntVar(&cfg.executorWorkers, "executor-workers", defaultExecutorWorkers(), "parallel OCC workers inside each executor")
 	fs.DurationVar(&cfg.reportInterval, "report-interval", defaultReportInterval, "stdout and rate-gauge reporting interval; 0 disables periodic reports")
 	fs.StringVar(&cfg.metricsAddr, "metrics-addr", defaultMetricsAddr, "Prometheus listen address; empty disables HTTP metrics")
+	fs.StringVar(&cfg.adminAddr, "admin-addr", "", "loopback admin gRPC listen address serving GetOCCContention; empty disables it")
 	fs.StringVar(&cfg.resultSink, "result-sink", resultSinkDiscard, "result sink mode: discard or file")
 	fs.IntVar(&cfg.resultPoolSize, "result-pool-size", 0, "pooled executor BlockResult slots; 0 sizes for in-flight sink results, negative disables pooling")
 	fs.StringVar(&cfg.persistDir, "persist-dir", "", "directory for --result-sink=file append-only changeset and receipt files, removed at shutdown")
 	fs.BoolVar(&cfg.persistSync, "persist-sync", false, "fsync persistent result files from the async sink writer")
 	fs.IntVar(&cfg.persistBufferSize, "persist-buffer-size", defaultPersistBuffer, "buffer size in bytes for --result-sink=file")
 	fs.IntVar(&cfg.persistQueueSize, "persist-queue-size", 0, "record queue size for async file persistence; 0 defaults to 2*queue-size")
+	fs.StringVar(&cfg.stateBackend, "state", stateBackendMemory, "executor state backend: memory, or flatkv to read from and commit every block to an on-disk FlatKV store")
+	fs.BoolVar(&cfg.pipeline, "pipeline", false, "execute blocks through the evmonly cross-block pipeline, committing every block to the state backend; requires workers=1")
+	fs.StringVar(&cfg.stateDir, "state-dir", "", "FlatKV data directory for --state=flatkv; generated genesis state is committed on top of any existing store")
 	fs.StringVar(&cfg.cpuProfile, "cpu-profile", "", "write Go CPU profile to this file; starts after prebuild")
 	fs.StringVar(&cfg.heapProfile, "heap-profile", "", "write Go heap profile to this file after execution")
 	fs.StringVar(&cfg.traceProfile, "trace-profile", "", "write Go runtime trace to this file; starts after prebuild")
@@ -283,6 +293,20 @@ func parseConfig(args []string) (config, error) {
 	if cfg.resultSink == resultSinkFile && strings.TrimSpace(cfg.persistDir) == "" {
 		return config{}, fmt.Errorf("persist-dir is required when result-sink=file")
 	}
+	cfg.stateBackend = strings.ToLower(strings.TrimSpace(cfg.stateBackend))
+	if cfg.stateBackend != stateBackendMemory && cfg.stateBackend != stateBackendFlatKV {
+		return config{}, fmt.Errorf("unsupported state %q", cfg.stateBackend)
+	}
+	if cfg.stateBackend == stateBackendFlatKV && strings.TrimSpace(cfg.stateDir) == "" {
+		return config{}, fmt.Errorf("state-dir is required when state=flatkv")
+	}
+	if cfg.stateBackend == stateBackendFlatKV && cfg.workers != 1 {
+		// Each block must read the state committed by the block before it.
+		return config{}, fmt.Errorf("state=flatkv requires workers=1")
+	}
+	if cfg.pipeline && cfg.workers != 1 {
+		return config{}, fmt.Errorf("pipeline requires workers=1")
+	}
 	if cfg.txGasLimit == 0 {
 		return config{}, fmt.Errorf("tx-gas-limit must be positive")
 	}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"encoding/binary"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
@@ -23,6 +24,9 @@ import (
 
 	"github.com/sei-protocol/sei-chain/giga/evmonly"
 	"github.com/sei-protocol/sei-chain/giga/evmonly/cmd/evmonly-loadtest/scenarios"
+	"github.com/sei-protocol/sei-chain/giga/evmonly/flatkvstate"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	flatkvconfig "github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/config"
 )
 
 func TestTransferWorkloadExecutesAgainstEVMOnlyExecutor(t *testing.T) {
@@ -511,16 +515,47 @@ func TestPrepareBlocksDrainsPreparedBlocksAfterWorkersFinish(t *testing.T) {
 func TestLoadMetricsRecordsOCCRerunsWithoutConflicts(t *testing.T) {
 	metrics := newLoadMetrics(prometheus.NewRegistry())
 	metrics.recordFinished(txStatusCounts{total: 4, successful: 4}, 84_000, evmonly.OCCStats{
-		Attempted:  true,
-		RerunCount: 3,
+		Attempted:        true,
+		RerunCount:       3,
+		CrossBlockReruns: 2,
 	})
 
 	snapshot := metrics.snapshot()
 	require.Equal(t, uint64(1), snapshot.occAttempts)
 	require.Equal(t, uint64(3), snapshot.occReruns)
+	require.Equal(t, uint64(2), snapshot.crossReruns)
 	require.Zero(t, snapshot.occConflicts)
 }
 
+func TestLoadMetricsProfilesOCCConflictsByContract(t *testing.T) {
+	metrics := newLoadMetrics(prometheus.NewRegistry())
+	token := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	slot := common.HexToHash("0x01")
+	metrics.recordFinished(txStatusCounts{total: 4, successful: 4}, 84_000, evmonly.OCCStats{
+		Attempted:     true,
+		RerunCount:    2,
+		ConflictCount: 4,
+		ConflictSamples: []evmonly.OCCConflictCount{
+			{Access: "read", Kind: "storage", Address: token, Slot: slot, Count: 2},
+			{Access: "write", Kind: "storage", Address: token, Slot: slot, Count: 1},
+			{Access: "read", Kind: "balance", Address: token, Count: 1},
+		},
+	})
+
+	report := metrics.contention.Report(0, "")
+	require.Equal(t, 1, report.Blocks)
+	require.Equal(t, 2, report.Reruns)
+	require.Equal(t, 4, report.Conflicts)
+	require.Equal(t, []tasks.ContractConflicts{{Contract: token.Hex(), Conflicts: 4, Keys: 2}}, report.Contracts)
+	require.Equal(t, tasks.KeyConflict{
+		Store:     "storage",
+		Key:       hex.EncodeToString(append(token.Bytes(), slot.Bytes()...)),
+		Contract:  token.Hex(),
+		Slot:      slot.Hex(),
+		Conflicts: 3,
+	}, report.Keys[0])
+}
+
 func TestLoadMetricsCountsOnlySuccessfulTxsAsTPS(t *testing.T) {
 	metrics := newLoadMetrics(prometheus.NewRegistry())
 	counts := countTxStatuses([]evmonly.TxResult{
@@ -773,6 +808,74 @@ func TestRunPrebuiltBlocksWithFileResultSinkCleansUp(t *testing.T) {
 	requireNoFileExists(t, filepath.Join(dir, "receipts.rlp"))
 }
 
+func TestFlatKVStateValidation(t *testing.T) {
+	_, err := parseConfig([]string{"--blocks=1", "--state=flatkv"})
+	require.ErrorContains(t, err, "state-dir is required when state=flatkv")
+
+	_, err = parseConfig([]string{"--blocks=1", "--state=flatkv", "--state-dir=" + t.TempDir(), "--workers=2"})
+	require.ErrorContains(t, err, "state=flatkv requires workers=1")
+
+	_, err = parseConfig([]string{"--blocks=1", "--state=pebble"})
+	require.ErrorContains(t, err, `unsupported state "pebble"`)
+}
+
+func TestPipelineRequiresSingleWorker(t *testing.T) {
+	_, err := parseConfig([]string{"--blocks=1", "--pipeline", "--workers=2"})
+	require.ErrorContains(t, err, "pipeline requires workers=1")
+}
+
+func TestRunPrebuiltPipelineAgainstFlatKVState(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "flatkv")
+	cfg, err := parseConfig([]string{
+		"--metrics-addr=",
+		"--report-interval=0",
+		"--blocks=3",
+		"--txs-per-block=4",
+		"--executor-workers=4",
+		"--pipeline",
+		"--state=flatkv",
+		"--state-dir=" + dir,
+	})
+	require.NoError(t, err)
+	require.NoError(t, run(cfg))
+
+	storeCfg := flatkvconfig.DefaultConfig()
+	storeCfg.DataDir = dir
+	state, err := flatkvstate.Open(t.Context(), storeCfg)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, state.Close()) }()
+	// The pipeline commits through the state writer: genesis plus one version
+	// per block.
+	require.Equal(t, int64(4), state.Version())
+}
+
+func TestRunPrebuiltBlocksAgainstFlatKVState(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "flatkv")
+	cfg, err := parseConfig([]string{
+		"--metrics-addr=",
+		"--report-interval=0",
+		"--blocks=2",
+		"--txs-per-block=2",
+		"--state=flatkv",
+		"--state-dir=" + dir,
+	})
+	require.NoError(t, err)
+	require.NoError(t, run(cfg))
+
+	storeCfg := flatkvconfig.DefaultConfig()
+	storeCfg.DataDir = dir
+	state, err := flatkvstate.Open(t.Context(), storeCfg)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, state.Close()) }()
+	// Genesis, then one version per block.
+	require.Equal(t, int64(3), state.Version())
+	for index := uint64(1); index <= 4; index++ {
+		key, err := scenarios.DeterministicPrivateKey(index)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), state.GetNonce(crypto.PubkeyToAddress(key.PublicKey)))
+	}
+}
+
 type preparedOnlyExecutor struct{}
 
 func (preparedOnlyExecutor) ExecuteBlock(context.Context, evmonly.BlockRequest) (*evmonly.BlockResult, error) {
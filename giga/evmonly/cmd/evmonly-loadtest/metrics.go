@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net"
@@ -9,9 +10,24 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/otlptranslator"
+	"github.com/sei-protocol/sei-chain/evmrpc"
 	"github.com/sei-protocol/sei-chain/giga/evmonly"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
+	"go.opentelemetry.io/otel"
+	otelprometheus "go.opentelemetry.io/otel/exporters/prometheus"
+	otelmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// contentionWindowBlocks is the number of most recent OCC blocks the final
+// contention report covers, and contentionReportLimit the number of contracts
+// and keys it lists.
+const (
+	contentionWindowBlocks = 100
+	contentionReportLimit  = 10
 )
 
 type loadMetrics struct {
@@ -28,6 +44,7 @@ type loadMetrics struct {
 	occAttempts     atomic.Uint64
 	occFallbacks    atomic.Uint64
 	occReruns       atomic.Uint64
+	crossReruns     atomic.Uint64
 	occConflicts    atomic.Uint64
 	sinkEnqueued    atomic.Uint64
 	sinkWritten     atomic.Uint64
@@ -39,6 +56,7 @@ type loadMetrics struct {
 	poolCapacity    atomic.Int64
 	poolAvailable   atomic.Int64
 	poolOverflow    atomic.Uint64
+	contention      *tasks.ConflictProfiler
 
 	inputBlocksTotal     prometheus.Counter
 	preparedBlocksTotal  prometheus.Counter
@@ -53,6 +71,7 @@ type loadMetrics struct {
 	occAttemptsTotal     prometheus.Counter
 	occFallbacksTotal    prometheus.Counter
 	occRerunsTotal       prometheus.Counter
+	crossRerunsTotal     prometheus.Counter
 	occConflictsTotal    prometheus.Counter
 
 	occFallbackReasonTotal *prometheus.CounterVec
@@ -95,6 +114,7 @@ type metricsSnapshot struct {
 	occAttempts     uint64
 	occFallbacks    uint64
 	occReruns       uint64
+	crossReruns     uint64
 	occConflicts    uint64
 	sinkEnqueued    uint64
 	sinkWritten     uint64
@@ -110,6 +130,7 @@ type metricsSnapshot struct {
 
 func newLoadMetrics(registry *prometheus.Registry) *loadMetrics {
 	m := &loadMetrics{
+		contention: tasks.NewConflictProfiler(contentionWindowBlocks, nil),
 		inputBlocksTotal: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "evmonly_loadtest_block_input_total",
 			Help: "Total blocks fed to the EVM-only executor input queue.",
@@ -162,6 +183,10 @@ func newLoadMetrics(registry *prometheus.Registry) *loadMetrics {
 			Name: "evmonly_loadtest_occ_reruns_total",
 			Help: "Total OCC transaction rerun attempts caused by stale speculative execution state.",
 		}),
+		crossRerunsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "evmonly_loadtest_cross_block_reruns_total",
+			Help: "Total pipelined transaction reruns caused by the previous block finalizing differently than speculated.",
+		}),
 		occConflictsTotal: prometheus.NewCounter(prometheus.CounterOpts{
 			Name: "evmonly_loadtest_occ_conflicts_total",
 			Help: "Total OCC conflict accesses observed before sequential fallback.",
@@ -269,6 +294,7 @@ func newLoadMetrics(registry *prometheus.Registry) *loadMetrics {
 		m.occAttemptsTotal,
 		m.occFallbacksTotal,
 		m.occRerunsTotal,
+		m.crossRerunsTotal,
 		m.occConflictsTotal,
 		m.occFallbackReasonTotal,
 		m.occConflictAccessTotal,
@@ -324,15 +350,18 @@ func (m *loadMetrics) recordFinished(counts txStatusCounts, gasUsed uint64, occS
 	m.successfulTxsTotal.Add(float64(counts.successful))
 	m.failedTxsTotal.Add(float64(counts.failed))
 	m.gasConsumedTotal.Add(float64(gasUsed))
-	m.recordOCC(occStats)
+	m.recordOCC(counts.total, occStats)
 }
 
-func (m *loadMetrics) recordOCC(stats evmonly.OCCStats) {
+func (m *loadMetrics) recordOCC(txs int, stats evmonly.OCCStats) {
 	if !stats.Attempted {
 		return
 	}
-	m.occAttempts.Add(1)
+	attempt := m.occAttempts.Add(1)
 	m.occAttemptsTotal.Inc()
+	// Workers finish blocks out of order, so the window is keyed by OCC
+	// attempt rather than block number.
+	m.contention.RecordBlock(context.Background(), int64(intFromUint64(attempt)), txs, intFromUint64(stats.RerunCount), occKeyConflicts(stats.ConflictSamples))
 	if stats.Fallback {
 		reason := stats.FallbackReason
 		if reason == "" {
@@ -346,6 +375,10 @@ func (m *loadMetrics) recordOCC(stats evmonly.OCCStats) {
 		m.occReruns.Add(stats.RerunCount)
 		m.occRerunsTotal.Add(float64(stats.RerunCount))
 	}
+	if stats.CrossBlockReruns > 0 {
+		m.crossReruns.Add(stats.CrossBlockReruns)
+		m.crossRerunsTotal.Add(float64(stats.CrossBlockReruns))
+	}
 	if stats.ConflictCount == 0 {
 		return
 	}
@@ -359,6 +392,38 @@ func (m *loadMetrics) recordOCC(stats evmonly.OCCStats) {
 	}
 }
 
+// occKeyConflicts attributes the executor's conflict samples to contracts,
+// merging the read and write conflicts on each key.
+func occKeyConflicts(samples []evmonly.OCCConflictCount) []tasks.KeyConflict {
+	if len(samples) == 0 {
+		return nil
+	}
+	index := make(map[string]int, len(samples))
+	conflicts := make([]tasks.KeyConflict, 0, len(samples))
+	for _, sample := range samples {
+		key := sample.Address.Bytes()
+		var slot string
+		if sample.Kind == "storage" {
+			key = append(key, sample.Slot.Bytes()...)
+			slot = sample.Slot.Hex()
+		}
+		id := sample.Kind + "/" + hex.EncodeToString(key)
+		if i, ok := index[id]; ok {
+			conflicts[i].Conflicts += intFromUint64(sample.Count)
+			continue
+		}
+		index[id] = len(conflicts)
+		conflicts = append(conflicts, tasks.KeyConflict{
+			Store:     sample.Kind,
+			Key:       hex.EncodeToString(key),
+			Contract:  sample.Address.Hex(),
+			Slot:      slot,
+			Conflicts: intFromUint64(sample.Count),
+		})
+	}
+	return conflicts
+}
+
 func (m *loadMetrics) recordExecutionError() {
 	m.executionErrors.Add(1)
 	m.executionErrorsTotal.Inc()
@@ -427,6 +492,7 @@ func (m *loadMetrics) snapshot() metricsSnapshot {
 		occAttempts:     m.occAttempts.Load(),
 		occFallbacks:    m.occFallbacks.Load(),
 		occReruns:       m.occReruns.Load(),
+		crossReruns:     m.crossReruns.Load(),
 		occConflicts:    m.occConflicts.Load(),
 		sinkEnqueued:    m.sinkEnqueued.Load(),
 		sinkWritten:     m.sinkWritten.Load(),
@@ -490,7 +556,7 @@ func reportLoop(ctx context.Context, interval time.Duration, metrics *loadMetric
 			sinkWaitSeconds := float64(curr.sinkWaitNanos-prev.sinkWaitNanos) / float64(time.Second)
 			sinkWriteSeconds := float64(curr.sinkWriteNanos-prev.sinkWriteNanos) / float64(time.Second)
 			fmt.Printf(
-				"input_blocks/s=%.2f prepared_blocks/s=%.2f prepared_tx/s=%.2f finished_blocks/s=%.2f finished_tx/s=%.2f success_tx/s=%.2f failed_tx/s=%.2f gas/s=%.2f queued_blocks=%d sink_queue=%d pool_available=%d pool_overflow=%d sink_enqueue_wait/s=%.6f sink_write/s=%.6f totals(input_blocks=%d prepared_blocks=%d prepared_txs=%d finished_blocks=%d txs=%d successful_txs=%d failed_txs=%d gas=%d prepare_errors=%d errors=%d occ_attempts=%d occ_fallbacks=%d occ_reruns=%d sink_enqueued=%d sink_written=%d)\n",
+				"input_blocks/s=%.2f prepared_blocks/s=%.2f prepared_tx/s=%.2f finished_blocks/s=%.2f finished_tx/s=%.2f success_tx/s=%.2f failed_tx/s=%.2f gas/s=%.2f queued_blocks=%d sink_queue=%d pool_available=%d pool_overflow=%d sink_enqueue_wait/s=%.6f sink_write/s=%.6f totals(input_blocks=%d prepared_blocks=%d prepared_txs=%d finished_blocks=%d txs=%d successful_txs=%d failed_txs=%d gas=%d prepare_errors=%d errors=%d occ_attempts=%d occ_fallbacks=%d occ_reruns=%d cross_block_reruns=%d sink_enqueued=%d sink_written=%d)\n",
 				float64(curr.inputBlocks-prev.inputBlocks)/elapsed,
 				float64(curr.preparedBlocks-prev.preparedBlocks)/elapsed,
 				float64(curr.preparedTxs-prev.preparedTxs)/elapsed,
@@ -518,6 +584,7 @@ func reportLoop(ctx context.Context, interval time.Duration, metrics *loadMetric
 				curr.occAttempts,
 				curr.occFallbacks,
 				curr.occReruns,
+				curr.crossReruns,
 				curr.sinkEnqueued,
 				curr.sinkWritten,
 			)
@@ -532,7 +599,7 @@ func printFinalReport(startedAt time.Time, snapshot metricsSnapshot) {
 		elapsed = 1
 	}
 	fmt.Printf(
-		"complete elapsed=%s input_blocks=%d prepared_blocks=%d prepared_txs=%d finished_blocks=%d txs=%d successful_txs=%d failed_txs=%d gas=%d prepare_errors=%d errors=%d occ_attempts=%d occ_fallbacks=%d occ_reruns=%d sink_queue=%d sink_enqueued=%d sink_written=%d sink_bytes=%d sink_enqueue_wait=%s sink_enqueue_wait_events=%d sink_write=%s pool_capacity=%d pool_available=%d pool_overflow=%d avg_input_blocks/s=%.2f avg_prepared_blocks/s=%.2f avg_prepared_tx/s=%.2f avg_finished_blocks/s=%.2f avg_finished_tx/s=%.2f avg_success_tx/s=%.2f avg_failed_tx/s=%.2f avg_gas/s=%.2f\n",
+		"complete elapsed=%s input_blocks=%d prepared_blocks=%d prepared_txs=%d finished_blocks=%d txs=%d successful_txs=%d failed_txs=%d gas=%d prepare_errors=%d errors=%d occ_attempts=%d occ_fallbacks=%d occ_reruns=%d cross_block_reruns=%d sink_queue=%d sink_enqueued=%d sink_written=%d sink_bytes=%d sink_enqueue_wait=%s sink_enqueue_wait_events=%d sink_write=%s pool_capacity=%d pool_available=%d pool_overflow=%d avg_input_blocks/s=%.2f avg_prepared_blocks/s=%.2f avg_prepared_tx/s=%.2f avg_finished_blocks/s=%.2f avg_finished_tx/s=%.2f avg_success_tx/s=%.2f avg_failed_tx/s=%.2f avg_gas/s=%.2f\n",
 		snapshot.at.Sub(startedAt).Round(time.Millisecond),
 		snapshot.inputBlocks,
 		snapshot.preparedBlocks,
@@ -547,6 +614,7 @@ func printFinalReport(startedAt time.Time, snapshot metricsSnapshot) {
 		snapshot.occAttempts,
 		snapshot.occFallbacks,
 		snapshot.occReruns,
+		snapshot.crossReruns,
 		snapshot.sinkQueued,
 		snapshot.sinkEnqueued,
 		snapshot.sinkWritten,
@@ -568,6 +636,25 @@ func printFinalReport(startedAt time.Time, snapshot metricsSnapshot) {
 	)
 }
 
+// printContentionReport lists the contracts and keys behind the most OCC
+// conflicts over the last contentionWindowBlocks OCC blocks.
+func printContentionReport(report tasks.ConflictReport) {
+	if report.Conflicts == 0 {
+		return
+	}
+	fmt.Printf("occ contention blocks=%d txs=%d reruns=%d conflicts=%d\n", report.Blocks, report.Txs, report.Reruns, report.Conflicts)
+	for _, c := range report.Contracts {
+		fmt.Printf("occ contention contract=%s conflicts=%d keys=%d\n", c.Contract, c.Conflicts, c.Keys)
+	}
+	for _, k := range report.Keys {
+		if k.Slot != "" {
+			fmt.Printf("occ contention key kind=%s contract=%s slot=%s conflicts=%d\n", k.Store, k.Contract, k.Slot, k.Conflicts)
+		} else {
+			fmt.Printf("occ contention key kind=%s contract=%s conflicts=%d\n", k.Store, k.Contract, k.Conflicts)
+		}
+	}
+}
+
 func printResultSinkReport(closeElapsed time.Duration, snapshot metricsSnapshot) {
 	fmt.Printf(
 		"result sink close elapsed=%s sink_queue=%d sink_enqueued=%d sink_written=%d sink_bytes=%d sink_enqueue_wait=%s sink_enqueue_wait_events=%d sink_write=%s\n",
@@ -598,18 +685,44 @@ func printPrebuildReport(elapsed time.Duration, blocks []blockEnvelope, txsPerBl
 	)
 }
 
+// setupOtelMetrics exports the OTel instruments to registry, so that the
+// conflict profiler's scheduler_key_conflicts counter is served at /metrics
+// next to the loadtest's own metrics, as it is on a node.
+func setupOtelMetrics(registry *prometheus.Registry) (func(context.Context) error, error) {
+	exporter, err := otelprometheus.New(
+		otelprometheus.WithRegisterer(registry),
+		otelprometheus.WithTranslationStrategy(otlptranslator.UnderscoreEscapingWithSuffixes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create prometheus exporter: %w", err)
+	}
+	provider := otelmetric.NewMeterProvider(otelmetric.WithReader(exporter))
+	otel.SetMeterProvider(provider)
+	return provider.Shutdown, nil
+}
+
 type metricsServer struct {
 	server *http.Server
+	rpc    *rpc.Server
 	done   chan error
 }
 
-func startMetricsServer(addr string, registry *prometheus.Registry) (*metricsServer, error) {
+// startMetricsServer serves the metrics at /metrics, and the OCC contention
+// report as debug_occContention on the JSON-RPC endpoint at /rpc, through the
+// same API as the EVM RPC server of a node.
+func startMetricsServer(addr string, registry *prometheus.Registry, contention *tasks.ConflictProfiler) (*metricsServer, error) {
+	rpcServer := rpc.NewServer()
+	if err := rpcServer.RegisterName("debug", evmrpc.NewOCCContentionAPI(contention, evmrpc.ConnectionTypeHTTP)); err != nil {
+		return nil, fmt.Errorf("register debug RPC: %w", err)
+	}
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
+		rpcServer.Stop()
 		return nil, fmt.Errorf("listen for metrics on %s: %w", addr, err)
 	}
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	mux.Handle("/rpc", rpcServer)
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
 		_, _ = w.Write([]byte("ok\n"))
 	})
@@ -618,7 +731,7 @@ func startMetricsServer(addr string, registry *prometheus.Registry) (*metricsSer
 		Handler:           mux,
 		ReadHeaderTimeout: 3 * time.Second,
 	}
-	ms := &metricsServer{server: server, done: make(chan error, 1)}
+	ms := &metricsServer{server: server, rpc: rpcServer, done: make(chan error, 1)}
 	go func() {
 		err := server.Serve(listener)
 		if errors.Is(err, http.ErrServerClosed) {
@@ -632,6 +745,7 @@ func startMetricsServer(addr string, registry *prometheus.Registry) (*metricsSer
 func (s *metricsServer) stop(timeout time.Duration) error {
 	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
+	defer s.rpc.Stop()
 	if err := s.server.Shutdown(ctx); err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"os/signal"
 	"sync/atomic"
@@ -12,6 +13,7 @@ import (
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sei-protocol/sei-chain/admin"
 	"github.com/sei-protocol/sei-chain/giga/evmonly"
 	"github.com/sei-protocol/sei-chain/giga/evmonly/cmd/evmonly-loadtest/scenarios"
 	"golang.org/x/sync/errgroup"
@@ -28,6 +30,11 @@ func run(cfg config) (err error) {
 	}
 	registry := prometheus.NewRegistry()
 	metrics := newLoadMetrics(registry)
+	shutdownOtel, err := setupOtelMetrics(registry)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = shutdownOtel(context.Background()) }()
 	sinks, err := newResultSinks(cfg, metrics)
 	if err != nil {
 		return err
@@ -51,7 +58,7 @@ func run(cfg config) (err error) {
 	var server *metricsServer
 	if cfg.metricsAddr != "" {
 		var err error
-		server, err = startMetricsServer(cfg.metricsAddr, registry)
+		server, err = startMetricsServer(cfg.metricsAddr, registry, metrics.contention)
 		if err != nil {
 			return err
 		}
@@ -60,7 +67,15 @@ func run(cfg config) (err error) {
 				fmt.Fprintf(os.Stderr, "evmonly-loadtest: metrics server shutdown: %v\n", err)
 			}
 		}()
-		fmt.Printf("metrics listening on http://%s/metrics\n", cfg.metricsAddr)
+		fmt.Printf("metrics listening on http://%s/metrics, debug_occContention on http://%s/rpc\n", cfg.metricsAddr, cfg.metricsAddr)
+	}
+	if cfg.adminAddr != "" {
+		adminServer, err := admin.StartServer(cfg.adminAddr, admin.WithOCCProfiler(metrics.contention))
+		if err != nil {
+			return err
+		}
+		defer adminServer.Stop()
+		fmt.Printf("admin gRPC listening on %s\n", cfg.adminAddr)
 	}
 
 	return runPrebuilt(ctx, cfg, state, workload, sinks, metrics)
@@ -105,6 +120,35 @@ func runPrebuilt(ctx context.Context, cfg config, state *generatedState, workloa
 	prebuildElapsed := time.Since(prebuildStartedAt)
 	printPrebuildReport(prebuildElapsed, prebuilt, cfg.txsPerBlock)
 
+	var reader evmonly.StateReader = state
+	var sink evmonly.ResultSink = sinks
+	// writer commits pipelined blocks; without --pipeline, blocks run against
+	// genesis unless the flatkv sink commits them.
+	var writer evmonly.StateWriter
+	if cfg.pipeline && cfg.stateBackend == stateBackendMemory {
+		memState := evmonly.NewMemoryState()
+		memState.ApplyChangeSet(state.changeSet())
+		reader, writer = memState, memState
+	}
+	if cfg.stateBackend == stateBackendFlatKV {
+		diskState, openErr := openFlatKVState(ctx, cfg, state)
+		if openErr != nil {
+			return openErr
+		}
+		defer func() {
+			if closeErr := diskState.Close(); closeErr != nil {
+				err = errors.Join(err, fmt.Errorf("close flatkv state: %w", closeErr))
+			}
+		}()
+		fmt.Printf("flatkv state at %s, genesis version %d\n", cfg.stateDir, diskState.Version())
+		reader = diskState
+		if cfg.pipeline {
+			writer = diskState
+		} else {
+			sink = stateCommitSink{state: diskState, next: sinks}
+		}
+	}
+
 	profiles, err := startProfiles(cfg)
 	if err != nil {
 		return err
@@ -121,7 +165,7 @@ func runPrebuilt(ctx context.Context, cfg config, state *generatedState, workloa
 
 	startedAt := time.Now()
 	group, groupCtx := errgroup.WithContext(ctx)
-	executor := evmonly.NewExecutor(executorConfig(cfg), evmonly.WithState(state), evmonly.WithResultSink(sinks))
+	executor := evmonly.NewExecutor(executorConfig(cfg), evmonly.WithState(reader), evmonly.WithResultSink(sink))
 	defer executor.Close()
 	metrics.recordResultPoolStats(executor.ResultPoolStats())
 	group.Go(func() error {
@@ -132,11 +176,17 @@ func runPrebuilt(ctx context.Context, cfg config, state *generatedState, workloa
 		defer close(preparedBlocks)
 		return prepareBlocks(groupCtx, cfg, executor, blocks, preparedBlocks, metrics)
 	})
-	for workerID := 0; workerID < cfg.workers; workerID++ {
-		workerID := workerID
+	if cfg.pipeline {
 		group.Go(func() error {
-			return executeBlocks(groupCtx, workerID, executor, preparedBlocks, metrics)
+			return executePipelinedBlocks(groupCtx, executor, executor.NewPipeline(writer), preparedBlocks, metrics)
 		})
+	} else {
+		for workerID := 0; workerID < cfg.workers; workerID++ {
+			workerID := workerID
+			group.Go(func() error {
+				return executeBlocks(groupCtx, workerID, executor, preparedBlocks, metrics)
+			})
+		}
 	}
 
 	err = group.Wait()
@@ -148,6 +198,7 @@ func runPrebuilt(ctx context.Context, cfg config, state *generatedState, workloa
 		err = nil
 	}
 	printFinalReport(startedAt, metrics.snapshot())
+	printContentionReport(metrics.contention.Report(contentionReportLimit, ""))
 	// Drop raw block retention before heap profiling forces a GC.
 	prebuilt = nil
 	finishProfiles(profiles, &err)
@@ -350,6 +401,13 @@ func uint64FromNonNegativeInt64(value int64) uint64 {
 	return uint64(value) //nolint:gosec // negative values are rejected above.
 }
 
+func intFromUint64(value uint64) int {
+	if value > math.MaxInt {
+		return math.MaxInt
+	}
+	return int(value) //nolint:gosec // capped to max int above.
+}
+
 func durationFromUint64Nanos(nanos uint64) time.Duration {
 	const maxDurationNanos = uint64(1<<63 - 1)
 	if nanos > maxDurationNanos {
@@ -390,6 +448,56 @@ func executeBlocks(
 	return nil
 }
 
+// executePipelinedBlocks runs the prepared block stream through pipeline, so
+// that each block speculatively overlaps the finalization of the one before.
+func executePipelinedBlocks(
+	ctx context.Context,
+	executor *evmonly.Executor,
+	pipeline *evmonly.Pipeline,
+	blocks <-chan preparedBlockEnvelope,
+	metrics *loadMetrics,
+) error {
+	prepared := make(chan evmonly.PreparedBlock)
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		defer close(prepared)
+		for groupCtx.Err() == nil {
+			select {
+			case <-groupCtx.Done():
+				return nil
+			case block, ok := <-blocks:
+				if !ok {
+					return nil
+				}
+				select {
+				case prepared <- block.block:
+				case <-groupCtx.Done():
+					return nil
+				}
+			}
+		}
+		return nil
+	})
+	group.Go(func() error {
+		err := pipeline.Run(groupCtx, prepared, func(result *evmonly.BlockResult) error {
+			counts := countTxStatuses(result.Txs)
+			metrics.recordFinished(counts, result.GasUsed, result.OCCStats)
+			result.Release()
+			metrics.recordResultPoolStats(executor.ResultPoolStats())
+			return nil
+		})
+		if err != nil {
+			if groupCtx.Err() != nil {
+				return nil
+			}
+			metrics.recordExecutionError()
+			return fmt.Errorf("pipeline execute blocks: %w", err)
+		}
+		return nil
+	})
+	return group.Wait()
+}
+
 type txStatusCounts struct {
 	total      int
 	successful int
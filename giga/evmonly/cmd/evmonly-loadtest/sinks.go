@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/sei-protocol/sei-chain/giga/evmonly"
+	"github.com/sei-protocol/sei-chain/giga/evmonly/flatkvstate"
 )
 
 type discardStateWriter struct{}
@@ -73,6 +74,21 @@ func (s discardResultSink) StoreBlockResult(_ context.Context, _ uint64, result
 	return nil
 }
 
+// stateCommitSink commits each block's changeset to the executor's FlatKV
+// state before handing the result to next, so that the following block reads
+// it.
+type stateCommitSink struct {
+	state *flatkvstate.State
+	next  evmonly.ResultSink
+}
+
+func (s stateCommitSink) StoreBlockResult(ctx context.Context, height uint64, result *evmonly.BlockResult, release func()) error {
+	if err := s.state.CommitChangeSet(s.state.Version()+1, result.ChangeSet); err != nil {
+		return fmt.Errorf("commit block %d state: %w", height, err)
+	}
+	return s.next.StoreBlockResult(ctx, height, result, release)
+}
+
 type fileResultSinks struct {
 	changeSetFile *appendRLPFile
 	receiptFile   *appendRLPFile
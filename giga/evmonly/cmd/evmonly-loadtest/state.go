@@ -1,12 +1,17 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"sync/atomic"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/sei-protocol/sei-chain/giga/evmonly"
+	"github.com/sei-protocol/sei-chain/giga/evmonly/flatkvstate"
+	flatkvconfig "github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/config"
 )
 
 type generatedState struct {
@@ -126,6 +131,43 @@ func (s *generatedState) SetState(addr common.Address, key common.Hash, value co
 	accountStorage[key] = value
 }
 
+// changeSet returns the generated state as a changeset that writes it.
+func (s *generatedState) changeSet() evmonly.StateChangeSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var cs evmonly.StateChangeSet
+	for addr, balance := range s.balances {
+		cs.Balances = append(cs.Balances, evmonly.BalanceChange{Address: addr, Balance: new(big.Int).Set(balance)})
+	}
+	for addr, nonce := range s.nonces {
+		cs.Nonces = append(cs.Nonces, evmonly.NonceChange{Address: addr, Nonce: nonce})
+	}
+	for addr, code := range s.code {
+		cs.Code = append(cs.Code, evmonly.CodeChange{Address: addr, Code: cloneBytes(code)})
+	}
+	for addr, accountStorage := range s.storage {
+		for key, value := range accountStorage {
+			cs.Storage = append(cs.Storage, evmonly.StorageChange{Address: addr, Key: key, Value: value})
+		}
+	}
+	return cs
+}
+
+// openFlatKVState opens the FlatKV store in cfg.stateDir and commits the
+// generated genesis state to it as one version.
+func openFlatKVState(ctx context.Context, cfg config, genesis *generatedState) (*flatkvstate.State, error) {
+	storeCfg := flatkvconfig.DefaultConfig()
+	storeCfg.DataDir = cfg.stateDir
+	state, err := flatkvstate.Open(ctx, storeCfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := state.CommitChangeSet(state.Version()+1, genesis.changeSet()); err != nil {
+		return nil, errors.Join(fmt.Errorf("commit genesis state: %w", err), state.Close())
+	}
+	return state, nil
+}
+
 func (s *generatedState) requireMutable() {
 	if s.frozen.Load() {
 		panic("generated state is frozen")
@@ -2,6 +2,7 @@ package evmonly
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -19,13 +20,15 @@ import (
 
 // Executor runs raw EVM transactions against an EVM-native state backend.
 type Executor struct {
-	cfg         Config
-	state       StateReader
-	resultSink  ResultSink
-	occPool     *occWorkerPool
-	resultPool  *blockResultPool
-	stateDBPool sync.Pool
-	closed      atomic.Bool
+	cfg          Config
+	state        StateReader
+	resultSink   ResultSink
+	blockHashes  BlockHashSource
+	recentHashes *BlockHashRing
+	occPool      *occWorkerPool
+	resultPool   *blockResultPool
+	stateDBPool  sync.Pool
+	closed       atomic.Bool
 }
 
 type Option func(*Executor)
@@ -44,13 +47,23 @@ func WithResultSink(sink ResultSink) Option {
 	}
 }
 
+// WithBlockHashSource sets the source of historical BLOCKHASH values that are
+// neither in the request's source nor among the hashes of blocks this executor
+// has already executed, e.g. a block store adapter after a restart.
+func WithBlockHashSource(src BlockHashSource) Option {
+	return func(e *Executor) {
+		e.blockHashes = src
+	}
+}
+
 // NewExecutor constructs an EVM-only executor. Call Close to disable future OCC
 // execution on this executor.
 func NewExecutor(cfg Config, opts ...Option) *Executor {
 	e := &Executor{
-		cfg:        cfg.WithDefaults(),
-		state:      NewMemoryState(),
-		resultPool: newBlockResultPool(cfg.BlockResultPoolSize),
+		cfg:          cfg.WithDefaults(),
+		state:        NewMemoryState(),
+		recentHashes: NewBlockHashRing(),
+		resultPool:   newBlockResultPool(cfg.BlockResultPoolSize),
 	}
 	if e.cfg.OCCWorkers > 1 {
 		e.occPool = newOCCWorkerPool(e.cfg.OCCWorkers)
@@ -101,8 +114,9 @@ func (e *Executor) PrepareBlock(ctx context.Context, req BlockRequest) (Prepared
 		return PreparedBlock{}, err
 	}
 	return PreparedBlock{
-		Context: req.Context,
-		Txs:     parsed,
+		Context:     req.Context,
+		Txs:         parsed,
+		BlockHashes: req.BlockHashes,
 	}, nil
 }
 
@@ -118,6 +132,7 @@ func (e *Executor) ExecutePreparedBlock(ctx context.Context, req PreparedBlock)
 		result.Release()
 		return nil, err
 	}
+	e.recordBlockHashes(req.Context)
 	return result, nil
 }
 
@@ -153,13 +168,7 @@ func (e *Executor) sinkBlockResult(ctx context.Context, height uint64, result *B
 }
 
 func (e *Executor) useOCC(txCount int) bool {
-	if e.closed.Load() || e.cfg.OCCWorkers <= 1 || txCount <= 1 {
-		return false
-	}
-	if e.cfg.CustomPrecompiles == nil {
-		return true
-	}
-	return len(e.cfg.CustomPrecompiles.Addresses()) == 0
+	return !e.closed.Load() && e.cfg.OCCWorkers > 1 && txCount > 1
 }
 
 func (e *Executor) acquireStateDB(source StateReader) *nativeStateDB {
@@ -187,7 +196,7 @@ func (e *Executor) executeBlockSequential(ctx context.Context, req PreparedBlock
 
 	stateDB := e.acquireStateDB(e.state)
 	defer e.releaseStateDB(stateDB)
-	blockCtx := buildBlockContext(req.Context)
+	blockCtx := buildBlockContext(req.Context, e.blockHashFunc(req))
 	evm := vm.NewEVM(blockCtx, stateDB, chainConfig, vm.Config{}, customPrecompileMap(e.cfg.CustomPrecompiles))
 	stateDB.SetEVM(evm)
 
@@ -345,19 +354,29 @@ func transactionToPreparedMessage(p PreparedTx, baseFee *big.Int) *core.Message
 	return msg
 }
 
-func buildBlockContext(ctx BlockContext) vm.BlockContext {
+// blockHashFunc resolves BLOCKHASH for req from the request's source, then
+// the hashes of blocks this executor has executed, then the executor's source.
+func (e *Executor) blockHashFunc(req PreparedBlock) func(uint64) common.Hash {
+	return blockHashFunc(req.Context, req.BlockHashes, e.recentHashes, e.blockHashes)
+}
+
+// recordBlockHashes remembers the parent and own hash of an executed block for
+// the BLOCKHASH lookups of the blocks that follow it.
+func (e *Executor) recordBlockHashes(ctx BlockContext) {
+	if ctx.Number > 0 {
+		e.recentHashes.Record(ctx.Number-1, ctx.ParentHash)
+	}
+	e.recentHashes.Record(ctx.Number, ctx.BlockHash)
+}
+
+func buildBlockContext(ctx BlockContext, getHash vm.GetHashFunc) vm.BlockContext {
 	prevRandao := ctx.PrevRandao
 	baseFee := cloneOptionalBig(ctx.BaseFee)
 	blobBaseFee := cloneOptionalBig(ctx.BlobBaseFee)
 	return vm.BlockContext{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
-		GetHash: func(n uint64) common.Hash {
-			if ctx.Number > 0 && n == ctx.Number-1 {
-				return ctx.ParentHash
-			}
-			return common.Hash{}
-		},
+		GetHash:     getHash,
 		Coinbase:    ctx.Coinbase,
 		GasLimit:    ctx.GasLimit,
 		BlockNumber: new(big.Int).SetUint64(ctx.Number),
@@ -369,14 +388,131 @@ func buildBlockContext(ctx BlockContext) vm.BlockContext {
 	}
 }
 
-type unresolvedCustomPrecompile struct{}
+// customPrecompile runs a native custom precompile against the executor's
+// state DB, so that its reads and writes go through the same tracking as any
+// contract's. A nil contract is a registered precompile which has not been
+// migrated: calls to it fail closed with ErrCustomPrecompilesOpen.
+type customPrecompile struct {
+	address  common.Address
+	contract precompiles.Contract
+}
+
+var _ vm.DynamicGasPrecompiledContract = customPrecompile{}
+
+func (p customPrecompile) RequiredGas(input []byte) uint64 {
+	if p.contract == nil {
+		return 0
+	}
+	return p.contract.RequiredGas(input)
+}
+
+func (p customPrecompile) Run(evm *vm.EVM, caller common.Address, callingContract common.Address, input []byte, value *big.Int, readOnly bool, isFromDelegateCall bool, hooks *tracing.Hooks) ([]byte, error) {
+	ret, _, err := p.RunAndCalculateGas(evm, caller, callingContract, input, p.RequiredGas(input), value, hooks, readOnly, isFromDelegateCall)
+	return ret, err
+}
+
+func (p customPrecompile) RunAndCalculateGas(evm *vm.EVM, caller common.Address, _ common.Address, input []byte, suppliedGas uint64, value *big.Int, _ *tracing.Hooks, readOnly bool, isFromDelegateCall bool) ([]byte, uint64, error) {
+	if p.contract == nil {
+		return nil, 0, precompiles.ErrCustomPrecompilesOpen
+	}
+	stateDB, ok := evm.StateDB.(*nativeStateDB)
+	if !ok {
+		return nil, 0, errCustomPrecompileStateDB
+	}
+	gas := p.contract.RequiredGas(input)
+	if suppliedGas < gas {
+		return nil, 0, vm.ErrOutOfGas
+	}
+	ctx := &precompiles.Context{
+		Caller:        caller,
+		Address:       p.address,
+		ApparentValue: value,
+		ReadOnly:      readOnly,
+		DelegateCall:  isFromDelegateCall,
+		GasRemaining:  suppliedGas - gas,
+		Block:         precompileBlockContext(evm),
+		State:         precompileState{stateDB: stateDB},
+		Logs:          stateDB,
+	}
+	ret, err := p.contract.Run(ctx, input)
+	if err != nil {
+		if errors.Is(err, precompiles.ErrCustomPrecompilesOpen) {
+			return nil, 0, err
+		}
+		// Like the Cosmos-backed precompiles, failures revert the call and
+		// consume all its gas.
+		return nil, 0, vm.ErrExecutionReverted
+	}
+	return ret, ctx.GasRemaining, nil
+}
+
+func precompileBlockContext(evm *vm.EVM) precompiles.BlockContext {
+	ctx := precompiles.BlockContext{
+		Time:        evm.Context.Time,
+		ChainID:     cloneOptionalBig(evm.ChainConfig().ChainID),
+		BaseFee:     cloneOptionalBig(evm.Context.BaseFee),
+		BlobBaseFee: cloneOptionalBig(evm.Context.BlobBaseFee),
+		Coinbase:    evm.Context.Coinbase,
+	}
+	if evm.Context.BlockNumber != nil {
+		ctx.Number = evm.Context.BlockNumber.Uint64()
+	}
+	if evm.Context.Random != nil {
+		ctx.PrevRandao = *evm.Context.Random
+	}
+	return ctx
+}
+
+// precompileState is the precompiles.State view of a nativeStateDB.
+type precompileState struct {
+	stateDB *nativeStateDB
+}
+
+func (s precompileState) GetBalance(addr common.Address) *big.Int {
+	return s.stateDB.GetBalance(addr).ToBig()
+}
+
+func (s precompileState) AddBalance(addr common.Address, amount *big.Int) error {
+	v, err := uint256FromBig(amount)
+	if err != nil {
+		return err
+	}
+	s.stateDB.AddBalance(addr, v, tracing.BalanceChangeTransfer)
+	return nil
+}
+
+func (s precompileState) SubBalance(addr common.Address, amount *big.Int) error {
+	v, err := uint256FromBig(amount)
+	if err != nil {
+		return err
+	}
+	// Check first: an insufficient balance fails the precompile call rather
+	// than the state DB.
+	if s.stateDB.GetBalance(addr).Cmp(v) < 0 {
+		return errInsufficientBalance
+	}
+	s.stateDB.SubBalance(addr, v, tracing.BalanceChangeTransfer)
+	return nil
+}
+
+func (s precompileState) GetNonce(addr common.Address) uint64 {
+	return s.stateDB.GetNonce(addr)
+}
+
+func (s precompileState) SetNonce(addr common.Address, nonce uint64) {
+	s.stateDB.SetNonce(addr, nonce, tracing.NonceChangeUnspecified)
+}
+
+func (s precompileState) GetCode(addr common.Address) []byte {
+	return s.stateDB.GetCode(addr)
+}
 
-func (unresolvedCustomPrecompile) RequiredGas([]byte) uint64 {
-	return 0
+func (s precompileState) GetState(addr common.Address, key common.Hash) common.Hash {
+	return s.stateDB.GetState(addr, key)
 }
 
-func (unresolvedCustomPrecompile) Run(*vm.EVM, common.Address, common.Address, []byte, *big.Int, bool, bool, *tracing.Hooks) ([]byte, error) {
-	return nil, precompiles.ErrCustomPrecompilesOpen
+func (s precompileState) SetState(addr common.Address, key common.Hash, value common.Hash) {
+	s.stateDB.SetState(addr, key, value)
 }
 
 func customPrecompileMap(registry precompiles.Registry) map[common.Address]vm.PrecompiledContract {
@@ -389,7 +525,8 @@ func customPrecompileMap(registry precompiles.Registry) map[common.Address]vm.Pr
 	}
 	contracts := make(map[common.Address]vm.PrecompiledContract, len(addresses))
 	for _, addr := range addresses {
-		contracts[addr] = unresolvedCustomPrecompile{}
+		contract, _ := registry.Get(addr)
+		contracts[addr] = customPrecompile{address: addr, contract: contract}
 	}
 	return contracts
 }
@@ -450,4 +587,7 @@ var (
 	errMissingBaseFee       = fmt.Errorf("missing base fee for post-London block")
 	errMissingBlobBaseFee   = fmt.Errorf("missing blob base fee for post-Cancun block")
 	errUnsupportedBlobTx    = fmt.Errorf("blob transactions require block-level blob gas accounting")
+	// errCustomPrecompileStateDB is unreachable: the executor always runs the
+	// EVM on a nativeStateDB.
+	errCustomPrecompileStateDB = fmt.Errorf("custom precompiles require the native state DB")
 )
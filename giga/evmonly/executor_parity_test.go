@@ -19,6 +19,7 @@ import (
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/triedb"
 	"github.com/holiman/uint256"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -585,6 +586,110 @@ func TestExecutorEnvironmentOpcodeCorpusMatchesGeth(t *testing.T) {
 	require.Equal(t, common.BigToHash(ctx.BlobBaseFee), state.GetState(contract, slots.blobBaseFee))
 }
 
+func TestExecutorHistoricalBlockHashMatchesGeth(t *testing.T) {
+	const number = 300
+	chainID := big.NewInt(testChainID)
+	hashes := make(map[uint64]common.Hash, number)
+	for n := uint64(0); n < number; n++ {
+		hashes[n] = crypto.Keccak256Hash(new(big.Int).SetUint64(n).Bytes())
+	}
+	history := func(n uint64) common.Hash { return hashes[n] }
+	ctxAt := func(n uint64) BlockContext {
+		ctx := blockContext(chainID)
+		ctx.Number = n
+		ctx.Time = n
+		ctx.ParentHash = hashes[n-1]
+		ctx.BlockHash = hashes[n]
+		return ctx
+	}
+	// BLOCKHASH of the block itself and of blocks outside the window is zero.
+	reads := []uint64{number - 1, number - 2, number - 100, number - MaxBlockHashHistory, number - MaxBlockHashHistory - 1, number}
+	slots := make([]common.Hash, len(reads))
+	for i := range reads {
+		slots[i] = testHash(byte(0x80 + i))
+	}
+	store := blockMetaStoreStub{}
+	for n, hash := range hashes {
+		store[int64(n)] = &tmtypes.BlockMeta{BlockID: tmtypes.BlockID{Hash: hash.Bytes()}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		workers int
+		run     func(t *testing.T, cfg Config, state *MemoryState, txs [][]byte) *BlockResult
+	}{
+		{
+			name: "request source",
+			run: func(t *testing.T, cfg Config, state *MemoryState, txs [][]byte) *BlockResult {
+				result, err := NewExecutor(cfg, WithState(state)).ExecuteBlock(t.Context(), BlockRequest{
+					Context:     ctxAt(number),
+					Txs:         txs,
+					BlockHashes: BlockHashSourceFunc(func(n uint64) (common.Hash, bool) { return hashes[n], true }),
+				})
+				require.NoError(t, err)
+				return result
+			},
+		},
+		{
+			name: "block store source",
+			run: func(t *testing.T, cfg Config, state *MemoryState, txs [][]byte) *BlockResult {
+				executor := NewExecutor(cfg, WithState(state), WithBlockHashSource(NewBlockStoreHashSource(store)))
+				result, err := executor.ExecuteBlock(t.Context(), BlockRequest{Context: ctxAt(number), Txs: txs})
+				require.NoError(t, err)
+				return result
+			},
+		},
+		{
+			name:    "executed blocks ring",
+			workers: 4,
+			run: func(t *testing.T, cfg Config, state *MemoryState, txs [][]byte) *BlockResult {
+				executor := NewExecutor(cfg, WithState(state))
+				defer executor.Close()
+				for n := uint64(1); n < number; n++ {
+					_, err := executor.ExecuteBlock(t.Context(), BlockRequest{Context: ctxAt(n)})
+					require.NoError(t, err)
+				}
+				result, err := executor.ExecuteBlock(t.Context(), BlockRequest{Context: ctxAt(number), Txs: txs})
+				require.NoError(t, err)
+				return result
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := crypto.GenerateKey()
+			require.NoError(t, err)
+			sender := crypto.PubkeyToAddress(key.PublicKey)
+			contracts := []common.Address{testAddress(0xd1), testAddress(0xd2)}
+			state := NewMemoryState()
+			state.SetBalance(sender, big.NewInt(1_000_000_000_000))
+			var txs [][]byte
+			for i, contract := range contracts {
+				state.SetCode(contract, blockHashRuntime(reads, slots))
+				txs = append(txs, signLegacyTxWithGasPrice(t, key, chainID, uint64(i), &contract, big.NewInt(0), nil, 500_000, big.NewInt(20)))
+			}
+			cfg := Config{MinGasPrice: big.NewInt(0), OCCWorkers: tc.workers}
+
+			gethResult, err := executeGethReferenceBlockWithHashes(t, state, cfg, ctxAt(number), history, txs)
+			require.NoError(t, err)
+			execResult := tc.run(t, cfg, state, txs)
+			state.ApplyChangeSet(execResult.ChangeSet)
+
+			requireExecutionParity(t, execResult, gethResult)
+			requireAddressParity(t, state, gethResult.state, sender)
+			for _, contract := range contracts {
+				requireAddressParity(t, state, gethResult.state, contract, slots...)
+				for i, n := range reads {
+					want := common.Hash{}
+					if n < number && number-n <= MaxBlockHashHistory {
+						want = hashes[n]
+					}
+					require.Equal(t, want, state.GetState(contract, slots[i]), "BLOCKHASH(%d)", n)
+				}
+			}
+		})
+	}
+}
+
 func TestExecutorVMFailureReceiptsAndFeesMatchGeth(t *testing.T) {
 	chainID := big.NewInt(testChainID)
 	contract := testAddress(0xdd)
@@ -910,13 +1015,31 @@ func TestExecutorOCCDeterministicAcrossRuns(t *testing.T) {
 }
 
 func executeGethReferenceBlock(t *testing.T, initial *MemoryState, cfg Config, ctx BlockContext, rawTxs [][]byte) (*gethReferenceResult, error) {
+	t.Helper()
+	parentOnly := func(n uint64) common.Hash {
+		if ctx.Number > 0 && n == ctx.Number-1 {
+			return ctx.ParentHash
+		}
+		return common.Hash{}
+	}
+	return executeGethReferenceBlockWithHashes(t, initial, cfg, ctx, parentOnly, rawTxs)
+}
+
+func executeGethReferenceBlockWithHashes(
+	t *testing.T,
+	initial *MemoryState,
+	cfg Config,
+	ctx BlockContext,
+	getHash vm.GetHashFunc,
+	rawTxs [][]byte,
+) (*gethReferenceResult, error) {
 	t.Helper()
 	chainConfig := chainConfigForTest(cfg, ctx)
 	if err := validateBlockContext(chainConfig, ctx); err != nil {
 		return nil, err
 	}
 	stateDB := newGethStateFromMemory(t, initial)
-	evm := vm.NewEVM(buildBlockContext(ctx), stateDB, chainConfig, vm.Config{}, nil)
+	evm := vm.NewEVM(buildBlockContext(ctx, getHash), stateDB, chainConfig, vm.Config{}, nil)
 	gasPool := new(core.GasPool).AddGas(ctx.GasLimit)
 	baseFee := cloneOptionalBig(ctx.BaseFee)
 	signer := ethtypes.MakeSigner(chainConfig, new(big.Int).SetUint64(ctx.Number), ctx.Time)
@@ -1188,6 +1311,23 @@ func environmentRuntime(blockNumberForHash uint64, slots envOpcodeSlots) []byte
 	return append(code, 0x00)
 }
 
+// blockHashRuntime stores BLOCKHASH(numbers[i]) at slots[i].
+func blockHashRuntime(numbers []uint64, slots []common.Hash) []byte {
+	var code []byte
+	for i, n := range numbers {
+		code = appendPushUint64(code, n)
+		code = append(code, 0x40)
+		code = appendStoreTop(code, slots[i])
+	}
+	return append(code, 0x00)
+}
+
+type blockMetaStoreStub map[int64]*tmtypes.BlockMeta
+
+func (s blockMetaStoreStub) LoadBlockMeta(height int64) *tmtypes.BlockMeta {
+	return s[height]
+}
+
 func erc20TransferRuntime(fromSlot, toSlot common.Hash, from, to common.Address, amount byte) []byte {
 	code := appendPush32(nil, fromSlot)
 	code = append(code, 0x54)
@@ -3,12 +3,15 @@ package evmonly
 import (
 	"context"
 	"crypto/ecdsa"
+	"encoding/hex"
 	"errors"
 	"math/big"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/tracing"
@@ -980,6 +983,101 @@ func TestExecutorOCCRerunsSameSenderNonceChain(t *testing.T) {
 	require.Equal(t, big.NewInt(1), state.GetBalance(secondRecipient))
 }
 
+func TestPipelineMatchesSequentialAcrossDependentBlocks(t *testing.T) {
+	chainID := big.NewInt(testChainID)
+	chainKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	chainSender := crypto.PubkeyToAddress(chainKey.PublicKey)
+	shared := testAddress(0xc0)
+	const blockCount, independentSenders = 4, 3
+	independentKeys := make([]*ecdsa.PrivateKey, independentSenders)
+	seqState := NewMemoryState()
+	pipeState := NewMemoryState()
+	for _, state := range []*MemoryState{seqState, pipeState} {
+		state.SetBalance(chainSender, big.NewInt(1_000_000))
+	}
+	for i := range independentKeys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		independentKeys[i] = key
+		for _, state := range []*MemoryState{seqState, pipeState} {
+			state.SetBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(1_000_000))
+		}
+	}
+
+	// Every block chains two nonces of one sender, so the speculative state
+	// of block N misses its second nonce and block N+1 must rerun.
+	requests := make([]BlockRequest, 0, blockCount)
+	for block := uint64(1); block <= blockCount; block++ {
+		ctx := blockContext(chainID)
+		ctx.Number = block
+		ctx.Time = block
+		txs := [][]byte{
+			signLegacyTxWithGasPrice(t, chainKey, chainID, 2*(block-1), &shared, big.NewInt(1), nil, 100_000, big.NewInt(0)),
+			signLegacyTxWithGasPrice(t, chainKey, chainID, 2*(block-1)+1, &shared, big.NewInt(1), nil, 100_000, big.NewInt(0)),
+		}
+		for i, key := range independentKeys {
+			recipient := testAddress(byte(0xd0 + i))
+			txs = append(txs, signLegacyTxWithGasPrice(t, key, chainID, block-1, &recipient, big.NewInt(3), nil, 100_000, big.NewInt(0)))
+		}
+		requests = append(requests, BlockRequest{Context: ctx, Txs: txs})
+	}
+
+	seqExecutor := NewExecutor(Config{MinGasPrice: big.NewInt(0)}, WithState(seqState))
+	var seqReceipts []*ethtypes.Receipt
+	for _, req := range requests {
+		result, err := seqExecutor.ExecuteBlock(t.Context(), req)
+		require.NoError(t, err)
+		seqReceipts = append(seqReceipts, result.Receipts...)
+		seqState.ApplyChangeSet(result.ChangeSet)
+	}
+
+	pipeExecutor := NewExecutor(Config{MinGasPrice: big.NewInt(0), OCCWorkers: 4}, WithState(pipeState))
+	defer pipeExecutor.Close()
+	blocks := make(chan PreparedBlock, len(requests))
+	for _, req := range requests {
+		prepared, err := pipeExecutor.PrepareBlock(t.Context(), req)
+		require.NoError(t, err)
+		blocks <- prepared
+	}
+	close(blocks)
+	var (
+		pipeReceipts     []*ethtypes.Receipt
+		crossBlockReruns uint64
+		speculatedBlocks int
+	)
+	err = pipeExecutor.NewPipeline(pipeState).Run(t.Context(), blocks, func(result *BlockResult) error {
+		require.True(t, result.OCCStats.Attempted)
+		require.False(t, result.OCCStats.Fallback)
+		if result.OCCStats.CrossBlockSpeculated {
+			speculatedBlocks++
+		}
+		crossBlockReruns += result.OCCStats.CrossBlockReruns
+		pipeReceipts = append(pipeReceipts, result.Receipts...)
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, blockCount-1, speculatedBlocks)
+	require.NotZero(t, crossBlockReruns)
+	require.Len(t, pipeReceipts, len(seqReceipts))
+	for i := range seqReceipts {
+		require.Equal(t, seqReceipts[i].TxHash, pipeReceipts[i].TxHash)
+		require.Equal(t, seqReceipts[i].Status, pipeReceipts[i].Status)
+		require.Equal(t, seqReceipts[i].CumulativeGasUsed, pipeReceipts[i].CumulativeGasUsed)
+	}
+	require.Equal(t, seqState.GetNonce(chainSender), pipeState.GetNonce(chainSender))
+	require.Equal(t, uint64(2*blockCount), pipeState.GetNonce(chainSender))
+	require.Equal(t, seqState.GetBalance(shared), pipeState.GetBalance(shared))
+	for i, key := range independentKeys {
+		sender := crypto.PubkeyToAddress(key.PublicKey)
+		require.Equal(t, seqState.GetNonce(sender), pipeState.GetNonce(sender))
+		require.Equal(t, seqState.GetBalance(sender), pipeState.GetBalance(sender))
+		recipient := testAddress(byte(0xd0 + i))
+		require.Equal(t, seqState.GetBalance(recipient), pipeState.GetBalance(recipient))
+	}
+}
+
 func TestExecutorOCCRejectsWhenDeclaredGasExceedsBlockLimit(t *testing.T) {
 	chainID := big.NewInt(testChainID)
 	rawTxs := make([][]byte, 0, 2)
@@ -2430,6 +2528,51 @@ func TestExecutorCustomPrecompilePlaceholder(t *testing.T) {
 	require.True(t, errors.Is(result.Txs[0].Err, precompiles.ErrCustomPrecompilesOpen))
 }
 
+func TestExecutorNativeCustomPrecompileStateIsTracked(t *testing.T) {
+	chainID := big.NewInt(testChainID)
+	associated, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	associatedAddr := crypto.PubkeyToAddress(associated.PublicKey)
+	addrABI, err := abi.JSON(strings.NewReader(`[{"inputs":[{"name":"pubKeyHex","type":"string"}],"name":"associatePubKey","outputs":[{"name":"seiAddr","type":"string"},{"name":"evmAddr","type":"address"}],"stateMutability":"nonpayable","type":"function"}]`))
+	require.NoError(t, err)
+	input, err := addrABI.Pack("associatePubKey", hex.EncodeToString(crypto.CompressPubkey(&associated.PublicKey)))
+	require.NoError(t, err)
+
+	state := NewMemoryState()
+	var rawTxs [][]byte
+	for range 2 {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		state.SetBalance(crypto.PubkeyToAddress(key.PublicKey), big.NewInt(testFundedBalanceWei))
+		rawTxs = append(rawTxs, signLegacyTx(t, key, chainID, 0, &precompiles.AddrAddress, big.NewInt(0), input))
+	}
+	executor := NewExecutor(Config{
+		MinGasPrice:       big.NewInt(0),
+		OCCWorkers:        2,
+		CustomPrecompiles: precompiles.NewSeiRegistry(precompiles.DefaultConfig()),
+	}, WithState(state))
+
+	result, err := executor.ExecuteBlock(t.Context(), BlockRequest{
+		Context: blockContext(chainID),
+		Txs:     rawTxs,
+	})
+
+	require.NoError(t, err)
+	require.True(t, result.OCCStats.Attempted)
+	require.False(t, result.OCCStats.Fallback)
+	// The second association of the same key conflicts with the first one
+	// through the addr precompile storage, and fails once rerun.
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, result.Txs[0].Status)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, result.Txs[1].Status)
+	require.ErrorIs(t, result.Txs[1].Err, vm.ErrExecutionReverted)
+	var slots []common.Hash
+	for _, change := range result.ChangeSet.Storage {
+		require.Equal(t, precompiles.AddrAddress, change.Address)
+		slots = append(slots, change.Key)
+	}
+	require.Contains(t, slots, precompiles.EVMToSeiSlot(associatedAddr))
+}
+
 func signLegacyTx(t testing.TB, key *ecdsa.PrivateKey, chainID *big.Int, nonce uint64, to *common.Address, value *big.Int, data []byte) []byte {
 	t.Helper()
 	return signLegacyTxWithGas(t, key, chainID, nonce, to, value, data, 100_000)
@@ -0,0 +1,245 @@
+// Package flatkvstate backs the EVM-only executor with a FlatKV store.
+package flatkvstate
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/sei-protocol/sei-chain/giga/evmonly"
+	"github.com/sei-protocol/sei-chain/sei-db/common/keys"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/config"
+)
+
+// EVM store key prefixes, mirrored from x/evm/types/keys.go so that this
+// package does not depend on the Cosmos module.
+var (
+	stateKeyPrefix    = []byte{0x03}
+	codeKeyPrefix     = []byte{0x07}
+	codeHashKeyPrefix = []byte{0x08}
+	codeSizeKeyPrefix = []byte{0x09}
+	nonceKeyPrefix    = []byte{0x0a}
+	// balanceKeyPrefix is x/evm's EvmOnlyBalancePrefix. FlatKV has no balance
+	// key kind yet, so EVM-only balances are stored as EVM misc keys holding a
+	// 32-byte big-endian wei amount.
+	balanceKeyPrefix = []byte{0x24}
+)
+
+const balanceLen = 32
+
+// State reads balance, nonce, code and storage from a FlatKV store and commits
+// executor changesets to it. Reads see committed state and are safe for
+// concurrent calls, as evmonly.StateReader requires.
+type State struct {
+	store flatkv.Store
+}
+
+var _ evmonly.StateBackend = (*State)(nil)
+
+// NewState wraps a FlatKV store that has already been loaded.
+func NewState(store flatkv.Store) *State {
+	return &State{store: store}
+}
+
+// Open opens the FlatKV store configured by cfg at its latest version. Close
+// the returned State to close the store.
+func Open(ctx context.Context, cfg *config.Config) (*State, error) {
+	stateWAL, err := flatkv.OpenStateWAL(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("open flatkv state WAL: %w", err)
+	}
+	store, err := flatkv.NewCommitStore(ctx, cfg, stateWAL)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("create flatkv store: %w", err), stateWAL.Close())
+	}
+	if err := store.LoadLatest(); err != nil {
+		return nil, errors.Join(fmt.Errorf("load flatkv store: %w", err), store.Close())
+	}
+	return NewState(store), nil
+}
+
+// Version returns the latest committed version.
+func (s *State) Version() int64 {
+	return s.store.Version()
+}
+
+func (s *State) Close() error {
+	return s.store.Close()
+}
+
+func (s *State) GetBalance(addr common.Address) *big.Int {
+	value, ok := s.store.Get(keys.EVMStoreKey, addressKey(balanceKeyPrefix, addr))
+	if !ok {
+		return new(big.Int)
+	}
+	return new(big.Int).SetBytes(value)
+}
+
+func (s *State) GetNonce(addr common.Address) uint64 {
+	value, ok := s.store.Get(keys.EVMStoreKey, addressKey(nonceKeyPrefix, addr))
+	if !ok || len(value) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+func (s *State) GetCode(addr common.Address) []byte {
+	value, ok := s.store.Get(keys.EVMStoreKey, addressKey(codeKeyPrefix, addr))
+	if !ok || len(value) == 0 {
+		return nil
+	}
+	// The executor treats code as immutable; do not hand out a slice the store
+	// may still own.
+	return append([]byte(nil), value...)
+}
+
+func (s *State) GetState(addr common.Address, key common.Hash) common.Hash {
+	value, ok := s.store.Get(keys.EVMStoreKey, storageKey(addr, key))
+	if !ok {
+		return common.Hash{}
+	}
+	return common.BytesToHash(value)
+}
+
+// ApplyChangeSet commits cs as the version after the latest committed one. It
+// panics if the commit fails, as StateWriter cannot return an error; use
+// CommitChangeSet to handle failures.
+func (s *State) ApplyChangeSet(cs evmonly.StateChangeSet) {
+	if err := s.CommitChangeSet(s.store.Version()+1, cs); err != nil {
+		panic(fmt.Sprintf("flatkvstate: %v", err))
+	}
+}
+
+// CommitChangeSet commits cs to the store as one FlatKV block at version.
+// Storage clears are applied before per-slot storage writes.
+func (s *State) CommitChangeSet(version int64, cs evmonly.StateChangeSet) error {
+	pairs, err := s.changeSetPairs(cs)
+	if err != nil {
+		return err
+	}
+	changeSets := []*proto.NamedChangeSet{{
+		Name:      keys.EVMStoreKey,
+		Changeset: proto.ChangeSet{Pairs: pairs},
+	}}
+	if err := s.store.CommitBlock(version, changeSets); err != nil {
+		return fmt.Errorf("commit flatkv version %d: %w", version, err)
+	}
+	return nil
+}
+
+// changeSetPairs translates cs to x/evm store writes. A key written twice
+// keeps its last write, so clears are emitted before slot writes.
+func (s *State) changeSetPairs(cs evmonly.StateChangeSet) ([]*proto.KVPair, error) {
+	pairs := make([]*proto.KVPair, 0, len(cs.Balances)+len(cs.Nonces)+3*len(cs.Code)+len(cs.Storage))
+	for _, change := range cs.Balances {
+		key := addressKey(balanceKeyPrefix, change.Address)
+		if change.Balance == nil || change.Balance.Sign() == 0 {
+			pairs = append(pairs, deletePair(key))
+			continue
+		}
+		if change.Balance.Sign() < 0 || change.Balance.BitLen() > 8*balanceLen {
+			return nil, fmt.Errorf("balance of %s out of range: %s", change.Address, change.Balance)
+		}
+		pairs = append(pairs, setPair(key, change.Balance.FillBytes(make([]byte, balanceLen))))
+	}
+	for _, change := range cs.Nonces {
+		key := addressKey(nonceKeyPrefix, change.Address)
+		if change.Nonce == 0 {
+			pairs = append(pairs, deletePair(key))
+			continue
+		}
+		pairs = append(pairs, setPair(key, binary.BigEndian.AppendUint64(nil, change.Nonce)))
+	}
+	for _, change := range cs.Code {
+		codeKey := addressKey(codeKeyPrefix, change.Address)
+		codeHashKey := addressKey(codeHashKeyPrefix, change.Address)
+		codeSizeKey := addressKey(codeSizeKeyPrefix, change.Address)
+		if change.Delete {
+			pairs = append(pairs, deletePair(codeKey), deletePair(codeHashKey), deletePair(codeSizeKey))
+			continue
+		}
+		// Mirror the keeper's SetCode: code, code size and code hash.
+		code := change.Code
+		if code == nil {
+			code = []byte{}
+		}
+		hash := crypto.Keccak256Hash(code)
+		pairs = append(pairs,
+			setPair(codeKey, code),
+			setPair(codeSizeKey, binary.BigEndian.AppendUint64(nil, uint64(len(code)))),
+			setPair(codeHashKey, hash.Bytes()),
+		)
+	}
+	for _, addr := range cs.StorageClears {
+		cleared, err := s.storageDeletes(addr)
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, cleared...)
+	}
+	for _, change := range cs.Storage {
+		key := storageKey(change.Address, change.Key)
+		if change.Delete || change.Value == (common.Hash{}) {
+			pairs = append(pairs, deletePair(key))
+			continue
+		}
+		pairs = append(pairs, setPair(key, change.Value.Bytes()))
+	}
+	return pairs, nil
+}
+
+// storageDeletes returns a delete for every committed storage slot of addr.
+func (s *State) storageDeletes(addr common.Address) ([]*proto.KVPair, error) {
+	start := addressKey(stateKeyPrefix, addr)
+	end := addressKey(stateKeyPrefix, addr)
+	// The slots of addr are exactly the keys prefixed by start; end is the
+	// smallest key above all of them.
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			break
+		}
+	}
+	iter, err := s.store.Iterator(keys.EVMStoreKey, start, end, true)
+	if err != nil {
+		return nil, fmt.Errorf("iterate storage of %s: %w", addr, err)
+	}
+	var pairs []*proto.KVPair
+	for ; iter.Valid(); iter.Next() {
+		pairs = append(pairs, deletePair(append([]byte(nil), iter.Key()...)))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, errors.Join(fmt.Errorf("iterate storage of %s: %w", addr, err), iter.Close())
+	}
+	if err := iter.Close(); err != nil {
+		return nil, fmt.Errorf("close storage iterator of %s: %w", addr, err)
+	}
+	return pairs, nil
+}
+
+func addressKey(prefix []byte, addr common.Address) []byte {
+	key := make([]byte, 0, len(prefix)+common.AddressLength)
+	key = append(key, prefix...)
+	return append(key, addr.Bytes()...)
+}
+
+func storageKey(addr common.Address, slot common.Hash) []byte {
+	key := make([]byte, 0, len(stateKeyPrefix)+common.AddressLength+common.HashLength)
+	key = append(key, stateKeyPrefix...)
+	key = append(key, addr.Bytes()...)
+	return append(key, slot.Bytes()...)
+}
+
+func setPair(key, value []byte) *proto.KVPair {
+	return &proto.KVPair{Key: key, Value: value}
+}
+
+func deletePair(key []byte) *proto.KVPair {
+	return &proto.KVPair{Key: key, Delete: true}
+}
@@ -0,0 +1,119 @@
+package flatkvstate
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/sei-protocol/sei-chain/giga/evmonly"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/config"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestState(t *testing.T) *State {
+	t.Helper()
+	state, err := Open(t.Context(), config.DefaultTestConfig(t))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, state.Close()) })
+	return state
+}
+
+func TestStateMatchesMemoryState(t *testing.T) {
+	state := openTestState(t)
+	memory := evmonly.NewMemoryState()
+	alice := common.HexToAddress("0xa1")
+	bob := common.HexToAddress("0xb0")
+	slot := func(b byte) common.Hash { return common.BytesToHash([]byte{b}) }
+
+	changeSets := []evmonly.StateChangeSet{
+		{
+			Balances: []evmonly.BalanceChange{{Address: alice, Balance: big.NewInt(1_000_000)}, {Address: bob, Balance: big.NewInt(7)}},
+			Nonces:   []evmonly.NonceChange{{Address: alice, Nonce: 3}},
+			Code:     []evmonly.CodeChange{{Address: bob, Code: []byte{0x60, 0x00}}},
+			Storage: []evmonly.StorageChange{
+				{Address: bob, Key: slot(1), Value: slot(0x11)},
+				{Address: bob, Key: slot(2), Value: slot(0x22)},
+				{Address: alice, Key: slot(1), Value: slot(0x33)},
+			},
+		},
+		{
+			// Clears come before slot writes: slot 2 is rewritten, slot 1 is gone.
+			Balances:      []evmonly.BalanceChange{{Address: bob, Balance: new(big.Int)}},
+			Nonces:        []evmonly.NonceChange{{Address: alice, Nonce: 0}},
+			StorageClears: []common.Address{bob},
+			Storage:       []evmonly.StorageChange{{Address: bob, Key: slot(2), Value: slot(0x44)}},
+		},
+		{
+			Code:    []evmonly.CodeChange{{Address: bob, Delete: true}},
+			Storage: []evmonly.StorageChange{{Address: alice, Key: slot(1), Delete: true}},
+		},
+	}
+	for i, cs := range changeSets {
+		require.NoError(t, state.CommitChangeSet(int64(i+1), cs))
+		memory.ApplyChangeSet(cs)
+		require.Equal(t, int64(i+1), state.Version())
+		for _, addr := range []common.Address{alice, bob} {
+			require.Equal(t, memory.GetBalance(addr).String(), state.GetBalance(addr).String())
+			require.Equal(t, memory.GetNonce(addr), state.GetNonce(addr))
+			require.Equal(t, memory.GetCode(addr), state.GetCode(addr))
+			for _, key := range []common.Hash{slot(1), slot(2)} {
+				require.Equal(t, memory.GetState(addr, key), state.GetState(addr, key), "changeset %d", i)
+			}
+		}
+	}
+}
+
+func TestExecutorRunsAgainstState(t *testing.T) {
+	state := openTestState(t)
+	chainID := big.NewInt(1337)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	recipient := common.HexToAddress("0xbeef")
+	state.ApplyChangeSet(evmonly.StateChangeSet{
+		Balances: []evmonly.BalanceChange{{Address: sender, Balance: big.NewInt(params.Ether)}},
+	})
+
+	executor := evmonly.NewExecutor(evmonly.Config{MinGasPrice: big.NewInt(0), OCCWorkers: 4}, evmonly.WithState(state))
+	defer executor.Close()
+	signer := ethtypes.LatestSignerForChainID(chainID)
+	for block := uint64(1); block <= 3; block++ {
+		var txs [][]byte
+		for i := range 2 {
+			nonce := (block-1)*2 + uint64(i)
+			tx, err := ethtypes.SignNewTx(key, signer, &ethtypes.LegacyTx{
+				Nonce:    nonce,
+				To:       &recipient,
+				Value:    big.NewInt(100),
+				Gas:      params.TxGas,
+				GasPrice: big.NewInt(0),
+			})
+			require.NoError(t, err)
+			raw, err := tx.MarshalBinary()
+			require.NoError(t, err)
+			txs = append(txs, raw)
+		}
+		result, err := executor.ExecuteBlock(t.Context(), evmonly.BlockRequest{
+			Context: evmonly.BlockContext{
+				Number:      block,
+				Time:        block,
+				GasLimit:    30_000_000,
+				ChainID:     chainID,
+				BaseFee:     big.NewInt(0),
+				BlobBaseFee: big.NewInt(0),
+			},
+			Txs: txs,
+		})
+		require.NoError(t, err)
+		for _, receipt := range result.Receipts {
+			require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+		}
+		require.NoError(t, state.CommitChangeSet(state.Version()+1, result.ChangeSet))
+		result.Release()
+	}
+	require.Equal(t, uint64(6), state.GetNonce(sender))
+	require.Equal(t, "600", state.GetBalance(recipient).String())
+}
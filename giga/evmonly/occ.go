@@ -50,7 +50,7 @@ func newOCCSpeculativeRunner(e *Executor, req PreparedBlock) occSpeculativeRunne
 		executor:      e,
 		req:           req,
 		chainConfig:   e.chainConfig(req.Context),
-		blockCtx:      buildBlockContext(req.Context),
+		blockCtx:      buildBlockContext(req.Context, e.blockHashFunc(req)),
 		baseFee:       cloneOptionalBig(req.Context.BaseFee),
 		blockGasLimit: req.Context.GasLimit,
 	}
@@ -58,19 +58,26 @@ func newOCCSpeculativeRunner(e *Executor, req PreparedBlock) occSpeculativeRunne
 
 func (e *Executor) executeBlockOCC(ctx context.Context, req PreparedBlock) (*BlockResult, error) {
 	runner := newOCCSpeculativeRunner(e, req)
-	workers := min(e.cfg.OCCWorkers, len(req.Txs))
-	executionPool := e.occPool
-
-	results := make([]occTxExecution, len(req.Txs))
-	chunkSize := occChunkSize(len(req.Txs), workers)
-	if err := runner.runRanges(ctx, executionPool, occRanges(len(req.Txs), chunkSize), e.state, runner.blockGasLimit, results); err != nil {
+	results, err := runner.runInitial(ctx, e.state)
+	if err != nil {
 		if errors.Is(err, errOCCWorkerPoolClosed) {
 			return e.executeBlockOCCSequentialFallback(ctx, req, occValidationResult{}, occFallbackReasonWorkerPoolClosed)
 		}
 		return nil, err
 	}
+	return e.finishBlockOCC(ctx, runner, results, occValidationResult{})
+}
 
-	results, finalState, validation, err := e.validateBlockSTM(ctx, runner, executionPool, results)
+// finishBlockOCC validates the incarnations in results, reruns the invalid
+// ones, and merges the accepted results into the block result.
+func (e *Executor) finishBlockOCC(
+	ctx context.Context,
+	runner occSpeculativeRunner,
+	results []occTxExecution,
+	validation occValidationResult,
+) (*BlockResult, error) {
+	req := runner.req
+	results, finalState, validation, err := e.validateBlockSTM(ctx, runner, e.occPool, results, validation)
 	if errors.Is(err, errOCCMaxIncarnation) || errors.Is(err, errOCCWorkerPoolClosed) {
 		reason := validation.fallbackReason
 		switch {
@@ -104,6 +111,18 @@ func (e *Executor) executeBlockOCCSequentialFallback(ctx context.Context, req Pr
 	return result, nil
 }
 
+// runInitial runs the first incarnation of every transaction against source.
+func (r occSpeculativeRunner) runInitial(ctx context.Context, source StateReader) ([]occTxExecution, error) {
+	txCount := len(r.req.Txs)
+	workers := min(r.executor.cfg.OCCWorkers, txCount)
+	results := make([]occTxExecution, txCount)
+	chunkSize := occChunkSize(txCount, workers)
+	if err := r.runRanges(ctx, r.executor.occPool, occRanges(txCount, chunkSize), source, r.blockGasLimit, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
 func (r occSpeculativeRunner) executeTx(
 	ctx context.Context,
 	source StateReader,
@@ -236,7 +255,7 @@ func (e *Executor) executeTxSpeculative(
 	stateDB := e.acquireStateDB(source)
 	defer e.releaseStateDB(stateDB)
 	stateDB.enableAccessTracking()
-	evm := vm.NewEVM(blockCtx, stateDB, chainConfig, vm.Config{}, nil)
+	evm := vm.NewEVM(blockCtx, stateDB, chainConfig, vm.Config{}, customPrecompileMap(e.cfg.CustomPrecompiles))
 	stateDB.SetEVM(evm)
 	gasPool := new(core.GasPool).AddGas(gasLimit)
 	txResult, receipt, err := e.executeTx(
@@ -285,9 +304,9 @@ func (e *Executor) validateBlockSTM(
 	runner occSpeculativeRunner,
 	pool *occWorkerPool,
 	results []occTxExecution,
+	validation occValidationResult,
 ) ([]occTxExecution, *blockSTMState, occValidationResult, error) {
 	state := newBlockSTMValidationState(e.state)
-	validation := occValidationResult{}
 	for state.nextToValidate < len(results) {
 		rerun, err := validateBlockSTMFrontier(ctx, runner, results, state, &validation)
 		if err != nil {
@@ -428,11 +447,13 @@ type occExecutionTask struct {
 }
 
 type occValidationResult struct {
-	fallbackReason  string
-	rerunCount      uint64
-	conflictCount   uint64
-	validationCount uint64
-	conflicts       map[occConflictAggregationKey]uint64
+	fallbackReason       string
+	rerunCount           uint64
+	conflictCount        uint64
+	validationCount      uint64
+	conflicts            map[occConflictAggregationKey]uint64
+	crossBlockSpeculated bool
+	crossBlockReruns     uint64
 }
 
 type occConflictAggregationKey struct {
@@ -508,6 +529,9 @@ func (r occValidationResult) stats(fallback bool) OCCStats {
 		RerunCount:      r.rerunCount,
 		ConflictCount:   r.conflictCount,
 		ValidationCount: r.validationCount,
+
+		CrossBlockSpeculated: r.crossBlockSpeculated,
+		CrossBlockReruns:     r.crossBlockReruns,
 	}
 	if fallback {
 		stats.FallbackReason = r.fallbackReason
@@ -0,0 +1,325 @@
+package evmonly
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Pipeline executes consecutive blocks with cross-block speculation. While
+// block N is validated and committed, the first incarnations of block N+1 run
+// against N's speculative writes. Once N is final, the transactions of N+1 that
+// touched state N finalized differently are rerun against committed state, and
+// N+1 then goes through the usual Block-STM validation.
+//
+// Only blocks that run under OCC speculate; other blocks execute after their
+// predecessor is committed, as with ExecutePreparedBlock.
+type Pipeline struct {
+	executor *Executor
+	writer   StateWriter
+}
+
+// NewPipeline returns a pipeline that commits each block's changeset with
+// writer before the next block is finalized. writer must update the
+// StateReader the executor was built with.
+func (e *Executor) NewPipeline(writer StateWriter) *Pipeline {
+	return &Pipeline{executor: e, writer: writer}
+}
+
+type pipelineBlock struct {
+	req    PreparedBlock
+	runner occSpeculativeRunner
+	// results holds the first incarnations when the block runs under OCC.
+	results     []occTxExecution
+	speculated  bool
+	invalidated map[stateAccessKey]struct{}
+}
+
+type pipelineSpeculation struct {
+	block   *pipelineBlock
+	changes StateChangeSet
+	cancel  context.CancelFunc
+	done    chan struct{}
+	err     error
+}
+
+// Run executes blocks in order until blocks is closed, ctx is done, or a block
+// fails. Each result is committed with the pipeline's writer, handed to the
+// executor's result sink, and then passed to emit, which owns it and must
+// Release it. A nil emit releases results directly. A block that fails leaves
+// every earlier block committed and emitted.
+func (p *Pipeline) Run(ctx context.Context, blocks <-chan PreparedBlock, emit func(*BlockResult) error) error {
+	cur, err := p.receive(ctx, blocks)
+	for cur != nil && err == nil {
+		cur, err = p.advance(ctx, cur, blocks, emit)
+	}
+	return err
+}
+
+// receive waits for the next block and starts it against committed state. It
+// returns nil once blocks is closed.
+func (p *Pipeline) receive(ctx context.Context, blocks <-chan PreparedBlock) (*pipelineBlock, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case req, ok := <-blocks:
+		if !ok {
+			return nil, nil
+		}
+		return p.start(ctx, req, p.executor.state)
+	}
+}
+
+// start validates the block context and, for OCC blocks, runs the first
+// incarnation of every transaction against source.
+func (p *Pipeline) start(ctx context.Context, req PreparedBlock, source StateReader) (*pipelineBlock, error) {
+	e := p.executor
+	if err := validateBlockContext(e.chainConfig(req.Context), req.Context); err != nil {
+		return nil, err
+	}
+	block := &pipelineBlock{req: req}
+	if !e.useOCC(len(req.Txs)) {
+		return block, nil
+	}
+	block.runner = newOCCSpeculativeRunner(e, req)
+	results, err := block.runner.runInitial(ctx, source)
+	if err != nil {
+		if errors.Is(err, errOCCWorkerPoolClosed) {
+			// Finishing without results executes the block from scratch.
+			return block, nil
+		}
+		return nil, err
+	}
+	block.results = results
+	return block, nil
+}
+
+// advance finishes and commits cur, overlapping it with the speculative start
+// of the next block when one is already queued. It returns the next block, or
+// nil once blocks is closed.
+func (p *Pipeline) advance(ctx context.Context, cur *pipelineBlock, blocks <-chan PreparedBlock, emit func(*BlockResult) error) (*pipelineBlock, error) {
+	var (
+		spec    *pipelineSpeculation
+		pending *PreparedBlock
+	)
+	if cur.results != nil {
+		select {
+		case req, ok := <-blocks:
+			switch {
+			case !ok:
+			case p.executor.useOCC(len(req.Txs)):
+				spec = p.speculate(ctx, cur, req)
+			default:
+				pending = &req
+			}
+		default:
+		}
+	}
+
+	result, err := p.finish(ctx, cur)
+	if spec != nil {
+		if err != nil {
+			spec.cancel()
+		}
+		// The speculation reads committed state, so it must be done before
+		// the writer changes it.
+		<-spec.done
+		spec.cancel()
+	}
+	if err != nil {
+		return nil, err
+	}
+	var invalidated map[stateAccessKey]struct{}
+	if spec != nil {
+		invalidated = changeSetDiffKeys(spec.changes, result.ChangeSet)
+	}
+	if err := p.commit(ctx, cur.req, result, emit); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case spec != nil:
+		if spec.err != nil {
+			return nil, spec.err
+		}
+		next := spec.block
+		if next.results != nil {
+			next.speculated = true
+			next.invalidated = invalidated
+		}
+		return next, nil
+	case pending != nil:
+		return p.start(ctx, *pending, p.executor.state)
+	default:
+		return p.receive(ctx, blocks)
+	}
+}
+
+// speculate starts req against the state cur produces if every first
+// incarnation of cur is accepted as is.
+func (p *Pipeline) speculate(ctx context.Context, cur *pipelineBlock, req PreparedBlock) *pipelineSpeculation {
+	state := newBlockSTMState(p.executor.state)
+	for _, result := range cur.results {
+		if result.err == nil {
+			state.apply(result)
+		}
+	}
+	specCtx, cancel := context.WithCancel(ctx)
+	spec := &pipelineSpeculation{
+		changes: state.ChangeSet(),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go func() {
+		defer close(spec.done)
+		spec.block, spec.err = p.start(specCtx, req, state)
+	}()
+	return spec
+}
+
+// finish reruns the speculative transactions invalidated by the previous
+// block, then validates and merges the block as executeBlockOCC does.
+func (p *Pipeline) finish(ctx context.Context, block *pipelineBlock) (*BlockResult, error) {
+	e := p.executor
+	if block.results == nil {
+		return e.executePreparedBlock(ctx, block.req)
+	}
+	validation := occValidationResult{}
+	if block.speculated {
+		validation.crossBlockSpeculated = true
+		if err := p.rerunInvalidated(ctx, block, &validation); err != nil {
+			if errors.Is(err, errOCCWorkerPoolClosed) {
+				return e.executeBlockOCCSequentialFallback(ctx, block.req, validation, occFallbackReasonWorkerPoolClosed)
+			}
+			return nil, err
+		}
+	}
+	return e.finishBlockOCC(ctx, block.runner, block.results, validation)
+}
+
+func (p *Pipeline) rerunInvalidated(ctx context.Context, block *pipelineBlock, validation *occValidationResult) error {
+	if len(block.invalidated) == 0 {
+		return nil
+	}
+	e := p.executor
+	changed := newStateAccessIndex()
+	changed.addAllAt(0, block.invalidated)
+	var tasks []occExecutionTask
+	for txIndex, result := range block.results {
+		conflictsBefore := validation.conflictCount
+		validation.addConflicts("read", changed, result.readSet, 0)
+		validation.addConflicts("write", changed, result.writeSet, 0)
+		if validation.conflictCount == conflictsBefore {
+			continue
+		}
+		tasks = append(tasks, occExecutionTask{
+			txIndex:      txIndex,
+			txIndexUint:  uint(txIndex), //nolint:gosec // transaction count is bounded by available memory.
+			incarnation:  result.incarnation + 1,
+			sourcePrefix: 0,
+			source:       e.state,
+			gasLimit:     block.runner.blockGasLimit,
+		})
+	}
+	validation.rerunCount += uint64(len(tasks))
+	validation.crossBlockReruns += uint64(len(tasks))
+	return block.runner.runTasks(ctx, e.occPool, tasks, block.results)
+}
+
+func (p *Pipeline) commit(ctx context.Context, req PreparedBlock, result *BlockResult, emit func(*BlockResult) error) error {
+	e := p.executor
+	if err := e.sinkBlockResult(ctx, req.Context.Number, result); err != nil {
+		result.Release()
+		return err
+	}
+	p.writer.ApplyChangeSet(result.ChangeSet)
+	e.recordBlockHashes(req.Context)
+	if emit == nil {
+		result.Release()
+		return nil
+	}
+	if err := emit(result); err != nil {
+		return fmt.Errorf("emit block %d: %w", req.Context.Number, err)
+	}
+	return nil
+}
+
+// changeSetDiffKeys returns the state keys whose post-block values differ
+// between two changesets of the same block over the same base state. A
+// storage clear present in only one of them invalidates the whole account.
+func changeSetDiffKeys(speculative, final StateChangeSet) map[stateAccessKey]struct{} {
+	diff := map[stateAccessKey]struct{}{}
+	addChangedKeys(diff,
+		indexChanges(speculative.Balances, func(c BalanceChange) (common.Address, *big.Int) { return c.Address, c.Balance }),
+		indexChanges(final.Balances, func(c BalanceChange) (common.Address, *big.Int) { return c.Address, c.Balance }),
+		func(a, b *big.Int) bool { return cloneBig(a).Cmp(cloneBig(b)) == 0 },
+		func(addr common.Address) stateAccessKey {
+			return stateAccessKey{kind: stateAccessBalance, address: addr}
+		},
+	)
+	addChangedKeys(diff,
+		indexChanges(speculative.Nonces, func(c NonceChange) (common.Address, uint64) { return c.Address, c.Nonce }),
+		indexChanges(final.Nonces, func(c NonceChange) (common.Address, uint64) { return c.Address, c.Nonce }),
+		func(a, b uint64) bool { return a == b },
+		func(addr common.Address) stateAccessKey { return stateAccessKey{kind: stateAccessNonce, address: addr} },
+	)
+	addChangedKeys(diff,
+		indexChanges(speculative.Code, func(c CodeChange) (common.Address, []byte) { return c.Address, c.Code }),
+		indexChanges(final.Code, func(c CodeChange) (common.Address, []byte) { return c.Address, c.Code }),
+		bytes.Equal,
+		func(addr common.Address) stateAccessKey { return stateAccessKey{kind: stateAccessCode, address: addr} },
+	)
+	addChangedKeys(diff,
+		indexChanges(speculative.StorageClears, func(addr common.Address) (common.Address, struct{}) { return addr, struct{}{} }),
+		indexChanges(final.StorageClears, func(addr common.Address) (common.Address, struct{}) { return addr, struct{}{} }),
+		func(struct{}, struct{}) bool { return true },
+		func(addr common.Address) stateAccessKey {
+			return stateAccessKey{kind: stateAccessAccount, address: addr}
+		},
+	)
+	addChangedKeys(diff,
+		indexChanges(speculative.Storage, func(c StorageChange) (storageChangeKey, common.Hash) {
+			return storageChangeKey{address: c.Address, key: c.Key}, c.Value
+		}),
+		indexChanges(final.Storage, func(c StorageChange) (storageChangeKey, common.Hash) {
+			return storageChangeKey{address: c.Address, key: c.Key}, c.Value
+		}),
+		func(a, b common.Hash) bool { return a == b },
+		func(key storageChangeKey) stateAccessKey {
+			return stateAccessKey{kind: stateAccessStorage, address: key.address, slot: key.key}
+		},
+	)
+	return diff
+}
+
+func indexChanges[T any, K comparable, V any](changes []T, entry func(T) (K, V)) map[K]V {
+	index := make(map[K]V, len(changes))
+	for _, change := range changes {
+		key, value := entry(change)
+		index[key] = value
+	}
+	return index
+}
+
+func addChangedKeys[K comparable, V any](
+	diff map[stateAccessKey]struct{},
+	speculative map[K]V,
+	final map[K]V,
+	equal func(V, V) bool,
+	accessKey func(K) stateAccessKey,
+) {
+	for key, value := range speculative {
+		if finalValue, ok := final[key]; !ok || !equal(value, finalValue) {
+			diff[accessKey(key)] = struct{}{}
+		}
+	}
+	for key := range final {
+		if _, ok := speculative[key]; !ok {
+			diff[accessKey(key)] = struct{}{}
+		}
+	}
+}
@@ -0,0 +1,304 @@
+package precompiles
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/ripemd160" //nolint:gosec,staticcheck // necessary for Cosmos address derivation
+)
+
+// Prefixes of the association slots in the addr precompile storage.
+const (
+	evmToSeiPrefix byte = 0x01
+	seiToEVMPrefix byte = 0x02
+)
+
+// EVMToSeiSlot is the slot of the addr precompile storage holding the Sei
+// address associated with an EVM address.
+func EVMToSeiSlot(evmAddr common.Address) common.Hash {
+	return crypto.Keccak256Hash([]byte{evmToSeiPrefix}, evmAddr.Bytes())
+}
+
+// SeiToEVMSlot is the slot of the addr precompile storage holding the EVM
+// address associated with a Sei address.
+func SeiToEVMSlot(seiAddr []byte) common.Hash {
+	return crypto.Keccak256Hash([]byte{seiToEVMPrefix}, seiAddr)
+}
+
+// Association reads the Sei address associated with evmAddr.
+func Association(st State, evmAddr common.Address) ([]byte, bool) {
+	v := st.GetState(AddrAddress, EVMToSeiSlot(evmAddr))
+	if v == (common.Hash{}) {
+		return nil, false
+	}
+	return v[common.HashLength-common.AddressLength:], true
+}
+
+// ReverseAssociation reads the EVM address associated with seiAddr.
+func ReverseAssociation(st State, seiAddr []byte) (common.Address, bool) {
+	v := st.GetState(AddrAddress, SeiToEVMSlot(seiAddr))
+	if v == (common.Hash{}) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(v[:]), true
+}
+
+// Associate records the association of seiAddr and evmAddr in both
+// directions. seiAddr must be 20 bytes long, as derived from a secp256k1 key.
+func Associate(st State, seiAddr []byte, evmAddr common.Address) {
+	st.SetState(AddrAddress, EVMToSeiSlot(evmAddr), common.BytesToHash(seiAddr))
+	st.SetState(AddrAddress, SeiToEVMSlot(seiAddr), common.BytesToHash(evmAddr.Bytes()))
+}
+
+// Addr is the native addr precompile. Associations are stored in its own
+// storage, see EVMToSeiSlot and SeiToEVMSlot.
+type Addr struct {
+	cfg Config
+	abi abi.ABI
+}
+
+func NewAddr(cfg Config) *Addr {
+	return &Addr{cfg: cfg, abi: mustABI("addr")}
+}
+
+func (p *Addr) RequiredGas(input []byte) uint64 {
+	return requiredGas(p.abi, input)
+}
+
+func (p *Addr) Run(ctx *Context, input []byte) ([]byte, error) {
+	method, args, err := unpack(p.abi, input)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getSeiAddr":
+		return p.getSeiAddr(ctx, method, args)
+	case "getEvmAddr":
+		return p.getEvmAddr(ctx, method, args)
+	case "associate":
+		if ctx.ReadOnly {
+			return nil, errors.New("cannot call associate precompile from staticcall")
+		}
+		return p.associate(ctx, method, args)
+	case "associatePubKey":
+		if ctx.ReadOnly {
+			return nil, errors.New("cannot call associate pub key precompile from staticcall")
+		}
+		return p.associatePubKey(ctx, method, args)
+	}
+	return nil, notMigrated("addr", method.Name)
+}
+
+func (p *Addr) getSeiAddr(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	evmAddr := args[0].(common.Address)
+	seiAddr, ok := Association(ctx.State, evmAddr)
+	if err := ctx.UseGas(kvReadGas(associationPrefixLen+common.AddressLength, len(seiAddr))); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("EVM address %s is not associated", evmAddr.Hex())
+	}
+	s, err := p.cfg.seiString(seiAddr)
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(s)
+}
+
+func (p *Addr) getEvmAddr(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	seiAddr, err := p.cfg.parseSeiAddress(args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	evmAddr, ok := ReverseAssociation(ctx.State, seiAddr)
+	valueLen := 0
+	if ok {
+		valueLen = common.AddressLength
+	}
+	if err := ctx.UseGas(kvReadGas(associationPrefixLen+len(seiAddr), valueLen)); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("sei address %s is not associated", args[0].(string))
+	}
+	return method.Outputs.Pack(evmAddr)
+}
+
+func (p *Addr) associate(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 4); err != nil {
+		return nil, err
+	}
+	// v, r and s are components of a signature over the custom message, from
+	// which the pubkey, and so both addresses, are recovered.
+	vBytes, err := decodeHexString(args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	rBytes, err := decodeHexString(args[1].(string))
+	if err != nil {
+		return nil, err
+	}
+	sBytes, err := decodeHexString(args[2].(string))
+	if err != nil {
+		return nil, err
+	}
+	v := new(big.Int).Add(new(big.Int).SetBytes(vBytes), big.NewInt(27))
+	if v.BitLen() > 8 {
+		return nil, ethtypes.ErrInvalidSig
+	}
+	r, s := new(big.Int).SetBytes(rBytes), new(big.Int).SetBytes(sBytes)
+	recovery := byte(v.Uint64() - 27)
+	if !crypto.ValidateSignatureValues(recovery, r, s, true) {
+		return nil, ethtypes.ErrInvalidSig
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	r.FillBytes(sig[0:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = recovery
+	hash := crypto.Keccak256Hash([]byte(args[3].(string)))
+	pubkey, err := crypto.Ecrecover(hash[:], sig)
+	if err != nil {
+		return nil, err
+	}
+	return p.associateAddresses(ctx, method, pubkey)
+}
+
+func (p *Addr) associatePubKey(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	// A compressed pubkey in hex, without the '0x' prefix.
+	pubkeyBytes, err := hex.DecodeString(args[0].(string))
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := btcec.ParsePubKey(pubkeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return p.associateAddresses(ctx, method, pubkey.SerializeUncompressed())
+}
+
+// associateAddresses associates the addresses of an uncompressed secp256k1
+// pubkey. The balance held by the Sei address before the association, which
+// the EVM-only state keeps under the address' cast EVM address, moves to the
+// EVM address, as the Cosmos precompile migrates the cast account balance.
+//
+// The lookup and the two association records are charged as the Cosmos
+// precompile charges them. The Cosmos precompile also reads and writes the
+// auth account and the bank balances it migrates, which have no counterpart
+// in the EVM-only state, so its gas is a lower bound of the Cosmos gas.
+func (p *Addr) associateAddresses(ctx *Context, method *abi.Method, pubkey []byte) ([]byte, error) {
+	evmAddr, seiAddr, err := pubkeyAddresses(pubkey)
+	if err != nil {
+		return nil, err
+	}
+	seiString, err := p.cfg.seiString(seiAddr)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := ReverseAssociation(ctx.State, seiAddr); ok {
+		return nil, fmt.Errorf("address %s is already associated with evm address %s", seiString, evmAddr)
+	}
+	gas := kvReadGas(associationPrefixLen+len(seiAddr), 0) +
+		kvWriteGas(associationPrefixLen+common.AddressLength, len(seiAddr)) +
+		kvWriteGas(associationPrefixLen+len(seiAddr), common.AddressLength)
+	if err := ctx.UseGas(gas); err != nil {
+		return nil, err
+	}
+	Associate(ctx.State, seiAddr, evmAddr)
+	castAddr := common.BytesToAddress(seiAddr)
+	if balance := ctx.State.GetBalance(castAddr); balance.Sign() > 0 {
+		if err := ctx.State.SubBalance(castAddr, balance); err != nil {
+			return nil, err
+		}
+		if err := ctx.State.AddBalance(evmAddr, balance); err != nil {
+			return nil, err
+		}
+	}
+	return method.Outputs.Pack(seiString, evmAddr)
+}
+
+// pubkeyAddresses derives the EVM address and the Sei address of an
+// uncompressed secp256k1 pubkey.
+func pubkeyAddresses(pubkey []byte) (common.Address, []byte, error) {
+	if len(pubkey) == 0 || pubkey[0] != 4 {
+		return common.Address{}, nil, errors.New("invalid public key")
+	}
+	key, err := btcec.ParsePubKey(pubkey)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	evmAddr := common.BytesToAddress(crypto.Keccak256(pubkey[1:])[12:])
+	sha := sha256.Sum256(key.SerializeCompressed())
+	hasher := ripemd160.New() //nolint:gosec // required by the Cosmos address derivation
+	hasher.Write(sha[:])
+	return evmAddr, hasher.Sum(nil), nil
+}
+
+// parseSeiAddress decodes a bech32 Sei account address.
+func (c Config) parseSeiAddress(s string) ([]byte, error) {
+	if len(strings.TrimSpace(s)) == 0 {
+		return nil, errors.New("empty address string is not allowed")
+	}
+	hrp, data, err := bech32.Decode(s, 1023)
+	if err != nil {
+		return nil, fmt.Errorf("decoding bech32 failed: %w", err)
+	}
+	if hrp != c.Bech32Prefix {
+		return nil, fmt.Errorf("invalid Bech32 prefix; expected %s, got %s", c.Bech32Prefix, hrp)
+	}
+	addr, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("decoding bech32 failed: %w", err)
+	}
+	if len(addr) == 0 || len(addr) > 255 {
+		return nil, fmt.Errorf("invalid address length %d", len(addr))
+	}
+	return addr, nil
+}
+
+// seiString encodes a Sei account address in bech32.
+func (c Config) seiString(addr []byte) (string, error) {
+	data, err := bech32.ConvertBits(addr, 8, 5, true)
+	if err != nil {
+		return "", fmt.Errorf("encoding bech32 failed: %w", err)
+	}
+	return bech32.Encode(c.Bech32Prefix, data)
+}
+
+func decodeHexString(s string) ([]byte, error) {
+	trimmed := strings.TrimPrefix(s, "0x")
+	if len(trimmed)%2 != 0 {
+		trimmed = "0" + trimmed
+	}
+	return hex.DecodeString(trimmed)
+}
@@ -0,0 +1,148 @@
+package precompiles
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// weiPerBaseDenom converts EVM balances (wei) to the base denom: 1usei is
+// 10^12wei.
+var weiPerBaseDenom = big.NewInt(1_000_000_000_000)
+
+// Bank is the native bank precompile. The base denom balance of an account is
+// its EVM balance, so balance and sendNative of the base denom run natively.
+// Other denoms and the supply and metadata queries are module state which has
+// not been migrated yet.
+type Bank struct {
+	cfg Config
+	abi abi.ABI
+}
+
+func NewBank(cfg Config) *Bank {
+	return &Bank{cfg: cfg, abi: mustABI("bank")}
+}
+
+func (p *Bank) RequiredGas(input []byte) uint64 {
+	return requiredGas(p.abi, input)
+}
+
+func (p *Bank) Run(ctx *Context, input []byte) ([]byte, error) {
+	method, args, err := unpack(p.abi, input)
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "sendNative":
+		return p.sendNative(ctx, method, args)
+	case "balance":
+		return p.balance(ctx, method, args)
+	case "decimals":
+		if err := validateNonPayable(ctx.ApparentValue); err != nil {
+			return nil, err
+		}
+		// All native tokens are integer-based: the decimals of the base denom.
+		return method.Outputs.Pack(uint8(0))
+	}
+	return nil, notMigrated("bank", method.Name)
+}
+
+// sendNative charges the association lookups and the two balance records as
+// the Cosmos precompile charges them. The Cosmos precompile also reads the
+// accounts, the locked coins and the wei balances, which have no counterpart
+// in the EVM-only state, so its gas is a lower bound of the Cosmos gas.
+func (p *Bank) sendNative(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if ctx.ReadOnly {
+		return nil, errors.New("cannot call sendNative from staticcall")
+	}
+	if ctx.DelegateCall {
+		return nil, errors.New("cannot delegatecall sendNative")
+	}
+	if err := validateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	value := ctx.ApparentValue
+	if value == nil || value.Sign() == 0 {
+		return nil, errors.New("set `value` field to non-zero to send")
+	}
+	senderAddr, ok := Association(ctx.State, ctx.Caller)
+	if !ok {
+		return nil, errors.New("invalid addr")
+	}
+	// The caller's association, and the precompile's, which is never set.
+	gas := kvReadGas(associationPrefixLen+common.AddressLength, len(senderAddr)) +
+		kvReadGas(associationPrefixLen+common.AddressLength, 0)
+	if err := ctx.UseGas(gas); err != nil {
+		return nil, err
+	}
+	receiver, _ := args[0].(string)
+	if receiver == "" {
+		return nil, errors.New("invalid addr")
+	}
+	seiAddr, err := p.cfg.parseSeiAddress(receiver)
+	if err != nil {
+		return nil, err
+	}
+	receiverAddr, ok := ReverseAssociation(ctx.State, seiAddr)
+	if !ok {
+		if len(seiAddr) != common.AddressLength {
+			// E.g. module accounts, which have no EVM address.
+			return nil, notMigrated("bank", "sendNative to a non-EVM account")
+		}
+		receiverAddr = common.BytesToAddress(seiAddr)
+	}
+	// The call already moved value to the precompile; forward it.
+	if err := ctx.State.SubBalance(ctx.Address, value); err != nil {
+		return nil, err
+	}
+	if err := ctx.State.AddBalance(receiverAddr, value); err != nil {
+		return nil, err
+	}
+	gas = balanceWriteGas(ctx.Address.Bytes(), p.cfg.BaseDenom, baseDenomBalance(ctx.State, ctx.Address)) +
+		balanceWriteGas(seiAddr, p.cfg.BaseDenom, baseDenomBalance(ctx.State, receiverAddr))
+	if err := ctx.UseGas(gas); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(true)
+}
+
+func (p *Bank) balance(ctx *Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	addr := args[0].(common.Address)
+	if addr == (common.Address{}) {
+		return nil, errors.New("invalid addr")
+	}
+	denom := args[1].(string)
+	if denom == "" {
+		return nil, errors.New("invalid denom")
+	}
+	if denom != p.cfg.BaseDenom {
+		return nil, notMigrated("bank", "balance of "+denom)
+	}
+	// Associated or not, the EVM address holds the account's balance, which
+	// the Cosmos precompile reads from the Sei address, or the cast address.
+	seiAddr, ok := Association(ctx.State, addr)
+	gas := kvReadGas(associationPrefixLen+common.AddressLength, len(seiAddr))
+	if !ok {
+		seiAddr = addr.Bytes()
+	}
+	balance := baseDenomBalance(ctx.State, addr)
+	gas += kvReadGas(balanceRecord(seiAddr, denom, balance))
+	if err := ctx.UseGas(gas); err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(balance)
+}
+
+// baseDenomBalance is the balance of addr in the base denom, less the wei
+// remainder.
+func baseDenomBalance(st State, addr common.Address) *big.Int {
+	return new(big.Int).Quo(st.GetBalance(addr), weiPerBaseDenom)
+}
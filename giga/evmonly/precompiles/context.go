@@ -6,17 +6,25 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 )
 
+// ErrCustomPrecompilesOpen is returned for calls into custom precompiles, or
+// precompile methods, that have not been migrated to the EVM-only path.
 var ErrCustomPrecompilesOpen = errors.New("evm-only custom precompiles are not implemented")
 
-// Registry resolves native custom precompiles for the EVM-only path.
+// Registry resolves native custom precompiles for the EVM-only path. Get may
+// return a nil Contract for a registered address whose precompile has not been
+// migrated; calls to it fail with ErrCustomPrecompilesOpen.
 type Registry interface {
 	Get(common.Address) (Contract, bool)
 	Addresses() []common.Address
 }
 
-// Contract is the sdk.Context-free custom precompile interface.
+// Contract is the sdk.Context-free custom precompile interface. RequiredGas is
+// charged before Run, and Run charges the rest through Context.UseGas. A Run
+// error reverts the call and consumes all its gas, like a failure of the
+// Cosmos-backed precompiles.
 type Contract interface {
 	RequiredGas(input []byte) uint64
 	Run(*Context, []byte) ([]byte, error)
@@ -36,6 +44,17 @@ type Context struct {
 	Logs          LogSink
 }
 
+// UseGas charges gas to the call, failing with vm.ErrOutOfGas once
+// GasRemaining runs out.
+func (c *Context) UseGas(gas uint64) error {
+	if c.GasRemaining < gas {
+		c.GasRemaining = 0
+		return vm.ErrOutOfGas
+	}
+	c.GasRemaining -= gas
+	return nil
+}
+
 // BlockContext is the block data custom precompiles may read.
 type BlockContext struct {
 	Number      uint64
@@ -48,18 +67,18 @@ type BlockContext struct {
 }
 
 // State is the precompile-facing state API. Implementations must make these
-// reads and writes visible to the executor's conflict tracking.
+// reads and writes visible to the executor's conflict tracking. Precompiles
+// keep their migrated module state as storage owned by their own address, so
+// there is deliberately no side state outside balance, nonce, code and storage.
 type State interface {
 	GetBalance(common.Address) *big.Int
-	AddBalance(common.Address, *big.Int)
+	AddBalance(common.Address, *big.Int) error
 	SubBalance(common.Address, *big.Int) error
 	GetNonce(common.Address) uint64
 	SetNonce(common.Address, uint64)
 	GetCode(common.Address) []byte
 	GetState(common.Address, common.Hash) common.Hash
 	SetState(common.Address, common.Hash, common.Hash)
-	GetCustom([]byte) ([]byte, bool)
-	SetCustom([]byte, []byte)
 }
 
 // LogSink lets custom precompiles emit Ethereum logs without Cosmos events.
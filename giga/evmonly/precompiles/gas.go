@@ -0,0 +1,133 @@
+package precompiles
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// The Cosmos-backed precompiles are charged through the Cosmos KV gas meter
+// (store/types.KVGasConfig) for decoding their calldata and for every store
+// access. The native precompiles charge the same amounts for the calldata and
+// for the Cosmos records their state stands in for, so that a call costs the
+// same gas on both paths, with the Cosmos gas multiplier and the EVM priority
+// normalizer, which convert Cosmos gas to EVM gas, both at 1.
+const (
+	kvDeleteCost       = 1000
+	kvReadCostFlat     = 1000
+	kvReadCostPerByte  = 3
+	kvWriteCostFlat    = 2000
+	kvWriteCostPerByte = 30
+)
+
+// kvReadGas is the Cosmos gas of reading a record.
+func kvReadGas(keyLen, valueLen int) uint64 {
+	return kvReadCostFlat + kvReadCostPerByte*uint64(keyLen+valueLen) //nolint:gosec // lengths are non-negative.
+}
+
+// kvWriteGas is the Cosmos gas of writing a record.
+func kvWriteGas(keyLen, valueLen int) uint64 {
+	return kvWriteCostFlat + kvWriteCostPerByte*uint64(keyLen+valueLen) //nolint:gosec // lengths are non-negative.
+}
+
+// Cosmos records the native state stands in for.
+const (
+	// An x/evm association record is keyed by a 1-byte prefix and the address
+	// on one side, and holds the address on the other.
+	associationPrefixLen = 1
+	// An x/bank balance record is keyed by a 1-byte prefix, the
+	// length-prefixed address and the denom, and holds the sdk.Coin.
+	balancePrefixLen = 1
+)
+
+// balanceRecord returns the key and value lengths of the x/bank record of the
+// balance of addr. Zero balances are not stored.
+func balanceRecord(addr []byte, denom string, amount *big.Int) (int, int) {
+	key := balancePrefixLen + 1 + len(addr) + len(denom)
+	if amount.Sign() == 0 {
+		return key, 0
+	}
+	return key, protoBytesFieldLen(len(denom)) + protoBytesFieldLen(len(amount.String()))
+}
+
+// balanceWriteGas is the Cosmos gas of setting the balance of addr, which
+// deletes the record of a zero balance.
+func balanceWriteGas(addr []byte, denom string, amount *big.Int) uint64 {
+	if amount.Sign() == 0 {
+		return kvDeleteCost
+	}
+	return kvWriteGas(balanceRecord(addr, denom, amount))
+}
+
+// protoBytesFieldLen is the encoded length of a protobuf bytes field of n bytes.
+func protoBytesFieldLen(n int) int {
+	l := 1
+	for v := n; v >= 0x80; v >>= 7 {
+		l++
+	}
+	return 1 + l + n
+}
+
+// scanGas is the read-priced pass over the whole input, which the Cosmos
+// precompiles charge before decoding it.
+func scanGas(input []byte) uint64 {
+	return kvReadCostFlat + kvReadCostPerByte*uint64(len(input))
+}
+
+// decodeGas is the gas the Cosmos precompiles charge for decoding input for
+// method: the pass over the input, plus the string bytes the ABI decoder
+// copies, at the read price per byte. The ABIs served here only take scalar,
+// bytes and string arguments, so only top-level strings are walked. ok is
+// false for input the decoder would reject, and for array and tuple arguments.
+func decodeGas(method *abi.Method, input []byte) (uint64, bool) {
+	gas := scanGas(input)
+	if len(input) < 4 {
+		return gas, true
+	}
+	data := input[4:]
+	for i, arg := range method.Inputs {
+		switch arg.Type.T {
+		case abi.StringTy:
+			length, ok := abiStringLength(data, i*32)
+			if !ok {
+				return gas, false
+			}
+			gas += kvReadCostPerByte * uint64(length) //nolint:gosec // bounded by len(data).
+		case abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			return gas, false
+		}
+	}
+	return gas, true
+}
+
+// requiredGas is the decode gas of input, charged before Run. Input which
+// does not decode is charged the pass over it, and fails in Run.
+func requiredGas(a abi.ABI, input []byte) uint64 {
+	method, ok := methodByInput(a, input)
+	if !ok {
+		return scanGas(input)
+	}
+	gas, _ := decodeGas(method, input)
+	return gas
+}
+
+// abiStringLength reads the length of the string whose offset is the word at
+// index, with the bounds checks of go-ethereum's abi.lengthPrefixPointsTo.
+func abiStringLength(data []byte, index int) (int, bool) {
+	if index+32 > len(data) {
+		return 0, false
+	}
+	dataLen := big.NewInt(int64(len(data)))
+	offsetEnd := new(big.Int).SetBytes(data[index : index+32])
+	offsetEnd.Add(offsetEnd, big.NewInt(32))
+	if offsetEnd.Cmp(dataLen) > 0 || offsetEnd.BitLen() > 63 {
+		return 0, false
+	}
+	end := int(offsetEnd.Uint64()) //nolint:gosec // at most len(data), checked above.
+	length := new(big.Int).SetBytes(data[end-32 : end])
+	total := new(big.Int).Add(offsetEnd, length)
+	if total.BitLen() > 63 || total.Cmp(dataLen) > 0 {
+		return 0, false
+	}
+	return int(length.Uint64()), true //nolint:gosec // at most len(data), checked above.
+}
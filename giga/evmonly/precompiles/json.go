@@ -0,0 +1,127 @@
+package precompiles
+
+import (
+	gjson "encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// jsonGasPerByte matches the per-byte parse cost of the Cosmos json precompile.
+const jsonGasPerByte = 100
+
+// JSON is the native json precompile. It is stateless.
+type JSON struct {
+	abi abi.ABI
+}
+
+func NewJSON() *JSON {
+	return &JSON{abi: mustABI("json")}
+}
+
+func (p *JSON) RequiredGas(input []byte) uint64 {
+	return requiredGas(p.abi, input)
+}
+
+func (p *JSON) Run(ctx *Context, input []byte) ([]byte, error) {
+	method, args, err := unpack(p.abi, input)
+	if err != nil {
+		return nil, err
+	}
+	// Parsing is charged on the JSON payload, which every method takes first.
+	if payload, ok := args[0].([]byte); ok {
+		if err := ctx.UseGas(jsonGasPerByte * uint64(len(payload))); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 2); err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "extractAsBytes":
+		result, err := jsonValue(args)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack([]byte(unquote(result)))
+	case "extractAsBytesList":
+		result, err := jsonValue(args)
+		if err != nil {
+			return nil, err
+		}
+		var list []gjson.RawMessage
+		if err := gjson.Unmarshal(result, &list); err != nil {
+			return nil, err
+		}
+		out := make([][]byte, len(list))
+		for i, r := range list {
+			out[i] = []byte(r)
+		}
+		return method.Outputs.Pack(out)
+	case "extractAsUint256":
+		result, err := jsonValue(args)
+		if err != nil {
+			return nil, err
+		}
+		str := strings.Trim(string(result), "\"")
+		if len(str) > 100 {
+			return nil, fmt.Errorf("value string too long: got %d, max 100", len(str))
+		}
+		v, ok := new(big.Int).SetString(str, 10)
+		if !ok {
+			return nil, fmt.Errorf("failed to convert %s to big.Int", str)
+		}
+		if v.BitLen() > 256 {
+			return nil, errors.New("value does not fit in 32 bytes")
+		}
+		out := make([]byte, 32)
+		v.FillBytes(out)
+		return out, nil
+	case "extractAsBytesFromArray":
+		var list []gjson.RawMessage
+		if err := gjson.Unmarshal(args[0].([]byte), &list); err != nil {
+			return nil, err
+		}
+		if len(list) > 1<<16 {
+			return nil, errors.New("input array is larger than 2^16")
+		}
+		index, ok := args[1].(uint16)
+		if !ok {
+			return nil, errors.New("index must be uint16")
+		}
+		if int(index) >= len(list) {
+			return nil, fmt.Errorf("index %d is out of bounds", index)
+		}
+		return method.Outputs.Pack([]byte(unquote(list[index])))
+	}
+	return nil, notMigrated("json", method.Name)
+}
+
+// jsonValue returns the raw value under the key args[1] of the JSON object
+// args[0].
+func jsonValue(args []interface{}) (gjson.RawMessage, error) {
+	decoded := map[string]gjson.RawMessage{}
+	if err := gjson.Unmarshal(args[0].([]byte), &decoded); err != nil {
+		return nil, err
+	}
+	key := args[1].(string)
+	result, ok := decoded[key]
+	if !ok {
+		return nil, fmt.Errorf("input does not contain key %s", key)
+	}
+	return result, nil
+}
+
+// unquote strips the quotes of a string value.
+func unquote(r gjson.RawMessage) gjson.RawMessage {
+	if len(r) >= 2 && r[0] == '"' && r[len(r)-1] == '"' {
+		return r[1 : len(r)-1]
+	}
+	return r
+}
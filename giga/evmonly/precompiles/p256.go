@@ -0,0 +1,69 @@
+package precompiles
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// p256VerifyGas matches RIP-7212's P256VERIFY precompile.
+	p256VerifyGas         = 3450
+	p256VerifyInputLength = 160
+)
+
+// P256 is the native p256 (secp256r1 signature verification) precompile. It
+// is stateless.
+type P256 struct {
+	abi abi.ABI
+}
+
+func NewP256() *P256 {
+	return &P256{abi: mustABI("p256")}
+}
+
+func (p *P256) RequiredGas(input []byte) uint64 {
+	return requiredGas(p.abi, input)
+}
+
+// Run implements https://github.com/ethereum/RIPs/blob/master/RIPS/rip-7212.md:
+// a valid signature returns a 32-byte 1, an invalid one returns no data.
+func (p *P256) Run(ctx *Context, input []byte) ([]byte, error) {
+	method, args, err := unpack(p.abi, input)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateNonPayable(ctx.ApparentValue); err != nil {
+		return nil, err
+	}
+	if ctx.DelegateCall {
+		return nil, errors.New("cannot delegatecall P256Verify")
+	}
+	if method.Name != "verify" {
+		return nil, notMigrated("p256", method.Name)
+	}
+	if err := ctx.UseGas(p256VerifyGas); err != nil {
+		return nil, err
+	}
+	if err := validateArgsLength(args, 1); err != nil {
+		return nil, err
+	}
+	in := args[0].([]byte)
+	if len(in) != p256VerifyInputLength {
+		return nil, errors.New("invalid input length")
+	}
+	hash := in[0:32]
+	r, s := new(big.Int).SetBytes(in[32:64]), new(big.Int).SetBytes(in[64:96])
+	x, y := new(big.Int).SetBytes(in[96:128]), new(big.Int).SetBytes(in[128:160])
+	if !elliptic.P256().IsOnCurve(x, y) {
+		return nil, nil
+	}
+	if !ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, hash, r, s) {
+		return nil, nil
+	}
+	return method.Outputs.Pack(common.LeftPadBytes([]byte{1}, 32))
+}
@@ -0,0 +1,357 @@
+package precompiles
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	cosmosaddr "github.com/sei-protocol/sei-chain/precompiles/addr"
+	cosmosbank "github.com/sei-protocol/sei-chain/precompiles/bank"
+	pcommon "github.com/sei-protocol/sei-chain/precompiles/common"
+	cosmosjson "github.com/sei-protocol/sei-chain/precompiles/json"
+	cosmosp256 "github.com/sei-protocol/sei-chain/precompiles/p256"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	tmtypes "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/state"
+)
+
+// The parity tests run the same calls through a Cosmos-backed precompile and
+// its native counterpart, set up with equivalent state, and compare the
+// returned data, whether the call failed and the gas used. The test app
+// converts Cosmos gas to EVM gas at 1.
+
+// parityGas is the gas supplied to every call.
+const parityGas = 10_000_000
+
+type parityCall struct {
+	caller       common.Address
+	value        *big.Int
+	readOnly     bool
+	delegateCall bool
+	// gasLowerBound is set for calls which the Cosmos precompile charges for
+	// Cosmos-only bookkeeping, which the native gas is a lower bound of.
+	gasLowerBound bool
+}
+
+// parityEnv holds both sides of a parity test.
+type parityEnv struct {
+	t       *testing.T
+	stateDB *state.DBImpl
+	evm     *vm.EVM
+	native  *memState
+}
+
+func newParityEnv(t *testing.T) *parityEnv {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.NewContext(false, tmtypes.Header{}).WithBlockHeight(2)
+	stateDB := state.NewDBImpl(ctx, &testApp.EvmKeeper, true)
+	return &parityEnv{
+		t:       t,
+		stateDB: stateDB,
+		evm:     &vm.EVM{StateDB: stateDB},
+		native:  newMemState(),
+	}
+}
+
+// addBalance credits addr on both sides.
+func (e *parityEnv) addBalance(addr common.Address, amount *big.Int) {
+	e.stateDB.AddBalance(addr, uint256.MustFromBig(amount), tracing.BalanceChangeUnspecified)
+	require.NoError(e.t, e.native.AddBalance(addr, amount))
+}
+
+// requireBalance checks that addr has the same balance on both sides.
+func (e *parityEnv) requireBalance(addr common.Address, want *big.Int) {
+	require.Equal(e.t, want.String(), e.stateDB.GetBalance(addr).ToBig().String())
+	require.Equal(e.t, want.String(), e.native.GetBalance(addr).String())
+}
+
+// associate associates the addresses of key on both sides.
+func (e *parityEnv) associate(key *ecdsa.PrivateKey) (common.Address, []byte) {
+	evmAddr, seiAddr, err := pubkeyAddresses(crypto.FromECDSAPub(&key.PublicKey))
+	require.NoError(e.t, err)
+	testkeeper.EVMTestApp.EvmKeeper.SetAddressMapping(e.stateDB.Ctx(), sdk.AccAddress(seiAddr), evmAddr)
+	Associate(e.native, seiAddr, evmAddr)
+	return evmAddr, seiAddr
+}
+
+// requireParity runs input through both precompiles and checks that they
+// agree. It returns the native output.
+func (e *parityEnv) requireParity(cosmos *pcommon.DynamicGasPrecompile, native Contract, input []byte, call parityCall) []byte {
+	e.t.Helper()
+	if call.value != nil && call.value.Sign() > 0 {
+		// Mirror the value transfer into the precompile done by the EVM call.
+		e.addBalance(cosmos.Address(), call.value)
+	}
+	want, wantRemaining, wantErr := cosmos.RunAndCalculateGas(e.evm, call.caller, call.caller, input, parityGas, call.value, nil, call.readOnly, call.delegateCall)
+	ctx := &Context{
+		Caller:        call.caller,
+		Address:       cosmos.Address(),
+		ApparentValue: call.value,
+		ReadOnly:      call.readOnly,
+		DelegateCall:  call.delegateCall,
+		GasRemaining:  parityGas - native.RequiredGas(input),
+		State:         e.native,
+	}
+	got, gotErr := native.Run(ctx, input)
+	require.Equal(e.t, wantErr == nil, gotErr == nil, "cosmos error: %v, native error: %v", wantErr, gotErr)
+	require.Equal(e.t, want, got)
+	// Failures consume all the gas on both sides.
+	gotRemaining := ctx.GasRemaining
+	if gotErr != nil {
+		gotRemaining = 0
+	}
+	wantGas, gotGas := parityGas-wantRemaining, parityGas-gotRemaining
+	if call.gasLowerBound {
+		require.LessOrEqual(e.t, gotGas, wantGas)
+	} else {
+		require.Equal(e.t, wantGas, gotGas)
+	}
+	return got
+}
+
+func pack(t *testing.T, p *pcommon.DynamicGasPrecompile, method string, args ...interface{}) []byte {
+	t.Helper()
+	input, err := p.ABI.Pack(method, args...)
+	require.NoError(t, err)
+	return input
+}
+
+func TestABIsMatchCosmos(t *testing.T) {
+	for _, name := range []string{"addr", "bank", "json", "p256"} {
+		want, err := os.ReadFile("../../../precompiles/" + name + "/abi.json")
+		require.NoError(t, err)
+		got, err := abiFiles.ReadFile("abi/" + name + ".json")
+		require.NoError(t, err)
+		require.JSONEq(t, string(want), string(got), name)
+	}
+}
+
+func TestJSONParity(t *testing.T) {
+	env := newParityEnv(t)
+	cosmos, err := cosmosjson.NewPrecompile(testkeeper.EVMTestApp.GetPrecompileKeepers())
+	require.NoError(t, err)
+	native := NewJSON()
+	call := parityCall{}
+	for _, tc := range []struct {
+		method string
+		args   []interface{}
+	}{
+		{"extractAsBytes", []interface{}{[]byte(`{"key":1}`), "key"}},
+		{"extractAsBytes", []interface{}{[]byte(`{"key":"1"}`), "key"}},
+		{"extractAsBytes", []interface{}{[]byte(`{"key":[1,2,3]}`), "key"}},
+		{"extractAsBytes", []interface{}{[]byte(`{"key":1}`), "missing"}},
+		{"extractAsBytes", []interface{}{[]byte(`not json`), "key"}},
+		{"extractAsBytesList", []interface{}{[]byte(`{"key":[],"key2":1}`), "key"}},
+		{"extractAsBytesList", []interface{}{[]byte(`{"key":["1", "2"]}`), "key"}},
+		{"extractAsBytesList", []interface{}{[]byte(`{"key":[{"nested":1}]}`), "key"}},
+		{"extractAsBytesList", []interface{}{[]byte(`{"key":1}`), "key"}},
+		{"extractAsUint256", []interface{}{[]byte(`{"key":"12345"}`), "key"}},
+		{"extractAsUint256", []interface{}{[]byte(`{"key":12345}`), "key"}},
+		{"extractAsUint256", []interface{}{[]byte(`{"key":"115792089237316195423570985008687907853269984665640564039457584007913129639936"}`), "key"}},
+		{"extractAsUint256", []interface{}{[]byte(`{"key":"abc"}`), "key"}},
+		{"extractAsBytesFromArray", []interface{}{[]byte(`[1,"a",{"b":2}]`), uint16(1)}},
+		{"extractAsBytesFromArray", []interface{}{[]byte(`[1,"a",{"b":2}]`), uint16(2)}},
+		{"extractAsBytesFromArray", []interface{}{[]byte(`[1]`), uint16(1)}},
+		{"extractAsBytesFromArray", []interface{}{[]byte(`{}`), uint16(0)}},
+	} {
+		env.requireParity(cosmos, native, pack(t, cosmos, tc.method, tc.args...), call)
+	}
+	// Non-payable.
+	env.requireParity(cosmos, native, pack(t, cosmos, "extractAsBytes", []byte(`{"key":1}`), "key"), parityCall{value: big.NewInt(1)})
+	// Unknown selector and truncated input.
+	env.requireParity(cosmos, native, []byte{1, 2, 3, 4}, call)
+	env.requireParity(cosmos, native, []byte{1}, call)
+}
+
+func TestP256Parity(t *testing.T) {
+	env := newParityEnv(t)
+	cosmos, err := cosmosp256.NewPrecompile(testkeeper.EVMTestApp.GetPrecompileKeepers())
+	require.NoError(t, err)
+	native := NewP256()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("hello"))
+	r, s, err := ecdsa.Sign(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	in := make([]byte, p256VerifyInputLength)
+	copy(in[0:32], hash[:])
+	r.FillBytes(in[32:64])
+	s.FillBytes(in[64:96])
+	key.X.FillBytes(in[96:128])
+	key.Y.FillBytes(in[128:160])
+	wrongHash := append([]byte{}, in...)
+	wrongHash[0] ^= 1
+	offCurve := append([]byte{}, in...)
+	offCurve[159] ^= 1
+
+	call := parityCall{}
+	got := env.requireParity(cosmos, native, pack(t, cosmos, "verify", in), call)
+	require.NotEmpty(t, got)
+	require.Empty(t, env.requireParity(cosmos, native, pack(t, cosmos, "verify", wrongHash), call))
+	require.Empty(t, env.requireParity(cosmos, native, pack(t, cosmos, "verify", offCurve), call))
+	env.requireParity(cosmos, native, pack(t, cosmos, "verify", in[:100]), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "verify", in), parityCall{value: big.NewInt(1)})
+	env.requireParity(cosmos, native, pack(t, cosmos, "verify", in), parityCall{delegateCall: true})
+}
+
+func TestAddrParity(t *testing.T) {
+	env := newParityEnv(t)
+	cosmos, err := cosmosaddr.NewPrecompile(testkeeper.EVMTestApp.GetPrecompileKeepers())
+	require.NoError(t, err)
+	native := NewAddr(DefaultConfig())
+	cfg := DefaultConfig()
+	call := parityCall{caller: common.HexToAddress("0x1234")}
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddr, seiAddr, err := pubkeyAddresses(crypto.FromECDSAPub(&key.PublicKey))
+	require.NoError(t, err)
+	seiString, err := cfg.seiString(seiAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(seiAddr).String(), seiString)
+	// Funds sent to the Sei address before the association.
+	castAddr := common.BytesToAddress(seiAddr)
+	env.addBalance(castAddr, big.NewInt(7_000_000_000_000))
+
+	// Not associated yet.
+	env.requireParity(cosmos, native, pack(t, cosmos, "getSeiAddr", evmAddr), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "getEvmAddr", seiString), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "getEvmAddr", "invalid"), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "getEvmAddr", ""), call)
+
+	pubKeyHex := hex.EncodeToString(crypto.CompressPubkey(&key.PublicKey))
+	env.requireParity(cosmos, native, pack(t, cosmos, "associatePubKey", pubKeyHex), parityCall{caller: call.caller, readOnly: true})
+	env.requireParity(cosmos, native, pack(t, cosmos, "associatePubKey", "zz"), call)
+	require.NotEmpty(t, env.requireParity(cosmos, native, pack(t, cosmos, "associatePubKey", pubKeyHex), parityCall{caller: call.caller, gasLowerBound: true}))
+	env.requireParity(cosmos, native, pack(t, cosmos, "associatePubKey", pubKeyHex), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "getSeiAddr", evmAddr), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "getEvmAddr", seiString), call)
+	env.requireBalance(evmAddr, big.NewInt(7_000_000_000_000))
+
+	// Association through a signature over a custom message.
+	key2, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	evmAddr2, _, err := pubkeyAddresses(crypto.FromECDSAPub(&key2.PublicKey))
+	require.NoError(t, err)
+	msg := "associate me"
+	sig, err := crypto.Sign(crypto.Keccak256([]byte(msg)), key2)
+	require.NoError(t, err)
+	v, r, s := hex.EncodeToString(sig[64:]), "0x"+hex.EncodeToString(sig[:32]), hex.EncodeToString(sig[32:64])
+	env.requireParity(cosmos, native, pack(t, cosmos, "associate", v, r, s, msg), parityCall{caller: call.caller, value: big.NewInt(1)})
+	env.requireParity(cosmos, native, pack(t, cosmos, "associate", "0x05", r, s, msg), call)
+	require.NotEmpty(t, env.requireParity(cosmos, native, pack(t, cosmos, "associate", v, r, s, msg), parityCall{caller: call.caller, gasLowerBound: true}))
+	env.requireParity(cosmos, native, pack(t, cosmos, "getSeiAddr", evmAddr2), call)
+}
+
+func TestBankParity(t *testing.T) {
+	env := newParityEnv(t)
+	cosmos, err := cosmosbank.NewPrecompile(testkeeper.EVMTestApp.GetPrecompileKeepers())
+	require.NoError(t, err)
+	native := NewBank(DefaultConfig())
+	cfg := DefaultConfig()
+
+	senderKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender, _ := env.associate(senderKey)
+	env.addBalance(sender, big.NewInt(50_000_000_000_000))
+	receiverKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	receiver, receiverSei := env.associate(receiverKey)
+	receiverString, err := cfg.seiString(receiverSei)
+	require.NoError(t, err)
+	// An account known only by its Sei address.
+	castSei := common.HexToAddress("0xabcdef").Bytes()
+	castString, err := cfg.seiString(castSei)
+	require.NoError(t, err)
+	call := parityCall{caller: sender}
+
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", sender, "usei"), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", receiver, "usei"), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", common.Address{}, "usei"), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", sender, ""), call)
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", sender, "usei"), parityCall{caller: sender, value: big.NewInt(1)})
+	env.requireParity(cosmos, native, pack(t, cosmos, "decimals", "usei"), call)
+
+	// 10usei and 100wei.
+	value := big.NewInt(10_000_000_000_100)
+	send := func(receiver string) []byte { return pack(t, cosmos, "sendNative", receiver) }
+	env.requireParity(cosmos, native, send(receiverString), parityCall{caller: sender})
+	env.requireParity(cosmos, native, send(receiverString), parityCall{caller: sender, value: value, readOnly: true})
+	env.requireParity(cosmos, native, send(receiverString), parityCall{caller: sender, value: value, delegateCall: true})
+	env.requireParity(cosmos, native, send(""), parityCall{caller: sender, value: value})
+	env.requireParity(cosmos, native, send("invalid"), parityCall{caller: sender, value: value})
+	env.requireParity(cosmos, native, send(receiverString), parityCall{caller: common.HexToAddress("0x5678"), value: value})
+	require.NotEmpty(t, env.requireParity(cosmos, native, send(receiverString), parityCall{caller: sender, value: value, gasLowerBound: true}))
+	env.requireBalance(receiver, value)
+	require.NotEmpty(t, env.requireParity(cosmos, native, send(castString), parityCall{caller: sender, value: value, gasLowerBound: true}))
+	env.requireBalance(common.BytesToAddress(castSei), value)
+	env.requireParity(cosmos, native, pack(t, cosmos, "balance", receiver, "usei"), call)
+}
+
+// memState is an in-memory State.
+type memState struct {
+	balances map[common.Address]*big.Int
+	nonces   map[common.Address]uint64
+	code     map[common.Address][]byte
+	storage  map[common.Address]map[common.Hash]common.Hash
+}
+
+func newMemState() *memState {
+	return &memState{
+		balances: map[common.Address]*big.Int{},
+		nonces:   map[common.Address]uint64{},
+		code:     map[common.Address][]byte{},
+		storage:  map[common.Address]map[common.Hash]common.Hash{},
+	}
+}
+
+func (s *memState) GetBalance(addr common.Address) *big.Int {
+	if b, ok := s.balances[addr]; ok {
+		return new(big.Int).Set(b)
+	}
+	return new(big.Int)
+}
+
+func (s *memState) AddBalance(addr common.Address, amount *big.Int) error {
+	s.balances[addr] = new(big.Int).Add(s.GetBalance(addr), amount)
+	return nil
+}
+
+func (s *memState) SubBalance(addr common.Address, amount *big.Int) error {
+	b := s.GetBalance(addr)
+	if b.Cmp(amount) < 0 {
+		return errors.New("insufficient balance")
+	}
+	s.balances[addr] = b.Sub(b, amount)
+	return nil
+}
+
+func (s *memState) GetNonce(addr common.Address) uint64        { return s.nonces[addr] }
+func (s *memState) SetNonce(addr common.Address, nonce uint64) { s.nonces[addr] = nonce }
+func (s *memState) GetCode(addr common.Address) []byte         { return s.code[addr] }
+
+func (s *memState) GetState(addr common.Address, key common.Hash) common.Hash {
+	return s.storage[addr][key]
+}
+
+func (s *memState) SetState(addr common.Address, key common.Hash, value common.Hash) {
+	if s.storage[addr] == nil {
+		s.storage[addr] = map[common.Hash]common.Hash{}
+	}
+	s.storage[addr][key] = value
+}
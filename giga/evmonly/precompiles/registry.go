@@ -0,0 +1,177 @@
+package precompiles
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Addresses of the Sei custom precompiles.
+var (
+	BankAddress        = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	WasmdAddress       = common.HexToAddress("0x0000000000000000000000000000000000001002")
+	JSONAddress        = common.HexToAddress("0x0000000000000000000000000000000000001003")
+	AddrAddress        = common.HexToAddress("0x0000000000000000000000000000000000001004")
+	StakingAddress     = common.HexToAddress("0x0000000000000000000000000000000000001005")
+	GovAddress         = common.HexToAddress("0x0000000000000000000000000000000000001006")
+	DistrAddress       = common.HexToAddress("0x0000000000000000000000000000000000001007")
+	OracleAddress      = common.HexToAddress("0x0000000000000000000000000000000000001008")
+	IBCAddress         = common.HexToAddress("0x0000000000000000000000000000000000001009")
+	PointerViewAddress = common.HexToAddress("0x000000000000000000000000000000000000100A")
+	PointerAddress     = common.HexToAddress("0x000000000000000000000000000000000000100B")
+	SoloAddress        = common.HexToAddress("0x000000000000000000000000000000000000100C")
+	AuthAddress        = common.HexToAddress("0x000000000000000000000000000000000000100D")
+	AuthzAddress       = common.HexToAddress("0x000000000000000000000000000000000000100E")
+	EvidenceAddress    = common.HexToAddress("0x000000000000000000000000000000000000100F")
+	FeegrantAddress    = common.HexToAddress("0x0000000000000000000000000000000000001010")
+	P256VerifyAddress  = common.HexToAddress("0x0000000000000000000000000000000000001011")
+	MintAddress        = common.HexToAddress("0x0000000000000000000000000000000000001012")
+	ParamsAddress      = common.HexToAddress("0x0000000000000000000000000000000000001013")
+	SlashingAddress    = common.HexToAddress("0x0000000000000000000000000000000000001014")
+	UpgradeAddress     = common.HexToAddress("0x0000000000000000000000000000000000001015")
+)
+
+// Config holds the chain parameters the native precompiles need.
+type Config struct {
+	// Bech32Prefix is the account address prefix of Sei addresses.
+	Bech32Prefix string
+	// BaseDenom is the native denom backing EVM balances.
+	BaseDenom string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Bech32Prefix: "sei",
+		BaseDenom:    "usei",
+	}
+}
+
+// registry is a static Registry.
+type registry struct {
+	contracts map[common.Address]Contract
+	addresses []common.Address
+}
+
+// NewRegistry returns a Registry serving contracts. A nil Contract registers
+// its address as not migrated, so that calls to it fail closed instead of
+// being treated as calls to an empty account.
+func NewRegistry(contracts map[common.Address]Contract) Registry {
+	r := &registry{contracts: make(map[common.Address]Contract, len(contracts))}
+	for addr, c := range contracts {
+		r.contracts[addr] = c
+		r.addresses = append(r.addresses, addr)
+	}
+	sort.Slice(r.addresses, func(i, j int) bool {
+		return bytes.Compare(r.addresses[i][:], r.addresses[j][:]) < 0
+	})
+	return r
+}
+
+// NewSeiRegistry returns the Sei custom precompiles: the stateless json and
+// p256 precompiles and the addr and bank precompiles run natively, and every
+// other Sei precompile address is registered as not migrated.
+func NewSeiRegistry(cfg Config) Registry {
+	return NewRegistry(map[common.Address]Contract{
+		BankAddress:        NewBank(cfg),
+		WasmdAddress:       nil,
+		JSONAddress:        NewJSON(),
+		AddrAddress:        NewAddr(cfg),
+		StakingAddress:     nil,
+		GovAddress:         nil,
+		DistrAddress:       nil,
+		OracleAddress:      nil,
+		IBCAddress:         nil,
+		PointerViewAddress: nil,
+		PointerAddress:     nil,
+		SoloAddress:        nil,
+		AuthAddress:        nil,
+		AuthzAddress:       nil,
+		EvidenceAddress:    nil,
+		FeegrantAddress:    nil,
+		P256VerifyAddress:  NewP256(),
+		MintAddress:        nil,
+		ParamsAddress:      nil,
+		SlashingAddress:    nil,
+		UpgradeAddress:     nil,
+	})
+}
+
+func (r *registry) Get(addr common.Address) (Contract, bool) {
+	c, ok := r.contracts[addr]
+	return c, ok
+}
+
+func (r *registry) Addresses() []common.Address {
+	return append([]common.Address(nil), r.addresses...)
+}
+
+// The ABIs are copies of the ABIs of the Cosmos-backed precompiles in
+// precompiles/, so that this package does not depend on Cosmos.
+//
+//go:embed abi/*.json
+var abiFiles embed.FS
+
+func mustABI(name string) abi.ABI {
+	bz, err := abiFiles.ReadFile("abi/" + name + ".json")
+	if err != nil {
+		panic(err)
+	}
+	a, err := abi.JSON(bytes.NewReader(bz))
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// unpack resolves the method of input and decodes its arguments. Input which
+// decodeGas cannot price is rejected without decoding it, as the Cosmos
+// precompiles do.
+func unpack(a abi.ABI, input []byte) (*abi.Method, []interface{}, error) {
+	if len(input) < 4 {
+		return nil, nil, errors.New("input too short to extract method ID")
+	}
+	method, err := a.MethodById(input[:4])
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, ok := decodeGas(method, input); !ok {
+		return nil, nil, fmt.Errorf("invalid calldata encoding for %s", method.Name)
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return method, args, nil
+}
+
+func methodByInput(a abi.ABI, input []byte) (*abi.Method, bool) {
+	if len(input) < 4 {
+		return nil, false
+	}
+	method, err := a.MethodById(input[:4])
+	return method, err == nil
+}
+
+func validateArgsLength(args []interface{}, length int) error {
+	if len(args) != length {
+		return fmt.Errorf("expected %d arguments but got %d", length, len(args))
+	}
+	return nil
+}
+
+func validateNonPayable(value *big.Int) error {
+	if value != nil && value.Sign() != 0 {
+		return errors.New("sending funds to a non-payable function")
+	}
+	return nil
+}
+
+func notMigrated(precompile string, method string) error {
+	return fmt.Errorf("%w: %s.%s", ErrCustomPrecompilesOpen, precompile, method)
+}
@@ -38,14 +38,18 @@ type ResultSink interface {
 type BlockRequest struct {
 	Context BlockContext
 	Txs     [][]byte
+	// BlockHashes optionally resolves historical BLOCKHASH values for this
+	// block ahead of the executor's own sources.
+	BlockHashes BlockHashSource
 }
 
 // PreparedBlock contains decoded transactions with recovered senders. It is a
 // trusted executor-produced value: ExecutePreparedBlock assumes callers pass the
 // result of PrepareBlock unchanged and does not recover senders again.
 type PreparedBlock struct {
-	Context BlockContext
-	Txs     []PreparedTx
+	Context     BlockContext
+	Txs         []PreparedTx
+	BlockHashes BlockHashSource
 }
 
 // PreparedTx is the stateless per-transaction work needed before EVM execution.
@@ -109,6 +113,12 @@ type OCCStats struct {
 	ConflictCount   uint64
 	ValidationCount uint64
 	ConflictSamples []OCCConflictCount
+
+	// CrossBlockSpeculated reports that a Pipeline ran the block's first
+	// incarnations against the previous block's speculative writes, and
+	// CrossBlockReruns how many of them were rerun once that block was final.
+	CrossBlockSpeculated bool
+	CrossBlockReruns     uint64
 }
 
 // OCCConflictCount aggregates conflicts observed while validating optimistic
@@ -17,6 +17,7 @@ import (
 	servertypes "github.com/sei-protocol/sei-chain/sei-cosmos/server/types"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/snapshots"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/telemetry"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
@@ -183,6 +184,8 @@ type BaseApp struct {
 
 	concurrencyWorkers int
 	occEnabled         bool
+	conflictPredictor  *tasks.ConflictPredictor
+	conflictProfiler   *tasks.ConflictProfiler
 
 	deliverTxHooks []DeliverTxHook
 
@@ -338,6 +341,16 @@ func (app *BaseApp) ConcurrencyWorkers() int {
 	return app.concurrencyWorkers
 }
 
+// ConflictPredictor returns the OCC scheduler's conflict predictor, or nil.
+func (app *BaseApp) ConflictPredictor() *tasks.ConflictPredictor {
+	return app.conflictPredictor
+}
+
+// ConflictProfiler returns the OCC scheduler's conflict profiler, or nil.
+func (app *BaseApp) ConflictProfiler() *tasks.ConflictProfiler {
+	return app.conflictProfiler
+}
+
 // OccEnabled returns whether OCC is enabled for the BaseApp.
 func (app *BaseApp) OccEnabled() bool {
 	return app.occEnabled
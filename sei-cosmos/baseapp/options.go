@@ -9,6 +9,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-cosmos/codec/types"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/snapshots"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/tasks"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 )
 
@@ -286,6 +287,24 @@ func (app *BaseApp) SetOccEnabled(occEnabled bool) {
 	app.occEnabled = occEnabled
 }
 
+// SetConflictPredictor sets the predictor the OCC scheduler uses to sequence
+// likely-conflicting transactions before they run. Nil disables prediction.
+func (app *BaseApp) SetConflictPredictor(p *tasks.ConflictPredictor) {
+	if app.sealed {
+		panic("SetConflictPredictor() on sealed BaseApp")
+	}
+	app.conflictPredictor = p
+}
+
+// SetConflictProfiler sets the profiler that records the keys behind OCC
+// validation failures. Nil disables profiling.
+func (app *BaseApp) SetConflictProfiler(p *tasks.ConflictProfiler) {
+	if app.sealed {
+		panic("SetConflictProfiler() on sealed BaseApp")
+	}
+	app.conflictProfiler = p
+}
+
 // SetSnapshotKeepRecent sets the number of recent snapshots to keep.
 func (app *BaseApp) SetSnapshotKeepRecent(snapshotKeepRecent uint32) {
 	if app.sealed {
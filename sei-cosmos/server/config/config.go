@@ -648,6 +648,7 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			Enable:               v.GetBool("state-store.ss-enable"),
 			DBDirectory:          v.GetString("state-store.ss-db-directory"),
 			Backend:              v.GetString("state-store.ss-backend"),
+			MigrateToBackend:     v.GetString("state-store.ss-migrate-to-backend"),
 			AsyncWriteBuffer:     v.GetInt("state-store.ss-async-write-buffer"),
 			KeepRecent:           v.GetInt("state-store.ss-keep-recent"),
 			PruneIntervalSeconds: v.GetInt("state-store.ss-prune-interval"),
@@ -26,8 +26,10 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-cosmos/server/types"
 	storetypes "github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/telemetry"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 	genesistypes "github.com/sei-protocol/sei-chain/sei-cosmos/types/genesis"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/utils/tracing"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
 	tcmd "github.com/sei-protocol/sei-chain/sei-tendermint/cmd/tendermint/commands"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/node"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/client/local"
@@ -360,6 +362,10 @@ func startInProcess(
 				gen = genDoc
 			}
 		}
+		nodeOptions := []node.Option{node.WithFreezeHeight(config.FreezeHeight)}
+		if recent := recentHashes(app); recent != nil {
+			nodeOptions = append(nodeOptions, node.WithHashSource(recent))
+		}
 		tmNode, err := node.New(
 			goCtx,
 			ctx.Config,
@@ -368,7 +374,7 @@ func startInProcess(
 			gen,
 			tracerProviderOptions,
 			tmtypes.DefaultConsensusPolicy(),
-			node.WithFreezeHeight(config.FreezeHeight),
+			nodeOptions...,
 		)
 		if err != nil {
 			return fmt.Errorf("error creating node: %w", err)
@@ -485,3 +491,16 @@ func startInProcess(
 	// wait for signal capture and gracefully return
 	return WaitForQuitSignals(goCtx, restartCh)
 }
+
+// recentHashes returns the block hashes the app's state commitment store logs, or nil if it logs none.
+func recentHashes(app types.Application) *hashlog.RecentHashes {
+	a, ok := app.(interface{ CommitMultiStore() sdk.CommitMultiStore })
+	if !ok {
+		return nil
+	}
+	cms, ok := a.CommitMultiStore().(interface{ RecentHashes() *hashlog.RecentHashes })
+	if !ok {
+		return nil
+	}
+	return cms.RecentHashes()
+}
@@ -349,12 +349,15 @@ func AddCommands(
 		VersionCmd(),
 		commands.MakeGenAutobahnConfigCommand(),
 		commands.MakeGenValidatorCommand(),
+		commands.MakeSplitValidatorKeyCommand(conf),
 		commands.MakeReindexEventCommand(conf),
+		commands.MakeExportBlocksCommand(conf),
 		commands.MakeLightCommand(conf),
 		commands.MakeResetCommand(conf),
 		commands.MakeUnsafeResetAllCommand(conf),
 		commands.GenNodeKeyCmd,
 		commands.MakeInspectCommand(conf),
+		commands.MakeAutobahnInspectCommand(conf),
 		commands.MakeKeyMigrateCommand(conf),
 		debug.GetDebugCommand(),
 		commands.NewCompletionCmd(tendermintCmd, true),
@@ -0,0 +1,247 @@
+package rootmulti
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/cachekv"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/tracekv"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/state"
+	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/types/kv"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+)
+
+// flatKVHistoryReader is implemented by the composite SC store; see CompositeCommitStore.FlatKVHistory.
+type flatKVHistoryReader interface {
+	FlatKVHistory(moduleName string) flatkv.Store
+}
+
+// flatKVHistoryAt returns the flatkv store when it can serve every read of module at version from its
+// history window, or nil when they have to come from SS.
+func (rs *Store) flatKVHistoryAt(module string, version int64) flatkv.Store {
+	h, ok := rs.scStore.(flatKVHistoryReader)
+	if !ok {
+		return nil
+	}
+	history := h.FlatKVHistory(module)
+	if history == nil {
+		return nil
+	}
+	if earliest, latest := history.HistoryRange(); version < earliest || version > latest {
+		return nil
+	}
+	return history
+}
+
+// ssAt returns the SS store for key at version, or nil when SS is disabled or has pruned version.
+func (rs *Store) ssAt(key types.StoreKey, version int64) *state.Store {
+	if rs.ssStore == nil || rs.validateSSReadVersion(version) != nil {
+		return nil
+	}
+	return state.NewStore(rs.ssStore, key, version)
+}
+
+// historicalStore returns the read-only store serving key at version: the flatkv history window when it
+// covers the module at version, SS otherwise. It returns nil when neither can serve the version.
+func (rs *Store) historicalStore(key types.StoreKey, version int64) types.KVStore {
+	ss := rs.ssAt(key, version)
+	if history := rs.flatKVHistoryAt(key.Name(), version); history != nil {
+		return &flatKVHistoryStore{ss: ss, history: history, module: key.Name(), version: version}
+	}
+	if ss == nil {
+		return nil
+	}
+	return ss
+}
+
+var (
+	_ types.KVStore         = (*flatKVHistoryStore)(nil)
+	_ types.Queryable       = (*flatKVHistoryStore)(nil)
+	_ types.ContextIterator = (*flatKVHistoryStore)(nil)
+)
+
+// flatKVHistoryStore serves a module at a version inside the flatkv history window. Point reads come from
+// the window. Iteration, which the window does not index, stays on SS; without SS it runs on a read-only
+// flatkv view opened at the version for the life of the iterator. A read whose version has slid out of
+// the window since the store was built falls back to SS too.
+type flatKVHistoryStore struct {
+	// ss is nil when SS is disabled or has pruned version.
+	ss      *state.Store
+	history flatkv.Store
+	module  string
+	version int64
+}
+
+func (st *flatKVHistoryStore) GetStoreType() types.StoreType {
+	return state.StoreTypeSSStore
+}
+
+// Get returns the value key held at version. A read flatkv cannot serve falls back to SS; without SS it is
+// logged and reads as missing, as a bad historical query must not take the node down.
+func (st *flatKVHistoryStore) Get(key []byte) []byte {
+	value, found, err := st.history.GetAt(st.version, st.module, key)
+	if err != nil {
+		if st.ss != nil {
+			return st.ss.Get(key)
+		}
+		logger.Error("failed to read flatkv history", "module", st.module, "version", st.version, "key", fmt.Sprintf("%X", key), "err", err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	return value
+}
+
+func (st *flatKVHistoryStore) Has(key []byte) bool {
+	return st.Get(key) != nil
+}
+
+func (st *flatKVHistoryStore) Set(_, _ []byte) {
+	panic("write operation is not supported")
+}
+
+func (st *flatKVHistoryStore) Delete(_ []byte) {
+	panic("write operation is not supported")
+}
+
+func (st *flatKVHistoryStore) Iterator(start, end []byte) types.Iterator {
+	return st.iterator(context.Background(), start, end, true)
+}
+
+func (st *flatKVHistoryStore) IteratorWithContext(ctx context.Context, start, end []byte) types.Iterator {
+	return st.iterator(ctx, start, end, true)
+}
+
+func (st *flatKVHistoryStore) ReverseIterator(start, end []byte) types.Iterator {
+	return st.iterator(context.Background(), start, end, false)
+}
+
+func (st *flatKVHistoryStore) ReverseIteratorWithContext(ctx context.Context, start, end []byte) types.Iterator {
+	return st.iterator(ctx, start, end, false)
+}
+
+func (st *flatKVHistoryStore) iterator(ctx context.Context, start, end []byte, ascending bool) types.Iterator {
+	if st.ss != nil {
+		if ascending {
+			return st.ss.IteratorWithContext(ctx, start, end)
+		}
+		return st.ss.ReverseIteratorWithContext(ctx, start, end)
+	}
+	view, err := st.history.LoadVersionReadOnly(st.version)
+	if err != nil {
+		return st.failedIterator(start, end, fmt.Errorf("open flatkv view at version %d: %w", st.version, err))
+	}
+	iter, err := view.Iterator(st.module, start, end, ascending)
+	if err != nil {
+		_ = view.Close()
+		return st.failedIterator(start, end, fmt.Errorf("iterate flatkv view at version %d: %w", st.version, err))
+	}
+	return &viewIterator{Iterator: iter, view: view}
+}
+
+func (st *flatKVHistoryStore) failedIterator(start, end []byte, err error) types.Iterator {
+	logger.Error("failed to iterate flatkv history", "module", st.module, "version", st.version, "err", err)
+	return &failedIterator{start: start, end: end, err: err}
+}
+
+func (st *flatKVHistoryStore) GetWorkingHash() ([]byte, error) {
+	panic("get working hash operation is not supported")
+}
+
+func (st *flatKVHistoryStore) VersionExists(version int64) bool {
+	earliest, latest := st.history.HistoryRange()
+	if version >= earliest && version <= latest {
+		return true
+	}
+	return st.ss != nil && st.ss.VersionExists(version)
+}
+
+func (st *flatKVHistoryStore) DeleteAll(start, end []byte) error {
+	return errors.New("write operation is not supported")
+}
+
+func (st *flatKVHistoryStore) GetAllKeyStrsInRange(start, end []byte) (res []string) {
+	iter := st.Iterator(start, end)
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		res = append(res, string(iter.Key()))
+	}
+	return
+}
+
+func (st *flatKVHistoryStore) CacheWrap(storeKey types.StoreKey) types.CacheWrap {
+	return cachekv.NewStore(st, storeKey, types.DefaultCacheSizeLimit)
+}
+
+func (st *flatKVHistoryStore) CacheWrapWithTrace(storeKey types.StoreKey, w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(st, w, tc), storeKey, types.DefaultCacheSizeLimit)
+}
+
+func (st *flatKVHistoryStore) Query(ctx context.Context, req abci.RequestQuery) (res abci.ResponseQuery) {
+	if st.ss != nil && req.Path != "/key" {
+		return st.ss.Query(ctx, req)
+	}
+	if req.Height > st.version {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrInvalidHeight, "invalid height"))
+	}
+	res.Height = st.version
+	switch req.Path {
+	case "/key": // get by key
+		res.Key = req.Data // data holds the key bytes
+		res.Value = st.Get(res.Key)
+	case "/subspace":
+		pairs := kv.Pairs{
+			Pairs: make([]kv.Pair, 0),
+		}
+		subspace := req.Data
+		res.Key = subspace
+		iterator := types.KVStorePrefixIterator(st, subspace)
+		for ; iterator.Valid(); iterator.Next() {
+			pairs.Pairs = append(pairs.Pairs, kv.Pair{Key: iterator.Key(), Value: iterator.Value()})
+		}
+		err := iterator.Error()
+		_ = iterator.Close()
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+
+		bz, err := pairs.Marshal()
+		if err != nil {
+			return sdkerrors.QueryResult(err)
+		}
+		res.Value = bz
+	default:
+		return sdkerrors.QueryResult(sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unexpected query path: %v", req.Path))
+	}
+	return res
+}
+
+// viewIterator closes the read-only flatkv view it iterates along with itself.
+type viewIterator struct {
+	types.Iterator
+	view flatkv.Store
+}
+
+func (it *viewIterator) Close() error {
+	return errors.Join(it.Iterator.Close(), it.view.Close())
+}
+
+// failedIterator is an empty iterator reporting why it could not be built from Error.
+type failedIterator struct {
+	start, end []byte
+	err        error
+}
+
+func (it *failedIterator) Domain() ([]byte, []byte) { return it.start, it.end }
+func (it *failedIterator) Valid() bool              { return false }
+func (it *failedIterator) Next()                    { panic("iterator is invalid") }
+func (it *failedIterator) Key() []byte              { panic("iterator is invalid") }
+func (it *failedIterator) Value() []byte            { panic("iterator is invalid") }
+func (it *failedIterator) Error() error             { return it.err }
+func (it *failedIterator) Close() error             { return nil }
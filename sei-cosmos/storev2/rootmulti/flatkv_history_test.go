@@ -0,0 +1,174 @@
+package rootmulti
+
+// Historical evm reads served from the flatkv history window instead of SS.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+
+	"github.com/sei-protocol/sei-chain/sei-db/common/keys"
+	seidbconfig "github.com/sei-protocol/sei-chain/sei-db/config"
+	seidbtypes "github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+// markedSS answers every evm point read with ssMarker, so a historical read that returns real data
+// can only have been served by flatkv.
+type markedSS struct {
+	seidbtypes.StateStore
+}
+
+var ssMarker = []byte("served-by-ss")
+
+func (s markedSS) Get(storeKey string, version int64, key []byte) ([]byte, error) {
+	if storeKey == keys.EVMStoreKey {
+		return ssMarker, nil
+	}
+	return s.StateStore.Get(storeKey, version, key)
+}
+
+func TestFlatKVHistoryServesHistoricalEVMReads(t *testing.T) {
+	scCfg := evmMigratedConfig()
+	scCfg.FlatKVConfig.HistoryWindow = 2
+	ssCfg := seidbconfig.DefaultStateStoreConfig()
+	ssCfg.Enable = true
+	ssCfg.AsyncWriteBuffer = 0
+
+	store, storeKeys := newTestRootMultiWithSS(t, t.TempDir(), scCfg, ssCfg)
+	defer func() { require.NoError(t, store.Close()) }()
+
+	evmData := newEVMTestData(0x42)
+	var last commitRecord
+	for block := 1; block <= 5; block++ {
+		cms := store.CacheMultiStore()
+		cms.GetKVStore(storeKeys["acc"]).Set([]byte("acct"), []byte{byte(block)})
+		cms.GetKVStore(storeKeys["evm"]).Set(evmData.storKey, makeSlot(byte(block)))
+		cms.Write()
+		last = finalizeBlock(t, store)
+	}
+	waitUntilSSVersion(t, store, last.version)
+	store.ssStore = markedSS{store.ssStore}
+
+	history := store.flatKVHistoryAt(keys.EVMStoreKey, last.version)
+	require.NotNil(t, history, "evm reads must come from flatkv once the EVM migration is done")
+	earliest, latest := history.HistoryRange()
+	require.Equal(t, last.version, latest)
+	require.Less(t, earliest, latest)
+
+	for version := earliest; version <= latest; version++ {
+		// eth_call and StateAtBlock build their context from CacheMultiStoreWithVersion.
+		cms, err := store.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err)
+		require.Equal(t, makeSlot(byte(version)), cms.GetKVStore(storeKeys["evm"]).Get(evmData.storKey), "version %d", version)
+		require.Equal(t, []byte{byte(version)}, cms.GetKVStore(storeKeys["acc"]).Get([]byte("acct")))
+
+		resp := store.Query(context.Background(), abci.RequestQuery{Path: "/evm/key", Data: evmData.storKey, Height: version})
+		require.EqualValues(t, 0, resp.Code, resp.Log)
+		require.Equal(t, makeSlot(byte(version)), resp.Value, "version %d", version)
+	}
+
+	// Below the window the read falls back to SS.
+	cms, err := store.CacheMultiStoreWithVersion(earliest - 1)
+	require.NoError(t, err)
+	require.Equal(t, ssMarker, cms.GetKVStore(storeKeys["evm"]).Get(evmData.storKey))
+}
+
+func TestFlatKVHistoryNotUsedBeforeEVMMigration(t *testing.T) {
+	scCfg := dualWriteConfig()
+	scCfg.FlatKVConfig.HistoryWindow = 2
+	ssCfg := seidbconfig.DefaultStateStoreConfig()
+	ssCfg.Enable = true
+	ssCfg.AsyncWriteBuffer = 0
+
+	store, storeKeys := newTestRootMultiWithSS(t, t.TempDir(), scCfg, ssCfg)
+	defer func() { require.NoError(t, store.Close()) }()
+
+	evmData := newEVMTestData(0x43)
+	cms := store.CacheMultiStore()
+	cms.GetKVStore(storeKeys["evm"]).Set(evmData.storKey, makeSlot(0x01))
+	cms.Write()
+	c1 := finalizeBlock(t, store)
+	waitUntilSSVersion(t, store, c1.version)
+	store.ssStore = markedSS{store.ssStore}
+
+	require.Nil(t, store.flatKVHistoryAt(keys.EVMStoreKey, c1.version))
+	hcms, err := store.CacheMultiStoreWithVersion(c1.version)
+	require.NoError(t, err)
+	require.Equal(t, ssMarker, hcms.GetKVStore(storeKeys["evm"]).Get(evmData.storKey))
+}
+
+func TestFlatKVHistoryServesHistoricalReadsWithSSDisabled(t *testing.T) {
+	scCfg := flatKVOnlyConfig()
+	scCfg.FlatKVConfig.HistoryWindow = 2
+
+	store, storeKeys := newTestRootMulti(t, t.TempDir(), scCfg)
+	defer func() { require.NoError(t, store.Close()) }()
+	require.Nil(t, store.ssStore)
+
+	evmData := newEVMTestData(0x44)
+	var last commitRecord
+	for block := 1; block <= 5; block++ {
+		cms := store.CacheMultiStore()
+		cms.GetKVStore(storeKeys["acc"]).Set([]byte("acct"), []byte{byte(block)})
+		cms.GetKVStore(storeKeys["bank"]).Set([]byte{byte(block)}, []byte("supply"))
+		cms.GetKVStore(storeKeys["evm"]).Set(evmData.storKey, makeSlot(byte(block)))
+		cms.Write()
+		last = finalizeBlock(t, store)
+	}
+
+	history := store.flatKVHistoryAt(keys.BankStoreKey, last.version)
+	require.NotNil(t, history, "every module is served by flatkv in flatkv_only mode")
+	earliest, latest := history.HistoryRange()
+	require.Less(t, earliest, latest)
+
+	for version := earliest; version <= latest; version++ {
+		cms, err := store.CacheMultiStoreWithVersion(version)
+		require.NoError(t, err, "version %d", version)
+		require.Equal(t, []byte{byte(version)}, cms.GetKVStore(storeKeys["acc"]).Get([]byte("acct")))
+		require.Equal(t, makeSlot(byte(version)), cms.GetKVStore(storeKeys["evm"]).Get(evmData.storKey))
+
+		// Iteration has no history index and runs on a read-only view at the version.
+		iter := cms.GetKVStore(storeKeys["bank"]).Iterator(nil, nil)
+		var denoms []byte
+		for ; iter.Valid(); iter.Next() {
+			denoms = append(denoms, iter.Key()...)
+		}
+		require.NoError(t, iter.Error())
+		require.NoError(t, iter.Close())
+		require.Len(t, denoms, int(version), "version %d", version)
+
+		resp := store.Query(context.Background(), abci.RequestQuery{Path: "/acc/key", Data: []byte("acct"), Height: version})
+		require.EqualValues(t, 0, resp.Code, resp.Log)
+		require.Equal(t, []byte{byte(version)}, resp.Value, "version %d", version)
+	}
+
+	// Below the window there is nothing left to serve it.
+	_, err := store.CacheMultiStoreWithVersion(earliest - 1)
+	require.ErrorContains(t, err, "SS disabled")
+}
+
+func TestFlatKVHistoryStoreDoesNotPanicWithoutSS(t *testing.T) {
+	scCfg := flatKVOnlyConfig()
+	scCfg.FlatKVConfig.HistoryWindow = 1
+
+	store, storeKeys := newTestRootMulti(t, t.TempDir(), scCfg)
+	defer func() { require.NoError(t, store.Close()) }()
+	var last commitRecord
+	for block := 1; block <= 4; block++ {
+		cms := store.CacheMultiStore()
+		cms.GetKVStore(storeKeys["acc"]).Set([]byte("acct"), []byte{byte(block)})
+		cms.Write()
+		last = finalizeBlock(t, store)
+	}
+	history := store.flatKVHistoryAt(keys.AuthStoreKey, last.version)
+	require.NotNil(t, history)
+
+	// A store whose version has slid out of the window, with no SS to fall back to.
+	var hs types.KVStore = &flatKVHistoryStore{history: history, module: keys.AuthStoreKey, version: 1}
+	require.NotPanics(t, func() {
+		require.Nil(t, hs.Get([]byte("acct")))
+	})
+}
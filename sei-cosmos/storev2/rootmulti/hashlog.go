@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
 	"github.com/sei-protocol/sei-chain/sei-db/proto"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
 )
@@ -22,6 +23,9 @@ const (
 	memIAVLRootHashType = "memIAVL/root"
 )
 
+// recentHashLogBlocks is how many of the most recently logged blocks are kept in memory for RecentHashes.
+const recentHashLogBlocks = 1000
+
 // hashReportingStore is the subset of the SC store that owns and reports its own hash categories. The
 // composite commit store implements it. Type-asserting keeps these methods out of the broad
 // sctypes.Committer interface. The caller registers the categories returned by HashCategories.
@@ -62,9 +66,14 @@ func (rs *Store) hashLogDir() string {
 	return filepath.Join(rs.scDir, "data", "hash.log")
 }
 
+// HashLogDir returns the directory the store writes its hash log to, whether or not hash logging is enabled.
+func (rs *Store) HashLogDir() string {
+	return rs.hashLogDir()
+}
+
 // desiredHashCategories computes the full caller-reported category set for the current backend state:
-// the app-level categories plus whatever the live backends report (and memIAVL/root when memIAVL is
-// present). The backend set is dynamic (memIAVL departs and flatKV arrives during migration), so this
+// the app-level categories, a changeset column per committed store, plus whatever the live backends report (and
+// memIAVL/root when memIAVL is present). The backend set is dynamic (memIAVL departs and flatKV arrives during migration), so this
 // is recomputed each block and used to detect when the logger's column set must change.
 func (rs *Store) desiredHashCategories() map[string]struct{} {
 	categories := map[string]struct{}{
@@ -72,6 +81,13 @@ func (rs *Store) desiredHashCategories() map[string]struct{} {
 		blockHashType:  {},
 		resultHashType: {},
 	}
+	// Per-module changeset columns: the logger hashes each store's slice of the changeset reported below, so a
+	// diverging block can be narrowed to a module without re-executing it. Only committed (IAVL) stores are flushed.
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL {
+			categories[hashlog.ModuleChangesetHashType(key.Name())] = struct{}{}
+		}
+	}
 	if h, ok := rs.scStore.(hashReportingStore); ok {
 		for _, category := range h.HashCategories() {
 			categories[category] = struct{}{}
@@ -99,6 +115,7 @@ func (rs *Store) openHashLogger() error {
 	cfg.BlocksToRetain = rs.hashLoggerConfig.BlocksToRetain
 	cfg.TargetFileSize = rs.hashLoggerConfig.TargetFileSize
 	cfg.MaxDiskSize = rs.hashLoggerConfig.MaxDiskSize
+	cfg.RecentHashes = rs.recentHashes
 
 	hl, err := hashlog.NewHashLogger(cfg)
 	if err != nil {
@@ -136,6 +153,12 @@ func (rs *Store) HashLoggingEnabled() bool {
 	return !rs.hashLoggerDisabled
 }
 
+// RecentHashes returns the most recently logged blocks, kept in memory so the node can compare them with its
+// peers while the chain is live. Nil when hash logging is disabled by config.
+func (rs *Store) RecentHashes() *hashlog.RecentHashes {
+	return rs.recentHashes
+}
+
 // disableHashLogger turns hash logging off after a fatal error, closing the logger if it is open.
 func (rs *Store) disableHashLogger() {
 	rs.hashLoggerDisabled = true
@@ -51,6 +51,7 @@ func TestRootMultiHashLogging(t *testing.T) {
 	expectedColumns := []string{
 		"appHash", "blockHash", "resultHash", "memIAVL/root",
 		"memIAVL/mod/bank", "memIAVL/mod/evm", hashlog.ChangesetHashType,
+		hashlog.ModuleChangesetHashType("bank"), hashlog.ModuleChangesetHashType("evm"),
 	}
 	for h := int64(1); h <= 3; h++ {
 		logs, err := hashlog.ReadHashForBlock(dir, uint64(h))
@@ -0,0 +1,139 @@
+package rootmulti
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/cachekv"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/cachemulti"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/tracekv"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/transient"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/state"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+// ReplayMultiStore returns a cache multistore over the state store's view of version, for re-executing the
+// block at version+1 without committing it. Writes flushed into it by Write are not applied anywhere; the
+// returned recorder collects them instead, in the form flush would have handed them to the commit store.
+// Transient stores start empty, as they do after a commit. Memory stores, which have no past versions, are read
+// live through a cache which is never written back, so that the replay does not modify them.
+//
+// Reads after a flush see the recorded writes through Get and Has but not through iterators, so the multistore
+// should be flushed once, when the block is done, as the deliver state is.
+func (rs *Store) ReplayMultiStore(version int64) (types.CacheMultiStore, *ReplayRecorder, error) {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+	if rs.ssStore == nil {
+		return nil, nil, fmt.Errorf("replaying version %d requires the state store", version+1)
+	}
+	if err := rs.validateSSReadVersion(version); err != nil {
+		return nil, nil, err
+	}
+	if latest := rs.ssStore.GetLatestVersion(); version > latest {
+		return nil, nil, fmt.Errorf("state store version %d is above latest available version %d", version, latest)
+	}
+	recorder := &ReplayRecorder{stores: make(map[string]*recordingStore)}
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(rs.ckvStores))
+	for k, store := range rs.ckvStores {
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			recording := &recordingStore{Store: state.NewStore(rs.ssStore, k, version), written: make(map[string]*proto.KVPair)}
+			recorder.stores[k.Name()] = recording
+			stores[k] = recording
+		case types.StoreTypeTransient:
+			stores[k] = transient.NewStore()
+		default:
+			stores[k] = cachekv.NewStore(store, k, types.DefaultCacheSizeLimit)
+		}
+	}
+	gigaKeys := make([]types.StoreKey, 0, len(rs.gigaKeys))
+	for _, k := range rs.gigaKeys {
+		gigaKeys = append(gigaKeys, rs.storeKeys[k])
+	}
+	return cachemulti.NewStore(nil, stores, rs.storeKeys, gigaKeys, nil, nil), recorder, nil
+}
+
+// ReplayRecorder collects the writes flushed into a ReplayMultiStore.
+type ReplayRecorder struct {
+	stores map[string]*recordingStore
+}
+
+// ChangeSets returns the recorded writes of every store that was written, sorted by store name, as flush
+// would have committed them.
+func (r *ReplayRecorder) ChangeSets() []*proto.NamedChangeSet {
+	var changeSets []*proto.NamedChangeSet
+	for name, store := range r.stores {
+		store.mtx.Lock()
+		if len(store.changeSet.Pairs) > 0 {
+			changeSets = append(changeSets, &proto.NamedChangeSet{
+				Name:      name,
+				Changeset: proto.ChangeSet{Pairs: append([]*proto.KVPair(nil), store.changeSet.Pairs...)},
+			})
+		}
+		store.mtx.Unlock()
+	}
+	sort.Slice(changeSets, func(i, j int) bool {
+		return changeSets[i].Name < changeSets[j].Name
+	})
+	return changeSets
+}
+
+// recordingStore reads a store at a past version from the state store and records the writes flushed into it
+// the way commitment.Store does, in write order.
+type recordingStore struct {
+	*state.Store
+
+	mtx       sync.Mutex
+	changeSet proto.ChangeSet
+	// written is the latest recorded write of each key, so that reads after a flush see it.
+	written map[string]*proto.KVPair
+}
+
+func (s *recordingStore) CacheWrap(storeKey types.StoreKey) types.CacheWrap {
+	return cachekv.NewStore(s, storeKey, types.DefaultCacheSizeLimit)
+}
+
+func (s *recordingStore) CacheWrapWithTrace(storeKey types.StoreKey, w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc), storeKey, types.DefaultCacheSizeLimit)
+}
+
+func (s *recordingStore) Get(key []byte) []byte {
+	s.mtx.Lock()
+	pair, ok := s.written[string(key)]
+	s.mtx.Unlock()
+	if !ok {
+		return s.Store.Get(key)
+	}
+	if pair.Delete {
+		return nil
+	}
+	return pair.Value
+}
+
+func (s *recordingStore) Has(key []byte) bool {
+	s.mtx.Lock()
+	pair, ok := s.written[string(key)]
+	s.mtx.Unlock()
+	if !ok {
+		return s.Store.Has(key)
+	}
+	return !pair.Delete
+}
+
+func (s *recordingStore) Set(key, value []byte) {
+	s.record(&proto.KVPair{Key: key, Value: value})
+}
+
+func (s *recordingStore) Delete(key []byte) {
+	s.record(&proto.KVPair{Key: key, Delete: true})
+}
+
+func (s *recordingStore) record(pair *proto.KVPair) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.changeSet.Pairs = append(s.changeSet.Pairs, pair)
+	s.written[string(pair.Key)] = pair
+}
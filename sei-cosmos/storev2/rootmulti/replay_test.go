@@ -0,0 +1,68 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+func TestReplayMultiStoreRecordsChangeSets(t *testing.T) {
+	home := t.TempDir()
+	scCfg := config.DefaultStateCommitConfig()
+	scCfg.Enable = true
+	ssCfg := config.DefaultStateStoreConfig()
+	ssCfg.Enable = true
+
+	store := NewStore(home, scCfg, ssCfg, []string{})
+	defer func() { _ = store.Close() }()
+	bank := types.NewKVStoreKey("bank")
+	evm := types.NewKVStoreKey("evm")
+	memKey := types.NewMemoryStoreKey("mem")
+	store.MountStoreWithDB(bank, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(evm, types.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(memKey, types.StoreTypeMemory, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	memStore := store.GetKVStore(memKey)
+	memStore.Set([]byte("m"), []byte("1"))
+
+	kv := store.GetStoreByName("bank").(types.KVStore)
+	kv.Set([]byte("a"), []byte("1"))
+	kv.Set([]byte("b"), []byte("1"))
+	c1 := store.Commit(true)
+	waitUntilSSVersion(t, store, c1.Version)
+
+	cms, recorder, err := store.ReplayMultiStore(c1.Version)
+	require.NoError(t, err)
+	replayed := cms.GetKVStore(bank)
+	require.Equal(t, []byte("1"), replayed.Get([]byte("a")))
+	replayed.Set([]byte("a"), []byte("2"))
+	replayed.Delete([]byte("b"))
+	require.Equal(t, []byte("1"), cms.GetKVStore(memKey).Get([]byte("m")))
+	cms.GetKVStore(memKey).Set([]byte("m"), []byte("2"))
+	cms.Write()
+
+	require.Equal(t, []*proto.NamedChangeSet{{
+		Name: "bank",
+		Changeset: proto.ChangeSet{Pairs: []*proto.KVPair{
+			{Key: []byte("a"), Value: []byte("2")},
+			{Key: []byte("b"), Delete: true},
+		}},
+	}}, recorder.ChangeSets())
+
+	// Reads after the flush see the recorded writes, and nothing reaches the stores.
+	cms = cms.CacheMultiStore()
+	require.Equal(t, []byte("2"), cms.GetKVStore(bank).Get([]byte("a")))
+	require.False(t, cms.GetKVStore(bank).Has([]byte("b")))
+	latest, err := store.CacheMultiStoreWithVersion(c1.Version)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), latest.GetKVStore(bank).Get([]byte("a")))
+	require.Equal(t, c1.Version, store.LastCommitID().Version)
+	require.Equal(t, []byte("1"), memStore.Get([]byte("m")))
+
+	_, _, err = store.ReplayMultiStore(c1.Version + 1)
+	require.Error(t, err)
+}
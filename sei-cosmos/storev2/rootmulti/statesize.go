@@ -0,0 +1,142 @@
+package rootmulti
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+	sctypes "github.com/sei-protocol/sei-chain/sei-db/state_db/sc/types"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
+)
+
+var _ statesize.Provider = (*Store)(nil)
+
+// stateSizeDir returns the directory state size totals and growth history are kept in, defaulting to
+// a "state-size" directory under the state-commit store's data directory, beside hash.log.
+func (rs *Store) stateSizeDir() string {
+	if rs.stateSizeConfig.Directory != "" {
+		return rs.stateSizeConfig.Directory
+	}
+	return filepath.Join(rs.scDir, "data", "state-size")
+}
+
+// openStateSize starts state size tracking at the latest committed version, replacing a tracker left
+// from an earlier load (a state-sync restore reloads the store). On failure tracking stays off rather
+// than failing the load.
+func (rs *Store) openStateSize() {
+	if !rs.stateSizeConfig.Enable {
+		return
+	}
+	if err := rs.closeStateSize(); err != nil {
+		logger.Error("failed to persist state sizes before reopening", "err", err)
+	}
+
+	version := rs.lastCommitInfo.Version
+	stores := rs.iavlStoreNames()
+	scStore := rs.scStore
+	tracker, err := statesize.Open(rs.stateSizeConfig, rs.stateSizeDir(), version, func() (statesize.Source, error) {
+		view, err := scStore.LoadVersion(version, true)
+		if err != nil {
+			return nil, err
+		}
+		return &committerSource{committer: view, stores: stores}, nil
+	})
+	if err != nil {
+		logger.Error("failed to open state size tracker; state size tracking is off", "err", err)
+		return
+	}
+	rs.stateSize = tracker
+}
+
+// closeStateSize persists and drops the tracker, if one is open.
+func (rs *Store) closeStateSize() error {
+	if rs.stateSize == nil {
+		return nil
+	}
+	err := rs.stateSize.Close()
+	rs.stateSize = nil
+	return err
+}
+
+// recordStateSize accounts for a flush's changesets before they reach the SC store, whose working
+// state still holds the values they replace. A failure turns tracking off rather than failing the
+// block. flush runs without rs.mtx, which is taken only to drop the tracker.
+func (rs *Store) recordStateSize(changeSets []*proto.NamedChangeSet) {
+	if rs.stateSize == nil || len(changeSets) == 0 {
+		return
+	}
+	if err := rs.stateSize.Record(changeSets, rs.scStore.Get); err != nil {
+		logger.Error("failed to record state sizes; state size tracking is off", "err", err)
+		rs.mtx.Lock()
+		defer rs.mtx.Unlock()
+		_ = rs.closeStateSize()
+	}
+}
+
+// commitStateSize marks version committed in the tracker. Must be called with rs.mtx held (from
+// Commit).
+func (rs *Store) commitStateSize(version int64) {
+	if rs.stateSize == nil {
+		return
+	}
+	if err := rs.stateSize.Commit(version); err != nil {
+		logger.Error("failed to persist state sizes", "version", version, "err", err)
+	}
+}
+
+// StateSizeReport implements statesize.Provider.
+func (rs *Store) StateSizeReport() (statesize.Report, error) {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+	if rs.stateSize == nil {
+		return statesize.Report{}, statesize.ErrDisabled
+	}
+	return rs.stateSize.Report(), nil
+}
+
+// StateSizeHistory implements statesize.Provider.
+func (rs *Store) StateSizeHistory(limit int) ([]statesize.GrowthSnapshot, error) {
+	rs.mtx.RLock()
+	defer rs.mtx.RUnlock()
+	if rs.stateSize == nil {
+		return nil, statesize.ErrDisabled
+	}
+	return rs.stateSize.History(limit), nil
+}
+
+// iavlStoreNames returns the names of the stores the SC store holds, in order.
+func (rs *Store) iavlStoreNames() []string {
+	names := make([]string, 0, len(rs.storesParams))
+	for key, params := range rs.storesParams {
+		if params.typ == types.StoreTypeIAVL {
+			names = append(names, key.Name())
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// committerSource counts a state size baseline from a read-only SC view.
+type committerSource struct {
+	committer sctypes.Committer
+	stores    []string
+}
+
+func (s *committerSource) Stores() []string { return s.stores }
+
+func (s *committerSource) Iterate(store string, fn func(key, value []byte) error) error {
+	it, err := s.committer.Iterator(store, nil, nil, true)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = it.Close() }()
+	for ; it.Valid(); it.Next() {
+		if err := fn(it.Key(), it.Value()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+func (s *committerSource) Close() error { return s.committer.Close() }
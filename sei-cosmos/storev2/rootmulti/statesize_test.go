@@ -0,0 +1,81 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
+)
+
+func newStateSizeStore(t *testing.T, home string) *Store {
+	t.Helper()
+	scCfg := config.DefaultStateCommitConfig()
+	scCfg.Enable = true
+	scCfg.HashLogger.Enable = false
+	scCfg.StateSize.Enable = true
+	scCfg.StateSize.GrowthInterval = 2
+	ssCfg := config.DefaultStateStoreConfig()
+	ssCfg.Enable = false
+
+	store := NewStore(home, scCfg, ssCfg, []string{})
+	store.MountStoreWithDB(types.NewKVStoreKey("bank"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	return store
+}
+
+// Sizes follow every commit, including overwrites and deletes, and survive a restart.
+func TestRootMultiStateSize(t *testing.T) {
+	home := t.TempDir()
+	store := newStateSizeStore(t, home)
+
+	bank := store.GetStoreByName("bank").(types.KVStore)
+	bank.Set([]byte("\x01a"), []byte("1234"))
+	bank.Set([]byte("\x02b"), []byte("5"))
+	store.Commit(true)
+	bank = store.GetStoreByName("bank").(types.KVStore)
+	bank.Set([]byte("\x01a"), []byte("12"))
+	bank.Delete([]byte("\x02b"))
+	store.Commit(true)
+	bank = store.GetStoreByName("bank").(types.KVStore)
+	bank.Set([]byte("\x01c"), []byte("3"))
+	store.Commit(true)
+
+	report, err := store.StateSizeReport()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), report.Version)
+	want := statesize.Sizes{Keys: 2, Bytes: (2 + 2) + (2 + 1)}
+	require.Equal(t, want, report.SC["bank"].Total)
+	require.Equal(t, want, report.SC["bank"].Prefixes["01"])
+	history, err := store.StateSizeHistory(0)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, int64(2), history[0].Version)
+	require.NoError(t, store.Close())
+
+	// Close persisted the totals at version 3, so the reopened store resumes them.
+	store = newStateSizeStore(t, home)
+	defer func() { require.NoError(t, store.Close()) }()
+	report, err = store.StateSizeReport()
+	require.NoError(t, err)
+	require.True(t, report.SCComplete)
+	require.Equal(t, want, report.SC["bank"].Total)
+}
+
+func TestRootMultiStateSizeDisabled(t *testing.T) {
+	scCfg := config.DefaultStateCommitConfig()
+	scCfg.Enable = true
+	scCfg.HashLogger.Enable = false
+	ssCfg := config.DefaultStateStoreConfig()
+	ssCfg.Enable = false
+
+	store := NewStore(t.TempDir(), scCfg, ssCfg, []string{})
+	store.MountStoreWithDB(types.NewKVStoreKey("bank"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	defer func() { require.NoError(t, store.Close()) }()
+
+	_, err := store.StateSizeReport()
+	require.ErrorIs(t, err, statesize.ErrDisabled)
+}
@@ -26,7 +26,6 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store/transient"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store/types"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/commitment"
-	"github.com/sei-protocol/sei-chain/sei-cosmos/storev2/state"
 	"github.com/sei-protocol/sei-chain/sei-cosmos/telemetry"
 	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
 	commonerrors "github.com/sei-protocol/sei-chain/sei-db/common/errors"
@@ -38,6 +37,7 @@ import (
 	sctypes "github.com/sei-protocol/sei-chain/sei-db/state_db/sc/types"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss"
 	sscomposite "github.com/sei-protocol/sei-chain/sei-db/state_db/ss/composite"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
 	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 )
@@ -82,8 +82,9 @@ type Store struct {
 	hashLoggerConfig   config.HashLoggerConfig
 	hashLoggerDisabled bool
 	hashLogger         hashlog.HashLogger
-	hashCategories     map[string]struct{} // the category set the current logger was opened with
-	scDir              string              // state-commit directory, for the default hash log location
+	hashCategories     map[string]struct{}   // the category set the current logger was opened with
+	scDir              string                // state-commit directory, for the default hash log location
+	recentHashes       *hashlog.RecentHashes // recently logged blocks, for live comparison with peers
 	// blockChangeSets is the aggregate changeset captured in flush for the block being committed, then
 	// reported (and cleared) in Commit. changesetCapturedVersion guards against the double-flush so it is
 	// captured only once (with the real, non-empty changeset) per block.
@@ -94,6 +95,10 @@ type Store struct {
 	// nextResultHash is the result hash (merkle root over the block's deterministic tx results)
 	// supplied by baseapp for the block being committed.
 	nextResultHash []byte
+
+	// State size accounting (per-module sizes and growth snapshots). See statesize.go.
+	stateSizeConfig config.StateSizeConfig
+	stateSize       *statesize.Tracker
 }
 
 type VersionedChangesets struct {
@@ -149,6 +154,10 @@ func NewStore(
 		hashLoggerConfig:   scConfig.HashLogger,
 		hashLoggerDisabled: !scConfig.HashLogger.Enable,
 		scDir:              scDir,
+		stateSizeConfig:    scConfig.StateSize,
+	}
+	if scConfig.HashLogger.Enable {
+		store.recentHashes = hashlog.NewRecentHashes(recentHashLogBlocks)
 	}
 	if ssConfig.Enable {
 		config.AlignSSSnapshotWithSC(scConfig, &ssConfig)
@@ -223,6 +232,7 @@ func (rs *Store) Commit(bumpVersion bool) types.CommitID {
 	rs.lastCommitInfo = convertCommitInfo(rs.scStore.LastCommitInfo())
 	rs.lastCommitInfo = amendCommitInfo(rs.lastCommitInfo, rs.storesParams)
 	rs.recordBlockHashes(rs.lastCommitInfo.Version)
+	rs.commitStateSize(rs.lastCommitInfo.Version)
 	return rs.lastCommitInfo.CommitID()
 }
 
@@ -289,11 +299,13 @@ func (rs *Store) flush() error {
 	if rs.ssSnapshots != nil {
 		rs.ssSnapshots.ScheduleSnapshot(currentVersion)
 	}
+	rs.recordStateSize(changeSets)
 	return rs.scStore.ApplyChangeSets(changeSets)
 }
 
 func (rs *Store) Close() error {
-	err := rs.scStore.Close()
+	err := rs.closeStateSize()
+	err = commonerrors.Join(err, rs.scStore.Close())
 	if rs.ssStore != nil {
 		err = commonerrors.Join(err, rs.ssStore.Close())
 	}
@@ -374,28 +386,30 @@ func (rs *Store) cacheMultiStoreLocked() types.CacheMultiStore {
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
 	rs.mtx.RLock()
 	defer rs.mtx.RUnlock()
+	if version <= 0 && rs.ssStore != nil {
+		version = rs.ssStore.GetLatestVersion()
+	}
+	if rs.ssStore == nil && (version <= 0 || (rs.lastCommitInfo != nil && version == rs.lastCommitInfo.Version)) {
+		// Only serve from SC when query latest version and SS not enabled
+		return rs.cacheMultiStoreLocked(), nil
+	}
+	// Serve historical queries from the flatkv history window for the modules it covers at version, and
+	// from SS for the rest.
 	stores := make(map[types.StoreKey]types.CacheWrapper)
-	// Serve from SS stores for ALL historical queries
-	if rs.ssStore != nil {
-		if version <= 0 {
-			version = rs.ssStore.GetLatestVersion()
-		}
-		if err := rs.validateSSReadVersion(version); err != nil {
-			return nil, err
+	// add the transient/mem stores registered in current app.
+	for k, store := range rs.ckvStores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			stores[k] = store
+			continue
 		}
-		// add the transient/mem stores registered in current app.
-		for k, store := range rs.ckvStores {
-			if store.GetStoreType() != types.StoreTypeIAVL {
-				stores[k] = store
-			} else {
-				stores[k] = state.NewStore(rs.ssStore, k, version)
+		historical := rs.historicalStore(k, version)
+		if historical == nil {
+			if rs.ssStore == nil {
+				return nil, fmt.Errorf("unable to load historical state with SS disabled for version: %d", version)
 			}
+			return nil, rs.validateSSReadVersion(version)
 		}
-	} else if version <= 0 || (rs.lastCommitInfo != nil && version == rs.lastCommitInfo.Version) {
-		// Only serve from SC when query latest version and SS not enabled
-		return rs.cacheMultiStoreLocked(), nil
-	} else {
-		return nil, fmt.Errorf("unable to load historical state with SS disabled for version: %d", version)
+		stores[k] = historical
 	}
 
 	return cachemulti.NewStore(nil, stores, rs.storeKeys, nil, nil, nil), nil
@@ -686,6 +700,10 @@ func (rs *Store) LoadVersionAndUpgrade(version int64, upgrades *types.StoreUpgra
 	} else {
 		rs.lastCommitInfo = &types.CommitInfo{}
 	}
+	// Only the live store is tracked; a non-zero version is a read-only view for export.
+	if version == 0 {
+		rs.openStateSize()
+	}
 	return nil
 }
 
@@ -942,13 +960,14 @@ func (rs *Store) Query(ctx context.Context, req abci.RequestQuery) abci.Response
 	needProof := req.Prove && rootmulti.RequireProof(subPath)
 	latest := version == rs.scStore.Version()
 
-	// Fast path: no proof + SS enabled
-	if !needProof && rs.ssStore != nil {
-		if err := rs.validateSSReadVersion(version); err != nil {
-			return sdkerrors.QueryResult(errors.Wrap(sdkerrors.ErrInvalidHeight, err.Error()))
+	// Fast path: no proof, served by SS or the flatkv history window
+	if !needProof {
+		if store := rs.historicalStore(types.NewKVStoreKey(storeName), version); store != nil {
+			return store.(types.Queryable).Query(ctx, req)
+		}
+		if rs.ssStore != nil {
+			return sdkerrors.QueryResult(errors.Wrap(sdkerrors.ErrInvalidHeight, rs.validateSSReadVersion(version).Error()))
 		}
-		store := types.Queryable(state.NewStore(rs.ssStore, types.NewKVStoreKey(storeName), version))
-		return store.Query(ctx, req)
 	}
 
 	var (
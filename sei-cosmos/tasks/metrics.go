@@ -27,6 +27,16 @@ var (
 			metric.WithUnit("{count}"),
 		)),
 	}
+
+	profilerMetrics = struct {
+		keyConflicts metric.Int64Counter
+	}{
+		keyConflicts: must(meter.Int64Counter(
+			"scheduler_key_conflicts",
+			metric.WithDescription("Number of OCC validation failures per conflicting key, by store; per-key and per-contract detail is served by the conflict profiler"),
+			metric.WithUnit("{count}"),
+		)),
+	}
 )
 
 func must[V any](v V, err error) V {
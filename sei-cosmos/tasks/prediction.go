@@ -0,0 +1,155 @@
+package tasks
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+)
+
+const (
+	// conflictHistoryDecay scales the conflict counts of earlier blocks each
+	// time a block is recorded.
+	conflictHistoryDecay = 0.5
+	// conflictHistoryHotScore is the decayed conflict count at which a key is
+	// treated as hot.
+	conflictHistoryHotScore = 2.0
+	// conflictHistoryMinScore is the decayed conflict count below which a key
+	// is forgotten.
+	conflictHistoryMinScore = 0.25
+)
+
+// TxAccess lists the state keys a transaction is expected to touch. Keys are
+// "<store name>/<raw key>", the format of the scheduler's conflict keys.
+type TxAccess struct {
+	// Declared keys come from the transaction itself, such as an EIP-2930
+	// access list. Transactions declaring the same key are sequenced.
+	Declared []string
+	// Candidates are keys derived by inspecting the transaction, such as the
+	// balances a token transfer moves. Transactions sharing a candidate key
+	// are sequenced only while the key is hot.
+	Candidates []string
+}
+
+// TxAccessFunc predicts the state keys of a decoded transaction. It runs
+// before execution and must not read state.
+type TxAccessFunc func(tx sdk.Tx) TxAccess
+
+// ConflictPredictor builds a predicted dependency DAG for a block before the
+// scheduler runs it. A transaction that shares a declared key, or a hot
+// candidate key, with an earlier transaction starts its first execution once
+// that transaction has executed, within the same scheduler iteration, instead
+// of running optimistically and being invalidated. Keys are hot when
+// configured as such or when they kept conflicting in recent blocks.
+//
+// A ConflictPredictor is meant to outlive a single scheduler so that conflict
+// history accumulates across blocks; it is safe for concurrent use.
+type ConflictPredictor struct {
+	access  TxAccessFunc
+	hotKeys map[string]struct{}
+
+	mu      sync.Mutex
+	history map[string]float64
+}
+
+// NewConflictPredictor returns a predictor that reads transaction keys with
+// access and always treats hotKeys as contended.
+func NewConflictPredictor(access TxAccessFunc, hotKeys []string) *ConflictPredictor {
+	hot := make(map[string]struct{}, len(hotKeys))
+	for _, key := range hotKeys {
+		hot[key] = struct{}{}
+	}
+	return &ConflictPredictor{
+		access:  access,
+		hotKeys: hot,
+		history: map[string]float64{},
+	}
+}
+
+// ParseHotKey converts a "<store name>/<hex key>" string, the encoding used by
+// the scheduler's key conflict log, to a predictor key.
+func ParseHotKey(s string) (string, error) {
+	storeName, hexKey, ok := strings.Cut(s, "/")
+	if !ok || storeName == "" {
+		return "", fmt.Errorf("hot key %q is not <store>/<hex key>", s)
+	}
+	if hexKey == "globalAccountNumber" {
+		// Logged unencoded, like the scheduler's key conflict log.
+		return s, nil
+	}
+	rawKey, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return "", fmt.Errorf("hot key %q: %w", s, err)
+	}
+	return storeName + "/" + string(rawKey), nil
+}
+
+// RecordConflicts decays the conflict history and adds one block's per-key
+// conflict counts to it. It should be called once per block, including blocks
+// without conflicts, so that keys that stop conflicting cool down.
+func (p *ConflictPredictor) RecordConflicts(counts map[string]int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for key, score := range p.history {
+		score *= conflictHistoryDecay
+		if score < conflictHistoryMinScore {
+			delete(p.history, key)
+			continue
+		}
+		p.history[key] = score
+	}
+	for key, count := range counts {
+		p.history[key] += float64(count)
+	}
+}
+
+// isHotLocked reports whether key is contended. p.mu must be held.
+func (p *ConflictPredictor) isHotLocked(key string) bool {
+	if _, ok := p.hotKeys[key]; ok {
+		return true
+	}
+	return p.history[key] >= conflictHistoryHotScore
+}
+
+// predictDependencies returns, by absolute index, the earlier tasks each task
+// is predicted to conflict with. Only the closest earlier task per key is
+// listed; longer chains follow through that task's own dependencies.
+func (p *ConflictPredictor) predictDependencies(tasks []*deliverTxTask) map[int][]int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	predicted := make(map[int][]int)
+	lastTask := make(map[string]int)
+	for _, task := range tasks {
+		if task.SdkTx == nil {
+			continue
+		}
+		access := p.access(task.SdkTx)
+		var deps []int
+		seen := make(map[int]struct{})
+		visit := func(key string) {
+			if prev, ok := lastTask[key]; ok && prev != task.AbsoluteIndex {
+				if _, dup := seen[prev]; !dup {
+					seen[prev] = struct{}{}
+					deps = append(deps, prev)
+				}
+			}
+			lastTask[key] = task.AbsoluteIndex
+		}
+		for _, key := range access.Declared {
+			visit(key)
+		}
+		for _, key := range access.Candidates {
+			if p.isHotLocked(key) {
+				visit(key)
+			}
+		}
+		if len(deps) > 0 {
+			sort.Ints(deps)
+			predicted[task.AbsoluteIndex] = deps
+		}
+	}
+	return predicted
+}
@@ -0,0 +1,123 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/utils/tracing"
+)
+
+type predictedTestTx struct {
+	sdk.Tx
+	access TxAccess
+}
+
+func predictedTestAccess(tx sdk.Tx) TxAccess {
+	return tx.(predictedTestTx).access
+}
+
+func predictedTasks(accesses ...TxAccess) []*deliverTxTask {
+	reqs := requestList(len(accesses))
+	for i, access := range accesses {
+		reqs[i].SdkTx = predictedTestTx{access: access}
+	}
+	tasks, _ := toTasks(reqs)
+	return tasks
+}
+
+func TestConflictPredictorDeclaredKeys(t *testing.T) {
+	p := NewConflictPredictor(predictedTestAccess, nil)
+	deps := p.predictDependencies(predictedTasks(
+		TxAccess{Declared: []string{"evm/a"}},
+		TxAccess{Declared: []string{"evm/b"}},
+		TxAccess{Declared: []string{"evm/a", "evm/b"}},
+		TxAccess{Declared: []string{"evm/a", "evm/a"}},
+		TxAccess{Candidates: []string{"evm/a"}},
+	))
+	require.Equal(t, map[int][]int{
+		2: {0, 1},
+		3: {2},
+	}, deps)
+}
+
+func TestConflictPredictorHotCandidateKeys(t *testing.T) {
+	p := NewConflictPredictor(predictedTestAccess, []string{"bank/fee"})
+	tasks := predictedTasks(
+		TxAccess{Candidates: []string{"bank/fee", "bank/erc20"}},
+		TxAccess{Candidates: []string{"bank/fee"}},
+		TxAccess{Candidates: []string{"bank/erc20"}},
+	)
+	require.Equal(t, map[int][]int{1: {0}}, p.predictDependencies(tasks))
+
+	// Two conflicts in one block make the key hot.
+	p.RecordConflicts(map[string]int{"bank/erc20": 2})
+	require.Equal(t, map[int][]int{1: {0}, 2: {0}}, p.predictDependencies(tasks))
+
+	// Without further conflicts the key cools down again.
+	p.RecordConflicts(nil)
+	require.Equal(t, map[int][]int{1: {0}}, p.predictDependencies(tasks))
+	for range 4 {
+		p.RecordConflicts(nil)
+	}
+	require.Empty(t, p.history)
+}
+
+func TestParseHotKey(t *testing.T) {
+	key, err := ParseHotKey("bank/0a0b")
+	require.NoError(t, err)
+	require.Equal(t, "bank/\x0a\x0b", key)
+
+	key, err = ParseHotKey("acc/globalAccountNumber")
+	require.NoError(t, err)
+	require.Equal(t, "acc/globalAccountNumber", key)
+
+	_, err = ParseHotKey("bank")
+	require.Error(t, err)
+	_, err = ParseHotKey("bank/zz")
+	require.Error(t, err)
+}
+
+func TestProcessAllSequencesPredictedDependencies(t *testing.T) {
+	// A chain longer than maximumIterations must still run in one iteration.
+	const txCount = 2 * maximumIterations
+	accesses := make([]TxAccess, txCount)
+	for i := range accesses {
+		accesses[i] = TxAccess{Declared: []string{testStoreKey.Name() + "/" + string(itemKey)}}
+	}
+	reqs := requestList(txCount)
+	for i, access := range accesses {
+		reqs[i].SdkTx = predictedTestTx{access: access}
+	}
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTxV2, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		val := string(kv.Get(itemKey))
+		kv.Set(itemKey, req.Tx)
+		return types.ResponseDeliverTx{Info: val}
+	}
+	ti := tracing.NewTracingInfo(trace.NewNoopTracerProvider().Tracer("scheduler-test"), true)
+	predictor := NewConflictPredictor(predictedTestAccess, nil)
+	s := NewScheduler(txCount, ti, deliverTx, WithConflictPredictor(predictor))
+	ctx := initTestCtx(true)
+
+	res, err := s.ProcessAll(ctx, reqs)
+	require.NoError(t, err)
+	require.Len(t, res, txCount)
+	for idx, response := range res {
+		if idx == 0 {
+			require.Equal(t, "", response.Info)
+		} else {
+			require.Equal(t, fmt.Sprintf("%d", idx-1), response.Info)
+		}
+	}
+	sched := s.(*scheduler)
+	require.Equal(t, txCount-1, sched.metrics.predicted)
+	require.Zero(t, sched.metrics.retries)
+	require.Zero(t, sched.maxIncarnation)
+	require.False(t, sched.synchronous)
+}
@@ -0,0 +1,199 @@
+package tasks
+
+import (
+	"context"
+	"encoding/hex"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ConflictAttributor names the contract whose state a conflicting key holds,
+// and the storage slot within it, for keys that belong to a contract.
+type ConflictAttributor func(storeName string, key []byte) (contract, slot string, ok bool)
+
+// KeyConflict counts the validation failures one state key caused.
+type KeyConflict struct {
+	Store string
+	// Key is the raw key in hex, or as logged by the scheduler's key conflict
+	// log for keys it does not encode.
+	Key string
+	// Contract and Slot are set when the key is contract storage.
+	Contract  string
+	Slot      string
+	Conflicts int
+}
+
+// ContractConflicts aggregates the conflicts on one contract's keys.
+type ContractConflicts struct {
+	Contract  string
+	Conflicts int
+	// Keys is the number of distinct keys of the contract that conflicted.
+	Keys int
+}
+
+// ConflictReport summarizes OCC contention over the profiler's window. Keys
+// and Contracts are ordered by conflicts, highest first.
+type ConflictReport struct {
+	FromHeight int64
+	ToHeight   int64
+	Blocks     int
+	Txs        int
+	Reruns     int
+	Conflicts  int
+	Keys       []KeyConflict
+	Contracts  []ContractConflicts
+}
+
+// ConflictProfiler aggregates the keys behind OCC validation failures over a
+// sliding window of recent blocks, so that the storage slots serializing a
+// contract's transactions can be inspected on a running node. It is safe for
+// concurrent use.
+type ConflictProfiler struct {
+	window    int
+	attribute ConflictAttributor
+
+	mu sync.Mutex
+	// blocks holds the window, oldest first.
+	blocks []profiledBlock
+}
+
+type profiledBlock struct {
+	height    int64
+	txs       int
+	reruns    int
+	conflicts []KeyConflict
+}
+
+// NewConflictProfiler returns a profiler over the last window blocks. attribute
+// may be nil, in which case no key is attributed to a contract.
+func NewConflictProfiler(window int, attribute ConflictAttributor) *ConflictProfiler {
+	if window < 1 {
+		window = 1
+	}
+	return &ConflictProfiler{window: window, attribute: attribute}
+}
+
+// RecordBlock adds one block to the window, evicting the oldest block once the
+// window is full. It should be called for every block, including blocks
+// without conflicts, so that the window spans the latest blocks.
+func (p *ConflictProfiler) RecordBlock(ctx context.Context, height int64, txs int, reruns int, conflicts []KeyConflict) {
+	for _, c := range conflicts {
+		profilerMetrics.keyConflicts.Add(ctx, int64(c.Conflicts), metric.WithAttributes(attribute.String("store", c.Store)))
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.blocks) == p.window {
+		p.blocks[0] = profiledBlock{}
+		p.blocks = p.blocks[1:]
+	}
+	p.blocks = append(p.blocks, profiledBlock{height: height, txs: txs, reruns: reruns, conflicts: conflicts})
+}
+
+// keyConflicts converts the scheduler's per-key conflict counts, keyed by
+// "<store name>/<raw key>", to attributed key conflicts.
+func (p *ConflictProfiler) keyConflicts(counts map[string]int) []KeyConflict {
+	if len(counts) == 0 {
+		return nil
+	}
+	conflicts := make([]KeyConflict, 0, len(counts))
+	for k, count := range counts {
+		storeName, rawKey, _ := strings.Cut(k, "/")
+		c := KeyConflict{Store: storeName, Key: encodeConflictKey(rawKey), Conflicts: count}
+		if p.attribute != nil {
+			if contract, slot, ok := p.attribute(storeName, []byte(rawKey)); ok {
+				c.Contract, c.Slot = contract, slot
+			}
+		}
+		conflicts = append(conflicts, c)
+	}
+	return conflicts
+}
+
+// Report aggregates the window. A non-empty contract limits the keys to that
+// contract's, matched case-insensitively; a positive limit caps the number of
+// keys and contracts returned.
+func (p *ConflictProfiler) Report(limit int, contract string) ConflictReport {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var report ConflictReport
+	keys := map[[2]string]*KeyConflict{}
+	for _, block := range p.blocks {
+		if report.Blocks == 0 {
+			report.FromHeight = block.height
+		}
+		report.ToHeight = block.height
+		report.Blocks++
+		report.Txs += block.txs
+		report.Reruns += block.reruns
+		for _, c := range block.conflicts {
+			report.Conflicts += c.Conflicts
+			if contract != "" && !strings.EqualFold(c.Contract, contract) {
+				continue
+			}
+			id := [2]string{c.Store, c.Key}
+			if agg, ok := keys[id]; ok {
+				agg.Conflicts += c.Conflicts
+				continue
+			}
+			agg := c
+			keys[id] = &agg
+		}
+	}
+
+	contracts := map[string]*ContractConflicts{}
+	for _, c := range keys {
+		report.Keys = append(report.Keys, *c)
+		if c.Contract == "" {
+			continue
+		}
+		agg, ok := contracts[c.Contract]
+		if !ok {
+			agg = &ContractConflicts{Contract: c.Contract}
+			contracts[c.Contract] = agg
+		}
+		agg.Conflicts += c.Conflicts
+		agg.Keys++
+	}
+	for _, c := range contracts {
+		report.Contracts = append(report.Contracts, *c)
+	}
+	sort.Slice(report.Keys, func(i, j int) bool {
+		a, b := report.Keys[i], report.Keys[j]
+		if a.Conflicts != b.Conflicts {
+			return a.Conflicts > b.Conflicts
+		}
+		if a.Store != b.Store {
+			return a.Store < b.Store
+		}
+		return a.Key < b.Key
+	})
+	sort.Slice(report.Contracts, func(i, j int) bool {
+		a, b := report.Contracts[i], report.Contracts[j]
+		if a.Conflicts != b.Conflicts {
+			return a.Conflicts > b.Conflicts
+		}
+		return a.Contract < b.Contract
+	})
+	if limit > 0 {
+		if len(report.Keys) > limit {
+			report.Keys = report.Keys[:limit]
+		}
+		if len(report.Contracts) > limit {
+			report.Contracts = report.Contracts[:limit]
+		}
+	}
+	return report
+}
+
+// encodeConflictKey renders a raw conflict key the way the scheduler's key
+// conflict log does.
+func encodeConflictKey(rawKey string) string {
+	if rawKey == "globalAccountNumber" {
+		return rawKey
+	}
+	return hex.EncodeToString([]byte(rawKey))
+}
@@ -0,0 +1,80 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/utils/tracing"
+)
+
+func testAttributor(storeName string, key []byte) (string, string, bool) {
+	if storeName != "evm" || len(key) < 2 {
+		return "", "", false
+	}
+	return "0xC" + string(key[:1]), "0x" + string(key[1:]), true
+}
+
+func TestConflictProfilerWindow(t *testing.T) {
+	p := NewConflictProfiler(2, testAttributor)
+	ctx := context.Background()
+	p.RecordBlock(ctx, 1, 10, 4, p.keyConflicts(map[string]int{"evm/a1": 4}))
+	p.RecordBlock(ctx, 2, 10, 3, p.keyConflicts(map[string]int{"evm/a1": 1, "evm/a2": 2, "bank/x": 1}))
+	p.RecordBlock(ctx, 3, 5, 0, nil)
+
+	report := p.Report(0, "")
+	require.Equal(t, int64(2), report.FromHeight)
+	require.Equal(t, int64(3), report.ToHeight)
+	require.Equal(t, 2, report.Blocks)
+	require.Equal(t, 15, report.Txs)
+	require.Equal(t, 3, report.Reruns)
+	require.Equal(t, 4, report.Conflicts)
+	require.Equal(t, []KeyConflict{
+		{Store: "evm", Key: "6132", Contract: "0xCa", Slot: "0x2", Conflicts: 2},
+		{Store: "bank", Key: "78", Conflicts: 1},
+		{Store: "evm", Key: "6131", Contract: "0xCa", Slot: "0x1", Conflicts: 1},
+	}, report.Keys)
+	require.Equal(t, []ContractConflicts{{Contract: "0xCa", Conflicts: 3, Keys: 2}}, report.Contracts)
+
+	filtered := p.Report(1, "0xca")
+	require.Equal(t, []KeyConflict{
+		{Store: "evm", Key: "6132", Contract: "0xCa", Slot: "0x2", Conflicts: 2},
+	}, filtered.Keys)
+	require.Equal(t, []ContractConflicts{{Contract: "0xCa", Conflicts: 3, Keys: 2}}, filtered.Contracts)
+}
+
+func TestProcessAllRecordsConflictProfile(t *testing.T) {
+	const txCount = 8
+	deliverTx := func(ctx sdk.Context, req types.RequestDeliverTxV2, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx) {
+		defer abortRecoveryFunc(&res)
+		kv := ctx.MultiStore().GetKVStore(testStoreKey)
+		val := string(kv.Get(itemKey))
+		kv.Set(itemKey, req.Tx)
+		return types.ResponseDeliverTx{Info: val}
+	}
+	ti := tracing.NewTracingInfo(trace.NewNoopTracerProvider().Tracer("scheduler-test"), true)
+	profiler := NewConflictProfiler(10, nil)
+	s := NewScheduler(txCount, ti, deliverTx, WithConflictProfiler(profiler))
+	ctx := initTestCtx(true)
+
+	_, err := s.ProcessAll(ctx, requestList(txCount))
+	require.NoError(t, err)
+
+	sched := s.(*scheduler)
+	conflicts := 0
+	for _, count := range sched.conflictKeyCounts {
+		conflicts += count
+	}
+	report := profiler.Report(0, "")
+	require.Equal(t, 1, report.Blocks)
+	require.Equal(t, txCount, report.Txs)
+	require.Equal(t, sched.metrics.retries, report.Reruns)
+	require.Equal(t, conflicts, report.Conflicts)
+	for _, k := range report.Keys {
+		require.Equal(t, testStoreKey.Name(), k.Store)
+	}
+}
@@ -3,13 +3,13 @@ package tasks
 import (
 	"context"
 	"crypto/sha256"
-	"encoding/hex"
 	"fmt"
 	"os"
 	"runtime/debug"
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/sei-protocol/sei-chain/sei-cosmos/store/multiversion"
@@ -63,6 +63,13 @@ type deliverTxTask struct {
 	Response      *types.ResponseDeliverTx
 	VersionStores map[sdk.StoreKey]*multiversion.VersionIndexedStore
 	TxTracer      sdk.TxTracer
+	// predictedNext are the tasks predicted to conflict with this one. Their
+	// first incarnation runs right after this task executes, in the same
+	// iteration.
+	predictedNext []*deliverTxTask
+	// predictedWait counts the predicted dependencies of the task that have
+	// not executed yet.
+	predictedWait atomic.Int32
 }
 
 // AppendDependencies appends the given indexes to the task's dependencies
@@ -121,16 +128,42 @@ type scheduler struct {
 	maxIncarnation     int            // current highest incarnation
 	conflictKeyCounts  map[string]int // per-key conflict counts accumulated over the block
 	conflictKeyMu      sync.Mutex
+	predictor          *ConflictPredictor // optional pre-scheduling conflict prediction
+	profiler           *ConflictProfiler  // optional per-key contention profiling
+}
+
+// SchedulerOption configures optional scheduler behavior.
+type SchedulerOption func(*scheduler)
+
+// WithConflictPredictor sequences the transactions p predicts to conflict
+// before they run, and feeds each block's observed conflicts back to p. A nil
+// p disables prediction.
+func WithConflictPredictor(p *ConflictPredictor) SchedulerOption {
+	return func(s *scheduler) {
+		s.predictor = p
+	}
+}
+
+// WithConflictProfiler records each block's conflicting keys with p. A nil p
+// disables profiling.
+func WithConflictProfiler(p *ConflictProfiler) SchedulerOption {
+	return func(s *scheduler) {
+		s.profiler = p
+	}
 }
 
 // NewScheduler creates a new scheduler
-func NewScheduler(workers int, tracingInfo *tracing.Info, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTxV2, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx)) Scheduler {
-	return &scheduler{
+func NewScheduler(workers int, tracingInfo *tracing.Info, deliverTxFunc func(ctx sdk.Context, req types.RequestDeliverTxV2, tx sdk.Tx, checksum [32]byte) (res types.ResponseDeliverTx), opts ...SchedulerOption) Scheduler {
+	s := &scheduler{
 		workers:     workers,
 		deliverTx:   deliverTxFunc,
 		tracingInfo: tracingInfo,
 		metrics:     &schedulerMetrics{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *scheduler) invalidateTask(task *deliverTxTask) {
@@ -265,6 +298,8 @@ type schedulerMetrics struct {
 	maxIncarnation int
 	// retries is the number of tx attempts beyond the first attempt
 	retries int
+	// predicted is the number of txs chained behind predicted dependencies
+	predicted int
 }
 
 func (s *scheduler) emitMetrics(ctx context.Context) {
@@ -309,6 +344,9 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 	// validation tasks uses length of tasks to avoid blocking on validation
 	start(workerCtx, s.validateCh, len(tasks))
 
+	if s.predictor != nil {
+		s.chainPredictedDependents(tasks)
+	}
 	toExecute := tasks
 	for !allValidated(tasks) {
 		// if the max incarnation >= x, we should revert to synchronous
@@ -335,7 +373,7 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 			return nil, err
 		}
 		// these are retries which apply to metrics
-		s.metrics.retries += len(toExecute)
+		s.metrics.retries += countRetries(toExecute)
 		iterations++
 	}
 
@@ -343,27 +381,55 @@ func (s *scheduler) ProcessAll(ctx sdk.Context, reqs []*sdk.DeliverTxEntry) ([]t
 		mv.WriteLatestToStore()
 	}
 	s.metrics.maxIncarnation = s.maxIncarnation
+	if s.predictor != nil {
+		s.predictor.RecordConflicts(s.conflictKeyCounts)
+	}
+	if s.profiler != nil {
+		s.profiler.RecordBlock(ctx.Context(), ctx.BlockHeight(), len(tasks), s.metrics.retries, s.profiler.keyConflicts(s.conflictKeyCounts))
+	}
 
 	if s.metrics.retries > 0 && len(s.conflictKeyCounts) > 0 {
 		encoded := make(map[string]int, len(s.conflictKeyCounts))
 		for k, v := range s.conflictKeyCounts {
 			storeName, rawKey, _ := strings.Cut(k, "/")
-			var encodedKey string
-			if rawKey == "globalAccountNumber" {
-				encodedKey = storeName + "/" + rawKey
-			} else {
-				encodedKey = storeName + "/" + hex.EncodeToString([]byte(rawKey))
-			}
-			encoded[encodedKey] = v
+			encoded[storeName+"/"+encodeConflictKey(rawKey)] = v
 		}
 		logger.Info("occ scheduler key conflicts", "height", ctx.BlockHeight(), "counts", encoded)
 	}
 
-	logger.Info("occ scheduler", "height", ctx.BlockHeight(), "txs", len(tasks), "latency_ms", time.Since(startTime).Milliseconds(), "retries", s.metrics.retries, "maxIncarnation", s.maxIncarnation, "iterations", iterations, "sync", s.synchronous, "workers", s.workers)
+	logger.Info("occ scheduler", "height", ctx.BlockHeight(), "txs", len(tasks), "latency_ms", time.Since(startTime).Milliseconds(), "retries", s.metrics.retries, "maxIncarnation", s.maxIncarnation, "iterations", iterations, "sync", s.synchronous, "workers", s.workers, "predicted", s.metrics.predicted)
 
 	return s.collectResponses(tasks), nil
 }
 
+// chainPredictedDependents orders the first execution of the tasks the
+// predictor expects to conflict with earlier tasks after those tasks, so that
+// they read their writes instead of being invalidated. A predicted chain runs
+// back to back within the first iteration, so it does not count towards
+// maximumIterations however long it is.
+func (s *scheduler) chainPredictedDependents(tasks []*deliverTxTask) {
+	predicted := s.predictor.predictDependencies(tasks)
+	for idx, deps := range predicted {
+		task := s.allTasksMap[idx]
+		task.predictedWait.Store(int32(len(deps))) //nolint:gosec // bounded by the number of txs in a block
+		for _, dep := range deps {
+			prev := s.allTasksMap[dep]
+			prev.predictedNext = append(prev.predictedNext, task)
+		}
+	}
+	s.metrics.predicted = len(predicted)
+}
+
+func countRetries(tasks []*deliverTxTask) int {
+	retries := 0
+	for _, t := range tasks {
+		if t.Incarnation > 0 {
+			retries++
+		}
+	}
+	return retries
+}
+
 func (s *scheduler) shouldRerun(task *deliverTxTask) bool {
 	switch task.Status {
 
@@ -476,6 +542,10 @@ func (s *scheduler) executeAll(ctx sdk.Context, tasks []*deliverTxTask) error {
 
 	for _, task := range tasks {
 		t := task
+		if t.predictedWait.Load() > 0 {
+			// runs once its predicted dependencies have executed
+			continue
+		}
 		s.DoExecute(func() {
 			s.prepareAndRunTask(wg, ctx, t)
 		})
@@ -494,6 +564,17 @@ func (s *scheduler) prepareAndRunTask(wg *sync.WaitGroup, ctx sdk.Context, task
 
 	task.Ctx = eCtx
 	s.executeTask(task)
+
+	// start the predicted dependents whose predicted dependencies have all executed
+	next := task.predictedNext
+	task.predictedNext = nil
+	for _, t := range next {
+		if t.predictedWait.Add(-1) == 0 {
+			s.DoExecute(func() {
+				s.prepareAndRunTask(wg, ctx, t)
+			})
+		}
+	}
 }
 
 func (s *scheduler) traceSpan(ctx sdk.Context, name string, task *deliverTxTask) (sdk.Context, trace.Span) {
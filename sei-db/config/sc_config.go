@@ -105,6 +105,10 @@ type StateCommitConfig struct {
 	// HashLogger configures the per-block hash logger (a debugging/forensics tool). Enabled by default.
 	// Loaded via explicit sc-hash-logger-* flag reads in app.parseSCConfigs, not mapstructure.
 	HashLogger HashLoggerConfig
+
+	// StateSize configures per-module state size accounting and growth snapshots. Disabled by default.
+	// Loaded via explicit sc-state-size-* flag reads in app.parseSCConfigs, not mapstructure.
+	StateSize StateSizeConfig
 }
 
 // DefaultStateCommitConfig returns the default StateCommitConfig
@@ -119,6 +123,7 @@ func DefaultStateCommitConfig() StateCommitConfig {
 		HistoricalProofRateLimit:   DefaultSCHistoricalProofRateLimit,
 		HistoricalProofBurst:       DefaultSCHistoricalProofBurst,
 		HashLogger:                 DefaultHashLoggerConfig(),
+		StateSize:                  DefaultStateSizeConfig(),
 	}
 }
 
@@ -33,6 +33,15 @@ type StateStoreConfig struct {
 	// defaults to pebbledb
 	Backend string `mapstructure:"backend"`
 
+	// MigrateToBackend, when set to a backend other than Backend, migrates every SS
+	// database to that backend while the node runs. New versions are written to
+	// both backends, history is copied across in the background from a cursor
+	// that survives restarts, and reads move to the new backend once the copy has
+	// been verified. The operator finishes the migration by setting Backend to
+	// this value and clearing it. Incompatible with SnapshotEnable.
+	// defaults to empty (no migration)
+	MigrateToBackend string `mapstructure:"migrate-to-backend"`
+
 	// AsyncWriteBuffer defines the async queue length for commits to be applied to State Store
 	// Set <= 0 for synchronous writes, which means commits also need to wait for data to be persisted in State Store.
 	// defaults to 100
@@ -0,0 +1,41 @@
+package config
+
+// StateSizeConfig configures continuous state size accounting: per-module and per-key-prefix key
+// counts and byte totals for the state commitment (live state) and the state store (bytes written),
+// maintained at every commit, plus periodic growth snapshots naming the modules, prefixes and EVM
+// contracts whose state grew the most over each interval.
+type StateSizeConfig struct {
+	// These fields are loaded by explicit flag reads in app.parseSCConfigs (keys: sc-state-size-*),
+	// not via mapstructure, so they carry no mapstructure tags.
+
+	// Enable turns on state size accounting. Each committed write costs one extra read of the value
+	// it replaces. Defaults to false.
+	Enable bool
+
+	// Directory is where the running totals and the growth history are kept. If empty, defaults to a
+	// "state-size" directory under the state-commit store's data directory.
+	Directory string
+
+	// GrowthInterval is the number of blocks between growth snapshots. The running totals are also
+	// persisted at each snapshot, so a node restarted at the height it last persisted resumes without
+	// a rescan. 0 disables growth snapshots, and the totals are then only persisted on a clean shutdown.
+	GrowthInterval uint
+
+	// GrowthRetain is the number of most-recent growth snapshots to keep. 0 keeps every snapshot.
+	GrowthRetain uint
+
+	// TopContributors is how many of the largest contributors to growth each snapshot names. A
+	// contributor is an EVM contract for EVM storage slots and a module key prefix otherwise.
+	TopContributors uint
+}
+
+// DefaultStateSizeConfig returns the default StateSizeConfig: disabled, with roughly hourly growth
+// snapshots retained for 30 days once enabled.
+func DefaultStateSizeConfig() StateSizeConfig {
+	return StateSizeConfig{
+		Enable:          false,
+		GrowthInterval:  9000,
+		GrowthRetain:    720,
+		TopContributors: 20,
+	}
+}
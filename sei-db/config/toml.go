@@ -88,6 +88,25 @@ sc-hash-logger-target-file-size = {{ .StateCommit.HashLogger.TargetFileSize }}
 # (block-count retention only).
 sc-hash-logger-max-disk-size = {{ .StateCommit.HashLogger.MaxDiskSize }}
 
+# StateSize keeps per-module and per-key-prefix key counts and byte sizes for the state commitment
+# (live state) and the state store (bytes written), updated at every commit and exported as metrics
+# and through the admin gRPC server. Each committed write costs one extra read. Disabled by default.
+sc-state-size-enable = {{ .StateCommit.StateSize.Enable }}
+
+# Directory for the running totals and growth history. If empty, defaults to a "state-size" directory
+# under the SC store's data directory (i.e. <home>/data/state-size).
+sc-state-size-directory = "{{ .StateCommit.StateSize.Directory }}"
+
+# Number of blocks between growth snapshots, each naming the modules, key prefixes and EVM contracts
+# whose state grew the most since the last one. 0 disables growth snapshots.
+sc-state-size-growth-interval = {{ .StateCommit.StateSize.GrowthInterval }}
+
+# Number of most-recent growth snapshots to keep. 0 keeps every snapshot.
+sc-state-size-growth-retain = {{ .StateCommit.StateSize.GrowthRetain }}
+
+# Number of largest contributors to growth named in each snapshot.
+sc-state-size-top-contributors = {{ .StateCommit.StateSize.TopContributors }}
+
 ###############################################################################
 ###                        FlatKV (EVM) Configuration                       ###
 ###############################################################################
@@ -117,6 +136,15 @@ ss-db-directory = "{{ .StateStore.DBDirectory }}"
 # defaults to pebbledb (recommended)
 ss-backend = "{{ .StateStore.Backend }}"
 
+# MigrateToBackend migrates the state store to another backend without a resync.
+# New blocks are written to both backends while history is copied across in the
+# background; reads switch over once the copy is verified. Progress is reported
+# by "seidb ss-migration-status". When it reports cut_over, set ss-backend to
+# this value and clear this one. Each database's copy sits beside it, in the
+# directory named for the new backend. Cannot be combined with ss-snapshot-enable.
+# defaults to empty (no migration)
+ss-migrate-to-backend = "{{ .StateStore.MigrateToBackend }}"
+
 # AsyncWriteBuffer defines the async queue length for commits to be applied to State Store
 # Set <= 0 for synchronous writes, which means commits also need to wait for data to be persisted in State Store.
 # defaults to 100 for asynchronous writes
@@ -1284,6 +1284,33 @@ func (db *Database) RawIterate(storeKey string, fn func(key []byte, value []byte
 	return false, nil
 }
 
+// StoreKeys returns every store key with at least one row, live or tombstoned, in
+// key order. It seeks past each store once its name is known, so the cost is one
+// seek per store rather than a scan of the data.
+func (db *Database) StoreKeys() ([]string, error) {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: []byte(PrefixStore),
+		UpperBound: prefixEnd([]byte(PrefixStore)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PebbleDB iterator: %w", err)
+	}
+	defer func() { _ = itr.Close() }()
+
+	var storeKeys []string
+	for valid := itr.First(); valid; valid = itr.SeekGE(prefixEnd(storePrefix(storeKeys[len(storeKeys)-1]))) {
+		storeKey, err := parseStoreKey(itr.Key())
+		if err != nil {
+			return nil, err
+		}
+		storeKeys = append(storeKeys, storeKey)
+	}
+	if err := itr.Error(); err != nil {
+		return nil, fmt.Errorf("failed to list store keys: %w", err)
+	}
+	return storeKeys, nil
+}
+
 func (db *Database) DeleteKeysAtVersion(module string, version int64) error {
 
 	batch, err := NewBatch(db.storage, version, db.descending, db.operationMetrics)
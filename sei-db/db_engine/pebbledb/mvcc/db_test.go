@@ -12,6 +12,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-db/controller"
 	sstest "github.com/sei-protocol/sei-chain/sei-db/db_engine/test"
 	"github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
 )
 
 func TestStorageTestSuite(t *testing.T) {
@@ -104,3 +105,36 @@ func TestScheduledCheckpointCanBeCanceledAtBarrier(t *testing.T) {
 	require.ErrorIs(t, <-done, controller.ErrCheckpointCanceled)
 	require.NoDirExists(t, dest)
 }
+
+func TestStoreKeysListsEveryStoreWithRows(t *testing.T) {
+	cfg := config.DefaultStateStoreConfig()
+	cfg.Backend = config.PebbleDBBackend
+
+	store, err := OpenDB(filepath.Join(t.TempDir(), "db"), cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+
+	changeset := func(name string, key, value []byte) *proto.NamedChangeSet {
+		return &proto.NamedChangeSet{
+			Name:      name,
+			Changeset: proto.ChangeSet{Pairs: []*proto.KVPair{{Key: key, Value: value}}},
+		}
+	}
+	require.NoError(t, store.ApplyChangesetSync(1, []*proto.NamedChangeSet{
+		changeset("staking", []byte("a"), []byte("1")),
+		changeset("bank", []byte("a"), []byte("1")),
+		changeset("bank", []byte("b"), []byte("2")),
+		changeset("bank0", []byte("a"), []byte("1")),
+	}))
+	// A store whose only key is deleted still holds a tombstone row.
+	require.NoError(t, store.ApplyChangesetSync(2, []*proto.NamedChangeSet{
+		changeset("acc", []byte("a"), []byte("1")),
+	}))
+	require.NoError(t, store.ApplyChangesetSync(3, []*proto.NamedChangeSet{
+		changeset("acc", []byte("a"), nil),
+	}))
+
+	storeKeys, err := store.(types.StoreKeyLister).StoreKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"acc", "bank", "bank0", "staking"}, storeKeys)
+}
@@ -447,6 +447,45 @@ func (db *Database) RawIterate(storeKey string, fn func(key []byte, value []byte
 	return false, nil
 }
 
+// StoreKeys returns every store key with at least one row, live or deleted, in key
+// order. The iterator spans all timestamps, as RawIterate's does, so a store whose
+// keys are all deleted at the latest version is still listed.
+func (db *Database) StoreKeys() ([]string, error) {
+	startTs := make([]byte, TimestampSize)
+	endTs := make([]byte, TimestampSize)
+	binary.LittleEndian.PutUint64(endTs, uint64(db.latestVersion.Load()))
+
+	readOpts := grocksdb.NewDefaultReadOptions()
+	defer readOpts.Destroy()
+	readOpts.SetIterStartTimestamp(startTs)
+	readOpts.SetTimestamp(endTs)
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer itr.Close()
+
+	prefix := []byte("s/k:")
+	var storeKeys []string
+	for itr.Seek(prefix); itr.Valid(); {
+		key := copyAndFreeSlice(itr.Key())
+		if !bytes.HasPrefix(key, prefix) {
+			break
+		}
+		rest := key[len(prefix):]
+		slash := bytes.IndexByte(rest, '/')
+		if slash < 0 {
+			return nil, fmt.Errorf("not a valid store key: %q", key)
+		}
+		storeKey := string(rest[:slash])
+		storeKeys = append(storeKeys, storeKey)
+		// '/' + 1 is '0', so this is the first key past every row of storeKey.
+		itr.Seek([]byte(fmt.Sprintf("s/k:%s0", storeKey)))
+	}
+	if err := itr.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list store keys: %w", err)
+	}
+	return storeKeys, nil
+}
+
 // newTSReadOptions returns ReadOptions used in the RocksDB column family read.
 func newTSReadOptions(version int64) *grocksdb.ReadOptions {
 	ts := make([]byte, TimestampSize)
@@ -146,6 +146,14 @@ type PendingWriteWaiter interface {
 	WaitForPendingWrites()
 }
 
+// StoreKeyLister is an optional capability for engines that can name every store
+// key they hold rows for. StateStore is always addressed one store key at a time,
+// so a caller that has to walk all of them, such as a backend migration, cannot
+// learn the names through it.
+type StoreKeyLister interface {
+	StoreKeys() ([]string, error)
+}
+
 // SnapshotWALPruner is an optional capability for engines that keep a changelog
 // WAL beside snapshotable state. It lets snapshot retention keep the WAL anchored
 // to the oldest retained snapshot instead of to a fixed count of recent entries.
@@ -2,6 +2,7 @@ package seiwal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -1142,6 +1143,74 @@ func TestGetRange(t *testing.T) {
 	})
 }
 
+func TestReadRecords(t *testing.T) {
+	t.Run("empty directory visits nothing", func(t *testing.T) {
+		require.NoError(t, ReadRecords(t.TempDir(), func(uint64, []byte) error {
+			t.Fatal("unexpected record")
+			return nil
+		}))
+	})
+
+	t.Run("reads sealed and unsealed files of an open WAL", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := testConfig(dir)
+		cfg.TargetFileSize = 1 // one record per sealed file
+		w := openWAL(t, cfg)
+		defer func() { require.NoError(t, w.Close()) }()
+		for index := uint64(1); index <= 5; index++ {
+			appendRecord(t, w, index)
+		}
+		require.NoError(t, w.Flush())
+		before := sealedFileNames(t, dir)
+
+		// The open WAL holds the directory lock; ReadRecords does not need it.
+		var indices []uint64
+		require.NoError(t, ReadRecords(dir, func(index uint64, payload []byte) error {
+			require.Equal(t, recordPayload(index), payload)
+			indices = append(indices, index)
+			return nil
+		}))
+		require.Equal(t, []uint64{1, 2, 3, 4, 5}, indices)
+		require.Equal(t, before, sealedFileNames(t, dir))
+	})
+
+	t.Run("does not seal an unsealed orphan", func(t *testing.T) {
+		dir := t.TempDir()
+		f, err := newWalFile(dir, 0)
+		require.NoError(t, err)
+		writeRecordTo(t, f, 1, recordPayload(1))
+		writeRecordTo(t, f, 2, recordPayload(2))
+		require.NoError(t, f.flush(true))
+		require.NoError(t, f.file.Close())
+
+		var indices []uint64
+		require.NoError(t, ReadRecords(dir, func(index uint64, _ []byte) error {
+			indices = append(indices, index)
+			return nil
+		}))
+		require.Equal(t, []uint64{1, 2}, indices)
+		require.Empty(t, sealedFileNames(t, dir))
+	})
+
+	t.Run("stops at the first visit error", func(t *testing.T) {
+		dir := t.TempDir()
+		w := openWAL(t, testConfig(dir))
+		for index := uint64(1); index <= 3; index++ {
+			appendRecord(t, w, index)
+		}
+		require.NoError(t, w.Close())
+
+		errStop := errors.New("stop")
+		calls := 0
+		err := ReadRecords(dir, func(uint64, []byte) error {
+			calls++
+			return errStop
+		})
+		require.ErrorIs(t, err, errStop)
+		require.Equal(t, 1, calls)
+	})
+}
+
 func TestPruneAfter(t *testing.T) {
 	t.Run("drops whole files beyond the rollback point", func(t *testing.T) {
 		dir := t.TempDir()
@@ -166,3 +166,77 @@ func VerifyIntegrity(path string) error {
 	}
 	return errors.Join(problems...)
 }
+
+// readRecordsAttempts bounds how many times ReadRecords re-lists a WAL directory which changes while it is read.
+const readRecordsAttempts = 3
+
+// ReadRecords calls visit with every intact record stored in the WAL directory at path, in file order (and so
+// in ascending index order), without constructing a live WAL instance. Unlike the other offline operations, it
+// neither takes the directory lock nor runs the recovery pass, and never modifies the directory, so it can read
+// the WAL of a running process. A torn trailing record in the unsealed file is skipped, as recovery would
+// discard it.
+//
+// The WAL may change while it is read. A file sealed or deleted between listing and reading makes the
+// directory be listed again; records appended after a file is read are missed. The payload passed to visit is
+// only valid for the duration of the call.
+func ReadRecords(path string, visit func(index uint64, payload []byte) error) error {
+	for range readRecordsAttempts {
+		contents, err := readAllFiles(path)
+		if errors.Is(err, errWalFileVanished) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, c := range contents {
+			for _, r := range c.records {
+				if err := visit(r.index, r.payload); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("WAL directory %s kept changing while being read", path)
+}
+
+// errWalFileVanished reports that a WAL file was sealed or pruned between listing its directory and reading it.
+var errWalFileVanished = errors.New("WAL file vanished while being read")
+
+// readAllFiles reads every WAL file in the directory at path, ordered by file sequence number. It returns
+// errWalFileVanished if a listed file disappears before it is read.
+func readAllFiles(path string) ([]*walFileContents, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL directory %s: %w", path, err)
+	}
+	var names []string
+	var parsed []parsedFileName
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if p, ok := parseFileName(entry.Name()); ok {
+			names = append(names, entry.Name())
+			parsed = append(parsed, p)
+		}
+	}
+	order := make([]int, len(names))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i int, j int) bool { return parsed[order[i]].fileSeq < parsed[order[j]].fileSeq })
+
+	contents := make([]*walFileContents, 0, len(names))
+	for _, i := range order {
+		c, err := readWalFile(filepath.Join(path, names[i]))
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errWalFileVanished
+		}
+		if err != nil {
+			return nil, err
+		}
+		contents = append(contents, c)
+	}
+	return contents, nil
+}
@@ -1097,6 +1097,36 @@ func (cs *CompositeCommitStore) LastCommitInfo() *proto.CommitInfo {
 	return ci
 }
 
+// FlatKVHistory returns the flatkv backend when it serves every read of moduleName, so historical reads
+// of that module inside its history window (see flatkv.Store.HistoryRange) can be served from it instead
+// of SS. The evm module is served once the EVM migration has completed, bank only in FlatKVOnly, and the
+// other modules of keys.MemIAVLStoreKeys once all but bank have migrated. It returns nil while any key of the module may still
+// live in memiavl.
+func (cs *CompositeCommitStore) FlatKVHistory(moduleName string) flatkv.Store {
+	if cs.flatKV == nil {
+		return nil
+	}
+	var served bool
+	switch moduleName {
+	case keys.EVMStoreKey:
+		switch cs.currentWriteMode {
+		case types.EVMMigrated, types.MigrateAllButBank, types.AllMigratedButBank, types.MigrateBank, types.FlatKVOnly:
+			served = true
+		}
+	case keys.BankStoreKey:
+		served = cs.currentWriteMode == types.FlatKVOnly
+	default:
+		switch cs.currentWriteMode {
+		case types.AllMigratedButBank, types.MigrateBank, types.FlatKVOnly:
+			served = keys.IsMemIAVLStoreKey(moduleName)
+		}
+	}
+	if !served {
+		return nil
+	}
+	return cs.flatKV
+}
+
 // GetChildStoreByName returns the underlying child store by module name.
 // Panics if the store name is not supported by the current write mode.
 //
@@ -45,7 +45,11 @@ func (f *failingEVMStore) Get(string, []byte) ([]byte, bool) { return nil, false
 func (f *failingEVMStore) GetBlockHeightModified(string, []byte) (int64, bool, error) {
 	return -1, false, nil
 }
+func (f *failingEVMStore) GetAt(int64, string, []byte) ([]byte, bool, error) {
+	return nil, false, nil
+}
 func (f *failingEVMStore) Has(string, []byte) bool                  { return false }
+func (f *failingEVMStore) HistoryRange() (int64, int64)             { return 0, 0 }
 func (f *failingEVMStore) RawGlobalIterator() (dbm.Iterator, error) { return nil, nil }
 func (f *failingEVMStore) Iterator(string, []byte, []byte, bool) (dbm.Iterator, error) {
 	return nil, nil
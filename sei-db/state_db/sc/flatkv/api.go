@@ -81,6 +81,16 @@ type Store interface {
 	// Has reports whether the key exists within the given module.
 	Has(moduleName string, key []byte) bool
 
+	// GetAt returns the value a key held at version (0 = latest committed), with Get's key and value
+	// semantics. Only committed state is visible. Versions below the latest are served from the
+	// reverse-delta history kept for the last Config.HistoryWindow versions; a version outside
+	// HistoryRange is an error.
+	GetAt(version int64, moduleName string, key []byte) (value []byte, found bool, err error)
+
+	// HistoryRange returns the oldest and newest versions GetAt can serve. With history disabled both
+	// are the latest committed version.
+	HistoryRange() (earliest int64, latest int64)
+
 	// RawGlobalIterator returns a positioned forward iterator over all committed
 	// keys across underlying data DBs, merged in global lexicographic order.
 	// Keys are physical format: "evm/" + type_prefix_byte + stripped_key.
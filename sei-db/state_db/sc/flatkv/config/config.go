@@ -55,6 +55,14 @@ type Config struct {
 	// Default: false
 	ExternalPruning bool `mapstructure:"-"`
 
+	// HistoryWindow is how many versions below the latest FlatKV can serve reads at (see GetAt). Each
+	// commit records the prior value of every key it writes (a reverse delta) in a separate history DB,
+	// and deltas older than the window are pruned as new versions commit. 0 disables history.
+	// Historical queries of the modules FlatKV serves are answered from the window, so a node whose
+	// modules all live in FlatKV can serve recent heights with SS disabled.
+	// Default: 0
+	HistoryWindow int64 `mapstructure:"history-window"`
+
 	// EnablePebbleMetrics defines if the Pebble metrics should be enabled.
 	// Default: true
 	EnablePebbleMetrics bool `mapstructure:"enable-pebble-metrics"`
@@ -87,6 +95,10 @@ type Config struct {
 	// MiscCacheConfig defines the cache configuration for the misc database.
 	MiscCacheConfig dbcache.CacheConfig
 
+	// HistoryDBConfig defines the PebbleDB configuration for the reverse-delta history database.
+	// Only opened when HistoryWindow > 0.
+	HistoryDBConfig pebbledb.PebbleDBConfig
+
 	// Controls the number of goroutines in the DB read pool. The number of threads in this pool is equal to
 	// ReaderThreadsPerCore * runtime.NumCPU() + ReaderConstantThreadCount.
 	ReaderThreadsPerCore float64
@@ -129,6 +141,7 @@ func DefaultConfig() *Config {
 		StorageCacheConfig:        dbcache.DefaultCacheConfig(),
 		MiscDBConfig:              pebbledb.DefaultConfig(),
 		MiscCacheConfig:           dbcache.DefaultCacheConfig(),
+		HistoryDBConfig:           pebbledb.DefaultConfig(),
 		ReaderThreadsPerCore:      2.0,
 		ReaderConstantThreadCount: 0,
 		ReaderPoolQueueSize:       1024,
@@ -180,6 +193,15 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("misc db config is invalid: %w", err)
 	}
 
+	if c.HistoryWindow < 0 {
+		return fmt.Errorf("history window must not be negative")
+	}
+	if c.HistoryWindow > 0 {
+		if err := c.HistoryDBConfig.Validate(); err != nil {
+			return fmt.Errorf("history db config is invalid: %w", err)
+		}
+	}
+
 	if c.ReaderThreadsPerCore <= 0 {
 		return fmt.Errorf("reader threads per core must be greater than 0")
 	}
@@ -129,3 +129,26 @@ func TestValidateNestedCacheConfigError(t *testing.T) {
 	require.Contains(t, err.Error(), "storage cache config is invalid")
 	require.Contains(t, err.Error(), "shard count must be a non-zero power of two")
 }
+
+func TestValidateNegativeHistoryWindow(t *testing.T) {
+	cfg := validBaseConfig()
+	cfg.HistoryWindow = -1
+	err := cfg.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "history window")
+}
+
+func TestValidateHistoryDBConfigOnlyWhenEnabled(t *testing.T) {
+	// The history DB's data dir is filled in by InitializeDataDirectories; with
+	// history disabled an unset one must not fail validation.
+	cfg := validBaseConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.HistoryWindow = 100
+	err := cfg.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "history db config is invalid")
+
+	cfg.HistoryDBConfig.DataDir = "/tmp/test/history"
+	require.NoError(t, cfg.Validate())
+}
@@ -38,6 +38,7 @@ func DefaultTestConfig(t *testing.T) *Config {
 		StorageCacheConfig:     smallTestCacheConfig(),
 		MiscDBConfig:           smallTestPebbleConfig(),
 		MiscCacheConfig:        smallTestCacheConfig(),
+		HistoryDBConfig:        smallTestPebbleConfig(),
 		ReaderThreadsPerCore:   2.0,
 		ReaderPoolQueueSize:    1024,
 		MiscPoolThreadsPerCore: 4.0,
@@ -0,0 +1,558 @@
+package flatkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"slices"
+
+	errorutils "github.com/sei-protocol/sei-chain/sei-db/common/errors"
+	"github.com/sei-protocol/sei-chain/sei-db/common/keys"
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/pebbledb"
+	seidbtypes "github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/ktype"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/vtype"
+)
+
+// The history DB holds reverse deltas: for every key a block writes, the serialized row the key held
+// just before that block. It lives beside the snapshots rather than inside them (<DataDir>/history), so
+// snapshots and state-sync exports are unaffected by it.
+//
+// Layout:
+//
+//	d/ + len(physKey) (2 bytes) + physKey + version (8 bytes) -> row before version (see encodeHistoryRow)
+//	v/ + version (8 bytes) + physKey                          -> empty; indexes d/ rows by version for pruning
+//	m/floor                                                   -> oldest version GetAt can serve
+//	m/tip                                                     -> newest version whose deltas are recorded
+//
+// The length prefix keeps every delta of one key contiguous even when another key extends it, so a
+// single seek finds the oldest delta above a target version.
+const historyDBDir = "history"
+
+var (
+	historyDeltaPrefix   = []byte("d/")
+	historyVersionPrefix = []byte("v/")
+	historyFloorKey      = []byte("m/floor")
+	historyTipKey        = []byte("m/tip")
+)
+
+// historyRowAbsent and historyRowPresent tag a recorded prior row: the key either did not exist before
+// the block, or held the bytes that follow the tag.
+const (
+	historyRowAbsent  byte = 0
+	historyRowPresent byte = 1
+)
+
+// historyDeltaKeyPrefix returns the prefix shared by every delta of physKey.
+func historyDeltaKeyPrefix(physKey []byte) ([]byte, error) {
+	if len(physKey) > math.MaxUint16 {
+		return nil, fmt.Errorf("flatkv: physical key of %d bytes is too long for history", len(physKey))
+	}
+	out := make([]byte, 0, len(historyDeltaPrefix)+2+len(physKey)+8)
+	out = append(out, historyDeltaPrefix...)
+	out = binary.BigEndian.AppendUint16(out, uint16(len(physKey))) //nolint:gosec // bounded above
+	return append(out, physKey...), nil
+}
+
+func historyDeltaKey(physKey []byte, version int64) ([]byte, error) {
+	prefix, err := historyDeltaKeyPrefix(physKey)
+	if err != nil {
+		return nil, err
+	}
+	return append(prefix, versionToBytes(version)...), nil
+}
+
+func historyVersionKey(version int64, physKey []byte) []byte {
+	out := make([]byte, 0, len(historyVersionPrefix)+8+len(physKey))
+	out = append(out, historyVersionPrefix...)
+	out = append(out, versionToBytes(version)...)
+	return append(out, physKey...)
+}
+
+// parseHistoryVersionKey splits a v/ index key into its version and physical key.
+func parseHistoryVersionKey(key []byte) (int64, []byte, error) {
+	rest, ok := bytes.CutPrefix(key, historyVersionPrefix)
+	if !ok || len(rest) < 8 {
+		return 0, nil, fmt.Errorf("flatkv: malformed history index key %x", key)
+	}
+	version, err := decodeVersion(key, rest[:8])
+	if err != nil {
+		return 0, nil, err
+	}
+	return version, rest[8:], nil
+}
+
+func encodeHistoryRow(row []byte, found bool) []byte {
+	if !found {
+		return []byte{historyRowAbsent}
+	}
+	out := make([]byte, 0, 1+len(row))
+	out = append(out, historyRowPresent)
+	return append(out, row...)
+}
+
+func decodeHistoryRow(value []byte) (row []byte, found bool, err error) {
+	if len(value) == 0 {
+		return nil, false, fmt.Errorf("flatkv: empty history row")
+	}
+	switch value[0] {
+	case historyRowAbsent:
+		return nil, false, nil
+	case historyRowPresent:
+		return value[1:], true, nil
+	default:
+		return nil, false, fmt.Errorf("flatkv: unknown history row tag %d", value[0])
+	}
+}
+
+// openHistory opens the history DB and reconciles it with the committed version the data DBs were
+// opened at. It must run before WAL replay: replay records the deltas of every block it re-applies, and
+// those must land on a history that ends at the version replay starts from.
+//
+//   - No history yet: history starts at the committed version.
+//   - History ahead of the data DBs (a crash between the history write and the data commit, or a
+//     rollback): deltas above the committed version describe blocks that are about to be replayed or were
+//     discarded, so they are dropped.
+//   - History behind the data DBs (history was disabled for a while): the gap cannot be reconstructed,
+//     so history restarts at the committed version. Deltas below it are pruned as the window moves.
+//
+// A no-op when HistoryWindow is 0.
+func (s *CommitStore) openHistory() error {
+	if err := s.closeHistory(); err != nil {
+		return err
+	}
+	if s.config.HistoryWindow <= 0 {
+		return nil
+	}
+	if err := os.MkdirAll(s.config.HistoryDBConfig.DataDir, 0750); err != nil {
+		return fmt.Errorf("create history directory: %w", err)
+	}
+	db, err := pebbledb.Open(s.ctx, &s.config.HistoryDBConfig)
+	if err != nil {
+		return fmt.Errorf("open history DB: %w", err)
+	}
+	s.historyDB = db
+
+	floor, tip, found, err := loadHistoryBounds(db)
+	if err != nil {
+		_ = s.closeHistory()
+		return err
+	}
+	committed := s.committedVersion
+	switch {
+	case !found:
+		floor, tip = committed, committed
+	case tip > committed:
+		if err := s.truncateHistoryAbove(committed); err != nil {
+			_ = s.closeHistory()
+			return err
+		}
+		tip = committed
+		floor = min(floor, committed)
+	case tip < committed:
+		logger.Warn("FlatKV history is behind the data DBs, restarting it at the committed version",
+			"historyTip", tip, "committedVersion", committed)
+		floor, tip = committed, committed
+	}
+	if err := writeHistoryBounds(db, floor, tip); err != nil {
+		_ = s.closeHistory()
+		return err
+	}
+	s.historyFloor = floor
+	s.historyTip = tip
+	otelMetrics.HistoryEarliestVersion.Record(s.ctx, floor)
+
+	logger.Info("FlatKV history opened", "earliest", floor, "latest", tip, "window", s.config.HistoryWindow)
+	return nil
+}
+
+// closeHistory closes the history DB, if open.
+func (s *CommitStore) closeHistory() error {
+	if s.historyDB == nil {
+		return nil
+	}
+	err := s.historyDB.Close()
+	s.historyDB = nil
+	if err != nil {
+		return fmt.Errorf("history DB close: %w", err)
+	}
+	return nil
+}
+
+// removeHistory deletes the history DB from disk. Import uses it: an imported state has no past a
+// delta could lead back to.
+func (s *CommitStore) removeHistory() error {
+	if err := s.closeHistory(); err != nil {
+		return err
+	}
+	dir := s.config.HistoryDBConfig.DataDir
+	if dir == "" {
+		return nil
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("remove history DB: %w", err)
+	}
+	return nil
+}
+
+func loadHistoryBounds(db seidbtypes.KeyValueDB) (floor int64, tip int64, found bool, err error) {
+	floorData, err := db.Get(historyFloorKey)
+	if err != nil {
+		if errorutils.IsNotFound(err) {
+			return 0, 0, false, nil
+		}
+		return 0, 0, false, fmt.Errorf("read history floor: %w", err)
+	}
+	tipData, err := db.Get(historyTipKey)
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("read history tip: %w", err)
+	}
+	if floor, err = decodeVersion(historyFloorKey, floorData); err != nil {
+		return 0, 0, false, err
+	}
+	if tip, err = decodeVersion(historyTipKey, tipData); err != nil {
+		return 0, 0, false, err
+	}
+	return floor, tip, true, nil
+}
+
+func writeHistoryBounds(db seidbtypes.KeyValueDB, floor, tip int64) error {
+	batch := db.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := batch.Set(historyFloorKey, versionToBytes(floor)); err != nil {
+		return fmt.Errorf("history floor: %w", err)
+	}
+	if err := batch.Set(historyTipKey, versionToBytes(tip)); err != nil {
+		return fmt.Errorf("history tip: %w", err)
+	}
+	return batch.Commit(seidbtypes.WriteOptions{Sync: true})
+}
+
+// truncateHistoryAbove deletes every delta recorded for a version above target.
+func (s *CommitStore) truncateHistoryAbove(target int64) error {
+	batch := s.historyDB.NewBatch()
+	defer func() { _ = batch.Close() }()
+	if err := deleteHistoryVersions(s.historyDB, batch,
+		historyVersionKey(target+1, nil), ktype.PrefixEnd(historyVersionPrefix)); err != nil {
+		return fmt.Errorf("truncate history above %d: %w", target, err)
+	}
+	if err := batch.Set(historyTipKey, versionToBytes(target)); err != nil {
+		return fmt.Errorf("history tip: %w", err)
+	}
+	return batch.Commit(seidbtypes.WriteOptions{Sync: true})
+}
+
+// deleteHistoryVersions adds to batch the deletion of every delta whose v/ index key lies in [lower, upper).
+func deleteHistoryVersions(db seidbtypes.KeyValueDB, batch seidbtypes.Batch, lower, upper []byte) error {
+	iter, err := db.NewIter(&seidbtypes.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		return fmt.Errorf("open history index iterator: %w", err)
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		version, physKey, err := parseHistoryVersionKey(iter.Key())
+		if err != nil {
+			return err
+		}
+		deltaKey, err := historyDeltaKey(physKey, version)
+		if err != nil {
+			return err
+		}
+		if err := batch.Delete(deltaKey); err != nil {
+			return err
+		}
+		if err := batch.Delete(bytes.Clone(iter.Key())); err != nil {
+			return err
+		}
+	}
+	return iter.Error()
+}
+
+// recordHistory persists the reverse deltas of the block about to be committed at version, and prunes
+// deltas that fall out of the window. Called by commitBatches before any data DB batch is committed:
+// the data DBs still hold the rows from before this block, which is what a delta records, and a crash
+// after this write but before the data commit is undone by openHistory on the next open.
+//
+// Callers must hold s.mu. A no-op when history is not open.
+func (s *CommitStore) recordHistory(version int64) error {
+	if s.historyDB == nil {
+		return nil
+	}
+
+	floor := s.historyFloor
+	if s.historyTip < version-1 {
+		// A block went by without deltas (SetInitialVersion, or history reopened after a gap): nothing
+		// below the previous version can be reconstructed.
+		floor = version - 1
+	}
+
+	batch := s.historyDB.NewBatch()
+	defer func() { _ = batch.Close() }()
+
+	for _, ndb := range s.namedDataDBs() {
+		physKeys := s.pendingPhysKeys(ndb.dir)
+		if len(physKeys) == 0 {
+			continue
+		}
+		if s.localMeta[ndb.dir].CommittedVersion >= version {
+			// Replay over a DB that already holds this block: its rows are the block's results, not what
+			// preceded it, so history can only start here.
+			floor = version
+		}
+		reads := make(map[string]seidbtypes.BatchGetResult, len(physKeys))
+		for _, k := range physKeys {
+			reads[k] = seidbtypes.BatchGetResult{}
+		}
+		if err := ndb.db.BatchGet(reads); err != nil {
+			return fmt.Errorf("%s history read: %w", ndb.dir, err)
+		}
+		for k, r := range reads {
+			if r.Error != nil {
+				return fmt.Errorf("%s history read for key %x: %w", ndb.dir, k, r.Error)
+			}
+			physKey := []byte(k)
+			deltaKey, err := historyDeltaKey(physKey, version)
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(deltaKey, encodeHistoryRow(r.Value, r.IsFound())); err != nil {
+				return fmt.Errorf("history delta: %w", err)
+			}
+			if err := batch.Set(historyVersionKey(version, physKey), []byte{}); err != nil {
+				return fmt.Errorf("history index: %w", err)
+			}
+		}
+	}
+
+	// A delta recorded at v is needed to read version v-1, so once the floor passes v-1 it can go.
+	floor = max(floor, version-s.config.HistoryWindow)
+	if floor > s.historyFloor {
+		if err := deleteHistoryVersions(s.historyDB, batch,
+			historyVersionPrefix, historyVersionKey(floor+1, nil)); err != nil {
+			return fmt.Errorf("prune history below %d: %w", floor, err)
+		}
+	}
+	if err := batch.Set(historyFloorKey, versionToBytes(floor)); err != nil {
+		return fmt.Errorf("history floor: %w", err)
+	}
+	if err := batch.Set(historyTipKey, versionToBytes(version)); err != nil {
+		return fmt.Errorf("history tip: %w", err)
+	}
+	if err := batch.Commit(seidbtypes.WriteOptions{Sync: s.config.Fsync}); err != nil {
+		return fmt.Errorf("history commit: %w", err)
+	}
+
+	s.historyFloor = floor
+	s.historyTip = version
+	otelMetrics.HistoryEarliestVersion.Record(s.ctx, floor)
+	return nil
+}
+
+// pendingPhysKeys returns the physical keys buffered for one data DB.
+func (s *CommitStore) pendingPhysKeys(dir string) []string {
+	switch dir {
+	case accountDBDir:
+		return slices.Collect(maps.Keys(s.accountWrites))
+	case codeDBDir:
+		return slices.Collect(maps.Keys(s.codeWrites))
+	case storageDBDir:
+		return slices.Collect(maps.Keys(s.storageWrites))
+	case miscDBDir:
+		return slices.Collect(maps.Keys(s.miscWrites))
+	}
+	return nil
+}
+
+// HistoryRange returns the versions GetAt can serve, oldest first. The committed version is always
+// servable, so with history disabled both bounds are the committed version.
+func (s *CommitStore) HistoryRange() (earliest int64, latest int64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.historyDB == nil {
+		return s.committedVersion, s.committedVersion
+	}
+	return s.historyFloor, s.committedVersion
+}
+
+// ErrHistoryUnavailable is returned by GetAt for a version outside HistoryRange. Callers serving
+// historical reads fall back to the state store on it.
+var ErrHistoryUnavailable = errors.New("flatkv: version is outside the history window")
+
+// GetAt returns the value a key held at version, with the same key and value semantics as Get. It
+// reads committed state only; pending writes are never visible. version 0 means the committed version.
+//
+// Versions below the committed one are reconstructed from the reverse deltas kept for the last
+// Config.HistoryWindow versions: the oldest delta recorded above version holds the row the key had at
+// version, and a key with no such delta still holds its committed row. A version outside HistoryRange
+// fails rather than being served approximately.
+func (s *CommitStore) GetAt(version int64, moduleName string, key []byte) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if version == 0 {
+		version = s.committedVersion
+	}
+	if version > s.committedVersion {
+		return nil, false, fmt.Errorf("%w: version %d is above the committed version %d",
+			ErrHistoryUnavailable, version, s.committedVersion)
+	}
+	if version < s.committedVersion && (s.historyDB == nil || version < s.historyFloor) {
+		return nil, false, fmt.Errorf("%w: version %d is below the earliest servable version",
+			ErrHistoryUnavailable, version)
+	}
+
+	physKey, db, decode, err := s.resolveLogicalKey(moduleName, key)
+	if err != nil {
+		return nil, false, err
+	}
+	if physKey == nil {
+		return nil, false, nil
+	}
+
+	row, found, err := s.rowAt(db, physKey, version)
+	if err != nil {
+		return nil, false, err
+	}
+	if !found {
+		return nil, false, nil
+	}
+	value, err := decode(row)
+	if err != nil {
+		return nil, false, fmt.Errorf("flatkv: GetAt module=%s key %x: %w", moduleName, key, err)
+	}
+	return value, value != nil, nil
+}
+
+// rowAt returns the serialized row physKey held at version (at or below the committed version).
+func (s *CommitStore) rowAt(db seidbtypes.KeyValueDB, physKey []byte, version int64) ([]byte, bool, error) {
+	if version < s.committedVersion {
+		prefix, err := historyDeltaKeyPrefix(physKey)
+		if err != nil {
+			return nil, false, err
+		}
+		lower, err := historyDeltaKey(physKey, version+1)
+		if err != nil {
+			return nil, false, err
+		}
+		iter, err := s.historyDB.NewIter(&seidbtypes.IterOptions{
+			LowerBound: lower,
+			UpperBound: ktype.PrefixEnd(prefix),
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("open history iterator: %w", err)
+		}
+		defer func() { _ = iter.Close() }()
+		if iter.Valid() {
+			row, found, err := decodeHistoryRow(iter.Value())
+			if err != nil {
+				return nil, false, err
+			}
+			return bytes.Clone(row), found, nil
+		}
+		if err := iter.Error(); err != nil {
+			return nil, false, fmt.Errorf("history iterator: %w", err)
+		}
+	}
+
+	row, err := db.Get(physKey)
+	if err != nil {
+		if errorutils.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("read key %x: %w", physKey, err)
+	}
+	return row, true, nil
+}
+
+// resolveLogicalKey maps a Get-style (module, key) pair to its physical key, the data DB holding it, and
+// a decoder that turns the serialized row into the value Get would return (nil when Get reports not
+// found). A nil physical key means the key can never exist.
+func (s *CommitStore) resolveLogicalKey(
+	moduleName string,
+	key []byte,
+) ([]byte, seidbtypes.KeyValueDB, func([]byte) ([]byte, error), error) {
+	if moduleName != keys.EVMStoreKey {
+		return ktype.ModulePhysicalKey(moduleName, key), s.miscDB, decodeMiscRow, nil
+	}
+
+	kind, keyBytes := keys.ParseEVMKey(key)
+	switch kind {
+	case keys.EVMKeyEmpty:
+		return nil, nil, nil, nil
+	case keys.EVMKeyStorage:
+		if len(keyBytes) != ktype.AddressLen+ktype.SlotLen {
+			return nil, nil, nil, fmt.Errorf("storageDB: expected key length %d, got %d",
+				ktype.AddressLen+ktype.SlotLen, len(keyBytes))
+		}
+		return ktype.EVMPhysicalKey(keys.EVMKeyStorage, keyBytes), s.storageDB, decodeStorageRow, nil
+	case keys.EVMKeyNonce, keys.EVMKeyCodeHash:
+		if len(keyBytes) != ktype.AddressLen {
+			return nil, nil, nil, fmt.Errorf("accountDB: expected key length %d, got %d", ktype.AddressLen, len(keyBytes))
+		}
+		decode := decodeNonceRow
+		if kind == keys.EVMKeyCodeHash {
+			decode = decodeCodeHashRow
+		}
+		return ktype.EVMPhysicalKey(ktype.EVMKeyAccount, keyBytes), s.accountDB, decode, nil
+	case keys.EVMKeyCode:
+		if len(keyBytes) != ktype.AddressLen {
+			return nil, nil, nil, fmt.Errorf("codeDB: expected key length %d, got %d", ktype.AddressLen, len(keyBytes))
+		}
+		return ktype.EVMPhysicalKey(keys.EVMKeyCode, keyBytes), s.codeDB, decodeCodeRow, nil
+	case keys.EVMKeyMisc:
+		return ktype.ModulePhysicalKey(keys.EVMStoreKey, keyBytes), s.miscDB, decodeMiscRow, nil
+	default:
+		return nil, nil, nil, fmt.Errorf("flatkv: unsupported key type: %v", kind)
+	}
+}
+
+func decodeStorageRow(row []byte) ([]byte, error) {
+	sd, err := vtype.DeserializeStorageData(row)
+	if err != nil || sd.IsDelete() {
+		return nil, err
+	}
+	return sd.GetValue()[:], nil
+}
+
+func decodeNonceRow(row []byte) ([]byte, error) {
+	ad, err := vtype.DeserializeAccountData(row)
+	if err != nil || ad.IsDelete() {
+		return nil, err
+	}
+	nonce := make([]byte, vtype.NonceLen)
+	binary.BigEndian.PutUint64(nonce, ad.GetNonce())
+	return nonce, nil
+}
+
+func decodeCodeHashRow(row []byte) ([]byte, error) {
+	ad, err := vtype.DeserializeAccountData(row)
+	if err != nil || ad.IsDelete() {
+		return nil, err
+	}
+	codeHash := ad.GetCodeHash()
+	var zero vtype.CodeHash
+	if *codeHash == zero {
+		return nil, nil
+	}
+	return codeHash[:], nil
+}
+
+func decodeCodeRow(row []byte) ([]byte, error) {
+	cd, err := vtype.DeserializeCodeData(row)
+	if err != nil || cd.IsDelete() {
+		return nil, err
+	}
+	return cd.GetBytecode(), nil
+}
+
+func decodeMiscRow(row []byte) ([]byte, error) {
+	md, err := vtype.DeserializeMiscData(row)
+	if err != nil || md.IsDelete() {
+		return nil, err
+	}
+	return md.GetValue(), nil
+}
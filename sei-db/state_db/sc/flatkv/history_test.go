@@ -0,0 +1,175 @@
+package flatkv
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-db/common/keys"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/config"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/ktype"
+)
+
+// historyTestConfig returns a test config with a history window of window versions.
+func historyTestConfig(t *testing.T, window int64) *config.Config {
+	t.Helper()
+	cfg := config.DefaultTestConfig(t)
+	cfg.HistoryWindow = window
+	return cfg
+}
+
+// commitPairs applies pairs as the next version and commits it.
+func commitPairs(t *testing.T, s *CommitStore, pairs ...*proto.KVPair) int64 {
+	t.Helper()
+	require.NoError(t, s.ApplyChangeSets(s.Version()+1, []*proto.NamedChangeSet{namedCS(pairs...)}))
+	return commitAndCheck(t, s)
+}
+
+// requireStorageAt asserts the value GetAt returns for one storage slot at version; a nil want means
+// the slot must be absent.
+func requireStorageAt(t *testing.T, s *CommitStore, version int64, addr ktype.Address, slot ktype.Slot, want []byte) {
+	t.Helper()
+	got, found, err := s.GetAt(version, keys.EVMStoreKey, evmStorageKey(addr, slot))
+	require.NoError(t, err)
+	if want == nil {
+		require.False(t, found, "slot should be absent at version %d", version)
+		return
+	}
+	require.True(t, found, "slot should exist at version %d", version)
+	require.Equal(t, padLeft32(want...), got, "slot value at version %d", version)
+}
+
+func TestGetAtReadsEveryVersionInTheWindow(t *testing.T) {
+	s := setupTestStoreWithConfig(t, historyTestConfig(t, 10))
+	defer s.Close()
+
+	addr, slot := addrN(1), slotN(1)
+	commitPairs(t, s, noncePair(addr, 1))                                        // v1: slot absent
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x0A}))                     // v2
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x0B}), noncePair(addr, 2)) // v3
+	commitPairs(t, s, storageDeletePair(addr, slot))                             // v4
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x0C}))                     // v5
+
+	requireStorageAt(t, s, 1, addr, slot, nil)
+	requireStorageAt(t, s, 2, addr, slot, []byte{0x0A})
+	requireStorageAt(t, s, 3, addr, slot, []byte{0x0B})
+	requireStorageAt(t, s, 4, addr, slot, nil)
+	requireStorageAt(t, s, 5, addr, slot, []byte{0x0C})
+	requireStorageAt(t, s, 0, addr, slot, []byte{0x0C})
+
+	nonceKey := keys.BuildEVMKey(keys.EVMKeyNonce, addr[:])
+	for version, want := range map[int64]uint64{1: 1, 2: 1, 3: 2, 5: 2} {
+		got, found, err := s.GetAt(version, keys.EVMStoreKey, nonceKey)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, nonceBytes(want), got, "nonce at version %d", version)
+	}
+
+	// Pending writes are never visible, not even at the committed version.
+	require.NoError(t, s.ApplyChangeSets(6, []*proto.NamedChangeSet{namedCS(storagePair(addr, slot, []byte{0x0D}))}))
+	requireStorageAt(t, s, 5, addr, slot, []byte{0x0C})
+	requireStorageAt(t, s, 4, addr, slot, nil)
+
+	earliest, latest := s.HistoryRange()
+	require.Equal(t, int64(0), earliest)
+	require.Equal(t, int64(5), latest)
+}
+
+func TestGetAtRejectsVersionsOutsideTheWindow(t *testing.T) {
+	s := setupTestStoreWithConfig(t, historyTestConfig(t, 3))
+	defer s.Close()
+
+	addr, slot := addrN(2), slotN(2)
+	for i := byte(1); i <= 8; i++ {
+		commitPairs(t, s, storagePair(addr, slot, []byte{i}))
+	}
+
+	earliest, latest := s.HistoryRange()
+	require.Equal(t, int64(5), earliest)
+	require.Equal(t, int64(8), latest)
+
+	for v := int64(5); v <= 8; v++ {
+		requireStorageAt(t, s, v, addr, slot, []byte{byte(v)})
+	}
+	_, _, err := s.GetAt(4, keys.EVMStoreKey, evmStorageKey(addr, slot))
+	require.ErrorIs(t, err, ErrHistoryUnavailable)
+	_, _, err = s.GetAt(9, keys.EVMStoreKey, evmStorageKey(addr, slot))
+	require.ErrorIs(t, err, ErrHistoryUnavailable)
+
+	// Pruning removes the deltas along with the index entries that point at them.
+	iter, err := s.historyDB.NewIter(nil)
+	require.NoError(t, err)
+	defer func() { _ = iter.Close() }()
+	deltas := 0
+	for ; iter.Valid(); iter.Next() {
+		if bytes.HasPrefix(iter.Key(), historyDeltaPrefix) {
+			deltas++
+		}
+	}
+	require.NoError(t, iter.Error())
+	require.Equal(t, 3, deltas, "only deltas for versions 6..8 should remain")
+}
+
+func TestGetAtWithHistoryDisabled(t *testing.T) {
+	s := setupTestStore(t)
+	defer s.Close()
+
+	addr, slot := addrN(3), slotN(3)
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x01}))
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x02}))
+
+	require.Nil(t, s.historyDB)
+	earliest, latest := s.HistoryRange()
+	require.Equal(t, int64(2), earliest)
+	require.Equal(t, int64(2), latest)
+
+	requireStorageAt(t, s, 2, addr, slot, []byte{0x02})
+	_, _, err := s.GetAt(1, keys.EVMStoreKey, evmStorageKey(addr, slot))
+	require.ErrorIs(t, err, ErrHistoryUnavailable)
+}
+
+func TestHistorySurvivesReopen(t *testing.T) {
+	cfg := historyTestConfig(t, 10)
+	s := setupTestStoreWithConfig(t, cfg)
+
+	addr, slot := addrN(4), slotN(4)
+	for i := byte(1); i <= 4; i++ {
+		commitPairs(t, s, storagePair(addr, slot, []byte{i}))
+	}
+
+	s = reopenStore(t, s, cfg)
+	require.NoError(t, s.LoadLatest())
+	defer s.Close()
+
+	earliest, latest := s.HistoryRange()
+	require.Equal(t, int64(0), earliest)
+	require.Equal(t, int64(4), latest)
+	for v := int64(1); v <= 4; v++ {
+		requireStorageAt(t, s, v, addr, slot, []byte{byte(v)})
+	}
+}
+
+func TestRollbackTruncatesHistory(t *testing.T) {
+	s := setupTestStoreWithConfig(t, historyTestConfig(t, 10))
+	defer s.Close()
+
+	addr, slot := addrN(5), slotN(5)
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x01}))
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x02}))
+	require.NoError(t, s.WriteSnapshot(""))
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x03}))
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x04}))
+
+	require.NoError(t, s.Rollback(3))
+	_, latest := s.HistoryRange()
+	require.Equal(t, int64(3), latest)
+	requireStorageAt(t, s, 3, addr, slot, []byte{0x03})
+	requireStorageAt(t, s, 1, addr, slot, []byte{0x01})
+
+	// A new block 4 after the rollback must not be confused with the discarded one.
+	commitPairs(t, s, storagePair(addr, slot, []byte{0x44}))
+	requireStorageAt(t, s, 3, addr, slot, []byte{0x03})
+	requireStorageAt(t, s, 4, addr, slot, []byte{0x44})
+}
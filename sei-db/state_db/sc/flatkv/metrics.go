@@ -35,6 +35,7 @@ var (
 		ImportKVPairs             metric.Int64Counter
 		ImportWorkerFlushLatency  metric.Float64Histogram
 		FlushLatency              metric.Float64Histogram
+		HistoryEarliestVersion    metric.Int64Gauge
 	}{
 		OpenLatency: must(flatkvMeter.Float64Histogram(
 			"flatkv_open_latency",
@@ -143,6 +144,11 @@ var (
 			metric.WithUnit("s"),
 			metric.WithExplicitBucketBoundaries(commonmetrics.LatencyBuckets...),
 		)),
+		HistoryEarliestVersion: must(flatkvMeter.Int64Gauge(
+			"flatkv_history_earliest_version",
+			metric.WithDescription("Oldest FlatKV version servable from the reverse-delta history"),
+			metric.WithUnit("{count}"),
+		)),
 	}
 )
 
@@ -605,6 +605,11 @@ func (s *CommitStore) Rollback(targetVersion int64) (err error) {
 		return fmt.Errorf("open for rollback: %w", err)
 	}
 
+	// Drop the deltas of every discarded block; replay below re-records those up to the target.
+	if err := s.openHistory(); err != nil {
+		return fmt.Errorf("open history for rollback: %w", err)
+	}
+
 	// Reset the WAL to targetVersion BEFORE catchup: drop every block after it so a later open-to-latest
 	// can't replay past target and the write head resumes at targetVersion+1. Rollback is a startup/offline
 	// operation (no concurrent commits), so rather than mutating a live instance we close the WAL, prune it
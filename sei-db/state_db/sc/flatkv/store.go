@@ -157,6 +157,16 @@ type CommitStore struct {
 
 	readOnlyWorkDir string // Temp working dir for readonly store; removed by Close.
 
+	// Reverse-delta history DB, open only when config.HistoryWindow > 0 (see history.go). It lives outside
+	// the snapshot/working layout, so its lifecycle is decoupled from the data DBs': openHistory reconciles
+	// it before replay, and only Close (or an import reset) closes it.
+	historyDB seidbtypes.KeyValueDB
+
+	// historyFloor is the oldest version GetAt can serve; historyTip the newest version whose deltas are
+	// recorded. Both are guarded by mu.
+	historyFloor int64
+	historyTip   int64
+
 	// A work pool for reading from the DBs.
 	//
 	// Uses a fixed-size pool.
@@ -496,6 +506,9 @@ func (s *CommitStore) openTo(catchupTarget int64) error {
 	if err := s.rebuildIfAnyDataDBIsUnreachable(); err != nil {
 		return err
 	}
+	if err := s.openHistory(); err != nil {
+		return err
+	}
 	return s.replayIntoMutableStore(catchupTarget)
 }
 
@@ -844,6 +857,9 @@ func (s *CommitStore) resetForImport() error {
 	if err := s.closeDBsOnly(); err != nil {
 		return fmt.Errorf("close before import reset: %w", err)
 	}
+	if err := s.removeHistory(); err != nil {
+		return fmt.Errorf("import reset: %w", err)
+	}
 
 	dir := s.flatkvDir()
 	if err := os.MkdirAll(dir, 0750); err != nil {
@@ -946,6 +962,9 @@ func InitializeDataDirectories(c *config.Config) {
 	if c.MiscDBConfig.DataDir == "" {
 		c.MiscDBConfig.DataDir = filepath.Join(workDir, miscDBDir)
 	}
+	if c.HistoryDBConfig.DataDir == "" {
+		c.HistoryDBConfig.DataDir = filepath.Join(c.DataDir, historyDBDir)
+	}
 	applyPebbleMetricsConfig(c)
 }
 
@@ -956,9 +975,11 @@ func applyPebbleMetricsConfig(c *config.Config) {
 	c.CodeDBConfig.EnableMetrics = c.EnablePebbleMetrics
 	c.StorageDBConfig.EnableMetrics = c.EnablePebbleMetrics
 	c.MiscDBConfig.EnableMetrics = c.EnablePebbleMetrics
+	c.HistoryDBConfig.EnableMetrics = c.EnablePebbleMetrics
 
 	c.AccountDBConfig.EnableReadWriteMetrics = c.EnableReadWriteMetrics
 	c.CodeDBConfig.EnableReadWriteMetrics = c.EnableReadWriteMetrics
 	c.StorageDBConfig.EnableReadWriteMetrics = c.EnableReadWriteMetrics
 	c.MiscDBConfig.EnableReadWriteMetrics = c.EnableReadWriteMetrics
+	c.HistoryDBConfig.EnableReadWriteMetrics = c.EnableReadWriteMetrics
 }
@@ -85,7 +85,7 @@ func (s *CommitStore) Close() error {
 	// submit to a closed pool. resetPools recreates both together.
 	s.ltCalc = nil
 
-	err := s.closeDBsOnly()
+	err := errors.Join(s.closeDBsOnly(), s.closeHistory())
 
 	// FlatKV owns Close of whatever WAL instance it currently holds (the injected one, or a replacement made
 	// by rollback/restore). A nil WAL means the outer context owns the pipeline — nothing to close. The
@@ -173,6 +173,14 @@ func (s *CommitStore) clearPendingWrites() {
 func (s *CommitStore) commitBatches(version int64) error {
 	syncOpt := types.WriteOptions{Sync: s.config.Fsync}
 
+	// Reverse deltas go first, while the data DBs still hold the rows this block replaces.
+	if s.historyDB != nil {
+		s.phaseTimer.SetPhase("commit_record_history")
+		if err := s.recordHistory(version); err != nil {
+			return fmt.Errorf("history: %w", err)
+		}
+	}
+
 	type pendingCommit struct {
 		dbDir string
 		batch types.Batch
@@ -429,5 +437,10 @@ func (s *CommitStore) FinalizeImport(version int64) error {
 	s.workingLtHash = globalHash
 	s.committedVersion = version
 	s.committedLtHash = s.workingLtHash.Clone()
+
+	// Import removed any prior history; start a fresh one at the imported version.
+	if err := s.openHistory(); err != nil {
+		return fmt.Errorf("open history after import: %w", err)
+	}
 	return nil
 }
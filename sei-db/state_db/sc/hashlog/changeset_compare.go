@@ -0,0 +1,48 @@
+package hashlog
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+// The result of comparing a block's changeset against the changeset hashes a hash log recorded for the block.
+type ChangesetDivergence struct {
+	// True if the recorded aggregate changeset hash differs.
+	Changeset bool
+
+	// The stores whose recorded module changeset hash differs, sorted by name.
+	Modules []string
+}
+
+// Reports whether the comparison found any difference.
+func (d ChangesetDivergence) Diverged() bool {
+	return d.Changeset || len(d.Modules) > 0
+}
+
+// Compares a block's changeset, e.g. one obtained by re-executing the block, against the changeset hashes recorded in
+// a hash log for that block. Columns the record does not carry, and nil (opted out) hashes, are not compared, so a
+// record written before module changeset columns existed is compared on its aggregate changeset hash alone.
+func CompareChangeset(log *HashLog, cs []*proto.NamedChangeSet) ChangesetDivergence {
+	var divergence ChangesetDivergence
+	if recorded := log.Hashes[ChangesetHashType]; recorded != nil {
+		divergence.Changeset = !bytes.Equal(recorded, hashChangeset(cs))
+	}
+	moduleHashes := hashModuleChangesets(cs)
+	for hashType, recorded := range log.Hashes {
+		store, ok := parseModuleChangesetHashType(hashType)
+		if !ok || recorded == nil {
+			continue
+		}
+		hash, touched := moduleHashes[store]
+		if !touched {
+			hash = HashModuleChangeset(store, nil)
+		}
+		if !bytes.Equal(recorded, hash) {
+			divergence.Modules = append(divergence.Modules, store)
+		}
+	}
+	sort.Strings(divergence.Modules)
+	return divergence
+}
@@ -2,11 +2,51 @@ package hashlog
 
 import (
 	"encoding/binary"
+	"strings"
 
 	"github.com/cespare/xxhash/v2"
 	"github.com/sei-protocol/sei-chain/sei-db/proto"
 )
 
+// The prefix of the logger-computed per-module changeset columns. See ModuleChangesetHashType.
+const moduleChangesetHashTypePrefix = ChangesetHashType + "/"
+
+// ModuleChangesetHashType is the hash type under which the logger records the changeset hash of a single store (e.g.
+// "changeset/bank"). Like the aggregate changeset column it is computed by the logger from ReportChangeset, but it is
+// only recorded for stores whose column has been registered, since the logger cannot know the full set of stores (a
+// store that made no changes in a block still needs a hash). A store's hash is HashModuleChangeset of its change set.
+func ModuleChangesetHashType(store string) string {
+	return moduleChangesetHashTypePrefix + store
+}
+
+// Reports whether a hash type is a logger-computed per-module changeset column, returning the store it covers.
+func parseModuleChangesetHashType(hashType string) (string, bool) {
+	store, ok := strings.CutPrefix(hashType, moduleChangesetHashTypePrefix)
+	return store, ok && store != ""
+}
+
+// HashModuleChangeset hashes the changes a block made to a single store, as recorded under ModuleChangesetHashType.
+// A nil change set hashes the same as an empty one, so a store a block did not touch has a stable hash.
+func HashModuleChangeset(store string, cs *proto.ChangeSet) []byte {
+	ncs := &proto.NamedChangeSet{Name: store}
+	if cs != nil {
+		ncs.Changeset = *cs
+	}
+	return hashChangeset([]*proto.NamedChangeSet{ncs})
+}
+
+// Hashes each store's change set in a block with HashModuleChangeset, keyed by store name.
+func hashModuleChangesets(cs []*proto.NamedChangeSet) map[string][]byte {
+	hashes := make(map[string][]byte, len(cs))
+	for _, ncs := range cs {
+		if ncs == nil {
+			continue
+		}
+		hashes[ncs.Name] = HashModuleChangeset(ncs.Name, &ncs.Changeset)
+	}
+	return hashes
+}
+
 // Hashes the changeset of a block's state. Order sensitive.
 //
 // This is NOT a cryptographically secure hash function. Its purpose is to be a canary in the coal mine for
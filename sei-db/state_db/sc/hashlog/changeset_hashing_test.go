@@ -89,3 +89,35 @@ func TestHashChangesetToleratesNilEntries(t *testing.T) {
 		hashChangeset([]*proto.NamedChangeSet{nil, cs("bank", kv("a", "1"), nil, kv("b", "2"))})
 	})
 }
+
+func TestHashModuleChangeset(t *testing.T) {
+	bank := cs("bank", kv("a", "1"))
+	require.Equal(t, hashChangeset([]*proto.NamedChangeSet{bank}), HashModuleChangeset("bank", &bank.Changeset))
+	require.Equal(t, HashModuleChangeset("bank", &proto.ChangeSet{}), HashModuleChangeset("bank", nil))
+	require.NotEqual(t, HashModuleChangeset("bank", nil), HashModuleChangeset("evm", nil))
+
+	store, ok := parseModuleChangesetHashType(ModuleChangesetHashType("bank"))
+	require.True(t, ok)
+	require.Equal(t, "bank", store)
+	_, ok = parseModuleChangesetHashType(ChangesetHashType)
+	require.False(t, ok)
+}
+
+func TestCompareChangeset(t *testing.T) {
+	recorded := []*proto.NamedChangeSet{cs("bank", kv("a", "1")), cs("evm", kv("b", "2"))}
+	log := &HashLog{Hashes: map[string][]byte{
+		ChangesetHashType:                 hashChangeset(recorded),
+		ModuleChangesetHashType("bank"):   HashModuleChangeset("bank", &recorded[0].Changeset),
+		ModuleChangesetHashType("evm"):    HashModuleChangeset("evm", &recorded[1].Changeset),
+		ModuleChangesetHashType("wasm"):   HashModuleChangeset("wasm", nil),
+		ModuleChangesetHashType("oracle"): nil,
+	}}
+	require.False(t, CompareChangeset(log, recorded).Diverged())
+
+	reexecuted := []*proto.NamedChangeSet{cs("bank", kv("a", "1")), cs("evm", kv("b", "3")), cs("wasm", kv("c", "4"))}
+	require.Equal(t, ChangesetDivergence{Changeset: true, Modules: []string{"evm", "wasm"}}, CompareChangeset(log, reexecuted))
+
+	// A record without module columns is compared on the aggregate alone.
+	aggregateOnly := &HashLog{Hashes: map[string][]byte{ChangesetHashType: hashChangeset(recorded)}}
+	require.Equal(t, ChangesetDivergence{Changeset: true}, CompareChangeset(aggregateOnly, reexecuted))
+}
@@ -20,7 +20,8 @@ import "github.com/sei-protocol/sei-chain/sei-db/proto"
 type HashLogger interface {
 
 	// Report the changeset for a block's state. The logger hashes the changeset itself, on a background thread, and
-	// records the result under the configured changeset hash type.
+	// records the result under the configured changeset hash type, along with the hash of each store's change set under
+	// its registered ModuleChangesetHashType column, if any.
 	//
 	// Passing a nil cs is supported: it records a nil changeset hash for the block without hashing anything. This is
 	// the way to skip changeset hashing for a particular block while changeset hashing is otherwise enabled. It is
@@ -54,7 +55,8 @@ type HashLogger interface {
 	// Report a hash for a block under the given type. The type must be one of the types this logger was
 	// configured to record (via HashLoggerConfig.HashTypes or RegisterHashType), otherwise an error is
 	// returned. The changeset hash type is reserved for the
-	// logger-computed changeset column (use ReportChangeset) and is also rejected when changeset hashing is enabled. A
+	// logger-computed changeset column (use ReportChangeset) and is also rejected when changeset hashing is enabled, as
+	// are the per-module changeset columns. A
 	// subsystem that is disabled should report a nil hash for its type rather than skipping the call, so that
 	// the block can still be completed.
 	ReportHash(blockNumber uint64, hashType string, hash []byte) error
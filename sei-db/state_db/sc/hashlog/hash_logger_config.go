@@ -32,7 +32,8 @@ type HashLoggerConfig struct {
 	// The ordered set of caller-reported hash types this logger records. Each type becomes a column in the
 	// CSV output, in this order, and a block is only written once a hash has been reported for every type.
 	// This must not include the reserved ChangesetHashType: the changeset column is owned and computed by the logger,
-	// not supplied via ReportHash.
+	// not supplied via ReportHash. Per-module changeset columns (see ModuleChangesetHashType) may be listed here, but
+	// are likewise computed by the logger.
 	HashTypes []string
 
 	// When true, changeset hashing is disabled entirely: no hasher thread is started, ReportChangeset becomes a no-op,
@@ -67,6 +68,10 @@ type HashLoggerConfig struct {
 	// fewer than BlocksToRetain blocks. Zero disables the disk-size cap (block-count retention is then the
 	// only bound).
 	MaxDiskSize uint
+
+	// When set, every complete block the logger flushes is also published here, for consumers that need a live
+	// block's hashes without reading the archive back. Blocks force-flushed while incomplete are not published.
+	RecentHashes *RecentHashes
 }
 
 // DefaultHashLoggerConfig returns a default configuration for a HashLogger.
@@ -115,6 +120,9 @@ func (c *HashLoggerConfig) Validate() error {
 		if hashType == ChangesetHashType {
 			return fmt.Errorf("hash type %q is reserved for the logger-computed changeset column", hashType)
 		}
+		if _, ok := parseModuleChangesetHashType(hashType); ok && c.DisableChangesetHashing {
+			return fmt.Errorf("hash type %q is a module changeset column, which requires changeset hashing", hashType)
+		}
 		if _, ok := seen[hashType]; ok {
 			return fmt.Errorf("duplicate hash type %q", hashType)
 		}
@@ -64,6 +64,8 @@ type hashWork struct {
 type hashResult struct {
 	blockNumber uint64
 	hash        []byte
+	// The per-store changeset hashes, keyed by store name. Stores the block did not touch are absent.
+	moduleHashes map[string][]byte
 }
 
 // A message destined for the writer: either a block to append to the current file, or (when rotate is
@@ -122,6 +124,9 @@ type hashLoggerImpl struct {
 	// memory.
 	maxBufferedBlocks uint64
 
+	// Where complete blocks are published as they are flushed. Nil when not configured.
+	recentHashes *RecentHashes
+
 	// For sending work to the control loop (the hub for all caller entry points).
 	controlChan chan controlMessage
 
@@ -245,6 +250,7 @@ func NewHashLogger(config *HashLoggerConfig) (HashLogger, error) {
 		blocksToRetain:           uint64(config.BlocksToRetain),
 		maxDiskSize:              uint64(config.MaxDiskSize),
 		maxBufferedBlocks:        uint64(config.MaxBufferedBlocks),
+		recentHashes:             config.RecentHashes,
 		controlChan:              make(chan controlMessage, config.ControlBufferSize),
 		writerChan:               make(chan writerMessage, config.WriteBufferSize),
 		ctx:                      ctx,
@@ -358,6 +364,9 @@ func (h *hashLoggerImpl) RegisterHashType(hashType string) error {
 	if !h.changesetHashingDisabled && hashType == ChangesetHashType {
 		return fmt.Errorf("hash type %q is reserved for the logger-computed changeset column", hashType)
 	}
+	if _, ok := parseModuleChangesetHashType(hashType); ok && h.changesetHashingDisabled {
+		return fmt.Errorf("hash type %q is a module changeset column, which requires changeset hashing", hashType)
+	}
 	if !legalHashTypeRegex.MatchString(hashType) {
 		return fmt.Errorf("hash type %q contains illegal characters (must match %s)",
 			hashType, legalHashTypeRegex.String())
@@ -437,6 +446,9 @@ func (h *hashLoggerImpl) ReportHash(blockNumber uint64, hashType string, hash []
 	if !h.changesetHashingDisabled && hashType == ChangesetHashType {
 		return fmt.Errorf("hash type %q is reserved for the logger-computed changeset; use ReportChangeset", hashType)
 	}
+	if _, ok := parseModuleChangesetHashType(hashType); ok {
+		return fmt.Errorf("hash type %q is a logger-computed module changeset; use ReportChangeset", hashType)
+	}
 	if _, ok := h.hashTypeSet[hashType]; !ok {
 		return fmt.Errorf("unknown hash type %q", hashType)
 	}
@@ -495,7 +507,11 @@ func (h *hashLoggerImpl) hasher() {
 				// The control loop closed hashChan after draining all in-flight changesets; nothing left to do.
 				return
 			}
-			result := hashResult{blockNumber: work.blockNumber, hash: hashChangeset(work.cs)}
+			result := hashResult{
+				blockNumber:  work.blockNumber,
+				hash:         hashChangeset(work.cs),
+				moduleHashes: hashModuleChangesets(work.cs),
+			}
 			select {
 			case h.hashResultChan <- result:
 			case <-h.ctx.Done():
@@ -598,7 +614,12 @@ func (h *hashLoggerImpl) handleHashReport(blockNumber uint64, hashType string, h
 	if h.hasFlushedAtLeastOnce && blockNumber <= h.flushedHighWater {
 		return // already on disk: a duplicate/late report, or a re-execution without reopening the logger
 	}
-	h.ensurePending(blockNumber).Hashes[hashType] = hash
+	log := h.ensurePending(blockNumber)
+	log.Hashes[hashType] = hash
+	if hashType == ChangesetHashType {
+		// Only the nil changeset opt-out reports the changeset column directly; it opts the module columns out too.
+		h.setModuleChangesetHashes(log, nil, false)
+	}
 }
 
 // handleChangesetRequest records that a block is awaiting a changeset hash and holds the work for dispatch to
@@ -620,7 +641,30 @@ func (h *hashLoggerImpl) applyChangesetResult(res hashResult) {
 	if h.hasFlushedAtLeastOnce && res.blockNumber <= h.flushedHighWater {
 		return // the block was already flushed (e.g. force-flushed by the overflow path); discard the stale changeset
 	}
-	h.ensurePending(res.blockNumber).Hashes[ChangesetHashType] = res.hash
+	log := h.ensurePending(res.blockNumber)
+	log.Hashes[ChangesetHashType] = res.hash
+	h.setModuleChangesetHashes(log, res.moduleHashes, true)
+}
+
+// setModuleChangesetHashes fills a block's registered per-module changeset columns. A hashed block records the hash
+// of each store's change set, falling back to the empty change set's hash for a store the block did not touch; a
+// block whose changeset was opted out records nil for every module column.
+func (h *hashLoggerImpl) setModuleChangesetHashes(log *HashLog, moduleHashes map[string][]byte, hashed bool) {
+	for _, hashType := range h.hashTypes {
+		store, ok := parseModuleChangesetHashType(hashType)
+		if !ok {
+			continue
+		}
+		if !hashed {
+			log.Hashes[hashType] = nil
+			continue
+		}
+		hash, touched := moduleHashes[store]
+		if !touched {
+			hash = HashModuleChangeset(store, nil)
+		}
+		log.Hashes[hashType] = hash
+	}
 }
 
 // ensurePending returns the pending HashLog for a block, creating an empty one if needed.
@@ -675,11 +719,15 @@ func (h *hashLoggerImpl) drainInFlightChangesets() {
 	}
 }
 
-// emit writes a single block to the writer and records it as flushed.
+// emit writes a single block to the writer and records it as flushed. A complete block is also published to
+// recentHashes, if configured.
 func (h *hashLoggerImpl) emit(blockNumber uint64) {
 	log := h.pendingBlocks[blockNumber]
 	delete(h.pendingBlocks, blockNumber)
 	delete(h.blocksWithPendingHashes, blockNumber)
+	if h.recentHashes != nil && len(log.Hashes) >= len(h.hashTypes) {
+		h.recentHashes.Add(log)
+	}
 	h.blockingSendToWriter(writerMessage{log: log})
 	h.flushedHighWater = blockNumber
 	h.hasFlushedAtLeastOnce = true
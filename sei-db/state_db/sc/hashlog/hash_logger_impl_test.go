@@ -164,6 +164,33 @@ func TestImplReportChangesetEmptyChangeSetIsHashed(t *testing.T) {
 	require.Equal(t, hashChangeset(empty), logs[0].Hashes["changeset"])
 }
 
+func TestImplReportChangesetPopulatesModuleChangesetHashes(t *testing.T) {
+	dir := t.TempDir()
+	config := testConfig(dir)
+	config.HashTypes = []string{ModuleChangesetHashType("bank"), ModuleChangesetHashType("evm")}
+	config.DisableChangesetHashing = false
+	l, err := NewHashLogger(config)
+	require.NoError(t, err)
+
+	// The module columns are logger-owned, like the aggregate changeset column.
+	require.Error(t, l.ReportHash(1, ModuleChangesetHashType("bank"), []byte{0x01}))
+
+	bank := cs("bank", kv("key", "value"))
+	l.ReportChangeset(1, []*proto.NamedChangeSet{bank, cs("wasm", kv("other", "value"))})
+	l.ReportChangeset(2, nil)
+	require.NoError(t, l.Close())
+
+	logs := readAllLogs(t, dir)
+	require.Len(t, logs, 2)
+	require.Equal(t, HashModuleChangeset("bank", &bank.Changeset), logs[0].Hashes["changeset/bank"])
+	// A store the block did not touch records the hash of its empty change set.
+	require.Equal(t, HashModuleChangeset("evm", nil), logs[0].Hashes["changeset/evm"])
+	require.NotContains(t, logs[0].Hashes, "changeset/wasm")
+	// Opting a block out of changeset hashing opts its module columns out too.
+	require.Nil(t, logs[1].Hashes["changeset/bank"])
+	require.Nil(t, logs[1].Hashes["changeset/evm"])
+}
+
 func TestImplChangesetFloodIsHashedReliably(t *testing.T) {
 	dir := t.TempDir()
 	config := testConfig(dir)
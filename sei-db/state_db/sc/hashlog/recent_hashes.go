@@ -0,0 +1,70 @@
+package hashlog
+
+import (
+	"sort"
+	"sync"
+)
+
+// RecentHashes keeps the most recent complete blocks a HashLogger has flushed in memory, so that consumers can look
+// up a block's hashes while the chain is live (e.g. to compare them with peers) without reading the archive back.
+//
+// The logger publishes blocks in increasing order. A block at or below the newest one held means the node rolled
+// back and is re-executing, so it replaces that block and discards everything above it.
+//
+// Safe for concurrent use. The HashLogs handed out are shared and must not be mutated.
+type RecentHashes struct {
+	mtx sync.RWMutex
+
+	// The number of most-recent blocks to keep.
+	capacity uint64
+
+	// The blocks held, in increasing block order.
+	blocks []*HashLog
+}
+
+// NewRecentHashes creates a RecentHashes that keeps the given number of most-recent blocks.
+func NewRecentHashes(capacity uint) *RecentHashes {
+	if capacity == 0 {
+		capacity = 1
+	}
+	return &RecentHashes{capacity: uint64(capacity)}
+}
+
+// Add records a block's hashes as the newest block.
+func (r *RecentHashes) Add(log *HashLog) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.blocks = r.blocks[:r.search(log.BlockNumber)]
+	r.blocks = append(r.blocks, log)
+	if uint64(len(r.blocks)) > r.capacity {
+		excess := uint64(len(r.blocks)) - r.capacity
+		clear(r.blocks[:excess])
+		r.blocks = r.blocks[excess:]
+	}
+}
+
+// Get returns the hashes recorded for a block, if it is still held.
+func (r *RecentHashes) Get(blockNumber uint64) (*HashLog, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	i := r.search(blockNumber)
+	if i == len(r.blocks) || r.blocks[i].BlockNumber != blockNumber {
+		return nil, false
+	}
+	return r.blocks[i], true
+}
+
+// Latest returns the newest block number held. Returns false if nothing has been recorded yet.
+func (r *RecentHashes) Latest() (uint64, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if len(r.blocks) == 0 {
+		return 0, false
+	}
+	return r.blocks[len(r.blocks)-1].BlockNumber, true
+}
+
+// search returns the index of the first held block numbered at or above blockNumber. Must be called with mtx held.
+func (r *RecentHashes) search(blockNumber uint64) int {
+	return sort.Search(len(r.blocks), func(i int) bool { return r.blocks[i].BlockNumber >= blockNumber })
+}
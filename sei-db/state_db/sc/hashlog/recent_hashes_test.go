@@ -0,0 +1,77 @@
+package hashlog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func recentLog(block uint64, hash byte) *HashLog {
+	return &HashLog{BlockNumber: block, Hashes: map[string][]byte{"root": {hash}}}
+}
+
+func TestRecentHashesWindow(t *testing.T) {
+	r := NewRecentHashes(3)
+	_, ok := r.Latest()
+	require.False(t, ok)
+
+	for block := uint64(1); block <= 5; block++ {
+		r.Add(recentLog(block, byte(block)))
+	}
+	latest, ok := r.Latest()
+	require.True(t, ok)
+	require.Equal(t, uint64(5), latest)
+
+	// Only the three newest blocks are kept.
+	for block := uint64(1); block <= 2; block++ {
+		_, ok := r.Get(block)
+		require.False(t, ok, "block %d", block)
+	}
+	for block := uint64(3); block <= 5; block++ {
+		log, ok := r.Get(block)
+		require.True(t, ok, "block %d", block)
+		require.Equal(t, []byte{byte(block)}, log.Hashes["root"])
+	}
+	_, ok = r.Get(6)
+	require.False(t, ok)
+}
+
+func TestRecentHashesRollback(t *testing.T) {
+	r := NewRecentHashes(10)
+	for block := uint64(1); block <= 5; block++ {
+		r.Add(recentLog(block, byte(block)))
+	}
+
+	// Re-executing block 4 replaces it and drops block 5, which has not been re-executed yet.
+	r.Add(recentLog(4, 0xff))
+	latest, _ := r.Latest()
+	require.Equal(t, uint64(4), latest)
+	log, ok := r.Get(4)
+	require.True(t, ok)
+	require.Equal(t, []byte{0xff}, log.Hashes["root"])
+	_, ok = r.Get(5)
+	require.False(t, ok)
+	_, ok = r.Get(3)
+	require.True(t, ok)
+}
+
+func TestHashLoggerPublishesRecentHashes(t *testing.T) {
+	dir := t.TempDir()
+	recent := NewRecentHashes(16)
+	cfg := DefaultHashLoggerConfig(dir, "v1")
+	cfg.HashTypes = []string{"root"}
+	cfg.DisableChangesetHashing = true
+	cfg.RecentHashes = recent
+
+	hl, err := NewHashLogger(cfg)
+	require.NoError(t, err)
+	require.NoError(t, hl.ReportHash(1, "root", []byte{0x01}))
+	require.NoError(t, hl.ReportHash(2, "root", []byte{0x02}))
+	require.NoError(t, hl.Close())
+
+	for block := uint64(1); block <= 2; block++ {
+		log, ok := recent.Get(block)
+		require.True(t, ok, "block %d", block)
+		require.Equal(t, []byte{byte(block)}, log.Hashes["root"])
+	}
+}
@@ -65,6 +65,9 @@ func (s *CompositeStateStore) planRollback(target int64) (ssRollbackPlan, error)
 	if s.evmStore != nil || s.config.EVMSplit {
 		return ssRollbackPlan{}, fmt.Errorf("state store rollback does not support evm-ss-split yet; rebuild SS from state sync or set evm-ss-split=false")
 	}
+	if s.config.MigrateToBackend != "" {
+		return ssRollbackPlan{}, fmt.Errorf("state store rollback does not support a backend migration in progress; finish or remove it first")
+	}
 	if s.config.Backend != config.PebbleDBBackend {
 		return ssRollbackPlan{}, fmt.Errorf("state store rollback requires pebbledb backend, got %q", s.config.Backend)
 	}
@@ -16,9 +16,9 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-db/proto"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/ktype"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/flatkv/vtype"
-	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/backend"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/cosmos"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/evm"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/migration"
 	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/pruning"
 	sssnapshot "github.com/sei-protocol/sei-chain/sei-db/state_db/ss/snapshot"
 	"github.com/sei-protocol/sei-chain/sei-db/wal"
@@ -83,7 +83,11 @@ func NewCompositeStateStore(
 		return nil, fmt.Errorf("complete pending state store rollback: %w", err)
 	}
 
-	mvccDB, err := backend.ResolveBackend(ssConfig.Backend)(dbHome, ssConfig)
+	// A snapshot of a migrating store would capture one of its two databases and restore as neither.
+	if ssConfig.MigrateToBackend != "" && ssConfig.SnapshotInterval > 0 {
+		return nil, fmt.Errorf("ss-migrate-to-backend cannot be combined with ss-snapshot-enable; disable snapshots for the migration")
+	}
+	mvccDB, err := migration.Open(dbHome, ssConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cosmos MVCC DB: %w", err)
 	}
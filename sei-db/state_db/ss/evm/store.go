@@ -15,7 +15,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-db/controller"
 	"github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
 	"github.com/sei-protocol/sei-chain/sei-db/proto"
-	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/backend"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/migration"
 	sssnapshot "github.com/sei-protocol/sei-chain/sei-db/state_db/ss/snapshot"
 )
 
@@ -38,7 +38,8 @@ type EVMStateStore struct {
 // NewEVMStateStore opens either a single unified MVCC DB for all EVM state
 // or one MVCC DB per EVM sub-type.
 func NewEVMStateStore(dir string, ssConfig config.StateStoreConfig) (*EVMStateStore, error) {
-	opener := backend.ResolveBackend(ssConfig.Backend)
+	// Each sub-DB migrates on its own when ssConfig.MigrateToBackend is set.
+	opener := migration.Open
 
 	store := &EVMStateStore{
 		subDBs:          make(map[EVMStoreType]types.StateStore, NumEVMStoreTypes),
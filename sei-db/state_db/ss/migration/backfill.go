@@ -0,0 +1,431 @@
+package migration
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+// backfillChunkSize is the number of rows copied between progress saves. A chunk holds writeMu, so
+// it also bounds how long a commit can wait on the backfill.
+const backfillChunkSize = 10_000
+
+// start runs the migration worker in the background, unless the migration has nothing left to do.
+func (s *Store) start() {
+	switch s.Progress().Phase {
+	case PhaseCutOver, PhaseFailed:
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	s.cancel = cancel
+	s.done = done
+	go func() {
+		defer close(done)
+		s.run(ctx)
+	}()
+}
+
+// stop cancels the migration worker and waits for it to exit. A cancelled chunk is abandoned before
+// it is written, so the saved progress still describes the target.
+func (s *Store) stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+	s.done = nil
+}
+
+// run advances the migration one phase at a time until it cuts over, fails, or is stopped.
+func (s *Store) run(ctx context.Context) {
+	for {
+		var err error
+		switch s.Progress().Phase {
+		case PhaseBackfill:
+			err = s.backfill(ctx)
+		case PhaseVerify:
+			err = s.verify(ctx)
+		default:
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			s.fail(err)
+			return
+		}
+	}
+}
+
+// updateProgress applies fn to the migration record and saves it.
+func (s *Store) updateProgress(fn func(*Progress)) error {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	fn(&s.progress)
+	return saveProgress(s.targetDir, s.progress)
+}
+
+// fail stops the migration with err. Reads stay on the source; dual writes continue so that the
+// record stays truthful about what the target holds.
+func (s *Store) fail(err error) {
+	logger.Error("state store backend migration failed", "target", s.targetDir, "err", err)
+	if saveErr := s.updateProgress(func(p *Progress) {
+		p.Phase = PhaseFailed
+		p.Error = err.Error()
+	}); saveErr != nil {
+		logger.Error("failed to save state store migration progress", "target", s.targetDir, "err", saveErr)
+	}
+}
+
+// backfill copies every store's history below StartVersion from the source into the target, one
+// store key at a time, resuming from the saved cursor.
+func (s *Store) backfill(ctx context.Context) error {
+	if s.Progress().StoreKeys == nil {
+		lister, ok := s.source.(types.StoreKeyLister)
+		if !ok {
+			return fmt.Errorf("%T cannot list its store keys", s.source)
+		}
+		storeKeys, err := lister.StoreKeys()
+		if err != nil {
+			return fmt.Errorf("list store keys: %w", err)
+		}
+		if err := s.updateProgress(func(p *Progress) {
+			p.StoreKeys = append(make([]string, 0, len(storeKeys)), storeKeys...)
+		}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		p := s.Progress()
+		if p.StoreIndex >= len(p.StoreKeys) {
+			break
+		}
+		storeKey := p.StoreKeys[p.StoreIndex]
+		if err := s.backfillStore(ctx, storeKey, p.StartVersion, p.Cursor); err != nil {
+			return fmt.Errorf("backfill store %s: %w", storeKey, err)
+		}
+		if err := s.updateProgress(func(p *Progress) {
+			p.StoreIndex++
+			p.Cursor = nil
+		}); err != nil {
+			return err
+		}
+		logger.Info("backfilled state store migration store", "target", s.targetDir, "store", storeKey,
+			"storeIndex", p.StoreIndex+1, "stores", len(p.StoreKeys))
+	}
+
+	if err := s.target.SetEarliestVersion(s.source.GetEarliestVersion(), false); err != nil {
+		return fmt.Errorf("stamp migration target earliest version: %w", err)
+	}
+	// From here on the target holds every version the source does, so dual writes no longer need to
+	// be ordered against anything and may go through the target's async path.
+	s.writeMu.Lock()
+	s.syncTarget = false
+	s.writeMu.Unlock()
+	return s.updateProgress(func(p *Progress) {
+		p.Phase = PhaseVerify
+	})
+}
+
+// backfillStore copies the history of one store, skipping the keys up to and including cursor.
+// RawIterate has no start key, so resuming walks the skipped keys again without copying them.
+func (s *Store) backfillStore(ctx context.Context, storeKey string, startVersion int64, cursor []byte) error {
+	chunk := newBackfillChunk()
+	var (
+		key      []byte
+		versions []keyVersion
+		walkErr  error
+	)
+	// finishKey queues the history of the key just walked, and writes the chunk once it is full.
+	finishKey := func() error {
+		if key == nil {
+			return nil
+		}
+		history, err := s.keyHistory(storeKey, key, versions, startVersion)
+		if err != nil {
+			return err
+		}
+		chunk.add(key, history)
+		key, versions = nil, nil
+		if chunk.rows >= backfillChunkSize {
+			return s.writeChunk(storeKey, chunk)
+		}
+		return nil
+	}
+
+	_, err := s.source.RawIterate(storeKey, func(k, v []byte, version int64) bool {
+		if cursor != nil && bytes.Compare(k, cursor) <= 0 {
+			return false
+		}
+		if key != nil && !bytes.Equal(k, key) {
+			if walkErr = finishKey(); walkErr != nil {
+				return true
+			}
+			if walkErr = ctx.Err(); walkErr != nil {
+				return true
+			}
+		}
+		if key == nil {
+			key = bytes.Clone(k)
+		}
+		// Versions at or above StartVersion were dual-written and are the target's already.
+		if version < startVersion {
+			versions = append(versions, keyVersion{version: version, value: bytes.Clone(v)})
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	if walkErr != nil {
+		return walkErr
+	}
+	if err := finishKey(); err != nil {
+		return err
+	}
+	if chunk.rows > 0 {
+		return s.writeChunk(storeKey, chunk)
+	}
+	return nil
+}
+
+// keyVersion is one live row of a key.
+type keyVersion struct {
+	version int64
+	value   []byte
+}
+
+// keyHistory returns the rows to write for one key: its live rows below startVersion, plus a delete
+// wherever the key stopped being visible between them. RawIterate skips tombstones, so deletes are
+// found by reading the source. Between two writes a key's visibility only changes once, from
+// present to deleted, which makes the delete version the first one the key is absent at.
+func (s *Store) keyHistory(storeKey string, key []byte, versions []keyVersion, startVersion int64) ([]backfillRow, error) {
+	if len(versions) == 0 {
+		return nil, nil
+	}
+	slices.SortFunc(versions, func(a, b keyVersion) int {
+		return cmp.Compare(a.version, b.version)
+	})
+	earliest := s.source.GetEarliestVersion()
+	history := make([]backfillRow, 0, len(versions))
+	for i, kv := range versions {
+		history = append(history, backfillRow{version: kv.version, pair: &proto.KVPair{Key: key, Value: kv.value}})
+		next := startVersion
+		if i+1 < len(versions) {
+			next = versions[i+1].version
+		}
+		// Versions below the source's earliest read as absent whatever they held, so probes start at
+		// it. A pruned row only survives as the last one at or below earliest, so next is above it.
+		lo, hi := max(kv.version+1, earliest), next-1
+		if lo > hi {
+			continue
+		}
+		present, err := s.source.Has(storeKey, hi, key)
+		if err != nil {
+			return nil, err
+		}
+		if present {
+			continue
+		}
+		for lo < hi {
+			mid := lo + (hi-lo)/2
+			present, err := s.source.Has(storeKey, mid, key)
+			if err != nil {
+				return nil, err
+			}
+			if present {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		history = append(history, backfillRow{version: lo, pair: &proto.KVPair{Key: key, Delete: true}})
+	}
+	return history, nil
+}
+
+// backfillRow is one row to write to the target, at the version it was written at in the source.
+type backfillRow struct {
+	version int64
+	pair    *proto.KVPair
+}
+
+// backfillChunk is the rows of a run of whole keys, grouped by the version they are written at.
+type backfillChunk struct {
+	byVersion map[int64][]*proto.KVPair
+	lastKey   []byte
+	keys      uint64
+	rows      int
+}
+
+func newBackfillChunk() *backfillChunk {
+	return &backfillChunk{byVersion: make(map[int64][]*proto.KVPair)}
+}
+
+func (c *backfillChunk) add(key []byte, history []backfillRow) {
+	for _, row := range history {
+		c.byVersion[row.version] = append(c.byVersion[row.version], row.pair)
+	}
+	c.lastKey = key
+	if len(history) > 0 {
+		c.keys++
+		c.rows += len(history)
+	}
+}
+
+func (c *backfillChunk) reset() {
+	c.byVersion = make(map[int64][]*proto.KVPair)
+	c.lastKey = nil
+	c.keys = 0
+	c.rows = 0
+}
+
+// writeChunk writes a chunk to the target and saves the cursor past it. The writes rewrite the
+// target's latest-version marker, so they happen under writeMu and the marker is restored before
+// the lock is released.
+func (s *Store) writeChunk(storeKey string, chunk *backfillChunk) error {
+	versions := make([]int64, 0, len(chunk.byVersion))
+	for version := range chunk.byVersion {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+
+	s.writeMu.Lock()
+	var writeErr error
+	for _, version := range versions {
+		cs := []*proto.NamedChangeSet{{Name: storeKey, Changeset: proto.ChangeSet{Pairs: chunk.byVersion[version]}}}
+		if writeErr = s.target.ApplyChangesetSync(version, cs); writeErr != nil {
+			break
+		}
+	}
+	synced := s.targetLatest
+	restoreErr := s.target.SetLatestVersion(synced)
+	s.writeMu.Unlock()
+	if err := errors.Join(writeErr, restoreErr); err != nil {
+		return fmt.Errorf("write backfill chunk: %w", err)
+	}
+
+	lastKey := bytes.Clone(chunk.lastKey)
+	keys, rows := chunk.keys, uint64(chunk.rows)
+	chunk.reset()
+	return s.updateProgress(func(p *Progress) {
+		p.Cursor = lastKey
+		p.TargetSynced = synced
+		p.KeysCopied += keys
+		p.EntriesCopied += rows
+	})
+}
+
+// verify compares the two databases at the last backfilled version and at the latest version both
+// hold, and cuts reads over to the target when they agree. A version pruned away while it was being
+// compared is skipped rather than failed; the other still has to match.
+func (s *Store) verify(ctx context.Context) error {
+	p := s.Progress()
+	// Both latest markers move once a version is applied, not when it is queued, so every version up
+	// to the smaller of them is complete on both sides.
+	latest := min(s.source.GetLatestVersion(), s.target.GetLatestVersion())
+
+	candidates := []int64{p.StartVersion - 1}
+	if latest > p.StartVersion-1 {
+		candidates = append(candidates, latest)
+	}
+	var verified []int64
+	for _, version := range candidates {
+		if version <= 0 || version < s.earliestReadable() {
+			continue
+		}
+		err := s.compareAt(ctx, version)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if version < s.earliestReadable() {
+			logger.Info("state store migration verify version was pruned, skipping",
+				"target", s.targetDir, "version", version)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("verify version %d: %w", version, err)
+		}
+		verified = append(verified, version)
+	}
+	if len(verified) == 0 {
+		return errors.New("verify: every candidate version was pruned before it could be compared")
+	}
+
+	if err := s.updateProgress(func(p *Progress) {
+		p.VerifiedVersions = verified
+		p.Phase = PhaseCutOver
+	}); err != nil {
+		return err
+	}
+	s.cutOver.Store(true)
+	logger.Info("state store backend migration verified, serving reads from the target",
+		"target", s.targetDir, "versions", verified)
+	return nil
+}
+
+// earliestReadable is the oldest version both databases can serve.
+func (s *Store) earliestReadable() int64 {
+	return max(s.source.GetEarliestVersion(), s.target.GetEarliestVersion())
+}
+
+// compareAt walks every store of both databases at version and reports the first difference.
+func (s *Store) compareAt(ctx context.Context, version int64) error {
+	lister, ok := s.source.(types.StoreKeyLister)
+	if !ok {
+		return fmt.Errorf("%T cannot list its store keys", s.source)
+	}
+	storeKeys, err := lister.StoreKeys()
+	if err != nil {
+		return fmt.Errorf("list store keys: %w", err)
+	}
+	for _, storeKey := range storeKeys {
+		if err := s.compareStore(ctx, storeKey, version); err != nil {
+			return fmt.Errorf("store %s: %w", storeKey, err)
+		}
+	}
+	return nil
+}
+
+func (s *Store) compareStore(ctx context.Context, storeKey string, version int64) (err error) {
+	sourceItr, err := s.source.Iterator(storeKey, version, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, sourceItr.Close()) }()
+	targetItr, err := s.target.Iterator(storeKey, version, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, targetItr.Close()) }()
+
+	for ; sourceItr.Valid() && targetItr.Valid(); sourceItr.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !bytes.Equal(sourceItr.Key(), targetItr.Key()) {
+			return fmt.Errorf("key mismatch: source %X, target %X", sourceItr.Key(), targetItr.Key())
+		}
+		if !bytes.Equal(sourceItr.Value(), targetItr.Value()) {
+			return fmt.Errorf("value mismatch at key %X", sourceItr.Key())
+		}
+		targetItr.Next()
+	}
+	if sourceItr.Valid() {
+		return fmt.Errorf("key %X missing from target", sourceItr.Key())
+	}
+	if targetItr.Valid() {
+		return fmt.Errorf("key %X missing from source", targetItr.Key())
+	}
+	return errors.Join(sourceItr.Error(), targetItr.Error())
+}
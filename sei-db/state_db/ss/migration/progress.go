@@ -0,0 +1,141 @@
+package migration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// progressFile is the name of the migration record inside the target directory. It is a file beside
+// the target DB rather than a key inside it so that seidb can report progress without opening either
+// database, both of which a running node holds locked.
+const progressFile = "ss-migration.json"
+
+// Phase is the stage a backend migration has reached.
+type Phase string
+
+const (
+	// PhaseBackfill copies the versions below StartVersion into the target while new versions are
+	// written to both backends. Reads are served by the source.
+	PhaseBackfill Phase = "backfill"
+	// PhaseVerify compares the two backends at fixed versions. Reads are served by the source.
+	PhaseVerify Phase = "verify"
+	// PhaseCutOver serves reads from the target. Both backends are still written, so the operator can
+	// switch the configured backend at any restart.
+	PhaseCutOver Phase = "cut_over"
+	// PhaseFailed stops the migration. Reads stay on the source and new versions are still written to
+	// both; Progress.Error says why. Removing the target directory starts over.
+	PhaseFailed Phase = "failed"
+)
+
+// Progress is the persisted state of one database's migration. It is rewritten after every backfill
+// chunk, so a restart resumes from Cursor rather than from the beginning.
+type Progress struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Phase Phase  `json:"phase"`
+
+	// StartVersion is the first version written to both backends. The backfill owns every version
+	// below it and nothing at or above it.
+	StartVersion int64 `json:"start_version"`
+
+	// TargetSynced is a version the target was known to hold every dual-written version up to when
+	// the record was saved. A backfill chunk briefly rewrites the target's own latest-version marker,
+	// so after a crash this is the floor the target is caught up from.
+	TargetSynced int64 `json:"target_synced"`
+
+	// StoreKeys are the stores the backfill walks, in order, listed once when it starts and nil until
+	// then. A store first written after the listing has no history below StartVersion to copy.
+	StoreKeys []string `json:"store_keys"`
+	// StoreIndex is the position in StoreKeys of the store being copied.
+	StoreIndex int `json:"store_index"`
+	// Cursor is the last key of StoreKeys[StoreIndex] whose history has been copied.
+	Cursor []byte `json:"cursor,omitempty"`
+
+	KeysCopied    uint64 `json:"keys_copied"`
+	EntriesCopied uint64 `json:"entries_copied"`
+
+	// VerifiedVersions are the versions at which the two backends were found to hold the same state.
+	VerifiedVersions []int64 `json:"verified_versions,omitempty"`
+
+	Error     string    `json:"error,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TargetDir returns the directory the migration of the database at dbHome writes to. The last path
+// element naming the source backend is swapped for the target's, which puts the copy where the
+// default layout looks for it once the configured backend changes: .../cosmos/pebbledb migrates to
+// .../cosmos/rocksdb, and an EVM sub-DB under .../evm/pebbledb/storage to .../evm/rocksdb/storage.
+// A directory that names no backend gets the target's name as a suffix, and must then be configured
+// explicitly when the migration is finished.
+func TargetDir(dbHome, from, to string) string {
+	clean := filepath.Clean(dbHome)
+	parts := strings.Split(clean, string(filepath.Separator))
+	for i := len(parts) - 1; i >= 0; i-- {
+		if parts[i] == from {
+			parts[i] = to
+			return strings.Join(parts, string(filepath.Separator))
+		}
+	}
+	return clean + "-" + to
+}
+
+// LoadProgress reads the migration record from targetDir. found is false when no migration has
+// been started there.
+func LoadProgress(targetDir string) (progress Progress, found bool, err error) {
+	data, err := os.ReadFile(filepath.Join(targetDir, progressFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return Progress{}, false, nil
+		}
+		return Progress{}, false, fmt.Errorf("read migration progress: %w", err)
+	}
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return Progress{}, false, fmt.Errorf("decode migration progress %s: %w", filepath.Join(targetDir, progressFile), err)
+	}
+	return progress, true, nil
+}
+
+// saveProgress replaces the migration record in targetDir. The record is written to a temporary
+// file and renamed over the old one, so a crash leaves one or the other.
+func saveProgress(targetDir string, progress Progress) error {
+	progress.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode migration progress: %w", err)
+	}
+	path := filepath.Join(targetDir, progressFile)
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("write migration progress: %w", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write migration progress: %w", err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("sync migration progress: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close migration progress: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replace migration progress: %w", err)
+	}
+	dir, err := os.Open(targetDir)
+	if err != nil {
+		return fmt.Errorf("open migration directory: %w", err)
+	}
+	defer func() { _ = dir.Close() }()
+	if err := dir.Sync(); err != nil {
+		return fmt.Errorf("sync migration directory: %w", err)
+	}
+	return nil
+}
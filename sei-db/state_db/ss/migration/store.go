@@ -0,0 +1,504 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/sei-protocol/sei-chain/sei-db/common/utils"
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/backend"
+	"github.com/sei-protocol/sei-chain/sei-db/wal"
+	"github.com/sei-protocol/seilog"
+)
+
+var logger = seilog.NewLogger("db", "state-db", "ss", "migration")
+
+var (
+	_ types.StateStore           = (*Store)(nil)
+	_ types.ContextIteratorStore = (*Store)(nil)
+	_ types.PendingWriteWaiter   = (*Store)(nil)
+	_ types.StoreKeyLister       = (*Store)(nil)
+	_ types.SnapshotWALPruner    = (*Store)(nil)
+	_ types.SnapshotWALReader    = (*Store)(nil)
+)
+
+// Store is one SS database in the middle of a move to another backend. It is a types.StateStore
+// over two MVCC databases of the same logical contents: the source, in the configured backend, and
+// the target, in the backend being migrated to.
+//
+//   - Every write goes to both, so the target holds every version from Progress.StartVersion on.
+//   - A background worker copies the versions below StartVersion out of the source, one store key
+//     at a time, saving a cursor after every chunk.
+//   - Once the copy is complete the worker compares the two at fixed versions, and on a match cuts
+//     reads over to the target.
+//
+// The migration is finished by the operator rather than by the store: switching the configured
+// backend to the target and dropping MigrateToBackend opens the target on its own at the next start.
+type Store struct {
+	source    types.StateStore
+	target    types.StateStore
+	sourceDir string
+	targetDir string
+
+	// writeMu orders target writes against backfill chunks. A chunk writes versions below every
+	// dual-written one, which rewrites the target's latest-version marker, and restores the marker
+	// before releasing the lock. While the backfill runs, target writes are applied synchronously
+	// under this lock so that the version being restored is one the target actually holds.
+	writeMu sync.Mutex
+	// syncTarget and targetLatest are guarded by writeMu.
+	syncTarget   bool
+	targetLatest int64
+
+	progressMu sync.Mutex
+	progress   Progress // guarded by progressMu
+
+	cutOver atomic.Bool
+
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// Open opens the SS database at dbHome. It has the backend.OpenFunc signature, and is what the
+// state stores open their MVCC databases through: without cfg.MigrateToBackend, or with it naming
+// the configured backend, it is the plain backend opener; otherwise it returns a migrating Store.
+func Open(dbHome string, cfg config.StateStoreConfig) (types.StateStore, error) {
+	return open(dbHome, cfg, backend.ResolveBackend)
+}
+
+// open is Open with the backend resolver injected, so tests can migrate between two engines that
+// are both available without build tags.
+func open(dbHome string, cfg config.StateStoreConfig, resolve func(string) backend.OpenFunc) (types.StateStore, error) {
+	from := backendName(cfg.Backend)
+	to := cfg.MigrateToBackend
+	if to == "" || to == from {
+		return resolve(cfg.Backend)(dbHome, cfg)
+	}
+	if to != config.PebbleDBBackend && to != config.RocksDBBackend {
+		return nil, fmt.Errorf("unsupported migrate-to backend %q: supported backends are %s and %s",
+			to, config.PebbleDBBackend, config.RocksDBBackend)
+	}
+
+	targetDir := TargetDir(dbHome, from, to)
+	progress, found, err := LoadProgress(targetDir)
+	if err != nil {
+		return nil, err
+	}
+	if found && (progress.From != from || progress.To != to) {
+		return nil, fmt.Errorf("%s holds a migration from %s to %s, not %s to %s; remove it to start over",
+			targetDir, progress.From, progress.To, from, to)
+	}
+
+	source, err := resolve(from)(dbHome, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		source:    source,
+		sourceDir: dbHome,
+		targetDir: targetDir,
+	}
+	if err := s.openTarget(resolve(to), cfg, from, to, progress, found); err != nil {
+		_ = source.Close()
+		return nil, err
+	}
+	s.start()
+	return s, nil
+}
+
+// backendName resolves an unset backend to the default, as backend.ResolveBackend does.
+func backendName(name string) string {
+	if name == "" {
+		return config.DefaultSSBackend
+	}
+	return name
+}
+
+// openTarget opens the target and brings it level with the source. A target that cannot be brought
+// level — it holds data but no record, or its gap to the source is older than the source's
+// changelog — is discarded, and the migration starts over from the source's current version.
+func (s *Store) openTarget(openDB backend.OpenFunc, cfg config.StateStoreConfig, from, to string, progress Progress, found bool) error {
+	targetCfg := cfg
+	targetCfg.Backend = to
+	targetCfg.MigrateToBackend = ""
+	targetCfg.DBDirectory = s.targetDir
+	open := func() error {
+		target, err := openDB(s.targetDir, targetCfg)
+		if err != nil {
+			return fmt.Errorf("open migration target %s: %w", s.targetDir, err)
+		}
+		s.target = target
+		return nil
+	}
+	if err := open(); err != nil {
+		return err
+	}
+
+	reset := false
+	if found {
+		caughtUp, err := s.catchUpTarget(progress)
+		if err != nil {
+			_ = s.target.Close()
+			return err
+		}
+		if caughtUp {
+			s.progress = progress
+			s.syncTarget = progress.Phase == PhaseBackfill
+			s.cutOver.Store(progress.Phase == PhaseCutOver)
+			logger.Info("resuming state store backend migration",
+				"dir", s.sourceDir, "target", s.targetDir, "phase", progress.Phase,
+				"startVersion", progress.StartVersion, "storeIndex", progress.StoreIndex,
+				"stores", len(progress.StoreKeys))
+			return nil
+		}
+		logger.Warn("state store migration target cannot be caught up from the changelog, starting over",
+			"target", s.targetDir, "targetSynced", progress.TargetSynced,
+			"sourceLatest", s.source.GetLatestVersion())
+		reset = true
+	} else if s.target.GetLatestVersion() > 0 || s.target.GetEarliestVersion() > 0 {
+		// Data without a record is a migration that crashed before its first save, or a directory
+		// that was never this migration's. Neither can be trusted as a copy.
+		logger.Warn("state store migration target holds data but no migration record, starting over",
+			"target", s.targetDir)
+		reset = true
+	}
+	if reset {
+		if err := s.target.Close(); err != nil {
+			return fmt.Errorf("close migration target %s: %w", s.targetDir, err)
+		}
+		if err := os.RemoveAll(s.targetDir); err != nil {
+			return fmt.Errorf("remove migration target %s: %w", s.targetDir, err)
+		}
+		if err := open(); err != nil {
+			return err
+		}
+	}
+
+	latest := s.source.GetLatestVersion()
+	s.progress = Progress{
+		From:         from,
+		To:           to,
+		Phase:        PhaseBackfill,
+		StartVersion: latest + 1,
+		TargetSynced: latest,
+		StartedAt:    time.Now().UTC(),
+	}
+	s.syncTarget = true
+	s.targetLatest = latest
+	if err := s.target.SetLatestVersion(latest); err != nil {
+		_ = s.target.Close()
+		return fmt.Errorf("stamp migration target version: %w", err)
+	}
+	if err := saveProgress(s.targetDir, s.progress); err != nil {
+		_ = s.target.Close()
+		return err
+	}
+	logger.Info("starting state store backend migration",
+		"dir", s.sourceDir, "target", s.targetDir, "from", from, "to", to,
+		"startVersion", s.progress.StartVersion)
+	return nil
+}
+
+// catchUpTarget replays the source's changelog into the target for every version the source holds
+// and the target may not. It reports false when the changelog no longer reaches back far enough.
+//
+// The target's own marker is not trusted alone during the backfill: a crash inside a chunk leaves
+// it at a backfilled version. Progress.TargetSynced, saved after the marker was restored, is a floor
+// that was true when written.
+func (s *Store) catchUpTarget(progress Progress) (bool, error) {
+	sourceLatest := s.source.GetLatestVersion()
+	from := s.target.GetLatestVersion()
+	if progress.Phase == PhaseBackfill {
+		from = max(from, progress.TargetSynced)
+	}
+	from = max(from, progress.StartVersion-1)
+	if from >= sourceLatest {
+		s.targetLatest = from
+		return true, nil
+	}
+
+	changelog, err := wal.NewChangelogWAL(utils.GetChangelogPath(s.sourceDir), wal.Config{})
+	if err != nil {
+		return false, fmt.Errorf("open source changelog: %w", err)
+	}
+	defer func() { _ = changelog.Close() }()
+	firstOffset, err := changelog.FirstOffset()
+	if err != nil {
+		return false, fmt.Errorf("read source changelog first offset: %w", err)
+	}
+	lastOffset, err := changelog.LastOffset()
+	if err != nil {
+		return false, fmt.Errorf("read source changelog last offset: %w", err)
+	}
+	if firstOffset == 0 || lastOffset == 0 {
+		return false, nil
+	}
+	oldest, err := changelog.ReadAt(firstOffset)
+	if err != nil {
+		return false, fmt.Errorf("read source changelog: %w", err)
+	}
+	// An empty block writes no entry, so an oldest entry above from+1 may still be contiguous with
+	// it. It cannot be told apart from a pruned gap, and a gap would leave the copy silently short.
+	if oldest.Version > from+1 {
+		return false, nil
+	}
+	startOffset, err := wal.FindFirstOffsetAfterVersion(changelog, firstOffset, lastOffset, from)
+	if err != nil {
+		return false, fmt.Errorf("find source changelog offset: %w", err)
+	}
+	if startOffset <= lastOffset {
+		err := changelog.Replay(startOffset, lastOffset, func(_ uint64, entry proto.ChangelogEntry) error {
+			if entry.Version > sourceLatest {
+				return nil
+			}
+			return s.target.ApplyChangesetSync(entry.Version, entry.Changesets)
+		})
+		if err != nil {
+			return false, fmt.Errorf("replay source changelog into migration target: %w", err)
+		}
+	}
+	if err := s.target.SetLatestVersion(sourceLatest); err != nil {
+		return false, fmt.Errorf("stamp migration target version: %w", err)
+	}
+	logger.Info("caught state store migration target up from the source changelog",
+		"target", s.targetDir, "from", from, "to", sourceLatest)
+	s.targetLatest = sourceLatest
+	return true, nil
+}
+
+// Progress returns a copy of the migration's current state.
+func (s *Store) Progress() Progress {
+	s.progressMu.Lock()
+	defer s.progressMu.Unlock()
+	p := s.progress
+	p.StoreKeys = append([]string(nil), p.StoreKeys...)
+	p.Cursor = append([]byte(nil), p.Cursor...)
+	p.VerifiedVersions = append([]int64(nil), p.VerifiedVersions...)
+	return p
+}
+
+// reader is the database reads are served from: the source until the cut-over, the target after.
+func (s *Store) reader() types.StateStore {
+	if s.cutOver.Load() {
+		return s.target
+	}
+	return s.source
+}
+
+func (s *Store) Get(storeKey string, version int64, key []byte) ([]byte, error) {
+	return s.reader().Get(storeKey, version, key)
+}
+
+func (s *Store) Has(storeKey string, version int64, key []byte) (bool, error) {
+	return s.reader().Has(storeKey, version, key)
+}
+
+func (s *Store) Iterator(storeKey string, version int64, start, end []byte) (dbm.Iterator, error) {
+	return s.reader().Iterator(storeKey, version, start, end)
+}
+
+func (s *Store) ReverseIterator(storeKey string, version int64, start, end []byte) (dbm.Iterator, error) {
+	return s.reader().ReverseIterator(storeKey, version, start, end)
+}
+
+func (s *Store) IteratorWithContext(ctx context.Context, storeKey string, version int64, start, end []byte) (dbm.Iterator, error) {
+	return types.IterateWithContext(s.reader(), ctx, storeKey, version, start, end, false)
+}
+
+func (s *Store) ReverseIteratorWithContext(ctx context.Context, storeKey string, version int64, start, end []byte) (dbm.Iterator, error) {
+	return types.IterateWithContext(s.reader(), ctx, storeKey, version, start, end, true)
+}
+
+func (s *Store) RawIterate(storeKey string, fn func([]byte, []byte, int64) bool) (bool, error) {
+	return s.reader().RawIterate(storeKey, fn)
+}
+
+func (s *Store) StoreKeys() ([]string, error) {
+	lister, ok := s.reader().(types.StoreKeyLister)
+	if !ok {
+		return nil, fmt.Errorf("%T cannot list its store keys", s.reader())
+	}
+	return lister.StoreKeys()
+}
+
+func (s *Store) GetLatestVersion() int64 {
+	return s.reader().GetLatestVersion()
+}
+
+func (s *Store) GetEarliestVersion() int64 {
+	return s.reader().GetEarliestVersion()
+}
+
+// =============================================================================
+// Write path
+// =============================================================================
+
+func (s *Store) SetLatestVersion(version int64) error {
+	if err := s.source.SetLatestVersion(version); err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err := s.target.SetLatestVersion(version); err != nil {
+		return fmt.Errorf("migration target: %w", err)
+	}
+	s.targetLatest = version
+	return nil
+}
+
+func (s *Store) SetEarliestVersion(version int64, ignoreVersion bool) error {
+	if err := s.source.SetEarliestVersion(version, ignoreVersion); err != nil {
+		return err
+	}
+	if err := s.target.SetEarliestVersion(version, ignoreVersion); err != nil {
+		return fmt.Errorf("migration target: %w", err)
+	}
+	return nil
+}
+
+func (s *Store) ApplyChangesetSync(version int64, changesets []*proto.NamedChangeSet) error {
+	if err := s.source.ApplyChangesetSync(version, changesets); err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	if err := s.target.ApplyChangesetSync(version, changesets); err != nil {
+		return fmt.Errorf("migration target: %w", err)
+	}
+	s.targetLatest = max(s.targetLatest, version)
+	return nil
+}
+
+// ApplyChangesetAsync enqueues on the source as usual. The target is written synchronously while
+// the backfill runs (see writeMu), which a commit pays for with the target's write latency and, at
+// worst, a wait for the backfill chunk in flight.
+func (s *Store) ApplyChangesetAsync(version int64, changesets []*proto.NamedChangeSet) error {
+	if err := s.source.ApplyChangesetAsync(version, changesets); err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	var err error
+	if s.syncTarget {
+		err = s.target.ApplyChangesetSync(version, changesets)
+	} else {
+		err = s.target.ApplyChangesetAsync(version, changesets)
+	}
+	if err != nil {
+		return fmt.Errorf("migration target: %w", err)
+	}
+	s.targetLatest = max(s.targetLatest, version)
+	return nil
+}
+
+func (s *Store) Prune(version int64) error {
+	if err := s.source.Prune(version); err != nil {
+		return err
+	}
+	if err := s.target.Prune(version); err != nil {
+		return fmt.Errorf("migration target: %w", err)
+	}
+	return nil
+}
+
+// Import loads a state-sync snapshot into both databases. Imported state is state the target
+// already has, so the migration restarts with its start version at the imported one: there is no
+// history below it on either side.
+func (s *Store) Import(version int64, ch <-chan types.SnapshotNode) error {
+	s.stop()
+
+	sourceCh := make(chan types.SnapshotNode, cap(ch))
+	targetCh := make(chan types.SnapshotNode, cap(ch))
+	var wg sync.WaitGroup
+	var sourceErr, targetErr error
+	importInto := func(db types.StateStore, in chan types.SnapshotNode, errOut *error) {
+		defer wg.Done()
+		*errOut = db.Import(version, in)
+		// An importer that gave up early must not stall the other one on a full channel.
+		for range in {
+		}
+	}
+	wg.Add(2)
+	go importInto(s.source, sourceCh, &sourceErr)
+	go importInto(s.target, targetCh, &targetErr)
+	for node := range ch {
+		sourceCh <- node
+		targetCh <- node
+	}
+	close(sourceCh)
+	close(targetCh)
+	wg.Wait()
+	if sourceErr != nil {
+		return sourceErr
+	}
+	if targetErr != nil {
+		return fmt.Errorf("migration target: %w", targetErr)
+	}
+
+	s.writeMu.Lock()
+	s.syncTarget = true
+	s.targetLatest = max(s.targetLatest, version)
+	s.writeMu.Unlock()
+	s.cutOver.Store(false)
+
+	s.progressMu.Lock()
+	s.progress = Progress{
+		From:         s.progress.From,
+		To:           s.progress.To,
+		Phase:        PhaseBackfill,
+		StartVersion: version,
+		TargetSynced: version,
+		StartedAt:    time.Now().UTC(),
+	}
+	err := saveProgress(s.targetDir, s.progress)
+	s.progressMu.Unlock()
+	if err != nil {
+		return err
+	}
+	s.start()
+	return nil
+}
+
+// PruneWALBeforeVersion and WALVersionsAfter act on the source's changelog. The target keeps one of
+// its own, which nothing reads: the gap a crash leaves in the target is filled from the source's.
+func (s *Store) PruneWALBeforeVersion(version int64) error {
+	if p, ok := s.source.(types.SnapshotWALPruner); ok {
+		return p.PruneWALBeforeVersion(version)
+	}
+	return nil
+}
+
+func (s *Store) WALVersionsAfter(version int64) (oldest int64, next int64, err error) {
+	r, ok := s.source.(types.SnapshotWALReader)
+	if !ok {
+		return 0, 0, fmt.Errorf("%T does not keep a changelog WAL", s.source)
+	}
+	return r.WALVersionsAfter(version)
+}
+
+func (s *Store) WaitForPendingWrites() {
+	for _, db := range []types.StateStore{s.source, s.target} {
+		if w, ok := db.(types.PendingWriteWaiter); ok {
+			w.WaitForPendingWrites()
+		}
+	}
+}
+
+func (s *Store) Close() error {
+	s.closeOnce.Do(func() {
+		s.stop()
+		s.closeErr = errors.Join(s.target.Close(), s.source.Close())
+	})
+	return s.closeErr
+}
@@ -0,0 +1,215 @@
+package migration
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/types"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/ss/backend"
+)
+
+// pebbleOnly resolves every backend to PebbleDB, so a "rocksdb" target is a second PebbleDB and
+// the migration can run without the rocksdbBackend build tag.
+func pebbleOnly(string) backend.OpenFunc {
+	return backend.ResolveBackend(config.PebbleDBBackend)
+}
+
+func testConfig() config.StateStoreConfig {
+	return config.StateStoreConfig{
+		Backend:    config.PebbleDBBackend,
+		KeepRecent: 100000,
+	}
+}
+
+func changeset(store string, pairs ...*proto.KVPair) []*proto.NamedChangeSet {
+	return []*proto.NamedChangeSet{{Name: store, Changeset: proto.ChangeSet{Pairs: pairs}}}
+}
+
+func set(key, value string) *proto.KVPair {
+	return &proto.KVPair{Key: []byte(key), Value: []byte(value)}
+}
+
+func del(key string) *proto.KVPair {
+	return &proto.KVPair{Key: []byte(key), Delete: true}
+}
+
+// writeHistory writes five versions to a plain PebbleDB at dir: "a" is rewritten, "b" is deleted
+// and written again, and "c" is deleted for good.
+func writeHistory(t *testing.T, dir string) {
+	t.Helper()
+	db, err := pebbleOnly("")(dir, testConfig())
+	require.NoError(t, err)
+	versions := [][]*proto.NamedChangeSet{
+		changeset("bank", set("a", "a1"), set("b", "b1"), set("c", "c1")),
+		changeset("bank", set("a", "a2")),
+		changeset("bank", del("b")),
+		changeset("staking", set("x", "x4")),
+		changeset("bank", set("b", "b5"), del("c")),
+	}
+	for i, cs := range versions {
+		require.NoError(t, db.ApplyChangesetSync(int64(i+1), cs))
+		require.NoError(t, db.SetLatestVersion(int64(i+1)))
+	}
+	require.NoError(t, db.Close())
+}
+
+func openMigrating(t *testing.T, dir string) *Store {
+	t.Helper()
+	cfg := testConfig()
+	cfg.MigrateToBackend = config.RocksDBBackend
+	db, err := open(dir, cfg, pebbleOnly)
+	require.NoError(t, err)
+	s, ok := db.(*Store)
+	require.True(t, ok, "expected a migrating store, got %T", db)
+	return s
+}
+
+func waitForPhase(t *testing.T, s *Store, phase Phase) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		p := s.Progress().Phase
+		return p == phase || p == PhaseFailed
+	}, 10*time.Second, 10*time.Millisecond)
+	p := s.Progress()
+	require.Equal(t, phase, p.Phase, p.Error)
+}
+
+// requireSameAt asserts both databases return the same value for every key at every version.
+func requireSameAt(t *testing.T, source, target types.StateStore, versions int64, stores map[string][]string) {
+	t.Helper()
+	for version := int64(1); version <= versions; version++ {
+		for store, storeKeys := range stores {
+			for _, key := range storeKeys {
+				want, err := source.Get(store, version, []byte(key))
+				require.NoError(t, err)
+				got, err := target.Get(store, version, []byte(key))
+				require.NoError(t, err)
+				require.Equal(t, want, got, "%s/%s at version %d", store, key, version)
+			}
+		}
+	}
+}
+
+func TestTargetDir(t *testing.T) {
+	for _, tc := range []struct {
+		dbHome, want string
+	}{
+		{"/home/data/state_store/cosmos/pebbledb", "/home/data/state_store/cosmos/rocksdb"},
+		{"/home/data/state_store/evm/pebbledb/storage", "/home/data/state_store/evm/rocksdb/storage"},
+		{"/home/data/pebbledb/ss/pebbledb/", "/home/data/pebbledb/ss/rocksdb"},
+		{"/home/data/ss", "/home/data/ss-rocksdb"},
+	} {
+		require.Equal(t, tc.want, TargetDir(tc.dbHome, config.PebbleDBBackend, config.RocksDBBackend), tc.dbHome)
+	}
+}
+
+func TestOpenWithoutMigrationIsThePlainBackend(t *testing.T) {
+	for _, migrateTo := range []string{"", config.PebbleDBBackend} {
+		cfg := testConfig()
+		cfg.MigrateToBackend = migrateTo
+		db, err := open(t.TempDir(), cfg, pebbleOnly)
+		require.NoError(t, err)
+		_, migrating := db.(*Store)
+		require.False(t, migrating, "migrate-to %q", migrateTo)
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestOpenRejectsUnknownTarget(t *testing.T) {
+	cfg := testConfig()
+	cfg.MigrateToBackend = "leveldb"
+	_, err := open(t.TempDir(), cfg, pebbleOnly)
+	require.ErrorContains(t, err, "unsupported migrate-to backend")
+}
+
+func TestMigrationCopiesHistoryAndCutsOver(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cosmos", config.PebbleDBBackend)
+	writeHistory(t, dir)
+
+	s := openMigrating(t, dir)
+	defer func() { require.NoError(t, s.Close()) }()
+	require.Equal(t, int64(6), s.Progress().StartVersion)
+
+	// Dual-written while the backfill runs.
+	require.NoError(t, s.ApplyChangesetSync(6, changeset("bank", set("a", "a6"), del("b"))))
+	require.NoError(t, s.SetLatestVersion(6))
+
+	waitForPhase(t, s, PhaseCutOver)
+	p := s.Progress()
+	require.Equal(t, []string{"bank", "staking"}, p.StoreKeys)
+	require.Contains(t, p.VerifiedVersions, int64(5))
+	require.Equal(t, uint64(4), p.KeysCopied)
+	// a1, a2, b1, b deleted at 3, b5, c1, c deleted at 5, x4.
+	require.Equal(t, uint64(8), p.EntriesCopied)
+
+	stores := map[string][]string{"bank": {"a", "b", "c"}, "staking": {"x"}}
+	requireSameAt(t, s.source, s.target, 6, stores)
+	require.Equal(t, int64(6), s.target.GetLatestVersion())
+
+	// Reads are served by the target now.
+	got, err := s.Get("bank", 2, []byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a2"), got)
+	ok, err := s.Has("bank", 4, []byte("b"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	saved, found, err := LoadProgress(s.targetDir)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, PhaseCutOver, saved.Phase)
+}
+
+func TestMigrationResumesFromSavedProgress(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cosmos", config.PebbleDBBackend)
+	writeHistory(t, dir)
+
+	s := openMigrating(t, dir)
+	waitForPhase(t, s, PhaseCutOver)
+	require.NoError(t, s.Close())
+
+	// A record still mid-backfill, with the first store done, picks up from the second.
+	saved, found, err := LoadProgress(s.targetDir)
+	require.NoError(t, err)
+	require.True(t, found)
+	saved.Phase = PhaseBackfill
+	saved.StoreIndex = 1
+	saved.Cursor = nil
+	saved.KeysCopied = 0
+	saved.EntriesCopied = 0
+	saved.VerifiedVersions = nil
+	require.NoError(t, saveProgress(s.targetDir, saved))
+
+	s = openMigrating(t, dir)
+	defer func() { require.NoError(t, s.Close()) }()
+	waitForPhase(t, s, PhaseCutOver)
+	p := s.Progress()
+	require.Equal(t, uint64(1), p.KeysCopied, "only staking should be walked again")
+	require.Equal(t, uint64(1), p.EntriesCopied)
+}
+
+func TestMigrationRestartsWhenTargetHasNoRecord(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cosmos", config.PebbleDBBackend)
+	writeHistory(t, dir)
+
+	// A target with data but no record is not trusted as a copy.
+	targetDir := TargetDir(dir, config.PebbleDBBackend, config.RocksDBBackend)
+	stray, err := pebbleOnly("")(targetDir, testConfig())
+	require.NoError(t, err)
+	require.NoError(t, stray.ApplyChangesetSync(9, changeset("bank", set("stray", "v"))))
+	require.NoError(t, stray.SetLatestVersion(9))
+	require.NoError(t, stray.Close())
+
+	s := openMigrating(t, dir)
+	defer func() { require.NoError(t, s.Close()) }()
+	waitForPhase(t, s, PhaseCutOver)
+	got, err := s.Get("bank", 5, []byte("stray"))
+	require.NoError(t, err)
+	require.Nil(t, got)
+	requireSameAt(t, s.source, s.target, 5, map[string][]string{"bank": {"a", "b", "c"}})
+}
@@ -0,0 +1,50 @@
+package statesize
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Values of the "store" metric attribute.
+const (
+	storeSC       = "sc"
+	storeSSWrites = "ss_writes"
+)
+
+var meter = otel.Meter("seidb_statesize")
+
+var metrics = struct {
+	Keys  metric.Int64Gauge
+	Bytes metric.Int64Gauge
+}{
+	Keys: must(meter.Int64Gauge(
+		"state_size_keys",
+		metric.WithDescription("Keys per module: live keys for sc, rows written since tracking started for ss_writes"),
+		metric.WithUnit("{count}"),
+	)),
+	Bytes: must(meter.Int64Gauge(
+		"state_size_bytes",
+		metric.WithDescription("Key and value bytes per module: live state for sc, bytes written since tracking started for ss_writes"),
+		metric.WithUnit("By"),
+	)),
+}
+
+func must[V any](instrument V, err error) V {
+	if err != nil {
+		panic(err)
+	}
+	return instrument
+}
+
+// recordSizes publishes each module's total. Prefixes are left to the admin query, which keeps the
+// series count to one per module.
+func recordSizes(store string, sizes table) {
+	for module, m := range sizes {
+		attrs := metric.WithAttributes(attribute.String("store", store), attribute.String("module", module))
+		metrics.Keys.Record(context.Background(), m.Total.Keys, attrs)
+		metrics.Bytes.Record(context.Background(), m.Total.Bytes, attrs)
+	}
+}
@@ -0,0 +1,162 @@
+package statesize
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// stateFile holds the running totals at the version they were persisted at.
+	stateFile = "state-size.json"
+	// historyFile holds one growth snapshot per line, oldest first.
+	historyFile = "growth.jsonl"
+)
+
+// savedState is the content of stateFile.
+type savedState struct {
+	Version        int64                  `json:"version"`
+	SCComplete     bool                   `json:"sc_complete"`
+	SC             map[string]ModuleSizes `json:"sc,omitempty"`
+	BaselineErrors map[string]string      `json:"baseline_errors,omitempty"`
+	SSWritesSince  int64                  `json:"ss_writes_since"`
+	SS             map[string]ModuleSizes `json:"ss_writes"`
+	IntervalFrom   int64                  `json:"interval_from"`
+	Growth         []Contributor          `json:"growth,omitempty"`
+}
+
+func ensureDir(dir string) error {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return fmt.Errorf("create state size directory: %w", err)
+	}
+	return nil
+}
+
+func loadState(dir string) (savedState, bool, error) {
+	data, err := os.ReadFile(filepath.Join(dir, stateFile)) //nolint:gosec // fixed name under the configured directory
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return savedState{}, false, nil
+		}
+		return savedState{}, false, fmt.Errorf("read state size totals: %w", err)
+	}
+	var state savedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		// The totals can always be recounted, so a damaged file costs a baseline count rather than
+		// the node.
+		logger.Warn("discarding unreadable state size totals", "err", err)
+		return savedState{}, false, nil
+	}
+	return state, true, nil
+}
+
+// saveState replaces stateFile by writing a temporary file and renaming it over the old one, so a
+// crash leaves one or the other.
+func saveState(dir string, state savedState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("encode state size totals: %w", err)
+	}
+	return writeFileAtomic(filepath.Join(dir, stateFile), data)
+}
+
+// loadHistory reads every growth snapshot in historyFile, and the number of lines it holds. A line
+// that does not decode, such as one cut short by a crash, is skipped.
+func loadHistory(dir string) ([]GrowthSnapshot, int, error) {
+	f, err := os.Open(filepath.Join(dir, historyFile)) //nolint:gosec // fixed name under the configured directory
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("open growth history: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+
+	var history []GrowthSnapshot
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		lines++
+		var snapshot GrowthSnapshot
+		if err := json.Unmarshal(line, &snapshot); err != nil {
+			logger.Warn("skipping unreadable growth snapshot", "line", lines, "err", err)
+			continue
+		}
+		history = append(history, snapshot)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, 0, fmt.Errorf("read growth history: %w", err)
+	}
+	return history, lines, nil
+}
+
+func appendHistory(dir string, snapshot GrowthSnapshot) error {
+	line, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("encode growth snapshot: %w", err)
+	}
+	f, err := os.OpenFile(filepath.Join(dir, historyFile), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600) //nolint:gosec // fixed name under the configured directory
+	if err != nil {
+		return fmt.Errorf("open growth history: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("append growth snapshot: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close growth history: %w", err)
+	}
+	return nil
+}
+
+// rewriteHistory replaces historyFile with exactly the given snapshots.
+func rewriteHistory(dir string, history []GrowthSnapshot) error {
+	var buf bytes.Buffer
+	for _, snapshot := range history {
+		line, err := json.Marshal(snapshot)
+		if err != nil {
+			return fmt.Errorf("encode growth snapshot: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	return writeFileAtomic(filepath.Join(dir, historyFile), buf.Bytes())
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600) //nolint:gosec // derived from a fixed name under the configured directory
+	if err != nil {
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write %s: %w", filepath.Base(path), err)
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("sync %s: %w", filepath.Base(path), err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close %s: %w", filepath.Base(path), err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replace %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// ReadHistory reads the growth history kept in dir without opening a tracker, for offline tools.
+func ReadHistory(dir string) ([]GrowthSnapshot, error) {
+	history, _, err := loadHistory(dir)
+	return history, err
+}
@@ -0,0 +1,135 @@
+package statesize
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/sei-protocol/sei-chain/sei-db/common/keys"
+)
+
+// Sizes is a key count and the bytes those keys occupy, counted as key length plus value length.
+// In a growth figure either can be negative.
+type Sizes struct {
+	Keys  int64 `json:"keys"`
+	Bytes int64 `json:"bytes"`
+}
+
+// Add returns the sum of two sizes.
+func (s Sizes) Add(d Sizes) Sizes {
+	return Sizes{Keys: s.Keys + d.Keys, Bytes: s.Bytes + d.Bytes}
+}
+
+// ModuleSizes is one module's total and its split by the first byte of the key, the same split
+// `seidb state-size` prints.
+type ModuleSizes struct {
+	Total    Sizes            `json:"total"`
+	Prefixes map[string]Sizes `json:"prefixes,omitempty"`
+}
+
+// Prefix returns the name a key is grouped under within its module: the first byte in upper-case
+// hex, e.g. "03" for an EVM storage slot. The empty key has the empty prefix.
+func Prefix(key []byte) string {
+	if len(key) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%02X", key[0])
+}
+
+// contributorName returns what a key's growth is attributed to: the contract for an EVM storage
+// slot, written as 0x-prefixed hex, and the key's prefix otherwise.
+func contributorName(module string, key []byte) string {
+	statePrefix := keys.StateKeyPrefix()
+	if module == keys.EVMStoreKey && len(key) >= len(statePrefix)+keys.AddressLen && bytes.HasPrefix(key, statePrefix) {
+		return "0x" + hex.EncodeToString(key[len(statePrefix):len(statePrefix)+keys.AddressLen])
+	}
+	return Prefix(key)
+}
+
+// table holds per-module sizes. A nil table is empty.
+type table map[string]*ModuleSizes
+
+func (t table) add(module, prefix string, d Sizes) {
+	m, ok := t[module]
+	if !ok {
+		m = &ModuleSizes{Prefixes: make(map[string]Sizes)}
+		t[module] = m
+	}
+	m.Total = m.Total.Add(d)
+	m.Prefixes[prefix] = m.Prefixes[prefix].Add(d)
+}
+
+// merge adds every entry of o into t.
+func (t table) merge(o table) {
+	for module, m := range o {
+		for prefix, d := range m.Prefixes {
+			t.add(module, prefix, d)
+		}
+	}
+}
+
+// snapshot returns a copy of t as plain values, leaving out prefixes whose sizes cancelled to zero.
+func (t table) snapshot() map[string]ModuleSizes {
+	out := make(map[string]ModuleSizes, len(t))
+	for module, m := range t {
+		prefixes := make(map[string]Sizes, len(m.Prefixes))
+		for prefix, s := range m.Prefixes {
+			if s != (Sizes{}) {
+				prefixes[prefix] = s
+			}
+		}
+		out[module] = ModuleSizes{Total: m.Total, Prefixes: prefixes}
+	}
+	return out
+}
+
+// fromSnapshot rebuilds a table from the value form written by snapshot.
+func fromSnapshot(modules map[string]ModuleSizes) table {
+	t := make(table, len(modules))
+	for module, m := range modules {
+		prefixes := make(map[string]Sizes, len(m.Prefixes))
+		for prefix, s := range m.Prefixes {
+			prefixes[prefix] = s
+		}
+		t[module] = &ModuleSizes{Total: m.Total, Prefixes: prefixes}
+	}
+	return t
+}
+
+// Contributor is a source of state growth over one growth interval.
+type Contributor struct {
+	Module string `json:"module"`
+	// Name is the contract address for EVM storage slots and the key prefix otherwise.
+	Name   string `json:"name"`
+	Growth Sizes  `json:"growth"`
+}
+
+type contributorKey struct {
+	module, name string
+}
+
+// topContributors returns up to n contributors with the largest byte growth, largest first. Ties
+// are broken by module and name so the order is stable. n == 0 returns none.
+func topContributors(growth map[contributorKey]Sizes, n int) []Contributor {
+	if n == 0 {
+		return nil
+	}
+	all := make([]Contributor, 0, len(growth))
+	for k, s := range growth {
+		all = append(all, Contributor{Module: k.module, Name: k.name, Growth: s})
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].Growth.Bytes != all[j].Growth.Bytes {
+			return all[i].Growth.Bytes > all[j].Growth.Bytes
+		}
+		if all[i].Module != all[j].Module {
+			return all[i].Module < all[j].Module
+		}
+		return all[i].Name < all[j].Name
+	})
+	if len(all) > n {
+		all = all[:n]
+	}
+	return all
+}
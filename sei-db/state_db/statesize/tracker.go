@@ -0,0 +1,440 @@
+// Package statesize keeps running per-module and per-key-prefix state sizes, updated at every
+// commit from the changesets the node writes, and records periodic growth snapshots naming what
+// drove the growth.
+//
+// Two kinds of size are kept. SC sizes are the live state: the keys the state commitment holds at
+// the latest version and the bytes they occupy. They are a baseline counted once from the committed
+// state plus the change every write makes to it, which needs the value the write replaces. SS writes
+// are what the state store was handed: every set and every delete since SSWritesSince, counted as a row
+// each. The state store keeps old versions until they are pruned, so this is its write volume, not
+// its size; nothing here measures the state store itself.
+package statesize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sei-protocol/seilog"
+
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+var logger = seilog.NewLogger("db", "state-db", "statesize")
+
+// allStores is the BaselineErrors key used when no store could be counted.
+const allStores = "*"
+
+// Source is a read-only view of the state commitment at one version, counted to establish the SC
+// baseline.
+type Source interface {
+	// Stores returns the names of the stores to count.
+	Stores() []string
+	// Iterate calls fn for every key in store in order, stopping at the first error fn returns.
+	Iterate(store string, fn func(key, value []byte) error) error
+	Close() error
+}
+
+// PriorFunc returns the value a write replaces, and whether the key existed before it.
+type PriorFunc func(store string, key []byte) (value []byte, found bool, err error)
+
+// Report is the tracked state at one version.
+type Report struct {
+	Version int64 `json:"version"`
+
+	// SCComplete is false while the SC baseline is still being counted. Until then SC holds only the
+	// change since BaselineVersion.
+	SCComplete bool `json:"sc_complete"`
+	// BaselineVersion is the version the SC baseline was counted at.
+	BaselineVersion int64 `json:"baseline_version"`
+	// BaselineErrors names the stores whose baseline could not be counted, and why, with "*" standing
+	// for every store when the state could not be opened at all. Their SC sizes are the change since
+	// BaselineVersion.
+	BaselineErrors map[string]string      `json:"baseline_errors,omitempty"`
+	SC             map[string]ModuleSizes `json:"sc"`
+
+	// SSWritesSince is the version SS write counting started after.
+	SSWritesSince int64 `json:"ss_writes_since"`
+	// SSWrites is the write volume handed to SS per module since SSWritesSince.
+	SSWrites map[string]ModuleSizes `json:"ss_writes"`
+}
+
+// GrowthSnapshot is the SC growth over one interval, (FromVersion, Version].
+type GrowthSnapshot struct {
+	FromVersion int64     `json:"from_version"`
+	Version     int64     `json:"version"`
+	Time        time.Time `json:"time"`
+	// SCComplete is whether Modules includes the SC baseline. Growth never depends on it.
+	SCComplete bool `json:"sc_complete"`
+	// Modules is each module's SC total at Version.
+	Modules map[string]Sizes `json:"modules"`
+	// Growth is each module's SC change over the interval.
+	Growth map[string]Sizes `json:"growth"`
+	// TopContributors are the contracts and key prefixes whose state grew the most.
+	TopContributors []Contributor `json:"top_contributors,omitempty"`
+}
+
+// Tracker maintains the sizes for one node. Record and Commit are called from the commit path; Report
+// and History may be called from any goroutine.
+type Tracker struct {
+	cfg config.StateSizeConfig
+	dir string
+
+	mu      sync.Mutex
+	version int64
+
+	// SC sizes are base + delta. delta is everything recorded after baseVersion, and is folded into
+	// base whenever the totals are persisted with a complete baseline.
+	base           table
+	delta          table
+	baseVersion    int64
+	scComplete     bool
+	baselineErrors map[string]string
+
+	ssWrites      table
+	ssWritesSince int64
+
+	// growth is the SC change per contributor since intervalFrom.
+	growth       map[contributorKey]Sizes
+	intervalFrom int64
+	history      []GrowthSnapshot
+	historyLines int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+	closed bool
+}
+
+// Open starts tracking at version, the latest committed version, keeping its files in dir. Totals
+// persisted at exactly that version are resumed. Otherwise the SC baseline is counted in the
+// background from the view openSource returns, and SS write counting starts over.
+func Open(cfg config.StateSizeConfig, dir string, version int64, openSource func() (Source, error)) (*Tracker, error) {
+	if err := ensureDir(dir); err != nil {
+		return nil, err
+	}
+	t := &Tracker{
+		cfg:            cfg,
+		dir:            dir,
+		version:        version,
+		base:           make(table),
+		delta:          make(table),
+		baseVersion:    version,
+		baselineErrors: make(map[string]string),
+		ssWrites:       make(table),
+		ssWritesSince:  version,
+		growth:         make(map[contributorKey]Sizes),
+		intervalFrom:   version,
+		done:           make(chan struct{}),
+	}
+	history, lines, err := loadHistory(dir)
+	if err != nil {
+		return nil, err
+	}
+	t.history, t.historyLines = history, lines
+	t.trimHistory()
+
+	saved, found, err := loadState(dir)
+	if err != nil {
+		return nil, err
+	}
+	if found && saved.Version == version {
+		t.ssWrites = fromSnapshot(saved.SSWrites)
+		t.ssWritesSince = saved.SSWritesSince
+		t.intervalFrom = saved.IntervalFrom
+		for _, c := range saved.Growth {
+			t.growth[contributorKey{c.Module, c.Name}] = c.Growth
+		}
+		if saved.SCComplete {
+			t.base = fromSnapshot(saved.SC)
+			t.scComplete = true
+			for store, reason := range saved.BaselineErrors {
+				t.baselineErrors[store] = reason
+			}
+		}
+	} else if found {
+		logger.Info("state size totals are from another version, recounting",
+			"saved", saved.Version, "latest", version)
+	}
+
+	if t.scComplete || version == 0 {
+		t.scComplete = true
+		close(t.done)
+		return t, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	go t.countBaseline(ctx, version, openSource)
+	return t, nil
+}
+
+// countBaseline counts the SC state at version and adopts it as the baseline. A store that cannot be
+// iterated is named in the report rather than failing the count.
+func (t *Tracker) countBaseline(ctx context.Context, version int64, openSource func() (Source, error)) {
+	defer close(t.done)
+	started := time.Now()
+	logger.Info("counting state size baseline", "version", version)
+
+	src, err := openSource()
+	if err != nil {
+		t.failBaseline(fmt.Errorf("open state at version %d: %w", version, err))
+		return
+	}
+	defer func() { _ = src.Close() }()
+
+	counted := make(table)
+	errs := make(map[string]string)
+	for _, store := range src.Stores() {
+		err := src.Iterate(store, func(key, value []byte) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			counted.add(store, Prefix(key), Sizes{Keys: 1, Bytes: int64(len(key) + len(value))})
+			return nil
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			logger.Warn("state size baseline skips a store", "store", store, "err", err)
+			errs[store] = err.Error()
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.base = counted
+	t.baselineErrors = errs
+	t.scComplete = true
+	logger.Info("state size baseline counted", "version", version, "stores", len(counted),
+		"skipped", len(errs), "elapsed", time.Since(started))
+}
+
+func (t *Tracker) failBaseline(err error) {
+	logger.Error("state size baseline failed; SC sizes are the change since tracking started", "err", err)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.baselineErrors = map[string]string{allStores: err.Error()}
+}
+
+// Record accounts for one batch of changesets before it is applied to the state commitment. prior must
+// read the state the batch is applied on top of. A key written more than once in a batch is counted
+// against its own earlier write.
+func (t *Tracker) Record(changeSets []*proto.NamedChangeSet, prior PriorFunc) error {
+	type value struct {
+		v     []byte
+		found bool
+	}
+	sc := make(table)
+	ss := make(table)
+	growth := make(map[contributorKey]Sizes)
+	for _, ncs := range changeSets {
+		written := make(map[string]value)
+		for _, pair := range ncs.Changeset.Pairs {
+			old, seen := written[string(pair.Key)]
+			if !seen {
+				v, found, err := prior(ncs.Name, pair.Key)
+				if err != nil {
+					return fmt.Errorf("read %s/%X before write: %w", ncs.Name, pair.Key, err)
+				}
+				old = value{v: v, found: found}
+			}
+			prefix := Prefix(pair.Key)
+			var d Sizes
+			if pair.Delete {
+				ss.add(ncs.Name, prefix, Sizes{Keys: 1, Bytes: int64(len(pair.Key))})
+				if old.found {
+					d = Sizes{Keys: -1, Bytes: -int64(len(pair.Key) + len(old.v))}
+				}
+				written[string(pair.Key)] = value{}
+			} else {
+				ss.add(ncs.Name, prefix, Sizes{Keys: 1, Bytes: int64(len(pair.Key) + len(pair.Value))})
+				if old.found {
+					d = Sizes{Bytes: int64(len(pair.Value) - len(old.v))}
+				} else {
+					d = Sizes{Keys: 1, Bytes: int64(len(pair.Key) + len(pair.Value))}
+				}
+				written[string(pair.Key)] = value{v: pair.Value, found: true}
+			}
+			if d != (Sizes{}) {
+				sc.add(ncs.Name, prefix, d)
+				k := contributorKey{ncs.Name, contributorName(ncs.Name, pair.Key)}
+				growth[k] = growth[k].Add(d)
+			}
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.delta.merge(sc)
+	t.ssWrites.merge(ss)
+	for k, d := range growth {
+		t.growth[k] = t.growth[k].Add(d)
+	}
+	return nil
+}
+
+// Commit marks version as committed. It publishes the sizes as metrics and, every GrowthInterval
+// blocks, records a growth snapshot and persists the totals.
+func (t *Tracker) Commit(version int64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.version = version
+	sc := t.scLocked()
+	recordSizes(storeSC, sc)
+	recordSizes(storeSSWrites, t.ssWrites)
+
+	interval := int64(t.cfg.GrowthInterval) //nolint:gosec // a block interval, far below MaxInt64
+	if interval == 0 || version-t.intervalFrom < interval {
+		return nil
+	}
+	snapshot := GrowthSnapshot{
+		FromVersion:     t.intervalFrom,
+		Version:         version,
+		Time:            time.Now().UTC(),
+		SCComplete:      t.scComplete,
+		Modules:         make(map[string]Sizes, len(sc)),
+		Growth:          make(map[string]Sizes),
+		TopContributors: topContributors(t.growth, int(t.cfg.TopContributors)), //nolint:gosec // a small count
+	}
+	for module, m := range sc {
+		snapshot.Modules[module] = m.Total
+	}
+	for k, d := range t.growth {
+		snapshot.Growth[k.module] = snapshot.Growth[k.module].Add(d)
+	}
+	t.growth = make(map[contributorKey]Sizes)
+	t.intervalFrom = version
+	if err := t.appendHistoryLocked(snapshot); err != nil {
+		return err
+	}
+	return t.persistLocked()
+}
+
+// scLocked returns the current SC totals, base plus delta.
+func (t *Tracker) scLocked() table {
+	sc := make(table, len(t.base))
+	sc.merge(t.base)
+	sc.merge(t.delta)
+	return sc
+}
+
+// Report returns the sizes at the latest committed version.
+func (t *Tracker) Report() Report {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	errs := make(map[string]string, len(t.baselineErrors))
+	for store, reason := range t.baselineErrors {
+		errs[store] = reason
+	}
+	return Report{
+		Version:         t.version,
+		SCComplete:      t.scComplete,
+		BaselineVersion: t.baseVersion,
+		BaselineErrors:  errs,
+		SC:              t.scLocked().snapshot(),
+		SSWritesSince:   t.ssWritesSince,
+		SS:              t.ssWrites.snapshot(),
+	}
+}
+
+// History returns up to limit of the most recent growth snapshots, newest first. limit <= 0 returns
+// all of them.
+func (t *Tracker) History(limit int) []GrowthSnapshot {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n := len(t.history)
+	if limit > 0 && limit < n {
+		n = limit
+	}
+	out := make([]GrowthSnapshot, 0, n)
+	for i := len(t.history) - 1; i >= 0 && len(out) < n; i-- {
+		out = append(out, t.history[i])
+	}
+	return out
+}
+
+// Close stops a baseline count still in progress and persists the totals.
+func (t *Tracker) Close() error {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	<-t.done
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	return t.persistLocked()
+}
+
+// persistLocked writes the totals at t.version. A complete baseline lets the delta be folded in, so
+// the next restart at this version resumes instead of recounting.
+func (t *Tracker) persistLocked() error {
+	state := savedState{
+		Version:       t.version,
+		SCComplete:    t.scComplete,
+		SSWritesSince: t.ssWritesSince,
+		SS:            t.ssWrites.snapshot(),
+		IntervalFrom:  t.intervalFrom,
+		Growth:        topContributors(t.growth, len(t.growth)),
+	}
+	if t.scComplete {
+		t.base = t.scLocked()
+		t.delta = make(table)
+		t.baseVersion = t.version
+		state.SC = t.base.snapshot()
+		state.BaselineErrors = t.baselineErrors
+	}
+	return saveState(t.dir, state)
+}
+
+// appendHistoryLocked adds a snapshot to the history and its file, rewriting the file once it holds
+// twice as many snapshots as are retained.
+func (t *Tracker) appendHistoryLocked(snapshot GrowthSnapshot) error {
+	t.history = append(t.history, snapshot)
+	t.trimHistory()
+	retain := int(t.cfg.GrowthRetain) //nolint:gosec // a small count
+	if retain > 0 && t.historyLines >= 2*retain {
+		if err := rewriteHistory(t.dir, t.history); err != nil {
+			return err
+		}
+		t.historyLines = len(t.history)
+		return nil
+	}
+	if err := appendHistory(t.dir, snapshot); err != nil {
+		return err
+	}
+	t.historyLines++
+	return nil
+}
+
+func (t *Tracker) trimHistory() {
+	retain := int(t.cfg.GrowthRetain) //nolint:gosec // a small count
+	if retain > 0 && len(t.history) > retain {
+		t.history = append([]GrowthSnapshot(nil), t.history[len(t.history)-retain:]...)
+	}
+}
+
+// Modules returns the module names in a size map in order.
+func Modules(sizes map[string]ModuleSizes) []string {
+	names := make([]string, 0, len(sizes))
+	for name := range sizes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ErrDisabled is returned by a Provider when state size tracking is not enabled on the node.
+var ErrDisabled = errors.New("state size tracking is disabled")
+
+// Provider serves the tracked sizes. The root multistore implements it.
+type Provider interface {
+	StateSizeReport() (Report, error)
+	StateSizeHistory(limit int) ([]GrowthSnapshot, error)
+}
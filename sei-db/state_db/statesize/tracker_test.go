@@ -0,0 +1,274 @@
+package statesize
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	"github.com/sei-protocol/sei-chain/sei-db/proto"
+)
+
+// memState is a map-backed state commitment: the prior values Record reads and the Source a
+// baseline is counted from.
+type memState map[string]map[string][]byte
+
+func (m memState) prior(store string, key []byte) ([]byte, bool, error) {
+	v, ok := m[store][string(key)]
+	return v, ok, nil
+}
+
+func (m memState) apply(changeSets []*proto.NamedChangeSet) {
+	for _, ncs := range changeSets {
+		if m[ncs.Name] == nil {
+			m[ncs.Name] = make(map[string][]byte)
+		}
+		for _, pair := range ncs.Changeset.Pairs {
+			if pair.Delete {
+				delete(m[ncs.Name], string(pair.Key))
+			} else {
+				m[ncs.Name][string(pair.Key)] = pair.Value
+			}
+		}
+	}
+}
+
+// source snapshots m, failing to iterate the stores named in broken.
+func (m memState) source(broken ...string) func() (Source, error) {
+	snapshot := make(memState, len(m))
+	for store, kvs := range m {
+		snapshot[store] = make(map[string][]byte, len(kvs))
+		for k, v := range kvs {
+			snapshot[store][k] = v
+		}
+	}
+	return func() (Source, error) { return memSource{state: snapshot, broken: broken}, nil }
+}
+
+type memSource struct {
+	state  memState
+	broken []string
+}
+
+func (s memSource) Stores() []string {
+	stores := make([]string, 0, len(s.state))
+	for store := range s.state {
+		stores = append(stores, store)
+	}
+	sort.Strings(stores)
+	return stores
+}
+
+func (s memSource) Iterate(store string, fn func(key, value []byte) error) error {
+	for _, b := range s.broken {
+		if b == store {
+			return errors.New("iteration not supported")
+		}
+	}
+	keys := make([]string, 0, len(s.state[store]))
+	for k := range s.state[store] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := fn([]byte(k), s.state[store][k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (memSource) Close() error { return nil }
+
+func testConfig() config.StateSizeConfig {
+	cfg := config.DefaultStateSizeConfig()
+	cfg.Enable = true
+	cfg.GrowthInterval = 2
+	cfg.TopContributors = 2
+	return cfg
+}
+
+func changeset(store string, pairs ...*proto.KVPair) *proto.NamedChangeSet {
+	return &proto.NamedChangeSet{Name: store, Changeset: proto.ChangeSet{Pairs: pairs}}
+}
+
+func set(key, value string) *proto.KVPair {
+	return &proto.KVPair{Key: []byte(key), Value: []byte(value)}
+}
+
+func del(key string) *proto.KVPair {
+	return &proto.KVPair{Key: []byte(key), Delete: true}
+}
+
+// commit records and applies one block at version.
+func commit(t *testing.T, tr *Tracker, state memState, version int64, changeSets ...*proto.NamedChangeSet) {
+	t.Helper()
+	require.NoError(t, tr.Record(changeSets, state.prior))
+	state.apply(changeSets)
+	require.NoError(t, tr.Commit(version))
+}
+
+func waitComplete(t *testing.T, tr *Tracker) Report {
+	t.Helper()
+	require.Eventually(t, func() bool { return tr.Report().SCComplete }, 5*time.Second, 5*time.Millisecond)
+	return tr.Report()
+}
+
+// storageKey is an EVM storage slot key for the contract whose address is twenty copies of b.
+func storageKey(b byte, slot byte) string {
+	key := []byte{0x03}
+	for range 20 {
+		key = append(key, b)
+	}
+	return string(append(key, make([]byte, 31)...)) + string([]byte{slot})
+}
+
+func TestRecordTracksLiveSizesAndWrites(t *testing.T) {
+	state := memState{}
+	tr, err := Open(testConfig(), t.TempDir(), 0, state.source())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+
+	commit(t, tr, state, 1, changeset("bank", set("\x01a", "100"), set("\x02b", "x")))
+	// An update changes only the byte count, and a delete removes the key's whole footprint.
+	commit(t, tr, state, 2, changeset("bank", set("\x01a", "1"), del("\x02b"), del("\x02missing")))
+
+	r := tr.Report()
+	require.True(t, r.SCComplete)
+	require.Equal(t, Sizes{Keys: 1, Bytes: 3}, r.SC["bank"].Total)
+	require.Equal(t, Sizes{Keys: 1, Bytes: 3}, r.SC["bank"].Prefixes["01"])
+	require.NotContains(t, r.SC["bank"].Prefixes, "02")
+
+	// Every set and delete is a row written to the state store.
+	require.Equal(t, Sizes{Keys: 5, Bytes: 5 + 3 + 3 + 2 + 8}, r.SSWrites["bank"].Total)
+}
+
+func TestRecordCountsRepeatedKeysAgainstTheirOwnWrite(t *testing.T) {
+	state := memState{}
+	tr, err := Open(testConfig(), t.TempDir(), 0, state.source())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+
+	commit(t, tr, state, 1, changeset("bank", set("a", "1"), set("a", "22"), del("a"), set("a", "333")))
+	require.Equal(t, Sizes{Keys: 1, Bytes: 4}, tr.Report().SC["bank"].Total)
+}
+
+func TestBaselineIsCountedFromTheCommittedState(t *testing.T) {
+	state := memState{
+		"bank":    {"\x01a": []byte("1"), "\x01b": []byte("22")},
+		"staking": {"\x05x": []byte("abc")},
+		"evm":     {"\x03k": []byte("v")},
+	}
+	tr, err := Open(testConfig(), t.TempDir(), 10, state.source("evm"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+
+	// A write committed while the baseline is counted lands on top of it.
+	commit(t, tr, state, 11, changeset("bank", set("\x01c", "4")))
+
+	r := waitComplete(t, tr)
+	require.Equal(t, int64(10), r.BaselineVersion)
+	require.Equal(t, Sizes{Keys: 3, Bytes: 3 + 4 + 3}, r.SC["bank"].Total)
+	require.Equal(t, Sizes{Keys: 1, Bytes: 5}, r.SC["staking"].Total)
+	require.Contains(t, r.BaselineErrors, "evm")
+	require.NotContains(t, r.SC, "evm")
+}
+
+func TestGrowthSnapshotsNameTheLargestContributors(t *testing.T) {
+	state := memState{}
+	tr, err := Open(testConfig(), t.TempDir(), 0, state.source())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+
+	big, small := storageKey(0xaa, 1), storageKey(0xbb, 1)
+	commit(t, tr, state, 1, changeset("evm", set(big, "0123456789"), set(storageKey(0xaa, 2), "0123456789")))
+	commit(t, tr, state, 2, changeset("evm", set(small, "1")), changeset("bank", set("\x02a", "1")))
+	commit(t, tr, state, 3, changeset("bank", set("\x02b", "1")))
+	commit(t, tr, state, 4)
+
+	history := tr.History(0)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(2), history[1].Version)
+	require.Equal(t, int64(0), history[1].FromVersion)
+	top := history[1].TopContributors
+	require.Len(t, top, 2)
+	require.Equal(t, Contributor{Module: "evm", Name: "0x" + repeatHex("aa"), Growth: Sizes{Keys: 2, Bytes: 2 * (53 + 10)}}, top[0])
+	require.Equal(t, "0x"+repeatHex("bb"), top[1].Name)
+
+	// The newest snapshot comes first and covers only its own interval.
+	require.Equal(t, int64(4), history[0].Version)
+	require.Equal(t, map[string]Sizes{"bank": {Keys: 1, Bytes: 3}}, history[0].Growth)
+	require.Equal(t, Sizes{Keys: 2, Bytes: 6}, history[0].Modules["bank"])
+	require.Len(t, tr.History(1), 1)
+}
+
+func repeatHex(b string) string {
+	out := ""
+	for range 20 {
+		out += b
+	}
+	return out
+}
+
+func TestTotalsResumeAtThePersistedVersion(t *testing.T) {
+	dir := t.TempDir()
+	state := memState{}
+	tr, err := Open(testConfig(), dir, 0, state.source())
+	require.NoError(t, err)
+	commit(t, tr, state, 1, changeset("bank", set("a", "1")))
+	commit(t, tr, state, 2, changeset("bank", set("b", "2")))
+	commit(t, tr, state, 3, changeset("bank", set("c", "3")))
+	want := tr.Report()
+	require.NoError(t, tr.Close())
+
+	counted := false
+	tr, err = Open(testConfig(), dir, 3, func() (Source, error) {
+		counted = true
+		return state.source()()
+	})
+	require.NoError(t, err)
+	got := tr.Report()
+	require.False(t, counted, "totals persisted at the open version must not be recounted")
+	require.Equal(t, want.SC, got.SC)
+	require.Equal(t, want.SSWrites, got.SSWrites)
+	require.Len(t, tr.History(0), 1)
+	require.NoError(t, tr.Close())
+
+	// Opened at another version the totals are recounted and SS write counting starts over.
+	tr, err = Open(testConfig(), dir, 5, state.source())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+	got = waitComplete(t, tr)
+	require.Equal(t, want.SC, got.SC)
+	require.Empty(t, got.SSWrites)
+	require.Equal(t, int64(5), got.SSWritesSince)
+}
+
+func TestHistoryIsTrimmedToTheRetainedCount(t *testing.T) {
+	dir := t.TempDir()
+	cfg := testConfig()
+	cfg.GrowthInterval = 1
+	cfg.GrowthRetain = 2
+	state := memState{}
+	tr, err := Open(cfg, dir, 0, state.source())
+	require.NoError(t, err)
+	for v := int64(1); v <= 7; v++ {
+		commit(t, tr, state, v, changeset("bank", set(string(rune('a'+v)), "1")))
+	}
+	require.NoError(t, tr.Close())
+
+	onDisk, err := ReadHistory(dir)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(onDisk), 2*int(cfg.GrowthRetain))
+	require.Equal(t, int64(7), onDisk[len(onDisk)-1].Version)
+
+	tr, err = Open(cfg, dir, 7, state.source())
+	require.NoError(t, err)
+	defer func() { require.NoError(t, tr.Close()) }()
+	history := tr.History(0)
+	require.Len(t, history, 2)
+	require.Equal(t, []int64{7, 6}, []int64{history[0].Version, history[1].Version})
+}
@@ -31,6 +31,8 @@ func main() {
 		operations.ReplayChangelogCmd(),
 		operations.TraceProfileReportCmd(),
 		operations.MigrateEvmStatusCmd(),
+		operations.SSMigrationStatusCmd(),
+		operations.StateGrowthCmd(),
 		operations.EvmLogicalDigestCmd(),
 		operations.HashLogCmd())
 	if err := rootCmd.Execute(); err != nil {
@@ -0,0 +1,70 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sei-protocol/sei-chain/sei-db/config"
+	ssmigration "github.com/sei-protocol/sei-chain/sei-db/state_db/ss/migration"
+	"github.com/spf13/cobra"
+)
+
+// SSMigrationStatusCmd is the seidb subcommand that reports the progress of a
+// state store backend migration (state-store.ss-migrate-to-backend) as JSON.
+//
+// The migration keeps its record in a file beside the target database rather
+// than inside either one, so this reads it without opening a database the
+// running node holds locked. An operator polls it until the phase reaches
+// cut_over, then switches ss-backend and clears ss-migrate-to-backend.
+func SSMigrationStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ss-migration-status",
+		Short: "Report the progress of a state store backend migration as JSON",
+		Long: "Reads the migration record of one state store database and prints it as JSON. " +
+			"--db-dir is the migrating database's directory, as the node opens it; the target " +
+			"directory is derived from it unless --target-dir is given.",
+		Run: executeSSMigrationStatus,
+	}
+	cmd.PersistentFlags().StringP("db-dir", "d", "", "Directory of the state store database being migrated")
+	cmd.PersistentFlags().String("from", config.DefaultSSBackend, "Backend being migrated from")
+	cmd.PersistentFlags().String("to", "", "Backend being migrated to")
+	cmd.PersistentFlags().String("target-dir", "", "Directory of the migration target; derived from --db-dir when empty")
+	return cmd
+}
+
+func executeSSMigrationStatus(cmd *cobra.Command, _ []string) {
+	dbDir, _ := cmd.Flags().GetString("db-dir")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	targetDir, _ := cmd.Flags().GetString("target-dir")
+
+	if targetDir == "" {
+		if dbDir == "" || to == "" {
+			panic("Must provide --db-dir and --to, or --target-dir")
+		}
+		targetDir = ssmigration.TargetDir(dbDir, from, to)
+	}
+
+	progress, found, err := ssmigration.LoadProgress(targetDir)
+	if err != nil {
+		panic(fmt.Errorf("load state store migration progress: %w", err))
+	}
+
+	out := struct {
+		TargetDir string `json:"target_dir"`
+		Found     bool   `json:"found"`
+		*ssmigration.Progress
+	}{
+		TargetDir: targetDir,
+		Found:     found,
+	}
+	if found {
+		out.Progress = &progress
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		panic(fmt.Errorf("encode json: %w", err))
+	}
+}
@@ -0,0 +1,74 @@
+package operations
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/statesize"
+	"github.com/spf13/cobra"
+)
+
+// StateGrowthCmd is the seidb subcommand that prints the growth snapshots a node
+// with state-commit.sc-state-size-enable records, newest first, as JSON.
+//
+// Unlike state-size, which scans a stopped node's database, this reads the
+// history file the running node appends to, so it needs neither the database nor
+// a stopped node. The live totals are served by the admin gRPC GetStateSize query.
+func StateGrowthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "state-growth",
+		Short: "Print recorded state growth snapshots as JSON",
+		Run:   executeStateGrowth,
+	}
+	cmd.PersistentFlags().StringP("dir", "d", "", "State size directory, <home>/data/state-size unless sc-state-size-directory is set")
+	cmd.PersistentFlags().Int("limit", 24, "Number of most recent snapshots to print; 0 prints all")
+	cmd.PersistentFlags().StringP("module", "m", "", "Only print this module's figures")
+	return cmd
+}
+
+func executeStateGrowth(cmd *cobra.Command, _ []string) {
+	dir, _ := cmd.Flags().GetString("dir")
+	limit, _ := cmd.Flags().GetInt("limit")
+	module, _ := cmd.Flags().GetString("module")
+	if dir == "" {
+		panic("Must provide --dir")
+	}
+
+	history, err := statesize.ReadHistory(dir)
+	if err != nil {
+		panic(fmt.Errorf("read state growth history: %w", err))
+	}
+	out := make([]statesize.GrowthSnapshot, 0, len(history))
+	for i := len(history) - 1; i >= 0 && (limit <= 0 || len(out) < limit); i-- {
+		out = append(out, filterGrowthSnapshot(history[i], module))
+	}
+
+	enc := json.NewEncoder(cmd.OutOrStdout())
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(out); err != nil {
+		panic(fmt.Errorf("encode json: %w", err))
+	}
+}
+
+// filterGrowthSnapshot keeps only module's entries in snapshot. An empty module keeps everything.
+func filterGrowthSnapshot(snapshot statesize.GrowthSnapshot, module string) statesize.GrowthSnapshot {
+	if module == "" {
+		return snapshot
+	}
+	filtered := snapshot
+	filtered.Modules = map[string]statesize.Sizes{}
+	filtered.Growth = map[string]statesize.Sizes{}
+	if s, ok := snapshot.Modules[module]; ok {
+		filtered.Modules[module] = s
+	}
+	if s, ok := snapshot.Growth[module]; ok {
+		filtered.Growth[module] = s
+	}
+	filtered.TopContributors = nil
+	for _, c := range snapshot.TopContributors {
+		if c.Module == module {
+			filtered.TopContributors = append(filtered.TopContributors, c)
+		}
+	}
+	return filtered
+}
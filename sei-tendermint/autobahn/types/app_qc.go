@@ -35,6 +35,24 @@ func (m *AppQC) Next() RoadIndex {
 	return m.Proposal().Next()
 }
 
+// Signers returns the keys of the validators which signed the AppQC.
+func (m *AppQC) Signers() []PublicKey {
+	keys := make([]PublicKey, len(m.sigs))
+	for i, sig := range m.sigs {
+		keys[i] = sig.key
+	}
+	return keys
+}
+
+// Votes returns the signed AppVotes aggregated into the AppQC.
+func (m *AppQC) Votes() []*Signed[*AppVote] {
+	votes := make([]*Signed[*AppVote], len(m.sigs))
+	for i, sig := range m.sigs {
+		votes[i] = &Signed[*AppVote]{hashed: m.vote, sig: sig}
+	}
+	return votes
+}
+
 // Verify verifies the AppQC against the committee.
 func (m *AppQC) Verify(c *Committee) error {
 	return m.vote.verifyQC(c, c.AppQuorum(), m.sigs)
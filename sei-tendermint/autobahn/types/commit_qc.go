@@ -45,6 +45,15 @@ func (m *CommitQC) GlobalRange() GlobalRange {
 	return m.Proposal().GlobalRange()
 }
 
+// Signers returns the keys of the validators which signed the CommitQC.
+func (m *CommitQC) Signers() []PublicKey {
+	keys := make([]PublicKey, len(m.sigs))
+	for i, sig := range m.sigs {
+		keys[i] = sig.key
+	}
+	return keys
+}
+
 // Verify verifies the CommitQC against the epoch.
 func (m *CommitQC) Verify(ep *Epoch) error {
 	if err := m.Proposal().Verify(ep); err != nil {
@@ -186,6 +186,20 @@ func TestAppQCVerifyChecksWeight(t *testing.T) {
 	require.Error(t, lightMajority.Verify(ep.Committee()))
 }
 
+func TestAppQCVotes(t *testing.T) {
+	rng := utils.TestRng()
+	ep, keys := makeEpoch(rng)
+	vote := NewAppVote(NewAppProposal(ProposalAt(ep, View{EpochIndex: ep.EpochIndex(), Index: ep.RoadRange().First}), GenAppHash(rng)))
+	votes := []*Signed[*AppVote]{Sign(keys[1], vote), Sign(keys[2], vote)}
+
+	got := NewAppQC(votes).Votes()
+	require.Equal(t, len(votes), len(got))
+	for i, v := range got {
+		require.Equal(t, votes[i].Key(), v.Key())
+		require.NoError(t, v.VerifySig(ep.Committee()))
+	}
+}
+
 func TestTimeoutQCVerifyChecksEpochBinding(t *testing.T) {
 	rng := utils.TestRng()
 	ep, keys := makeEpoch(rng)
@@ -4,12 +4,16 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"flag"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -19,11 +23,14 @@ import (
 	"github.com/sei-protocol/seilog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	insecurecreds "google.golang.org/grpc/credentials/insecure"
 
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/frost"
 	tmnet "github.com/sei-protocol/sei-chain/sei-tendermint/libs/net"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/privval"
 	grpcprivval "github.com/sei-protocol/sei-chain/sei-tendermint/privval/grpc"
 	privvalproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/privval"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
 )
 
 var (
@@ -47,6 +54,10 @@ func main() {
 		keyFile          = flag.String("keyfile", "", "absolute path to server key")
 		rootCA           = flag.String("rootcafile", "", "absolute path to root CA")
 		prometheusAddr   = flag.String("prometheus-addr", "", "address for prometheus endpoint (host:port)")
+		thresholdKeyPath = flag.String("threshold-key", "", "threshold key share file path; sign as a cosigner of a split key instead of with priv-key")
+		cosignerState    = flag.String("cosigner-state", "", "cosigner state file path")
+		cosigners        = flag.String("cosigners", "", "the other cosigners of the split key, as comma-separated id@host:port")
+		signTimeout      = flag.Duration("sign-timeout", 2*time.Second, "how long to wait for the cosigners of a signature")
 	)
 	flag.Parse()
 
@@ -63,9 +74,24 @@ func main() {
 		"certFile", *certFile,
 		"keyFile", *keyFile,
 		"rootCA", *rootCA,
+		"thresholdKeyPath", *thresholdKeyPath,
 	)
 
-	pv, err := privval.LoadFilePV(*privValKeyPath, *privValStatePath)
+	var (
+		pv       types.PrivValidator
+		cosigner *privval.LocalCosigner
+		err      error
+	)
+	if *thresholdKeyPath == "" {
+		pv, err = privval.LoadFilePV(*privValKeyPath, *privValStatePath)
+	} else {
+		dialOpt := grpc.WithTransportCredentials(insecurecreds.NewCredentials())
+		if !*insecure {
+			dialOpt = grpcprivval.GenerateTLS(*certFile, *keyFile, *rootCA)
+		}
+		pv, cosigner, err = newThresholdSigner(ctx, *chainID, *thresholdKeyPath, *cosignerState, *privValStatePath,
+			*cosigners, *signTimeout, dialOpt)
+	}
 	if err != nil {
 		fmt.Fprint(os.Stderr, err)
 		os.Exit(1)
@@ -121,6 +147,10 @@ func main() {
 	s := grpc.NewServer(opts...)
 
 	privvalproto.RegisterPrivValidatorAPIServer(s, ss)
+	if cosigner != nil {
+		// The other cosigners reach this one on the same server.
+		privvalproto.RegisterThresholdCosignerAPIServer(s, grpcprivval.NewCosignerServer(cosigner))
+	}
 
 	var httpSrv *http.Server
 	if *prometheusAddr != "" {
@@ -152,6 +182,66 @@ func main() {
 	select {}
 }
 
+// newThresholdSigner returns a signer of the threshold key at keyPath, cosigning with the local
+// cosigner and the remote ones listed in peers.
+func newThresholdSigner(
+	ctx context.Context,
+	chainID, keyPath, cosignerStatePath, signerStatePath, peers string,
+	timeout time.Duration,
+	dialOpt grpc.DialOption,
+) (*privval.ThresholdSigner, *privval.LocalCosigner, error) {
+	// The cosigner and the signer each guard against double signing with their own state; sharing a
+	// file would let one overwrite the other's.
+	if cosignerStatePath == "" {
+		return nil, nil, errors.New("-cosigner-state is required with -threshold-key")
+	}
+	cosignerState, err := filepath.Abs(cosignerStatePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("cosigner state: %w", err)
+	}
+	signerState, err := filepath.Abs(signerStatePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("priv val state: %w", err)
+	}
+	if cosignerState == signerState {
+		return nil, nil, errors.New("-cosigner-state must be another file than -priv-state")
+	}
+	key, err := privval.LoadThresholdKey(keyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	local, err := privval.NewLocalCosigner(chainID, key, cosignerStatePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	cosigners := []privval.Cosigner{local}
+	for _, peer := range strings.Split(peers, ",") {
+		if peer == "" {
+			continue
+		}
+		idStr, addr, ok := strings.Cut(peer, "@")
+		if !ok {
+			return nil, nil, fmt.Errorf("cosigner %q is not of the form id@host:port", peer)
+		}
+		id, err := strconv.ParseUint(idStr, 10, 32)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cosigner %q: invalid id: %w", peer, err)
+		}
+		conn, err := grpc.DialContext(ctx, addr, grpcprivval.DefaultDialOptions(dialOpt)...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cosigner %q: %w", peer, err)
+		}
+		cosigners = append(cosigners, grpcprivval.NewCosignerClient(conn, frost.Identifier(id)))
+	}
+	signer, err := privval.NewThresholdSigner(key.Group, cosigners, signerStatePath, timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger.Info("Signing as a cosigner of a threshold key",
+		"id", key.Share.ID, "threshold", key.Group.Threshold, "shares", len(key.Group.Shares))
+	return signer, local, nil
+}
+
 func registerPrometheus(addr string, s *grpc.Server) *http.Server {
 	// Initialize all metrics.
 	grpcMetrics.InitializeMetrics(s)
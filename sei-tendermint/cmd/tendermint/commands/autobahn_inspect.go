@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	atypes "github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	tmcfg "github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/ed25519"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/inspect"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/cli"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/privval"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// MakeAutobahnInspectCommand constructs a command to inspect the persisted
+// autobahn state of a node, and to replay it offline.
+func MakeAutobahnInspectCommand(conf *tmcfg.Config) *cobra.Command {
+	var (
+		fromHeight uint64
+		toHeight   uint64
+	)
+	cmd := &cobra.Command{
+		Use:   "autobahn-inspect",
+		Short: "print the persisted autobahn consensus state",
+		Long: `
+autobahn-inspect is an offline tool printing the persisted autobahn state of the node:
+the consensus state with the node's own votes, the lane blocks, the CommitQCs, and
+for every finalized height the block, the CommitQC (commit votes) and AppQC (app
+votes) covering it. The default from-height is 0, meaning the first retained block;
+and the default to-height is 0, meaning the latest block. The consensus state and
+the WALs are read in place without being modified, but the BlockStore can only be
+opened while the node is stopped.
+	`,
+		Example: `
+	tendermint autobahn-inspect
+	tendermint autobahn-inspect --from-height 100 --to-height 120
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, stores, err := openAutobahnStores(cmd, conf)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = stores.Close() }()
+			heights := atypes.GlobalRange{First: atypes.GlobalBlockNumber(fromHeight), Next: math.MaxUint64}
+			if toHeight != 0 {
+				heights.Next = atypes.GlobalBlockNumber(toHeight) + 1
+			}
+			return stores.Print(cmd.OutOrStdout(), heights)
+		},
+	}
+	cmd.Flags().Uint64Var(&fromHeight, "from-height", 0, "the first global block number to print")
+	cmd.Flags().Uint64Var(&toHeight, "to-height", 0, "the last global block number to print (0 means the latest)")
+	cmd.AddCommand(makeAutobahnReplayCommand(conf))
+	return cmd
+}
+
+func makeAutobahnReplayCommand(conf *tmcfg.Config) *cobra.Command {
+	var useValidatorKey bool
+	cmd := &cobra.Command{
+		Use:   "replay",
+		Short: "replay the persisted autobahn state into a local consensus state",
+		Long: `
+replay restores the finalized blocks of the node and pushes the persisted lane
+blocks, CommitQCs, app votes, consensus QCs and the node's own votes, in a fixed
+order, into a fresh consensus state running without network. Replay runs on a
+logical clock: views time out only through the persisted timeout votes, and what
+replay finalizes is kept in memory, so the node's state is never modified. Every
+input is reported as accepted, rejected (e.g. a forked or corrupt input) or
+stalled (not applicable in order), followed by the view, CommitQC and block
+numbers the consensus state reached. This reproduces a stall or a fork of the
+node locally.
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fc, stores, err := openAutobahnStores(cmd, conf)
+			if err != nil {
+				return err
+			}
+			defer func() { _ = stores.Close() }()
+			genDoc, err := types.GenesisDocFromFile(conf.GenesisFile())
+			if err != nil {
+				return err
+			}
+			validatorAddrs := map[atypes.PublicKey]p2p.GigaNodeAddr{}
+			for _, v := range fc.Validators {
+				validatorAddrs[v.ValidatorKey] = p2p.GigaNodeAddr{Key: v.NodeKey, HostPort: v.Address}
+			}
+			ds, err := p2p.BuildDataState(&p2p.GigaRouterCommonConfig{
+				DialInterval:   time.Duration(fc.DialInterval),
+				ValidatorAddrs: validatorAddrs,
+				GenDoc:         genDoc,
+			}, stores.ReplayBlockStore())
+			if err != nil {
+				return err
+			}
+			// By default replay with a throwaway key, so that the node's key
+			// does not sign anything, even locally.
+			key := atypes.SecretKeyFromED25519(ed25519.GenerateSecretKey())
+			if useValidatorKey {
+				pv, err := privval.LoadFilePV(conf.PrivValidator.KeyFile(), conf.PrivValidator.StateFile())
+				if err != nil {
+					return err
+				}
+				key = atypes.SecretKeyFromED25519(pv.Key.PrivKey)
+			}
+			return stores.Replay(cmd.Context(), ds, &inspect.ReplayConfig{Key: key}, cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().BoolVar(&useValidatorKey, "use-validator-key", false,
+		"replay with the node's validator key, to reproduce its proposals and votes")
+	return cmd
+}
+
+// openAutobahnStores loads the autobahn config file of the node and opens its
+// persistent state.
+func openAutobahnStores(cmd *cobra.Command, conf *tmcfg.Config) (*tmcfg.AutobahnFileConfig, *inspect.Stores, error) {
+	home, err := cmd.Flags().GetString(cli.HomeFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+	conf.RootDir = home
+	if conf.AutobahnConfigFile == "" {
+		return nil, nil, errors.New("autobahn is not enabled (autobahn-config-file is empty)")
+	}
+	raw, err := os.ReadFile(conf.AutobahnConfigFile) //nolint:gosec // G304: path is from operator-controlled config
+	if err != nil {
+		return nil, nil, err
+	}
+	var fc tmcfg.AutobahnFileConfig
+	if err := json.Unmarshal(raw, &fc); err != nil {
+		return nil, nil, fmt.Errorf("parsing %q: %w", conf.AutobahnConfigFile, err)
+	}
+	if err := fc.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid %q: %w", conf.AutobahnConfigFile, err)
+	}
+	dir, ok := fc.PersistentStateDir.Get()
+	if !ok || dir == "" {
+		return nil, nil, errors.New("autobahn persistent_state_dir is not set, there is no persisted state")
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(conf.RootDir, dir)
+	}
+	stores, err := inspect.Open(dir, fc.BlockDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &fc, stores, nil
+}
@@ -24,6 +24,7 @@ func GetDebugCommand() *cobra.Command {
 
 	cmd.AddCommand(getKillCmd())
 	cmd.AddCommand(getDumpCmd())
+	cmd.AddCommand(getTimelineCmd())
 	return cmd
 
 }
@@ -140,6 +140,12 @@ func dumpDebugData(ctx context.Context, rpc *rpchttp.HTTP, args dumpDebugDataArg
 		return
 	}
 
+	logger.Info("getting node flight recorder events...")
+	if err := dumpFlightRecorder(ctx, rpc, tmpDir, "flight_recorder.json"); err != nil {
+		logger.Error("failed to dump node flight recorder events", "error", err)
+		return
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(args.conf, tmpDir); err != nil {
 		logger.Error("failed to copy node WAL", "error", err)
@@ -79,6 +79,11 @@ $ tendermint debug kill 34255 /path/to/tm-debug.zip`,
 				return err
 			}
 
+			logger.Info("getting node flight recorder events...")
+			if err := dumpFlightRecorder(ctx, rpc, tmpDir, "flight_recorder.json"); err != nil {
+				return err
+			}
+
 			logger.Info("copying node WAL...")
 			if err := copyWAL(conf, tmpDir); err != nil {
 				if !os.IsNotExist(err) {
@@ -0,0 +1,42 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/coretypes"
+)
+
+func getTimelineCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "timeline [flight-recorder-file]",
+		Short: "Print the timeline of consensus and mempool events from a flight recorder dump",
+		Long: `Print the timeline of consensus and mempool events from a flight recorder dump,
+as written to flight_recorder.json by the dump and kill commands or returned by the
+dump_flight_recorder RPC. Events are grouped by the consensus height and round they
+occurred in, with times relative to the start of the round.
+
+Example:
+$ tendermint debug timeline /path/to/flight_recorder.json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			raw, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var dump coretypes.ResultFlightRecorder
+			if err := json.Unmarshal(raw, &dump); err != nil {
+				return fmt.Errorf("invalid flight recorder dump: %w", err)
+			}
+			var events []flightrec.Event
+			if err := json.Unmarshal(dump.Events, &events); err != nil {
+				return fmt.Errorf("invalid flight recorder events: %w", err)
+			}
+			return flightrec.Timeline(events, cmd.OutOrStdout())
+		},
+	}
+}
@@ -46,6 +46,17 @@ func dumpConsensusState(ctx context.Context, rpc *rpchttp.HTTP, dir, filename st
 	return writeStateJSONToFile(consDump, dir, filename)
 }
 
+// dumpFlightRecorder gets the flight recorder events from the Tendermint RPC
+// and writes them to file. It returns an error upon failure.
+func dumpFlightRecorder(ctx context.Context, rpc *rpchttp.HTTP, dir, filename string) error {
+	events, err := rpc.DumpFlightRecorder(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get node flight recorder events: %w", err)
+	}
+
+	return writeStateJSONToFile(events, dir, filename)
+}
+
 // copyWAL copies the Tendermint node's WAL file. It returns an error if the
 // WAL file cannot be read or copied.
 func copyWAL(conf *config.Config, dir string) error {
@@ -0,0 +1,131 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	tmcfg "github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/blocksync"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/progressbar"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/store"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/cli"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// MakeExportBlocksCommand constructs a command to export committed blocks to a block archive.
+func MakeExportBlocksCommand(conf *tmcfg.Config) *cobra.Command {
+	var (
+		startHeight int64
+		endHeight   int64
+		create      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export-blocks [archive-dir]",
+		Short: "export committed blocks to a block archive",
+		Long: `
+export-blocks is an offline tooling to copy blocks, together with the commits which
+finalized them, from the blockstore into a block archive. A node configured with
+blocksync-archive-dir pointing to the archive applies the blocks from it before
+syncing the rest from peers. The archive must exist unless --create is set.
+Blocks already present in the archive are skipped, so the same archive can be
+extended by consecutive exports, and an interrupted export resumed. The default
+start-height is 0, meaning the base block height (inclusive); and the default
+end-height is 0, meaning the latest block height (inclusive).
+	`,
+		Example: `
+	tendermint export-blocks /data/archive --create
+	tendermint export-blocks /data/archive --start-height 2 --end-height 10
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			home, err := cmd.Flags().GetString(cli.HomeFlag)
+			if err != nil {
+				return err
+			}
+			conf.RootDir = home
+			bs, ss, err := loadStateAndBlockStore(conf)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = bs.Close()
+				_ = ss.Close()
+			}()
+
+			if startHeight == 0 {
+				startHeight = bs.Base()
+			}
+			if endHeight == 0 {
+				endHeight = bs.Height()
+			}
+			if startHeight < bs.Base() || endHeight > bs.Height() || startHeight > endHeight {
+				return fmt.Errorf("invalid height range [%d,%d], blockstore has [%d,%d]", startHeight, endHeight, bs.Base(), bs.Height())
+			}
+
+			archive, err := blocksync.OpenArchive(args[0], create)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if closeErr := archive.Close(); closeErr != nil && err == nil {
+					err = fmt.Errorf("closing archive: %w", closeErr)
+				}
+			}()
+			if err := exportBlocks(cmd, bs, archive, startHeight, endHeight); err != nil {
+				return err
+			}
+			logger.Info("block export finished", "start_height", startHeight, "end_height", endHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64Var(&startHeight, "start-height", 0, "the block height to start the export at")
+	cmd.Flags().Int64Var(&endHeight, "end-height", 0, "the block height to finish the export at")
+	cmd.Flags().BoolVar(&create, "create", false, "create the archive if it does not exist")
+	return cmd
+}
+
+func exportBlocks(cmd *cobra.Command, bs *store.BlockStore, archive *blocksync.Archive, startHeight, endHeight int64) error {
+	var bar progressbar.Bar
+	bar.NewOption(startHeight-1, endHeight)
+	defer bar.Finish()
+	for h := startHeight; h <= endHeight; h++ {
+		if err := cmd.Context().Err(); err != nil {
+			return fmt.Errorf("block export terminated at height %d: %w", h, err)
+		}
+		// Put writes whichever of the commit and the block the archive is missing.
+		existing, err := archive.Get(h)
+		if err != nil {
+			return err
+		}
+		if !existing.IsPresent() {
+			block := bs.LoadBlock(h)
+			if block == nil {
+				return fmt.Errorf("not able to load block at height %d from the blockstore", h)
+			}
+			commit, err := loadCommit(bs, h)
+			if err != nil {
+				return err
+			}
+			if err := archive.Put(block, commit); err != nil {
+				return fmt.Errorf("archive.Put(%d): %w", h, err)
+			}
+		}
+		bar.Play(h)
+	}
+	return nil
+}
+
+// loadCommit loads the commit for the block at height h: the canonical commit
+// included in the next block, or the seen commit for the latest block.
+func loadCommit(bs *store.BlockStore, h int64) (*types.Commit, error) {
+	if commit := bs.LoadBlockCommit(h); commit != nil {
+		return commit, nil
+	}
+	if commit := bs.LoadSeenCommit(); commit != nil && commit.Height == h {
+		return commit, nil
+	}
+	return nil, fmt.Errorf("not able to load commit for height %d from the blockstore", h)
+}
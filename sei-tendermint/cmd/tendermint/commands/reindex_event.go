@@ -15,7 +15,9 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/kv"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/parquet"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/psql"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/sqlite"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/store"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/cli"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/os"
@@ -44,6 +46,9 @@ replace the backend. The default start-height is 0, meaning the tooling will sta
 reindex from the base block height(inclusive); and the default end-height is 0, meaning
 the tooling will reindex until the latest block height(inclusive). User can omit
 either or both arguments.
+
+With the "parquet" sink, this exports the events of the height interval to Parquet
+files, one per range of parquet-blocks-per-file heights.
 	`,
 		Example: `
 	tendermint reindex-event
@@ -51,12 +56,13 @@ either or both arguments.
 	tendermint reindex-event --end-height 10
 	tendermint reindex-event --start-height 2 --end-height 10
 	`,
-		RunE: func(cmd *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			home, err := cmd.Flags().GetString(cli.HomeFlag)
 			if err != nil {
 				return fmt.Errorf("%s: %w", reindexFailed, err)
 			}
 			conf.RootDir = home
+			conf.TxIndex.RootDir = home
 			bs, ss, err := loadStateAndBlockStore(conf)
 			if err != nil {
 				return fmt.Errorf("%s: %w", reindexFailed, err)
@@ -74,6 +80,15 @@ either or both arguments.
 			if err != nil {
 				return fmt.Errorf("%s: %w", reindexFailed, err)
 			}
+			// Stopping the sinks closes their databases and writes out the
+			// events the parquet sink still buffers.
+			defer func() {
+				for _, sink := range es {
+					if stopErr := sink.Stop(); stopErr != nil && err == nil {
+						err = fmt.Errorf("%s: stopping %s sink: %w", reindexFailed, sink.Type(), stopErr)
+					}
+				}
+			}()
 
 			riArgs := eventReIndexArgs{
 				startHeight: startHeight,
@@ -108,6 +123,13 @@ func loadEventSinks(cfg *tmcfg.Config) ([]indexer.EventSink, error) {
 	}
 
 	eventSinks := []indexer.EventSink{}
+	loadChainID := func() (string, error) {
+		genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+		if err != nil {
+			return "", fmt.Errorf("failed to load genesis file: %w", err)
+		}
+		return genDoc.ChainID, nil
+	}
 
 	for k := range sinks {
 		switch k {
@@ -126,11 +148,31 @@ func loadEventSinks(cfg *tmcfg.Config) ([]indexer.EventSink, error) {
 			if conn == "" {
 				return nil, errors.New("the psql connection settings cannot be empty")
 			}
-			genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
+			chainID, err := loadChainID()
+			if err != nil {
+				return nil, err
+			}
+			es, err := psql.NewEventSink(conn, chainID)
+			if err != nil {
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
+		case string(indexer.SQLITE):
+			chainID, err := loadChainID()
+			if err != nil {
+				return nil, err
+			}
+			es, err := sqlite.NewEventSink(cfg.TxIndex.SqliteFile(), chainID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to load genesis file: %w", err)
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
+		case string(indexer.PARQUET):
+			chainID, err := loadChainID()
+			if err != nil {
+				return nil, err
 			}
-			es, err := psql.NewEventSink(conn, genDoc.ChainID)
+			es, err := parquet.NewEventSink(cfg.TxIndex.ParquetDir(), chainID, cfg.TxIndex.ParquetBlocksPerFile)
 			if err != nil {
 				return nil, err
 			}
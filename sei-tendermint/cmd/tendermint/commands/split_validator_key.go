@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/privval"
+)
+
+// MakeSplitValidatorKeyCommand constructs a command that splits the private
+// validator key into shares for threshold signing.
+func MakeSplitValidatorKeyCommand(conf *config.Config) *cobra.Command {
+	var (
+		threshold int
+		shares    int
+		outDir    string
+	)
+	cmd := &cobra.Command{
+		Use:   "split-validator-key",
+		Short: "Split the validator key into shares for threshold signing",
+		Long: `Split the private validator key into shares, any threshold of which sign
+for the same validator. Each share is written to threshold_key_<id>.json in the
+output directory, to be given to one cosigner of priv_val_server.
+
+The validator key must be deleted once the shares are distributed: anyone who
+holds it can sign without the cosigners, and double sign.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pv, err := privval.LoadFilePVEmptyState(conf.PrivValidator.KeyFile(), "")
+			if err != nil {
+				return err
+			}
+			keys, err := privval.SplitFilePVKey(pv.Key, threshold, shares)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(outDir, 0700); err != nil {
+				return err
+			}
+			for _, key := range keys {
+				path := filepath.Join(outDir, fmt.Sprintf("threshold_key_%d.json", key.Share.ID))
+				if err := key.Save(path); err != nil {
+					return err
+				}
+				cmd.Printf("Wrote share %d of %d to %s\n", key.Share.ID, shares, path)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&threshold, "threshold", 2, "number of cosigners needed to sign")
+	cmd.Flags().IntVar(&shares, "shares", 3, "number of shares to split the key into")
+	cmd.Flags().StringVar(&outDir, "out", "threshold-keys", "directory to write the shares to")
+
+	return cmd
+}
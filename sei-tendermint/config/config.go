@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -74,6 +75,7 @@ type Config struct {
 	Instrumentation *InstrumentationConfig `mapstructure:"instrumentation"`
 	PrivValidator   *PrivValidatorConfig   `mapstructure:"priv-validator"`
 	SelfRemediation *SelfRemediationConfig `mapstructure:"self-remediation"`
+	HashCompare     *HashCompareConfig     `mapstructure:"hash-compare"`
 
 	// AutobahnConfigFile is the path to a JSON file containing the Autobahn (GigaRouter)
 	// configuration. Leave empty to disable Autobahn. The autobahn role
@@ -102,6 +104,7 @@ func DefaultConfig() *Config {
 		Instrumentation:         DefaultInstrumentationConfig(),
 		PrivValidator:           DefaultPrivValidatorConfig(),
 		SelfRemediation:         DefaultSelfRemediationConfig(),
+		HashCompare:             DefaultHashCompareConfig(),
 		HashVaultDisabledUnsafe: false,
 	}
 }
@@ -127,6 +130,7 @@ func TestConfig() *Config {
 		Instrumentation: TestInstrumentationConfig(),
 		PrivValidator:   DefaultPrivValidatorConfig(),
 		SelfRemediation: DefaultSelfRemediationConfig(),
+		HashCompare:     DefaultHashCompareConfig(),
 	}
 }
 
@@ -138,6 +142,7 @@ func (cfg *Config) SetRoot(root string) *Config {
 	cfg.Mempool.RootDir = root
 	cfg.Consensus.RootDir = root
 	cfg.PrivValidator.RootDir = root
+	cfg.TxIndex.RootDir = root
 	return cfg
 }
 
@@ -168,6 +173,12 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.SelfRemediation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [self-remediation] section: %w", err)
 	}
+	if err := cfg.HashCompare.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [hash-compare] section: %w", err)
+	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [tx-index] section: %w", err)
+	}
 	return nil
 }
 
@@ -227,6 +238,11 @@ type BaseConfig struct {
 	// Database directory
 	DBPath string `mapstructure:"db-dir"`
 
+	// Directory of a block archive, as written by the export-blocks command.
+	// When set, blocksync applies the blocks available in the archive before
+	// fetching the rest from peers. Empty disables the import.
+	BlockSyncArchiveDir string `mapstructure:"blocksync-archive-dir"`
+
 	// Output level for logging
 	LogLevel string `mapstructure:"log-level"`
 
@@ -323,6 +339,15 @@ func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
 }
 
+// BlockSyncArchivePath returns the full path to the block archive directory,
+// or "" if the archive import is disabled.
+func (cfg BaseConfig) BlockSyncArchivePath() string {
+	if cfg.BlockSyncArchiveDir == "" {
+		return ""
+	}
+	return rootify(cfg.BlockSyncArchiveDir, cfg.RootDir)
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg BaseConfig) ValidateBasic() error {
@@ -922,6 +947,58 @@ type MempoolConfig struct {
 	//
 	// See DropUtilisationThreshold and DropPriorityThreshold.
 	DropPriorityReservoirSize int `mapstructure:"drop-priority-reservoir-size"`
+
+	// PriceBump is the minimum percentage by which an EVM transaction's priority
+	// (its gas price) must exceed that of the transaction it replaces, i.e. the
+	// transaction from the same sender with the same nonce. With 0, any higher
+	// priority replaces.
+	PriceBump uint64 `mapstructure:"price-bump"`
+
+	// MaxReadyTxsPerSender, if non-zero, limits the number of ready transactions
+	// an EVM sender may have in the mempool.
+	MaxReadyTxsPerSender int `mapstructure:"max-ready-txs-per-sender"`
+
+	// MaxPendingTxsPerSender, if non-zero, limits the number of pending
+	// transactions (nonce-gapped, or not yet affordable) an EVM sender may have in
+	// the mempool.
+	MaxPendingTxsPerSender int `mapstructure:"max-pending-txs-per-sender"`
+
+	// EvictLowestPricedTail, if true, keeps the mempool within its size limits on
+	// every insertion by evicting the last transaction of the lowest-priced sender,
+	// provided it is priced below the new one. Otherwise the mempool may grow to
+	// twice its limits before the lowest-priority transactions are pruned in bulk.
+	EvictLowestPricedTail bool `mapstructure:"evict-lowest-priced-tail"`
+
+	// OrderingPolicy is how the transactions of a proposal are ordered: by
+	// priority ("priority"), by arrival ("fifo"), or by arrival moved earlier in
+	// proportion to priority, by at most TimeBoostMax ("timeboost").
+	OrderingPolicy string `mapstructure:"ordering-policy"`
+
+	// TimeBoostMax is the most the timeboost ordering policy moves a transaction
+	// ahead of its arrival time.
+	TimeBoostMax time.Duration `mapstructure:"time-boost-max"`
+
+	// InterleaveSenders, if true, has the EVM senders of a proposal take turns,
+	// each contributing its next transaction per round.
+	InterleaveSenders bool `mapstructure:"interleave-senders"`
+
+	// OrderingAuditSize is the number of recent proposal orderings kept for the
+	// proposal_ordering RPC. 0 records none.
+	OrderingAuditSize int `mapstructure:"ordering-audit-size"`
+
+	// Journal, if true, records admitted and removed transactions on disk, so that the
+	// transactions in the mempool when the node stops are re-checked and re-admitted
+	// when it restarts, subject to TTLDuration and TTLNumBlocks.
+	Journal bool `mapstructure:"journal"`
+
+	// JournalPath is the directory of the journal. Relative paths are relative to the
+	// home directory.
+	JournalPath string `mapstructure:"journal-path"`
+}
+
+// JournalDir returns the full path to the mempool journal directory.
+func (cfg *MempoolConfig) JournalDir() string {
+	return rootify(cfg.JournalPath, cfg.RootDir)
 }
 
 func (cfg *MempoolConfig) ToMempoolConfig() *mempoolcfg.Config {
@@ -939,6 +1016,14 @@ func (cfg *MempoolConfig) ToMempoolConfig() *mempoolcfg.Config {
 		DropPriorityThreshold:     cfg.DropPriorityThreshold,
 		DropUtilisationThreshold:  cfg.DropUtilisationThreshold,
 		DropPriorityReservoirSize: cfg.DropPriorityReservoirSize,
+		PriceBump:                 cfg.PriceBump,
+		MaxReadyTxsPerSender:      cfg.MaxReadyTxsPerSender,
+		MaxPendingTxsPerSender:    cfg.MaxPendingTxsPerSender,
+		EvictLowestPricedTail:     cfg.EvictLowestPricedTail,
+		OrderingPolicy:            cfg.OrderingPolicy,
+		TimeBoostMax:              cfg.TimeBoostMax,
+		InterleaveSenders:         cfg.InterleaveSenders,
+		OrderingAuditSize:         cfg.OrderingAuditSize,
 	}
 	if cfg.TTLDuration != 0 {
 		mcfg.TTLDuration = utils.Some(cfg.TTLDuration)
@@ -946,6 +1031,9 @@ func (cfg *MempoolConfig) ToMempoolConfig() *mempoolcfg.Config {
 	if cfg.TTLNumBlocks != 0 {
 		mcfg.TTLNumBlocks = utils.Some(cfg.TTLNumBlocks)
 	}
+	if cfg.Journal {
+		mcfg.JournalDir = utils.Some(cfg.JournalDir())
+	}
 	return mcfg
 }
 
@@ -974,6 +1062,16 @@ func DefaultMempoolConfig() *MempoolConfig {
 		DropPriorityThreshold:        cfg.DropPriorityThreshold,
 		DropUtilisationThreshold:     cfg.DropUtilisationThreshold,
 		DropPriorityReservoirSize:    cfg.DropPriorityReservoirSize,
+		PriceBump:                    cfg.PriceBump,
+		MaxReadyTxsPerSender:         cfg.MaxReadyTxsPerSender,
+		MaxPendingTxsPerSender:       cfg.MaxPendingTxsPerSender,
+		EvictLowestPricedTail:        cfg.EvictLowestPricedTail,
+		OrderingPolicy:               cfg.OrderingPolicy,
+		TimeBoostMax:                 cfg.TimeBoostMax,
+		InterleaveSenders:            cfg.InterleaveSenders,
+		OrderingAuditSize:            cfg.OrderingAuditSize,
+		Journal:                      false,
+		JournalPath:                  filepath.Join(defaultDataDir, "mempool.journal"),
 	}
 }
 
@@ -1017,6 +1115,24 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.DropUtilisationThreshold < 0.0 || cfg.DropUtilisationThreshold > 1.0 {
 		return errors.New("drop-utilisation-threshold must be between 0.0 and 1.0")
 	}
+	if cfg.MaxReadyTxsPerSender < 0 {
+		return errors.New("max-ready-txs-per-sender can't be negative")
+	}
+	if cfg.MaxPendingTxsPerSender < 0 {
+		return errors.New("max-pending-txs-per-sender can't be negative")
+	}
+	if !slices.Contains(mempoolcfg.OrderingPolicyNames(), cfg.OrderingPolicy) {
+		return fmt.Errorf("ordering-policy must be one of %v, got %q", mempoolcfg.OrderingPolicyNames(), cfg.OrderingPolicy)
+	}
+	if cfg.TimeBoostMax < 0 {
+		return errors.New("time-boost-max can't be negative")
+	}
+	if cfg.OrderingAuditSize < 0 {
+		return errors.New("ordering-audit-size can't be negative")
+	}
+	if cfg.Journal && cfg.JournalPath == "" {
+		return errors.New("journal-path can't be empty when the journal is enabled")
+	}
 
 	return nil
 }
@@ -1085,6 +1201,12 @@ type StateSyncConfig struct {
 	// which are located in the snapshot-dir configured in app.toml (default to [home-dir]/data/snapshots)
 	UseLocalSnapshot bool `mapstructure:"use-local-snapshot"`
 
+	// SnapshotArchive is the location of a snapshot archive to state sync from
+	// instead of snapshots served by peers: either a local directory or the
+	// http(s) URL of a publicly readable bucket. Snapshots are still verified
+	// against the app hash obtained through the light client.
+	SnapshotArchive string `mapstructure:"snapshot-archive"`
+
 	// LightBlockResponseTimeout is how long the dispatcher waits for a peer to
 	// return a light block.
 	LightBlockResponseTimeout time.Duration `mapstructure:"light-block-response-timeout"`
@@ -1351,6 +1473,8 @@ func (cfg *ConsensusConfig) DeprecatedFieldWarning() error {
 // TxIndexConfig defines the configuration for the transaction indexer,
 // including composite keys to index.
 type TxIndexConfig struct {
+	RootDir string `mapstructure:"home"`
+
 	// The backend database list to back the indexer.
 	// If list contains `null`, meaning no indexer service will be used.
 	//
@@ -1358,21 +1482,74 @@ type TxIndexConfig struct {
 	//   1) "null" (default) - no indexer services.
 	//   2) "kv" - a simple indexer backed by key-value storage (see DBBackend)
 	//   3) "psql" - the indexer services backed by PostgreSQL.
+	//   4) "sqlite" - the psql schema in an embedded SQLite database. Requires a
+	//      binary built with -tags=sqliteSink, as SQLite is linked through cgo.
+	//   5) "parquet" - events exported to Parquet files by height range.
 	Indexer []string `mapstructure:"indexer"`
 
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// The SQLite database file of the "sqlite" indexer, relative to the home
+	// directory unless absolute. It is created along with its schema if missing.
+	SqlitePath string `mapstructure:"sqlite-path"`
+
+	// The directory the "parquet" indexer writes its files to, relative to the
+	// home directory unless absolute.
+	ParquetPath string `mapstructure:"parquet-path"`
+
+	// The number of blocks per Parquet file. Files cover aligned height ranges:
+	// with 1000, heights 1-1000, 1001-2000 and so on. A file is written once its
+	// range is complete or the node stops, so a crash loses the events of up to
+	// this many blocks; the reindex-event command restores them.
+	ParquetBlocksPerFile int64 `mapstructure:"parquet-blocks-per-file"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
 func DefaultTxIndexConfig() *TxIndexConfig {
-	return &TxIndexConfig{Indexer: []string{"kv"}}
+	return &TxIndexConfig{
+		Indexer:              []string{"kv"},
+		SqlitePath:           filepath.Join(defaultDataDir, "tx_index.sqlite"),
+		ParquetPath:          filepath.Join(defaultDataDir, "events.parquet"),
+		ParquetBlocksPerFile: 1000,
+	}
 }
 
 // TestTxIndexConfig returns a default configuration for the transaction indexer.
 func TestTxIndexConfig() *TxIndexConfig {
-	return &TxIndexConfig{Indexer: []string{"kv"}}
+	return DefaultTxIndexConfig()
+}
+
+// SqliteFile returns the full path to the SQLite database of the "sqlite" indexer.
+func (cfg *TxIndexConfig) SqliteFile() string {
+	return rootify(cfg.SqlitePath, cfg.RootDir)
+}
+
+// ParquetDir returns the full path to the directory of the "parquet" indexer.
+func (cfg *TxIndexConfig) ParquetDir() string {
+	return rootify(cfg.ParquetPath, cfg.RootDir)
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	for _, s := range cfg.Indexer {
+		switch strings.ToLower(s) {
+		case "sqlite":
+			if cfg.SqlitePath == "" {
+				return errors.New("sqlite-path cannot be empty when the sqlite indexer is enabled")
+			}
+		case "parquet":
+			if cfg.ParquetPath == "" {
+				return errors.New("parquet-path cannot be empty when the parquet indexer is enabled")
+			}
+		}
+	}
+	if cfg.ParquetBlocksPerFile <= 0 {
+		return errors.New("parquet-blocks-per-file must be positive")
+	}
+	return nil
 }
 
 //-----------------------------------------------------------------------------
@@ -1517,3 +1694,73 @@ func (cfg *SelfRemediationConfig) ValidateBasic() error {
 	}
 	return nil
 }
+
+//-----------------------------------------------------------------------------
+// HashCompareConfig
+
+// HashCompareConfig defines the configuration for comparing the per-module hashes this node
+// logs for each block with the hashes its peers logged for the same block.
+type HashCompareConfig struct {
+	// Exchange recently logged block hashes with peers and alert on the first
+	// hash category and height at which they diverge. Requires the app's hash
+	// logger to be enabled.
+	Enable bool `mapstructure:"enable"`
+
+	// Comma-separated node IDs to compare with, typically this node's sentries or
+	// other trusted nodes. Empty compares with every connected peer that runs the
+	// service.
+	Peers string `mapstructure:"peers"`
+
+	// Halt the node when one of the configured peers diverges. Requires peers to
+	// be set, so that an arbitrary peer can never halt the node.
+	HaltOnDivergence bool `mapstructure:"halt-on-divergence"`
+
+	// How often newly logged hashes are sent to peers.
+	BroadcastInterval time.Duration `mapstructure:"broadcast-interval"`
+}
+
+// DefaultHashCompareConfig returns a default configuration for hash comparison.
+func DefaultHashCompareConfig() *HashCompareConfig {
+	return &HashCompareConfig{
+		Enable:            false,
+		Peers:             "",
+		HaltOnDivergence:  false,
+		BroadcastInterval: 5 * time.Second,
+	}
+}
+
+// PeerIDs parses Peers.
+func (cfg *HashCompareConfig) PeerIDs() ([]types.NodeID, error) {
+	var ids []types.NodeID
+	for _, p := range strings.Split(cfg.Peers, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		id, err := types.NewNodeID(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer ID %q: %w", p, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *HashCompareConfig) ValidateBasic() error {
+	if cfg == nil {
+		return nil
+	}
+	ids, err := cfg.PeerIDs()
+	if err != nil {
+		return fmt.Errorf("peers: %w", err)
+	}
+	if cfg.HaltOnDivergence && len(ids) == 0 {
+		return errors.New("halt-on-divergence requires peers to be set")
+	}
+	if cfg.BroadcastInterval <= 0 {
+		return errors.New("broadcast-interval must be positive")
+	}
+	return nil
+}
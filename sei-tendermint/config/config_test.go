@@ -230,6 +230,29 @@ func TestP2PConfigAcceptInterval(t *testing.T) {
 	require.Equal(t, rate.Inf, rate.Every(cfg.AcceptInterval))
 }
 
+func TestHashCompareConfigValidateBasic(t *testing.T) {
+	cfg := DefaultHashCompareConfig()
+	require.NoError(t, cfg.ValidateBasic())
+
+	// Halting on the word of an arbitrary peer is refused.
+	cfg.HaltOnDivergence = true
+	require.Error(t, cfg.ValidateBasic())
+
+	id := types.NodeID("00112233445566778899aabbccddeeff00112233")
+	cfg.Peers = " " + string(id) + ", "
+	require.NoError(t, cfg.ValidateBasic())
+	ids, err := cfg.PeerIDs()
+	require.NoError(t, err)
+	require.Equal(t, []types.NodeID{id}, ids)
+
+	cfg.Peers = "not-a-node-id"
+	require.Error(t, cfg.ValidateBasic())
+
+	cfg = DefaultHashCompareConfig()
+	cfg.BroadcastInterval = 0
+	require.Error(t, cfg.ValidateBasic())
+}
+
 // --- WalFile legacy fallback tests ---
 
 func TestWalFile_NewDefault_NoLegacy(t *testing.T) {
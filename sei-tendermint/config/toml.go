@@ -126,6 +126,11 @@ db-backend = "{{ .BaseConfig.DBBackend }}"
 # Database directory
 db-dir = "{{ js .BaseConfig.DBPath }}"
 
+# Directory of a block archive, as written by the export-blocks command.
+# When set, blocksync applies the blocks available in the archive before
+# fetching the rest from peers.
+blocksync-archive-dir = "{{ js .BaseConfig.BlockSyncArchiveDir }}"
+
 # Output level for logging, including package level options
 log-level = "{{ .BaseConfig.LogLevel }}"
 
@@ -483,6 +488,53 @@ drop-utilisation-threshold = {{ .Mempool.DropUtilisationThreshold }}
 # See DropUtilisationThreshold and DropPriorityThreshold.
 drop-priority-reservoir-size = {{ .Mempool.DropPriorityReservoirSize }}
 
+# Minimum percentage by which an EVM transaction's priority (its gas price) must
+# exceed that of the transaction it replaces, i.e. the transaction from the same
+# sender with the same nonce. With 0, any higher priority replaces.
+price-bump = {{ .Mempool.PriceBump }}
+
+# Maximum number of ready transactions an EVM sender may have in the mempool.
+# 0 means no limit.
+max-ready-txs-per-sender = {{ .Mempool.MaxReadyTxsPerSender }}
+
+# Maximum number of pending transactions (nonce-gapped, or not yet affordable)
+# an EVM sender may have in the mempool. 0 means no limit.
+max-pending-txs-per-sender = {{ .Mempool.MaxPendingTxsPerSender }}
+
+# If true, keep the mempool within its size limits on every insertion by
+# evicting the last transaction of the lowest-priced sender, provided it is
+# priced below the new one. Otherwise the mempool may grow to twice its limits
+# before the lowest-priority transactions are pruned in bulk.
+evict-lowest-priced-tail = {{ .Mempool.EvictLowestPricedTail }}
+
+# How the transactions of a proposal are ordered:
+#   "priority"  - highest priority (gas price) first
+#   "fifo"      - in the order they arrived
+#   "timeboost" - in the order they arrived, each moved earlier in proportion
+#                 to its priority, by at most time-boost-max
+# An EVM sender's transactions are always included in nonce order.
+ordering-policy = "{{ .Mempool.OrderingPolicy }}"
+
+# Head start the timeboost ordering policy gives the highest priority.
+time-boost-max = "{{ .Mempool.TimeBoostMax }}"
+
+# If true, EVM senders take turns in a proposal, each contributing its next
+# transaction per round.
+interleave-senders = {{ .Mempool.InterleaveSenders }}
+
+# Number of recent proposal orderings kept for the proposal_ordering RPC.
+# 0 records none.
+ordering-audit-size = {{ .Mempool.OrderingAuditSize }}
+
+# journal, if true, records admitted and removed transactions on disk, so that
+# the transactions in the mempool when the node stops are re-checked and
+# re-admitted when it restarts, subject to ttl-duration and ttl-num-blocks.
+journal = {{ .Mempool.Journal }}
+
+# Directory of the mempool journal. Relative paths are relative to the home
+# directory.
+journal-path = "{{ js .Mempool.JournalPath }}"
+
 #######################################################################
 ###         State Sync Configuration Options                        ###
 #######################################################################
@@ -517,6 +569,11 @@ trust-period = "{{ .StateSync.TrustPeriod }}"
 # which are located in the snapshot-dir configured in app.toml (default to [home-dir]/data/snapshots)
 use-local-snapshot = {{ .StateSync.UseLocalSnapshot }}
 
+# Location of a snapshot archive to state sync from, instead of snapshots served
+# by peers: either a local directory or the http(s) URL of a publicly readable bucket.
+# Snapshots are still verified against the app hash obtained through the light client.
+snapshot-archive = "{{ .StateSync.SnapshotArchive }}"
+
 # Advanced state-sync tuning knobs are intentionally omitted from this template:
 #   - backfill-blocks, backfill-duration: post-sync historical light-block backfill
 #   - discovery-time, temp-dir: snapshot discovery and chunk staging
@@ -549,6 +606,27 @@ gossip-tx-key-only = "{{ .Consensus.GossipTransactionKeyOnly }}"
 peer-gossip-sleep-duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer-query-maj23-sleep-duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+#######################################################################
+###         Hash Comparison Configuration Options                   ###
+#######################################################################
+[hash-compare]
+
+# Exchange the per-module hashes this node logs for each block with peers and
+# alert on the first hash category and height at which they diverge.
+# Requires the app's hash logger (sc-hash-logger-enable) to be enabled.
+enable = {{ .HashCompare.Enable }}
+
+# Comma separated list of node IDs to compare with, typically this node's
+# sentries or other trusted nodes. Empty compares with every connected peer
+# that runs the service.
+peers = "{{ .HashCompare.Peers }}"
+
+# Halt the node when one of the configured peers diverges. Requires peers.
+halt-on-divergence = {{ .HashCompare.HaltOnDivergence }}
+
+# How often newly logged hashes are sent to peers.
+broadcast-interval = "{{ .HashCompare.BroadcastInterval }}"
+
 `
 
 // autoManagedConfigTemplate contains configuration sections that are auto-managed
@@ -568,13 +646,29 @@ const autoManagedConfigTemplate = `
 #   1) "null" (default) - no indexer services.
 #   2) "kv" - a simple indexer backed by key-value storage (see DBBackend)
 #   3) "psql" - the indexer services backed by PostgreSQL.
-# When "kv" or "psql" is chosen "tx.height" and "tx.hash" will always be indexed.
+#   4) "sqlite" - the psql schema in an embedded SQLite database. Requires a
+#      binary built with -tags=sqliteSink, as SQLite is linked through cgo.
+#   5) "parquet" - events exported to Parquet files by height range.
+# When "kv", "psql" or "sqlite" is chosen "tx.height" and "tx.hash" will always be indexed.
 indexer = [{{ range $i, $e := .TxIndex.Indexer }}{{if $i}}, {{end}}{{ printf "%q" $e}}{{end}}]
 
 # The PostgreSQL connection configuration, the connection format:
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# The SQLite database file of the "sqlite" indexer. It is created along with
+# its schema if missing.
+sqlite-path = "{{ js .TxIndex.SqlitePath }}"
+
+# The directory the "parquet" indexer writes its files to.
+parquet-path = "{{ js .TxIndex.ParquetPath }}"
+
+# The number of blocks per Parquet file. Files cover aligned height ranges:
+# with 1000, heights 1-1000, 1001-2000 and so on. A file is written once its
+# range is complete or the node stops, so a crash loses the events of up to
+# this many blocks; the reindex-event command restores them.
+parquet-blocks-per-file = {{ .TxIndex.ParquetBlocksPerFile }}
+
 #######################################################################
 ###       Instrumentation Configuration (Auto-managed)             ###
 #######################################################################
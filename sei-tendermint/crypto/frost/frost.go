@@ -0,0 +1,335 @@
+// Package frost implements FROST(Ed25519, SHA-512) threshold signatures, as specified by RFC 9591.
+//
+// A secret key is split by a trusted dealer into n shares, any t of which can cooperate to produce a
+// signature that is an ordinary Ed25519 signature under the original public key. Fewer than t shares
+// reveal nothing about the key.
+//
+// Signing takes two rounds. In the first, each participant commits to a pair of fresh nonces. In the
+// second, each participant signs the message given the commitments of all participants, and the
+// coordinator aggregates the signature shares. A nonce must be used for a single signature share: a
+// participant that signs twice with the same nonce reveals its key share.
+package frost
+
+import (
+	"crypto/rand"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"slices"
+
+	"filippo.io/edwards25519"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/ed25519"
+)
+
+const contextString = "FROST-ED25519-SHA512-v1"
+
+// Identifier identifies a participant. Participants of a t-of-n split are numbered from 1 to n.
+type Identifier uint32
+
+func (id Identifier) scalar() *edwards25519.Scalar {
+	var b [32]byte
+	b[0], b[1], b[2], b[3] = byte(id), byte(id>>8), byte(id>>16), byte(id>>24)
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(b[:])
+	if err != nil {
+		panic(err) // 32-bit values are always canonical.
+	}
+	return s
+}
+
+// GroupKey is the public part of a split key: the group public key, which verifies the aggregated
+// signatures, and the public key of every share, which verifies signature shares.
+type GroupKey struct {
+	Threshold int
+	PublicKey *edwards25519.Point
+	Shares    map[Identifier]*edwards25519.Point
+}
+
+// Ed25519 returns the group public key as an Ed25519 public key.
+func (g *GroupKey) Ed25519() ed25519.PublicKey {
+	k, err := ed25519.PublicKeyFromBytes(g.PublicKey.Bytes())
+	if err != nil {
+		panic(err) // Encoded points are always 32 bytes.
+	}
+	return k
+}
+
+// KeyShare is the secret share of a participant.
+type KeyShare struct {
+	ID     Identifier
+	Secret *edwards25519.Scalar
+}
+
+// Split splits an Ed25519 secret key into n shares, any threshold of which can sign for its public key.
+func Split(key ed25519.SecretKey, threshold, n int) (*GroupKey, []*KeyShare, error) {
+	if threshold < 1 || threshold > n {
+		return nil, nil, fmt.Errorf("threshold must be between 1 and %d, got %d", n, threshold)
+	}
+	if n > 1<<16 {
+		return nil, nil, fmt.Errorf("too many shares: %d", n)
+	}
+	// The Ed25519 secret scalar is the clamped first half of the SHA-512 hash of the seed.
+	secretBytes := key.SecretBytes()
+	h := sha512.Sum512(secretBytes[:32])
+	clear(secretBytes)
+	secret, err := edwards25519.NewScalar().SetBytesWithClamping(h[:32])
+	clear(h[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	coefficients := make([]*edwards25519.Scalar, threshold-1)
+	for i := range coefficients {
+		if coefficients[i], err = randomScalar(); err != nil {
+			return nil, nil, err
+		}
+	}
+	group, shares := splitSecret(secret, coefficients, n)
+	return group, shares, nil
+}
+
+// splitSecret is Shamir's secret sharing: share i is f(i) for the polynomial f of degree
+// len(coefficients) with f(0) = secret and the given higher coefficients.
+func splitSecret(secret *edwards25519.Scalar, coefficients []*edwards25519.Scalar, n int) (*GroupKey, []*KeyShare) {
+	coefficients = slices.Concat([]*edwards25519.Scalar{secret}, coefficients)
+	group := &GroupKey{
+		Threshold: len(coefficients),
+		PublicKey: edwards25519.NewIdentityPoint().ScalarBaseMult(secret),
+		Shares:    make(map[Identifier]*edwards25519.Point, n),
+	}
+	shares := make([]*KeyShare, n)
+	for i := range shares {
+		id := Identifier(i + 1) //nolint:gosec // n is bounded above
+		x, y := id.scalar(), edwards25519.NewScalar()
+		for _, c := range slices.Backward(coefficients) {
+			y.MultiplyAdd(y, x, c)
+		}
+		shares[i] = &KeyShare{ID: id, Secret: y}
+		group.Shares[id] = edwards25519.NewIdentityPoint().ScalarBaseMult(y)
+	}
+	return group, shares
+}
+
+func randomScalar() (*edwards25519.Scalar, error) {
+	var b [64]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	return edwards25519.NewScalar().SetUniformBytes(b[:])
+}
+
+// Commitment is the public commitment of a participant to its signing nonces.
+type Commitment struct {
+	ID      Identifier
+	Hiding  *edwards25519.Point
+	Binding *edwards25519.Point
+}
+
+// Nonce is the secret pair of nonces a participant signs with. It must be used at most once.
+type Nonce struct {
+	hiding, binding *edwards25519.Scalar
+	commitment      Commitment
+}
+
+// Commitment returns the commitment to the nonce, to be sent to the coordinator.
+func (n *Nonce) Commitment() Commitment { return n.commitment }
+
+// NewNonce generates a fresh nonce for the share.
+func NewNonce(share *KeyShare) (*Nonce, error) {
+	var hidingRandom, bindingRandom [32]byte
+	if _, err := rand.Read(hidingRandom[:]); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(bindingRandom[:]); err != nil {
+		return nil, err
+	}
+	return newNonce(share, hidingRandom[:], bindingRandom[:]), nil
+}
+
+func newNonce(share *KeyShare, hidingRandom, bindingRandom []byte) *Nonce {
+	hiding := nonceGenerate(hidingRandom, share.Secret)
+	binding := nonceGenerate(bindingRandom, share.Secret)
+	return &Nonce{
+		hiding:  hiding,
+		binding: binding,
+		commitment: Commitment{
+			ID:      share.ID,
+			Hiding:  edwards25519.NewIdentityPoint().ScalarBaseMult(hiding),
+			Binding: edwards25519.NewIdentityPoint().ScalarBaseMult(binding),
+		},
+	}
+}
+
+// nonceGenerate mixes the secret into 32 bytes of fresh randomness, so that a weak random source alone
+// does not reveal the nonce.
+func nonceGenerate(random []byte, secret *edwards25519.Scalar) *edwards25519.Scalar {
+	return hashToScalar("nonce", random, secret.Bytes())
+}
+
+func hash(tag string, parts ...[]byte) []byte {
+	h := sha512.New()
+	h.Write([]byte(contextString))
+	h.Write([]byte(tag))
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
+
+func hashToScalar(tag string, parts ...[]byte) *edwards25519.Scalar {
+	s, err := edwards25519.NewScalar().SetUniformBytes(hash(tag, parts...))
+	if err != nil {
+		panic(err) // SHA-512 outputs are always 64 bytes.
+	}
+	return s
+}
+
+// signingPackage holds what every participant derives from the message and the commitments.
+type signingPackage struct {
+	commitments    []Commitment
+	bindingFactors map[Identifier]*edwards25519.Scalar
+	groupCommit    *edwards25519.Point
+	challenge      *edwards25519.Scalar
+}
+
+func newSigningPackage(group *edwards25519.Point, msg []byte, commitments []Commitment) (*signingPackage, error) {
+	if len(commitments) == 0 {
+		return nil, errors.New("no commitments")
+	}
+	identity := edwards25519.NewIdentityPoint()
+	var encoded []byte
+	for i, c := range commitments {
+		if i > 0 && c.ID <= commitments[i-1].ID {
+			return nil, errors.New("commitments must be sorted by identifier, without duplicates")
+		}
+		if c.ID == 0 || c.Hiding == nil || c.Binding == nil || c.Hiding.Equal(identity) == 1 || c.Binding.Equal(identity) == 1 {
+			return nil, fmt.Errorf("invalid commitment of participant %d", c.ID)
+		}
+		encoded = append(encoded, c.ID.scalar().Bytes()...)
+		encoded = append(encoded, c.Hiding.Bytes()...)
+		encoded = append(encoded, c.Binding.Bytes()...)
+	}
+
+	prefix := slices.Concat(group.Bytes(), hash("msg", msg), hash("com", encoded))
+	p := &signingPackage{
+		commitments:    commitments,
+		bindingFactors: make(map[Identifier]*edwards25519.Scalar, len(commitments)),
+		groupCommit:    edwards25519.NewIdentityPoint(),
+	}
+	for _, c := range commitments {
+		rho := hashToScalar("rho", prefix, c.ID.scalar().Bytes())
+		p.bindingFactors[c.ID] = rho
+		p.groupCommit.Add(p.groupCommit, c.Hiding)
+		p.groupCommit.Add(p.groupCommit, edwards25519.NewIdentityPoint().ScalarMult(rho, c.Binding))
+	}
+	// The challenge is the one Ed25519 verification computes, so the aggregate is an Ed25519 signature.
+	h := sha512.New()
+	h.Write(p.groupCommit.Bytes())
+	h.Write(group.Bytes())
+	h.Write(msg)
+	challenge, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	p.challenge = challenge
+	return p, nil
+}
+
+// lagrange returns the Lagrange coefficient of participant id at 0, over the signing participants.
+func (p *signingPackage) lagrange(id Identifier) *edwards25519.Scalar {
+	num, den := scalarOne(), scalarOne()
+	x := id.scalar()
+	for _, c := range p.commitments {
+		if c.ID == id {
+			continue
+		}
+		xj := c.ID.scalar()
+		num.Multiply(num, xj)
+		den.Multiply(den, edwards25519.NewScalar().Subtract(xj, x))
+	}
+	return num.Multiply(num, edwards25519.NewScalar().Invert(den))
+}
+
+func scalarOne() *edwards25519.Scalar {
+	return Identifier(1).scalar()
+}
+
+// Sign returns the signature share of the msg given the commitments of all signing participants, which
+// must be sorted by identifier and include the commitment of the nonce. The nonce must not be used
+// again, whether or not Sign succeeds.
+func Sign(group *GroupKey, share *KeyShare, nonce *Nonce, msg []byte, commitments []Commitment) (*edwards25519.Scalar, error) {
+	if len(commitments) < group.Threshold {
+		return nil, fmt.Errorf("%d commitments, need at least %d", len(commitments), group.Threshold)
+	}
+	i := slices.IndexFunc(commitments, func(c Commitment) bool { return c.ID == share.ID })
+	if i < 0 {
+		return nil, errors.New("own commitment is missing")
+	}
+	if own := commitments[i]; own.Hiding == nil || own.Binding == nil ||
+		own.Hiding.Equal(nonce.commitment.Hiding) != 1 || own.Binding.Equal(nonce.commitment.Binding) != 1 {
+		return nil, errors.New("own commitment does not match the nonce")
+	}
+	p, err := newSigningPackage(group.PublicKey, msg, commitments)
+	if err != nil {
+		return nil, err
+	}
+	z := edwards25519.NewScalar().MultiplyAdd(nonce.binding, p.bindingFactors[share.ID], nonce.hiding)
+	lambdaC := edwards25519.NewScalar().Multiply(p.lagrange(share.ID), p.challenge)
+	return z.MultiplyAdd(lambdaC, share.Secret, z), nil
+}
+
+// VerifyShare checks the signature share of participant id.
+func VerifyShare(group *GroupKey, id Identifier, z *edwards25519.Scalar, msg []byte, commitments []Commitment) error {
+	p, err := newSigningPackage(group.PublicKey, msg, commitments)
+	if err != nil {
+		return err
+	}
+	return p.verifyShare(group, id, z)
+}
+
+func (p *signingPackage) verifyShare(group *GroupKey, id Identifier, z *edwards25519.Scalar) error {
+	i := slices.IndexFunc(p.commitments, func(c Commitment) bool { return c.ID == id })
+	pub, ok := group.Shares[id]
+	if i < 0 || !ok {
+		return fmt.Errorf("participant %d is not signing", id)
+	}
+	c := p.commitments[i]
+	want := edwards25519.NewIdentityPoint().ScalarMult(p.bindingFactors[id], c.Binding)
+	want.Add(want, c.Hiding)
+	lambdaC := edwards25519.NewScalar().Multiply(p.lagrange(id), p.challenge)
+	want.Add(want, edwards25519.NewIdentityPoint().ScalarMult(lambdaC, pub))
+	if edwards25519.NewIdentityPoint().ScalarBaseMult(z).Equal(want) != 1 {
+		return fmt.Errorf("invalid signature share of participant %d", id)
+	}
+	return nil
+}
+
+// Aggregate verifies the signature shares of all the signing participants and combines them into an
+// Ed25519 signature of msg under the group public key.
+func Aggregate(group *GroupKey, msg []byte, commitments []Commitment, shares map[Identifier]*edwards25519.Scalar) (ed25519.Signature, error) {
+	if len(commitments) < group.Threshold {
+		return ed25519.Signature{}, fmt.Errorf("%d commitments, need at least %d", len(commitments), group.Threshold)
+	}
+	p, err := newSigningPackage(group.PublicKey, msg, commitments)
+	if err != nil {
+		return ed25519.Signature{}, err
+	}
+	z := edwards25519.NewScalar()
+	for _, c := range commitments {
+		share, ok := shares[c.ID]
+		if !ok {
+			return ed25519.Signature{}, fmt.Errorf("missing signature share of participant %d", c.ID)
+		}
+		if err := p.verifyShare(group, c.ID, share); err != nil {
+			return ed25519.Signature{}, err
+		}
+		z.Add(z, share)
+	}
+	sig, err := ed25519.SignatureFromBytes(slices.Concat(p.groupCommit.Bytes(), z.Bytes()))
+	if err != nil {
+		return ed25519.Signature{}, err
+	}
+	if err := group.Ed25519().Verify(msg, sig); err != nil {
+		return ed25519.Signature{}, fmt.Errorf("aggregated signature does not verify: %w", err)
+	}
+	return sig, nil
+}
@@ -0,0 +1,203 @@
+package frost
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"filippo.io/edwards25519"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/ed25519"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+)
+
+// sign runs both signing rounds with the given shares.
+func sign(t *testing.T, group *GroupKey, shares []*KeyShare, msg []byte) (ed25519.Signature, error) {
+	t.Helper()
+	var nonces []*Nonce
+	var commitments []Commitment
+	for _, s := range shares {
+		n, err := NewNonce(s)
+		require.NoError(t, err)
+		nonces = append(nonces, n)
+		commitments = append(commitments, n.Commitment())
+	}
+	zs := map[Identifier]*edwards25519.Scalar{}
+	for i, s := range shares {
+		z, err := Sign(group, s, nonces[i], msg, commitments)
+		if err != nil {
+			return ed25519.Signature{}, err
+		}
+		zs[s.ID] = z
+	}
+	return Aggregate(group, msg, commitments, zs)
+}
+
+func TestSplitKeepsPublicKey(t *testing.T) {
+	key := ed25519.TestSecretKey([]byte("frost"))
+	group, shares, err := Split(key, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, key.Public(), group.Ed25519())
+	require.Equal(t, 3, len(shares))
+	require.Equal(t, 3, len(group.Shares))
+
+	_, _, err = Split(key, 4, 3)
+	require.Error(t, err)
+	_, _, err = Split(key, 0, 3)
+	require.Error(t, err)
+}
+
+func TestThresholdSign(t *testing.T) {
+	key := ed25519.TestSecretKey([]byte("frost"))
+	msg := []byte("test message")
+	for _, tc := range []struct{ threshold, n int }{{1, 1}, {2, 3}, {3, 5}, {5, 5}} {
+		group, shares, err := Split(key, tc.threshold, tc.n)
+		require.NoError(t, err)
+		// Every large enough subset signs, and the signature is an Ed25519 signature of the original key.
+		for _, subset := range [][]*KeyShare{shares[:tc.threshold], shares[tc.n-tc.threshold:], shares} {
+			sig, err := sign(t, group, subset, msg)
+			require.NoError(t, err)
+			require.NoError(t, key.Public().Verify(msg, sig))
+		}
+		if tc.threshold > 1 {
+			_, err := sign(t, group, shares[:tc.threshold-1], msg)
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestSignRejectsBadInput(t *testing.T) {
+	key := ed25519.TestSecretKey([]byte("frost"))
+	msg := []byte("test message")
+	group, shares, err := Split(key, 2, 3)
+	require.NoError(t, err)
+
+	n1, err := NewNonce(shares[0])
+	require.NoError(t, err)
+	n2, err := NewNonce(shares[1])
+	require.NoError(t, err)
+	commitments := []Commitment{n1.Commitment(), n2.Commitment()}
+
+	// Commitments out of order.
+	_, err = Sign(group, shares[0], n1, msg, []Commitment{n2.Commitment(), n1.Commitment()})
+	require.Error(t, err)
+	// A commitment list without the signer's, or with another nonce in its place.
+	_, err = Sign(group, shares[2], n1, msg, commitments)
+	require.Error(t, err)
+	_, err = Sign(group, shares[1], n1, msg, commitments)
+	require.Error(t, err)
+
+	z1, err := Sign(group, shares[0], n1, msg, commitments)
+	require.NoError(t, err)
+	z2, err := Sign(group, shares[1], n2, msg, commitments)
+	require.NoError(t, err)
+	require.NoError(t, VerifyShare(group, 1, z1, msg, commitments))
+
+	// A share of another message, or a tampered share, is caught before aggregation.
+	require.Error(t, VerifyShare(group, 1, z1, []byte("other message"), commitments))
+	bad := edwards25519.NewScalar().Add(z2, scalarOne())
+	require.Error(t, VerifyShare(group, 2, bad, msg, commitments))
+	_, err = Aggregate(group, msg, commitments, map[Identifier]*edwards25519.Scalar{1: z1, 2: bad})
+	require.Error(t, err)
+
+	sig, err := Aggregate(group, msg, commitments, map[Identifier]*edwards25519.Scalar{1: z1, 2: z2})
+	require.NoError(t, err)
+	require.NoError(t, key.Public().Verify(msg, sig))
+}
+
+func scalarFromHex(t *testing.T, s string) *edwards25519.Scalar {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	x, err := edwards25519.NewScalar().SetCanonicalBytes(b)
+	require.NoError(t, err)
+	return x
+}
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+// TestRFC9591Vectors checks the FROST(Ed25519, SHA-512) test vectors of RFC 9591, Appendix E.1: a 2-of-3
+// split signed by participants 1 and 3.
+func TestRFC9591Vectors(t *testing.T) {
+	msg := mustHex(t, "74657374")
+
+	// Key generation by a trusted dealer.
+	group, shares := splitSecret(
+		scalarFromHex(t, "7b1c33d3f5291d85de664833beb1ad469f7fb6025a0ec78b3a790c6e13a98304"),
+		[]*edwards25519.Scalar{scalarFromHex(t, "178199860edd8c62f5212ee91eff1295d0d670ab4ed4506866bae57e7030b204")},
+		3,
+	)
+	require.Equal(t, "15d21ccd7ee42959562fc8aa63224c8851fb3ec85a3faf66040d380fb9738673", hex.EncodeToString(group.PublicKey.Bytes()))
+	for i, want := range []string{
+		"929dcc590407aae7d388761cddb0c0db6f5627aea8e217f4a033f2ec83d93509",
+		"a91e66e012e4364ac9aaa405fcafd370402d9859f7b6685c07eed76bf409e80d",
+		"d3cb090a075eb154e82fdb4b3cb507f110040905468bb9c46da8bdea643a9a02",
+	} {
+		require.Equal(t, want, hex.EncodeToString(shares[i].Secret.Bytes()))
+	}
+
+	signers := []struct {
+		share                               *KeyShare
+		hidingRandom, bindingRandom         string
+		hidingNonce, bindingNonce           string
+		hidingCommitment, bindingCommitment string
+		bindingFactor, sigShare             string
+	}{
+		{
+			share:             shares[0],
+			hidingRandom:      "0fd2e39e111cdc266f6c0f4d0fd45c947761f1f5d3cb583dfcb9bbaf8d4c9fec",
+			bindingRandom:     "69cd85f631d5f7f2721ed5e40519b1366f340a87c2f6856363dbdcda348a7501",
+			hidingNonce:       "812d6104142944d5a55924de6d49940956206909f2acaeedecda2b726e630407",
+			bindingNonce:      "b1110165fc2334149750b28dd813a39244f315cff14d4e89e6142f262ed83301",
+			hidingCommitment:  "b5aa8ab305882a6fc69cbee9327e5a45e54c08af61ae77cb8207be3d2ce13de3",
+			bindingCommitment: "67e98ab55aa310c3120418e5050c9cf76cf387cb20ac9e4b6fdb6f82a469f932",
+			bindingFactor:     "f2cb9d7dd9beff688da6fcc83fa89046b3479417f47f55600b106760eb3b5603",
+			sigShare:          "001719ab5a53ee1a12095cd088fd149702c0720ce5fd2f29dbecf24b7281b603",
+		},
+		{
+			share:             shares[2],
+			hidingRandom:      "86d64a260059e495d0fb4fcc17ea3da7452391baa494d4b00321098ed2a0062f",
+			bindingRandom:     "13e6b25afb2eba51716a9a7d44130c0dbae0004a9ef8d7b5550c8a0e07c61775",
+			hidingNonce:       "c256de65476204095ebdc01bd11dc10e57b36bc96284595b8215222374f99c0e",
+			bindingNonce:      "243d71944d929063bc51205714ae3c2218bd3451d0214dfb5aeec2a90c35180d",
+			hidingCommitment:  "cfbdb165bd8aad6eb79deb8d287bcc0ab6658ae57fdcc98ed12c0669e90aec91",
+			bindingCommitment: "7487bc41a6e712eea2f2af24681b58b1cf1da278ea11fe4e8b78398965f13552",
+			bindingFactor:     "b087686bf35a13f3dc78e780a34b0fe8a77fef1b9938c563f5573d71d8d7890f",
+			sigShare:          "bd86125de990acc5e1f13781d8e32c03a9bbd4c53539bbc106058bfd14326007",
+		},
+	}
+
+	// Round one: nonces and their commitments.
+	var nonces []*Nonce
+	var commitments []Commitment
+	for _, s := range signers {
+		n := newNonce(s.share, mustHex(t, s.hidingRandom), mustHex(t, s.bindingRandom))
+		require.Equal(t, s.hidingNonce, hex.EncodeToString(n.hiding.Bytes()))
+		require.Equal(t, s.bindingNonce, hex.EncodeToString(n.binding.Bytes()))
+		require.Equal(t, s.hidingCommitment, hex.EncodeToString(n.Commitment().Hiding.Bytes()))
+		require.Equal(t, s.bindingCommitment, hex.EncodeToString(n.Commitment().Binding.Bytes()))
+		nonces = append(nonces, n)
+		commitments = append(commitments, n.Commitment())
+	}
+
+	// Round two: binding factors and signature shares.
+	p, err := newSigningPackage(group.PublicKey, msg, commitments)
+	require.NoError(t, err)
+	zs := map[Identifier]*edwards25519.Scalar{}
+	for i, s := range signers {
+		require.Equal(t, s.bindingFactor, hex.EncodeToString(p.bindingFactors[s.share.ID].Bytes()))
+		z, err := Sign(group, s.share, nonces[i], msg, commitments)
+		require.NoError(t, err)
+		require.Equal(t, s.sigShare, hex.EncodeToString(z.Bytes()))
+		zs[s.share.ID] = z
+	}
+
+	sig, err := Aggregate(group, msg, commitments, zs)
+	require.NoError(t, err)
+	require.Equal(t, "36282629c383bb820a88b71cae937d41f2f2adfcc3d02e55507e2fb9e2dd3cbe"+
+		"bd9d2b0844e49ae0f3fa935161e1419aab7b47d21a37ebeae1f17d4987b3160b", hex.EncodeToString(sig.Bytes()))
+}
@@ -3,12 +3,16 @@ package export
 import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/pubsub/query"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/statesync"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/store"
 )
 
 type Query = query.Query
+type SnapshotArchive = statesync.SnapshotArchive
 
 var NewBlockStore = store.NewBlockStore
 var NewStore = state.NewStore
+var BuildLastCommitInfo = state.BuildLastCommitInfo
 var NewQuery = query.New
 var QueryAll = query.All
+var OpenSnapshotArchive = statesync.OpenSnapshotArchive
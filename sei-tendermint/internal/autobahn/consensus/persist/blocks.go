@@ -173,6 +173,22 @@ func laneDir(lane types.LaneID) string {
 	return lane.HexString()
 }
 
+// parseLaneDir returns the lane whose WAL lives in the blocks/ subdirectory name, logging and
+// reporting false for entries which are not lane directories.
+func parseLaneDir(name string) (types.LaneID, bool) {
+	laneBytes, err := hex.DecodeString(name)
+	if err != nil {
+		logger.Warn("skipping unexpected entry in blocks dir", "name", name)
+		return types.LaneID{}, false
+	}
+	lane, err := types.LaneIDFromBytes(laneBytes)
+	if err != nil {
+		logger.Warn("skipping lane dir with invalid LaneID (leaks until state wipe)", "name", name, "err", err)
+		return types.LaneID{}, false
+	}
+	return lane, true
+}
+
 func newLaneWALState(dir string) (*laneWALState, error) {
 	wal, err := openWAL(dir, blocksWALName, types.SignedLaneProposalConv, targetFileSize, blocksWALMetrics)
 	if err != nil {
@@ -216,14 +232,8 @@ func NewBlockPersister(stateDir utils.Option[string]) (*BlockPersister, map[type
 		if !e.IsDir() {
 			continue
 		}
-		laneBytes, err := hex.DecodeString(e.Name())
-		if err != nil {
-			logger.Warn("skipping unexpected entry in blocks dir", "name", e.Name())
-			continue
-		}
-		lane, err := types.LaneIDFromBytes(laneBytes)
-		if err != nil {
-			logger.Warn("skipping lane dir with invalid LaneID (leaks until state wipe)", "name", e.Name(), "err", err)
+		lane, ok := parseLaneDir(e.Name())
+		if !ok {
 			continue
 		}
 		lanePath := filepath.Join(dir, e.Name())
@@ -0,0 +1,103 @@
+package persist
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/sei-protocol/sei-chain/sei-db/seiwal"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/protoutils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+)
+
+// The loaders in this file read the persisted state for offline inspection. Unlike the persisters, they
+// never create, lock, seal or truncate anything, so they are safe to run against the state directory of
+// a running node. The state they return is what a restart would load, except that a write in progress
+// may be missed.
+
+// Load returns the message persisted under prefix in stateDir (None if there is none).
+func Load[T protoutils.Message](stateDir string, prefix string) (utils.Option[T], error) {
+	none := utils.None[T]()
+	ds, err := loadPersisted(stateDir, prefix)
+	if errors.Is(err, ErrNoData) {
+		return none, nil
+	}
+	if err != nil {
+		return none, err
+	}
+	msg, err := protoutils.Unmarshal[T](ds.data)
+	if err != nil {
+		return none, fmt.Errorf("unmarshal persisted %s: %w", prefix, err)
+	}
+	return utils.Some(msg), nil
+}
+
+// LoadBlocks returns the live blocks of every lane persisted in stateDir, as NewBlockPersister would.
+func LoadBlocks(stateDir string) (map[types.LaneID][]LoadedBlock, error) {
+	dir := filepath.Join(stateDir, blocksDir)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read blocks dir %s: %w", dir, err)
+	}
+	allBlocks := map[types.LaneID][]LoadedBlock{}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		lane, ok := parseLaneDir(e.Name())
+		if !ok {
+			continue
+		}
+		lanePath := filepath.Join(dir, e.Name())
+		walEntries, err := readRecords(lanePath, types.SignedLaneProposalConv)
+		if err != nil {
+			return nil, fmt.Errorf("read lane WAL in %s: %w", lanePath, err)
+		}
+		for _, entry := range contiguousSuffix(walEntries) {
+			allBlocks[lane] = append(allBlocks[lane], LoadedBlock{
+				Number:   entry.value.Msg().Block().Header().BlockNumber(),
+				Proposal: entry.value,
+			})
+		}
+	}
+	return allBlocks, nil
+}
+
+// LoadCommitQCs returns the live CommitQCs persisted in stateDir, as NewCommitQCPersister would.
+func LoadCommitQCs(stateDir string) ([]*types.CommitQC, error) {
+	dir := filepath.Join(stateDir, commitqcsDir)
+	if _, err := os.Stat(dir); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	entries, err := readRecords(dir, types.CommitQCConv)
+	if err != nil {
+		return nil, fmt.Errorf("read commitqc WAL in %s: %w", dir, err)
+	}
+	var loaded []*types.CommitQC
+	for _, entry := range contiguousSuffix(entries) {
+		loaded = append(loaded, entry.value)
+	}
+	return loaded, nil
+}
+
+// readRecords decodes every record of the WAL in dir without opening it. See seiwal.ReadRecords.
+func readRecords[T any](dir string, codec codec[T]) ([]walEntry[T], error) {
+	var entries []walEntry[T]
+	err := seiwal.ReadRecords(dir, func(index uint64, payload []byte) error {
+		value, err := codec.Unmarshal(payload)
+		if err != nil {
+			return fmt.Errorf("decode record %d: %w", index, err)
+		}
+		entries = append(entries, walEntry[T]{index: index, value: value})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
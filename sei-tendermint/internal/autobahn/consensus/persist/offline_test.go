@@ -0,0 +1,80 @@
+package persist
+
+import (
+	"os"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/epoch"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+)
+
+func TestLoadEmptyDir(t *testing.T) {
+	dir := t.TempDir()
+	data, err := Load[*wrapperspb.StringValue](dir, "test")
+	require.NoError(t, err)
+	require.False(t, data.IsPresent())
+	blocks, err := LoadBlocks(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(blocks))
+	qcs, err := LoadCommitQCs(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(qcs))
+
+	// Nothing is created in the state dir.
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+}
+
+func TestLoadWhilePersistersAreOpen(t *testing.T) {
+	rng := utils.TestRng()
+	registry, keys := epoch.GenRegistry(rng, 4)
+	committee := registry.LatestEpoch().Committee()
+	dir := t.TempDir()
+
+	p, _, err := NewPersister[*wrapperspb.StringValue](utils.Some(dir), "test")
+	require.NoError(t, err)
+	require.NoError(t, p.Persist(wrapperspb.String("data")))
+
+	key := keys[0]
+	lane := types.LaneID{Validator: key.Public(), Joined: 0}
+	bp, _, err := NewBlockPersister(utils.Some(dir))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, bp.Close()) }()
+	b0 := testSignedProposal(rng, key, 0)
+	b1 := testSignedProposal(rng, key, 1)
+	testPersistBlock(t, bp, b0)
+	testPersistBlock(t, bp, b1)
+
+	cp, _, err := NewCommitQCPersister(utils.Some(dir))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, cp.Close()) }()
+	qcs := makeSequentialCommitQCs(committee, keys, 3)
+	require.NoError(t, cp.PruneAndPersist(0, qcs))
+
+	// The persisters hold the WAL locks; the loaders do not need them.
+	data, err := Load[*wrapperspb.StringValue](dir, "test")
+	require.NoError(t, err)
+	got, ok := data.Get()
+	require.True(t, ok)
+	require.Equal(t, "data", got.GetValue())
+
+	blocks, err := LoadBlocks(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	require.Equal(t, 2, len(blocks[lane]))
+	require.Equal(t, types.BlockNumber(1), blocks[lane][1].Number)
+	require.NoError(t, utils.TestDiff(b0, blocks[lane][0].Proposal))
+	require.NoError(t, utils.TestDiff(b1, blocks[lane][1].Proposal))
+
+	loaded, err := LoadCommitQCs(dir)
+	require.NoError(t, err)
+	require.Equal(t, len(qcs), len(loaded))
+	for i, qc := range loaded {
+		require.NoError(t, utils.TestDiff(qcs[i], qc))
+	}
+}
@@ -213,6 +213,12 @@ func (s *State) PushTimeoutVote(vote *types.FullTimeoutVote) error {
 	return nil
 }
 
+// View returns the current view of the consensus state.
+func (s *State) View() types.View {
+	vs := s.myView.Load()
+	return vs.View()
+}
+
 // Data is the underlying data state.
 func (s *State) Data() *data.State   { return s.avail.Data() }
 func (s *State) Avail() *avail.State { return s.avail }
@@ -0,0 +1,246 @@
+// Package inspect implements offline inspection of the persisted autobahn
+// state of a node: the consensus state (inner_a.pb/inner_b.pb), the lane block
+// and CommitQC WALs, and the finalized blocks in the BlockStore.
+//
+// The consensus state and the WALs are read in place without locking or
+// repairing them, so they can be inspected while the node is running. The
+// BlockStore has no read-only mode: opening it takes an exclusive lock and
+// repairs its tail the way a restart would, so it can only be opened while
+// the node is stopped.
+package inspect
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/sei-protocol/sei-chain/sei-db/ledger_db/block/littblock"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/blockstore"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/consensus/persist"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/pb"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+)
+
+// Prefix of the consensus inner state files, see consensus.innerFile.
+const innerFile = "inner"
+
+// Stores are the persisted autobahn stores of a node.
+type Stores struct {
+	Inner      utils.Option[*pb.PersistedInner]
+	Lanes      map[types.LaneID][]persist.LoadedBlock
+	CommitQCs  []*types.CommitQC
+	BlockStore types.BlockStore
+}
+
+// Open loads the stores from the persistent state directory stateDir in
+// place. The consensus state and the WALs are only read; the BlockStore is
+// opened as the node would open it, which fails while the node is running.
+// The caller must Close the returned stores.
+func Open(stateDir string, blockDB config.AutobahnBlockDBConfig) (*Stores, error) {
+	if fi, err := os.Stat(stateDir); err != nil {
+		return nil, fmt.Errorf("invalid state dir: %w", err)
+	} else if !fi.IsDir() {
+		return nil, fmt.Errorf("invalid state dir %q: not a directory", stateDir)
+	}
+	inner, err := persist.Load[*pb.PersistedInner](stateDir, innerFile)
+	if err != nil {
+		return nil, fmt.Errorf("load consensus state: %w", err)
+	}
+	lanes, err := persist.LoadBlocks(stateDir)
+	if err != nil {
+		return nil, fmt.Errorf("load lane blocks: %w", err)
+	}
+	commitQCs, err := persist.LoadCommitQCs(stateDir)
+	if err != nil {
+		return nil, fmt.Errorf("load CommitQCs: %w", err)
+	}
+	littCfg, err := blockDB.LittBlockConfig(filepath.Join(stateDir, "blockdb"))
+	if err != nil {
+		return nil, fmt.Errorf("block_db: %w", err)
+	}
+	db, err := littblock.NewBlockDB(&littCfg)
+	if err != nil {
+		return nil, fmt.Errorf("open BlockDB (is the node stopped?): %w", err)
+	}
+	store, err := blockstore.New(db)
+	if err != nil {
+		// The store takes ownership of db only once it is built, so a failure here leaves db ours.
+		_ = db.Close()
+		return nil, fmt.Errorf("open BlockStore: %w", err)
+	}
+	return &Stores{Inner: inner, Lanes: lanes, CommitQCs: commitQCs, BlockStore: store}, nil
+}
+
+// Close closes the BlockStore.
+func (s *Stores) Close() error {
+	return s.BlockStore.Close()
+}
+
+// ReplayBlockStore returns a view of s.BlockStore which keeps everything
+// written to it in memory, to build the data state passed to Replay without
+// modifying the node's finalized blocks.
+func (s *Stores) ReplayBlockStore() types.BlockStore {
+	return newOverlayStore(s.BlockStore)
+}
+
+// Print writes a human readable description of the stores to w: the
+// persisted consensus state, the lanes, the CommitQCs and, for every global
+// block number in heights (clamped to the BlockStore content), the finalized
+// block with the CommitQC, AppProposal and AppQC covering it.
+func (s *Stores) Print(w io.Writer, heights types.GlobalRange) error {
+	p := &printer{w: w}
+	s.printInner(p)
+	s.printLanes(p)
+	p.printf("== commit qcs (%d)\n", len(s.CommitQCs))
+	for _, qc := range s.CommitQCs {
+		p.printf("  %s\n", fmtCommitQC(qc))
+	}
+	if p.err != nil {
+		return p.err
+	}
+	return s.printHeights(p, heights)
+}
+
+func (s *Stores) printInner(p *printer) {
+	p.printf("== consensus state\n")
+	inner, ok := s.Inner.Get()
+	if !ok {
+		p.printf("  none\n")
+		return
+	}
+	if inner.CommitQc != nil {
+		p.printf("  commit_qc: %s\n", decode(types.CommitQCConv.Decode, inner.CommitQc, fmtCommitQC))
+	}
+	if inner.PrepareQc != nil {
+		p.printf("  prepare_qc: %s\n", decode(types.PrepareQCConv.Decode, inner.PrepareQc, func(qc *types.PrepareQC) string {
+			return fmt.Sprintf("view=%s global=%v", fmtView(qc.View()), qc.Proposal().GlobalRange())
+		}))
+	}
+	if inner.TimeoutQc != nil {
+		p.printf("  timeout_qc: %s\n", decode(types.TimeoutQCConv.Decode, inner.TimeoutQc, func(qc *types.TimeoutQC) string {
+			signers := make([]types.PublicKey, len(qc.Votes()))
+			for i, v := range qc.Votes() {
+				signers[i] = v.Key()
+			}
+			return fmt.Sprintf("view=%s signers=%v", fmtView(qc.View()), signers)
+		}))
+	}
+	if inner.PrepareVoteV2 != nil {
+		p.printf("  prepare_vote: %s\n", decode(types.SignedPrepareVoteConv.Decode, inner.PrepareVoteV2, func(v *types.Signed[*types.PrepareVote]) string {
+			return fmt.Sprintf("view=%s global=%v signer=%v", fmtView(v.Msg().Proposal().View()), v.Msg().Proposal().GlobalRange(), v.Key())
+		}))
+	}
+	if inner.CommitVoteV2 != nil {
+		p.printf("  commit_vote: %s\n", decode(types.SignedCommitVoteConv.Decode, inner.CommitVoteV2, func(v *types.Signed[*types.CommitVote]) string {
+			return fmt.Sprintf("view=%s global=%v signer=%v", fmtView(v.Msg().Proposal().View()), v.Msg().Proposal().GlobalRange(), v.Key())
+		}))
+	}
+	if inner.TimeoutVote != nil {
+		p.printf("  timeout_vote: %s\n", decode(types.FullTimeoutVoteConv.Decode, inner.TimeoutVote, func(v *types.FullTimeoutVote) string {
+			return fmt.Sprintf("view=%s signer=%v", fmtView(v.View()), v.Vote().Key())
+		}))
+	}
+}
+
+func (s *Stores) printLanes(p *printer) {
+	lanes := make([]types.LaneID, 0, len(s.Lanes))
+	for lane := range s.Lanes {
+		lanes = append(lanes, lane)
+	}
+	slices.SortFunc(lanes, func(a, b types.LaneID) int { return a.Compare(b) })
+	p.printf("== lanes (%d)\n", len(lanes))
+	for _, lane := range lanes {
+		blocks := s.Lanes[lane]
+		p.printf("  lane %v: %d blocks\n", lane, len(blocks))
+		for _, b := range blocks {
+			p.printf("    %s\n", fmtHeader(b.Proposal.Msg().Block()))
+		}
+	}
+}
+
+func (s *Stores) printHeights(p *printer, heights types.GlobalRange) error {
+	status, ok := s.BlockStore.Status().Get()
+	if !ok {
+		p.printf("== blockstore: empty\n")
+		return p.err
+	}
+	first := max(heights.First, s.BlockStore.First())
+	next := min(heights.Next, status.NextBlock)
+	p.printf("== blockstore first=%d next_qc=%d next_block=%d next_app_proposal=%d next_app_qc=%d\n",
+		s.BlockStore.First(), status.NextQC, status.NextBlock, status.NextAppProposal, status.NextAppQC)
+	for n := first; n < next && p.err == nil; n++ {
+		// QCs cover ranges of blocks; print them at the start of their range.
+		qc, err := s.BlockStore.ReadQCByBlockNumber(n)
+		if err != nil {
+			return fmt.Errorf("ReadQCByBlockNumber(%d): %w", n, err)
+		}
+		if qc, ok := qc.Get(); ok && (n == first || qc.QC().GlobalRange().First == n) {
+			p.printf("  %s\n", fmtCommitQC(qc.QC()))
+		}
+		appProposal, err := s.BlockStore.ReadAppProposalByBlockNumber(n)
+		if err != nil {
+			return fmt.Errorf("ReadAppProposalByBlockNumber(%d): %w", n, err)
+		}
+		if ap, ok := appProposal.Get(); ok && (n == first || ap.GlobalRange().First == n) {
+			p.printf("    app_proposal road=%d global=%v app_hash=%X\n", ap.RoadIndex(), ap.GlobalRange(), []byte(ap.AppHash()))
+		}
+		appQC, err := s.BlockStore.ReadAppQCByBlockNumber(n)
+		if err != nil {
+			return fmt.Errorf("ReadAppQCByBlockNumber(%d): %w", n, err)
+		}
+		if qc, ok := appQC.Get(); ok && (n == first || qc.Proposal().GlobalRange().First == n) {
+			p.printf("    app_qc road=%d app_hash=%X app_votes=%v\n", qc.Proposal().RoadIndex(), []byte(qc.Proposal().AppHash()), qc.Signers())
+		}
+		block, err := s.BlockStore.ReadBlockByNumber(n)
+		if err != nil {
+			return fmt.Errorf("ReadBlockByNumber(%d): %w", n, err)
+		}
+		if b, ok := block.Get(); ok {
+			p.printf("    height %d: %s\n", n, fmtHeader(b))
+		} else {
+			p.printf("    height %d: missing\n", n)
+		}
+	}
+	return p.err
+}
+
+// printer writes formatted output, remembering the first error.
+type printer struct {
+	w   io.Writer
+	err error
+}
+
+func (p *printer) printf(format string, args ...any) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+// decode decodes a persisted message and formats it. Decoding errors are
+// formatted instead, since they are what the operator is looking for.
+func decode[T, P any](conv func(P) (T, error), msg P, format func(T) string) string {
+	m, err := conv(msg)
+	if err != nil {
+		return fmt.Sprintf("CORRUPT: %v", err)
+	}
+	return format(m)
+}
+
+func fmtView(v types.View) string {
+	return fmt.Sprintf("(epoch=%d index=%d number=%d)", v.EpochIndex, v.Index, v.Number)
+}
+
+func fmtCommitQC(qc *types.CommitQC) string {
+	return fmt.Sprintf("commit_qc road=%d view=%s global=%v time=%v commit_votes=%v",
+		qc.Index(), fmtView(qc.Proposal().View()), qc.GlobalRange(), qc.Proposal().Timestamp(), qc.Signers())
+}
+
+func fmtHeader(b *types.Block) string {
+	h := b.Header()
+	return fmt.Sprintf("lane=%v block=%d hash=%v parent=%v txs=%d",
+		h.Lane(), h.BlockNumber(), h.Hash(), h.ParentHash(), len(b.Payload().Txs()))
+}
@@ -0,0 +1,60 @@
+package inspect
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/consensus/persist"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/epoch"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/pb"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+)
+
+func TestOpen_PrintsPersistedState(t *testing.T) {
+	rng := utils.TestRng()
+	_, keys := epoch.GenRegistry(rng, 3)
+	view := types.View{Index: 0, Number: 2}
+	var votes []*types.FullTimeoutVote
+	for _, k := range keys {
+		votes = append(votes, types.NewFullTimeoutVote(k, view, utils.None[*types.PrepareQC]()))
+	}
+	tqc := types.NewTimeoutQC(votes)
+
+	stateDir := t.TempDir()
+	p, _, err := persist.NewPersister[*pb.PersistedInner](utils.Some(stateDir), innerFile)
+	require.NoError(t, err)
+	require.NoError(t, p.Persist(&pb.PersistedInner{TimeoutQc: types.TimeoutQCConv.Encode(tqc)}))
+	readState := func() map[string][]byte {
+		entries, err := os.ReadDir(stateDir)
+		require.NoError(t, err)
+		files := map[string][]byte{}
+		for _, e := range entries {
+			if e.IsDir() {
+				continue
+			}
+			data, err := os.ReadFile(filepath.Join(stateDir, e.Name()))
+			require.NoError(t, err)
+			files[e.Name()] = data
+		}
+		return files
+	}
+	before := readState()
+
+	stores, err := Open(stateDir, config.AutobahnBlockDBConfig{})
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, stores.Print(&out, types.GlobalRange{Next: 100}))
+	require.Contains(t, out.String(), "timeout_qc: view=(epoch=0 index=0 number=2)")
+	require.Contains(t, out.String(), "== blockstore: empty")
+	require.NoError(t, stores.Close())
+
+	// The consensus state is read in place and the WALs are not created.
+	require.Equal(t, before, readState())
+	require.NoDirExists(t, filepath.Join(stateDir, "commitqcs"))
+	require.NoDirExists(t, filepath.Join(stateDir, "blocks"))
+}
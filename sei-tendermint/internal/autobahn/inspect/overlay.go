@@ -0,0 +1,128 @@
+package inspect
+
+import (
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+)
+
+// overlayWrites are the records written to an overlayStore, by global block number.
+type overlayWrites struct {
+	blocks       map[types.GlobalBlockNumber]*types.Block
+	byHash       map[types.BlockHeaderHash]types.BlockWithNumber
+	qcs          map[types.GlobalBlockNumber]*types.FullCommitQC
+	appProposals map[types.GlobalBlockNumber]*types.AppProposal
+	appQCs       map[types.GlobalBlockNumber]*types.AppQC
+}
+
+// overlayStore is a BlockStore which reads through to the persisted
+// BlockStore of the node, but keeps everything written to it in memory, so
+// that replay never modifies the node's state. Pruning and flushing are
+// no-ops, and Status, ReadSuffix and First report the persisted store only:
+// data.State reads them only when it is constructed.
+type overlayStore struct {
+	types.BlockStore
+	writes utils.Mutex[*overlayWrites]
+}
+
+func newOverlayStore(base types.BlockStore) *overlayStore {
+	return &overlayStore{
+		BlockStore: base,
+		writes: utils.NewMutex(&overlayWrites{
+			blocks:       map[types.GlobalBlockNumber]*types.Block{},
+			byHash:       map[types.BlockHeaderHash]types.BlockWithNumber{},
+			qcs:          map[types.GlobalBlockNumber]*types.FullCommitQC{},
+			appProposals: map[types.GlobalBlockNumber]*types.AppProposal{},
+			appQCs:       map[types.GlobalBlockNumber]*types.AppQC{},
+		}),
+	}
+}
+
+func (s *overlayStore) WriteBlock(n types.GlobalBlockNumber, block *types.Block) error {
+	for w := range s.writes.Lock() {
+		w.blocks[n] = block
+		w.byHash[block.Header().Hash()] = types.BlockWithNumber{Block: block, Number: n}
+	}
+	return nil
+}
+
+func (s *overlayStore) WriteQC(qc *types.FullCommitQC) error {
+	for w := range s.writes.Lock() {
+		r := qc.QC().GlobalRange()
+		for n := r.First; n < r.Next; n++ {
+			w.qcs[n] = qc
+		}
+	}
+	return nil
+}
+
+func (s *overlayStore) WriteAppProposal(appProposal *types.AppProposal) error {
+	for w := range s.writes.Lock() {
+		r := appProposal.GlobalRange()
+		for n := r.First; n < r.Next; n++ {
+			w.appProposals[n] = appProposal
+		}
+	}
+	return nil
+}
+
+func (s *overlayStore) WriteAppQC(appQC *types.AppQC) error {
+	for w := range s.writes.Lock() {
+		r := appQC.Proposal().GlobalRange()
+		for n := r.First; n < r.Next; n++ {
+			w.appQCs[n] = appQC
+		}
+	}
+	return nil
+}
+
+func (s *overlayStore) PruneBefore(types.GlobalBlockNumber) error { return nil }
+
+func (s *overlayStore) Flush() error { return nil }
+
+// Close does not close the persisted store, which is owned by Stores.
+func (s *overlayStore) Close() error { return nil }
+
+func (s *overlayStore) ReadBlockByNumber(n types.GlobalBlockNumber) (utils.Option[*types.Block], error) {
+	for w := range s.writes.Lock() {
+		if b, ok := w.blocks[n]; ok {
+			return utils.Some(b), nil
+		}
+	}
+	return s.BlockStore.ReadBlockByNumber(n)
+}
+
+func (s *overlayStore) ReadBlockByHash(hash types.BlockHeaderHash) (utils.Option[types.BlockWithNumber], error) {
+	for w := range s.writes.Lock() {
+		if b, ok := w.byHash[hash]; ok {
+			return utils.Some(b), nil
+		}
+	}
+	return s.BlockStore.ReadBlockByHash(hash)
+}
+
+func (s *overlayStore) ReadQCByBlockNumber(n types.GlobalBlockNumber) (utils.Option[*types.FullCommitQC], error) {
+	for w := range s.writes.Lock() {
+		if qc, ok := w.qcs[n]; ok {
+			return utils.Some(qc), nil
+		}
+	}
+	return s.BlockStore.ReadQCByBlockNumber(n)
+}
+
+func (s *overlayStore) ReadAppProposalByBlockNumber(n types.GlobalBlockNumber) (utils.Option[*types.AppProposal], error) {
+	for w := range s.writes.Lock() {
+		if p, ok := w.appProposals[n]; ok {
+			return utils.Some(p), nil
+		}
+	}
+	return s.BlockStore.ReadAppProposalByBlockNumber(n)
+}
+
+func (s *overlayStore) ReadAppQCByBlockNumber(n types.GlobalBlockNumber) (utils.Option[*types.AppQC], error) {
+	for w := range s.writes.Lock() {
+		if qc, ok := w.appQCs[n]; ok {
+			return utils.Some(qc), nil
+		}
+	}
+	return s.BlockStore.ReadAppQCByBlockNumber(n)
+}
@@ -0,0 +1,303 @@
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/autobahn/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/consensus"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/autobahn/data"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/scope"
+)
+
+// ReplayConfig configures Stores.Replay.
+type ReplayConfig struct {
+	// Key of the replaying consensus state. To reproduce the behaviour of
+	// the node (e.g. reproposals of a leader), use the node's validator key.
+	// Nothing signed during replay leaves the process.
+	Key types.SecretKey
+}
+
+// errStalled marks an input which is not applicable in order: it follows a
+// gap in the persisted state, so pushing it would wait forever.
+var errStalled = errors.New("not applicable in order")
+
+// Replay pushes the persisted inputs, in a deterministic order, into a fresh
+// consensus state built on top of ds, and reports to w whether each input
+// was accepted, followed by the state reached. ds should be built on
+// s.ReplayBlockStore(), so that replay starts where the finalized blocks end
+// without modifying them.
+//
+// Replay runs on a logical clock: views never time out on their own, only
+// through the replayed TimeoutQC and timeout votes, and every input is
+// checked against the inputs applied before it instead of against a
+// deadline. The inputs are, in order: for every CommitQC (by road index,
+// including the one of the persisted consensus state) the lane blocks it
+// finalizes, the CommitQC itself and the app votes of its AppQC from the
+// BlockStore; then the remaining lane blocks; then the TimeoutQC and the
+// node's own votes from the persisted consensus state. A rejected input shows where the persisted state diverges from what
+// the committee signed (e.g. a fork), a stalled one shows a gap, and the
+// reported view and CommitQC show where consensus stalls.
+func (s *Stores) Replay(ctx context.Context, ds *data.State, cfg *ReplayConfig, w io.Writer) error {
+	cs, err := consensus.NewState(&consensus.Config{
+		Key:         cfg.Key,
+		ViewTimeout: func(types.View) time.Duration { return time.Duration(math.MaxInt64) },
+		// The consensus state is rebuilt from the pushed inputs rather than
+		// loaded from the state directory.
+		PersistentStateDir: utils.None[string](),
+	}, ds)
+	if err != nil {
+		return fmt.Errorf("consensus.NewState: %w", err)
+	}
+	p := &printer{w: w}
+	err = scope.Run(ctx, func(ctx context.Context, sc scope.Scope) error {
+		sc.SpawnBg(func() error { return utils.IgnoreCancel(ds.Run(ctx)) })
+		sc.SpawnBg(func() error { return utils.IgnoreCancel(cs.Run(ctx)) })
+		r := newReplayer(s, cs)
+		p.printf("== replay\n")
+		for _, step := range r.steps() {
+			err := step.push(ctx)
+			switch {
+			case err == nil:
+				p.printf("  ok       %s\n", step.name)
+			case errors.Is(err, errStalled):
+				p.printf("  STALLED  %s: %v\n", step.name, err)
+			case ctx.Err() != nil:
+				return ctx.Err()
+			default:
+				p.printf("  REJECTED %s: %v\n", step.name, err)
+			}
+		}
+		if err := r.settle(ctx, ds, p); err != nil {
+			return err
+		}
+		p.printf("== replayed state\n")
+		p.printf("  view: %s\n", fmtView(cs.View()))
+		if qc, ok := cs.Avail().LastCommitQC().Load().Get(); ok {
+			p.printf("  last %s\n", fmtCommitQC(qc))
+		} else {
+			p.printf("  last commit_qc: none\n")
+		}
+		p.printf("  next block: %d\n", ds.NextBlock())
+		p.printf("  next app_qc: %d\n", ds.NextAppQC())
+		if v, ok := cs.SubscribeTimeoutVote().Load().Get(); ok {
+			p.printf("  timeout vote: view=%s\n", fmtView(v.View()))
+		}
+		return p.err
+	})
+	return errors.Join(err, cs.Close())
+}
+
+type replayStep struct {
+	name string
+	push func(ctx context.Context) error
+}
+
+// replayer tracks the logical progress of a replay: the next road index the
+// consensus state accepts a CommitQC for. The next block of each lane is
+// read from the state directly, since a block it drops (e.g. on a parent
+// hash mismatch) does not advance it.
+type replayer struct {
+	stores   *Stores
+	cs       *consensus.State
+	nextRoad types.RoadIndex
+	// Road index the replay started at.
+	firstRoad types.RoadIndex
+}
+
+func newReplayer(s *Stores, cs *consensus.State) *replayer {
+	next := cs.Avail().First()
+	if qc, ok := cs.Avail().LastCommitQC().Load().Get(); ok {
+		next = qc.Index() + 1
+	}
+	return &replayer{stores: s, cs: cs, nextRoad: next, firstRoad: next}
+}
+
+func (r *replayer) steps() []replayStep {
+	var steps []replayStep
+	lanes := make([]types.LaneID, 0, len(r.stores.Lanes))
+	for lane := range r.stores.Lanes {
+		lanes = append(lanes, lane)
+	}
+	slices.SortFunc(lanes, func(a, b types.LaneID) int { return a.Compare(b) })
+	// Index of the next block to push in every lane's persisted blocks.
+	pos := map[types.LaneID]int{}
+	pushBlocks := func(lane types.LaneID, before types.BlockNumber) {
+		blocks := r.stores.Lanes[lane]
+		for ; pos[lane] < len(blocks) && blocks[pos[lane]].Number < before; pos[lane]++ {
+			steps = append(steps, r.blockStep(blocks[pos[lane]].Proposal))
+		}
+	}
+
+	qcs := slices.Clone(r.stores.CommitQCs)
+	inner, hasInner := r.stores.Inner.Get()
+	// Corrupt messages are reported by Print; replay skips them.
+	if hasInner && inner.CommitQc != nil {
+		if qc, err := types.CommitQCConv.Decode(inner.CommitQc); err == nil {
+			if len(qcs) == 0 || qcs[len(qcs)-1].Index() < qc.Index() {
+				qcs = append(qcs, qc)
+			}
+		}
+	}
+	for _, qc := range qcs {
+		for _, lane := range lanes {
+			pushBlocks(lane, qc.LaneRange(lane).Next())
+		}
+		steps = append(steps, r.commitQCStep(qc))
+		steps = append(steps, r.appVoteSteps(qc)...)
+	}
+	for _, lane := range lanes {
+		pushBlocks(lane, math.MaxUint64)
+	}
+	if !hasInner {
+		return steps
+	}
+	if inner.TimeoutQc != nil {
+		if qc, err := types.TimeoutQCConv.Decode(inner.TimeoutQc); err == nil {
+			steps = append(steps, replayStep{
+				name: fmt.Sprintf("timeout_qc view=%s", fmtView(qc.View())),
+				push: func(ctx context.Context) error {
+					// The consensus state reaches the view of the TimeoutQC
+					// once it has the CommitQC preceding it.
+					if qc.View().Index > r.nextRoad {
+						return fmt.Errorf("%w: commit_qc %d is missing", errStalled, r.nextRoad)
+					}
+					return r.cs.PushTimeoutQC(ctx, qc)
+				},
+			})
+		}
+	}
+	if inner.PrepareVoteV2 != nil {
+		if v, err := types.SignedPrepareVoteConv.Decode(inner.PrepareVoteV2); err == nil {
+			steps = append(steps, replayStep{
+				name: fmt.Sprintf("prepare_vote view=%s signer=%v", fmtView(v.Msg().Proposal().View()), v.Key()),
+				push: func(context.Context) error { return r.cs.PushPrepareVote(v) },
+			})
+		}
+	}
+	if inner.CommitVoteV2 != nil {
+		if v, err := types.SignedCommitVoteConv.Decode(inner.CommitVoteV2); err == nil {
+			steps = append(steps, replayStep{
+				name: fmt.Sprintf("commit_vote view=%s signer=%v", fmtView(v.Msg().Proposal().View()), v.Key()),
+				push: func(context.Context) error { return r.cs.PushCommitVote(v) },
+			})
+		}
+	}
+	if inner.TimeoutVote != nil {
+		if v, err := types.FullTimeoutVoteConv.Decode(inner.TimeoutVote); err == nil {
+			steps = append(steps, replayStep{
+				name: fmt.Sprintf("timeout_vote view=%s signer=%v", fmtView(v.View()), v.Vote().Key()),
+				push: func(context.Context) error { return r.cs.PushTimeoutVote(v) },
+			})
+		}
+	}
+	return steps
+}
+
+func (r *replayer) blockStep(p *types.Signed[*types.LaneProposal]) replayStep {
+	h := p.Msg().Block().Header()
+	return replayStep{
+		name: fmt.Sprintf("lane block %s", fmtHeader(p.Msg().Block())),
+		push: func(ctx context.Context) error {
+			if next := r.cs.Avail().NextBlock(h.Lane()); h.BlockNumber() > next {
+				return fmt.Errorf("%w: lane block %d is missing", errStalled, next)
+			}
+			if err := r.cs.Avail().PushBlock(ctx, p); err != nil {
+				return err
+			}
+			if r.cs.Avail().NextBlock(h.Lane()) <= h.BlockNumber() {
+				return errors.New("dropped (lane not in the committee or parent hash mismatch)")
+			}
+			return nil
+		},
+	}
+}
+
+func (r *replayer) commitQCStep(qc *types.CommitQC) replayStep {
+	return replayStep{
+		name: fmtCommitQC(qc),
+		push: func(ctx context.Context) error {
+			if qc.Index() > r.nextRoad {
+				return fmt.Errorf("%w: commit_qc %d is missing", errStalled, r.nextRoad)
+			}
+			if err := r.cs.Avail().PushCommitQC(ctx, qc); err != nil {
+				return err
+			}
+			r.nextRoad = max(r.nextRoad, qc.Index()+1)
+			return nil
+		},
+	}
+}
+
+// appVoteSteps pushes the app votes of the AppQC finalized for qc, if the
+// BlockStore has one. AppQCs are only persisted with the finalized blocks,
+// so the app votes of the latest CommitQCs are not replayed.
+func (r *replayer) appVoteSteps(qc *types.CommitQC) []replayStep {
+	appQC, err := r.stores.BlockStore.ReadAppQCByBlockNumber(qc.GlobalRange().First)
+	if err != nil {
+		return []replayStep{{
+			name: fmt.Sprintf("app_qc road=%d", qc.Index()),
+			push: func(context.Context) error { return fmt.Errorf("ReadAppQCByBlockNumber: %w", err) },
+		}}
+	}
+	aqc, ok := appQC.Get()
+	if !ok || aqc.Proposal().RoadIndex() != qc.Index() {
+		return nil
+	}
+	var steps []replayStep
+	for _, v := range aqc.Votes() {
+		steps = append(steps, replayStep{
+			name: fmt.Sprintf("app_vote road=%d app_hash=%X signer=%v", qc.Index(), []byte(aqc.Proposal().AppHash()), v.Key()),
+			push: func(ctx context.Context) error {
+				if qc.Index() >= r.nextRoad {
+					return fmt.Errorf("%w: commit_qc %d is missing", errStalled, qc.Index())
+				}
+				return r.cs.Avail().PushAppVote(ctx, v)
+			},
+		})
+	}
+	return steps
+}
+
+// settle waits until the consensus state has processed the pushed
+// CommitQCs, and the data state has finalized the blocks they cover. It
+// waits on the progress of the states rather than for a fixed time.
+func (r *replayer) settle(ctx context.Context, ds *data.State, p *printer) error {
+	if r.nextRoad == r.firstRoad {
+		return nil
+	}
+	last, err := r.cs.Avail().LastCommitQC().Wait(ctx, func(last utils.Option[*types.CommitQC]) bool {
+		qc, ok := last.Get()
+		return ok && qc.Index()+1 >= r.nextRoad
+	})
+	if err != nil {
+		return err
+	}
+	qc := last.OrPanic("waited for a CommitQC")
+	ep, ok := ds.Registry().EpochByIndex(qc.Proposal().EpochIndex())
+	if !ok {
+		return fmt.Errorf("unknown epoch_index %d", qc.Proposal().EpochIndex())
+	}
+	var missing []types.LaneID
+	for lane := range ep.Committee().Lanes().All() {
+		if r.cs.Avail().NextBlock(lane) < qc.LaneRange(lane).Next() {
+			missing = append(missing, lane)
+		}
+	}
+	if len(missing) > 0 {
+		p.printf("  STALLED  finalizing %s: lanes %v are missing blocks\n", fmtCommitQC(qc), missing)
+		return nil
+	}
+	if gr := qc.GlobalRange(); gr.Next > gr.First {
+		if _, err := ds.Block(ctx, gr.Next-1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
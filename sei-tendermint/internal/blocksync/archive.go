@@ -0,0 +1,198 @@
+package blocksync
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/sei-protocol/sei-chain/sei-db/ledger_db/block/littblock"
+	blocktypes "github.com/sei-protocol/sei-chain/sei-db/ledger_db/block/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/consensus"
+	sm "github.com/sei-protocol/sei-chain/sei-tendermint/internal/state"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// ArchivedBlock is a block together with the commit which finalized it.
+type ArchivedBlock struct {
+	Block  *types.Block
+	Commit *types.Commit
+}
+
+// Archive is a block archive backed by a LittDB BlockDB, used to catch up
+// without fetching blocks from peers.
+//
+// Block at height h is stored as a KindBlock record at h, and the commit for it
+// as a KindQC record at h. The commit is written first, so that a truncated
+// archive never holds a block without its commit.
+type Archive struct {
+	db blocktypes.BlockDB
+}
+
+// OpenArchive opens the block archive in dir. A missing or empty dir is an error
+// unless create is set, so that a mistyped path does not open a fresh, empty archive.
+func OpenArchive(dir string, create bool) (*Archive, error) {
+	if !create {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("no block archive in %q: %w", dir, err)
+		}
+		if len(entries) == 0 {
+			return nil, fmt.Errorf("no block archive in %q", dir)
+		}
+	}
+	cfg, err := littblock.DefaultConfig(dir)
+	if err != nil {
+		return nil, fmt.Errorf("littblock.DefaultConfig(): %w", err)
+	}
+	db, err := littblock.NewBlockDB(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("littblock.NewBlockDB(): %w", err)
+	}
+	return &Archive{db: db}, nil
+}
+
+// Put appends a block and the commit for it to the archive.
+// Records already in the archive are kept, so that an export interrupted
+// between the commit and the block resumes by writing just the block.
+func (a *Archive) Put(block *types.Block, commit *types.Commit) error {
+	if block.Height <= 0 {
+		return fmt.Errorf("invalid block height %d", block.Height)
+	}
+	if commit.Height != block.Height {
+		return fmt.Errorf("commit height %d does not match block height %d", commit.Height, block.Height)
+	}
+	h := uint64(block.Height) //nolint:gosec // checked to be positive above.
+	_, hasCommit, err := a.db.GetRecord(blocktypes.KindQC, h)
+	if err != nil {
+		return fmt.Errorf("GetRecord(QC, %d): %w", h, err)
+	}
+	if !hasCommit {
+		commitBytes, err := commit.ToProto().Marshal()
+		if err != nil {
+			return fmt.Errorf("commit.Marshal(): %w", err)
+		}
+		if err := a.db.PutRecord(blocktypes.KindQC, h, h+1, commitBytes); err != nil {
+			return fmt.Errorf("PutRecord(%d): %w", h, err)
+		}
+	}
+	_, hasBlock, err := a.db.GetRecord(blocktypes.KindBlock, h)
+	if err != nil {
+		return fmt.Errorf("GetRecord(Block, %d): %w", h, err)
+	}
+	if !hasBlock {
+		bp, err := block.ToProto()
+		if err != nil {
+			return fmt.Errorf("block.ToProto(): %w", err)
+		}
+		blockBytes, err := bp.Marshal()
+		if err != nil {
+			return fmt.Errorf("block.Marshal(): %w", err)
+		}
+		if err := a.db.PutBlock(h, block.Hash(), blockBytes); err != nil {
+			return fmt.Errorf("PutBlock(%d): %w", h, err)
+		}
+	}
+	return nil
+}
+
+// Get returns the block at the given height, or None if the archive does not have it.
+func (a *Archive) Get(height int64) (utils.Option[ArchivedBlock], error) {
+	none := utils.None[ArchivedBlock]()
+	if height <= 0 {
+		return none, nil
+	}
+	h := uint64(height) //nolint:gosec // checked to be positive above.
+	blockBytes, ok, err := a.db.GetRecord(blocktypes.KindBlock, h)
+	if err != nil || !ok {
+		return none, err
+	}
+	commitBytes, ok, err := a.db.GetRecord(blocktypes.KindQC, h)
+	if err != nil || !ok {
+		return none, err
+	}
+	var bp tmproto.Block
+	if err := bp.Unmarshal(blockBytes); err != nil {
+		return none, fmt.Errorf("block.Unmarshal(%d): %w", h, err)
+	}
+	block, err := types.BlockFromProto(&bp)
+	if err != nil {
+		return none, fmt.Errorf("types.BlockFromProto(%d): %w", h, err)
+	}
+	var cp tmproto.Commit
+	if err := cp.Unmarshal(commitBytes); err != nil {
+		return none, fmt.Errorf("commit.Unmarshal(%d): %w", h, err)
+	}
+	commit, err := types.CommitFromProto(&cp)
+	if err != nil {
+		return none, fmt.Errorf("types.CommitFromProto(%d): %w", h, err)
+	}
+	if block.Height != height || commit.Height != height {
+		return none, fmt.Errorf("archive record at %d holds block %d and commit %d", height, block.Height, commit.Height)
+	}
+	return utils.Some(ArchivedBlock{Block: block, Commit: commit}), nil
+}
+
+// Close flushes pending writes and closes the archive.
+func (a *Archive) Close() error {
+	if err := a.db.Flush(); err != nil {
+		_ = a.db.Close()
+		return fmt.Errorf("Flush(): %w", err)
+	}
+	return a.db.Close()
+}
+
+// importArchive applies the blocks available in the archive on top of state,
+// verifying them exactly as blocks fetched from peers are verified. It stops at
+// the first height missing from the archive, or at the freeze height, and
+// returns the resulting state and the number of blocks applied.
+func (s *syncController) importArchive(ctx context.Context, state sm.State) (sm.State, uint64, error) {
+	archive, err := OpenArchive(s.archiveDir, false)
+	if err != nil {
+		return state, 0, fmt.Errorf("OpenArchive(%q): %w", s.archiveDir, err)
+	}
+	defer func() { _ = archive.Close() }()
+
+	logger.Info("importing blocks from archive", "dir", s.archiveDir, "height", startHeightForState(state))
+	var blocksSynced uint64
+	for !s.shouldFreeze(state) {
+		if err := ctx.Err(); err != nil {
+			return state, blocksSynced, err
+		}
+		height := startHeightForState(state)
+		ab, err := archive.Get(height)
+		if err != nil {
+			return state, blocksSynced, err
+		}
+		b, ok := ab.Get()
+		if !ok {
+			break
+		}
+		parts, err := b.Block.MakePartSet(types.BlockPartSizeBytes)
+		if err != nil {
+			return state, blocksSynced, fmt.Errorf("block.MakePartSet(%d): %w", height, err)
+		}
+		blockID := types.BlockID{Hash: b.Block.Hash(), PartSetHeader: parts.Header()}
+		if err := state.Validators.VerifyCommitLight(state.ChainID, blockID, height, b.Commit); err != nil {
+			if err := types.DefaultConsensusPolicy().HandleError(fmt.Errorf("%w: %w", types.ErrLastCommitVerify, err)); err != nil {
+				return state, blocksSynced, fmt.Errorf("archived block %d: %w", height, err)
+			}
+		}
+		if err := s.blockExec.ValidateBlock(ctx, state, b.Block); err != nil {
+			return state, blocksSynced, fmt.Errorf("archived block %d: %w", height, err)
+		}
+		s.store.SaveBlock(b.Block, parts, b.Commit)
+		state, err = s.blockExec.ApplyBlock(ctx, state, blockID, b.Block, nil)
+		if err != nil {
+			panic(fmt.Sprintf("failed to process committed block (%d:%X): %v", height, blockID.Hash, err))
+		}
+		consensus.Global.RecordConsMetrics(b.Block)
+		blocksSynced++
+		if blocksSynced%1000 == 0 {
+			logger.Info("importing blocks from archive", "height", height)
+		}
+	}
+	logger.Info("finished importing blocks from archive", "height", state.LastBlockHeight, "blocks", blocksSynced)
+	return state, blocksSynced, nil
+}
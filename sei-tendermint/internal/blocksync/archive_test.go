@@ -0,0 +1,131 @@
+package blocksync
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	blocktypes "github.com/sei-protocol/sei-chain/sei-db/ledger_db/block/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/test/factory"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+func TestArchive_Import(t *testing.T) {
+	ctx := t.Context()
+
+	cfg, err := config.ResetTestRoot(t.TempDir(), "block_sync_archive_test")
+	require.NoError(t, err)
+	valSet, privVals := factory.ValidatorSet(ctx, 1, 30)
+	genDoc := factory.GenesisDoc(cfg, time.Now(), valSet.Validators, factory.ConsensusParams())
+	network := p2p.MakeTestNetwork(t, p2p.TestNetworkOptions{NumNodes: 2})
+	ids := network.NodeIDs()
+	remediationConfig := config.DefaultSelfRemediationConfig()
+	src := makeReactor(ctx, t, genDoc, network.Node(ids[0]).Router, false, func() {}, remediationConfig)
+	dst := makeReactor(ctx, t, genDoc, network.Node(ids[1]).Router, false, func() {}, remediationConfig)
+
+	t.Log("archive a chain produced by the source node")
+	const maxBlockHeight = 10
+	dir := t.TempDir()
+	archive, err := OpenArchive(dir, true)
+	require.NoError(t, err)
+	state, err := src.stateStore.Load()
+	require.NoError(t, err)
+	srcSyncer := src.syncer.OrPanic("syncer should be configured in tests")
+	lastCommit := &types.Commit{}
+	for height := int64(1); height <= maxBlockHeight; height++ {
+		block, blockID, _, seenCommit := makeNextBlock(ctx, t, state, privVals[0], height, lastCommit)
+		state, err = srcSyncer.blockExec.ApplyBlock(ctx, state, blockID, block, nil)
+		require.NoError(t, err)
+		if height == maxBlockHeight {
+			t.Log("an export interrupted after the commit is resumed by writing the block")
+			commitBytes, err := seenCommit.ToProto().Marshal()
+			require.NoError(t, err)
+			h := uint64(height)
+			require.NoError(t, archive.db.PutRecord(blocktypes.KindQC, h, h+1, commitBytes))
+			ab, err := archive.Get(height)
+			require.NoError(t, err)
+			require.False(t, ab.IsPresent())
+		}
+		require.NoError(t, archive.Put(block, seenCommit))
+		lastCommit = seenCommit
+	}
+	ab, err := archive.Get(maxBlockHeight)
+	require.NoError(t, err)
+	require.True(t, ab.IsPresent())
+	require.NoError(t, archive.Close())
+
+	t.Log("import the archive on the destination node")
+	syncer := dst.syncer.OrPanic("syncer should be configured in tests")
+	syncer.archiveDir = dir
+	initial, err := dst.stateStore.Load()
+	require.NoError(t, err)
+	imported, blocksSynced, err := syncer.importArchive(ctx, initial)
+	require.NoError(t, err)
+	require.Equal(t, uint64(maxBlockHeight), blocksSynced)
+	require.Equal(t, int64(maxBlockHeight), imported.LastBlockHeight)
+	require.Equal(t, state.AppHash, imported.AppHash)
+	require.Equal(t, int64(maxBlockHeight), dst.store.Height())
+
+	t.Log("importing again is a no-op")
+	again, blocksSynced, err := syncer.importArchive(ctx, imported)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), blocksSynced)
+	require.Equal(t, imported.LastBlockHeight, again.LastBlockHeight)
+}
+
+func TestArchive_RejectsBadCommit(t *testing.T) {
+	ctx := t.Context()
+
+	cfg, err := config.ResetTestRoot(t.TempDir(), "block_sync_archive_test")
+	require.NoError(t, err)
+	valSet, privVals := factory.ValidatorSet(ctx, 1, 30)
+	genDoc := factory.GenesisDoc(cfg, time.Now(), valSet.Validators, factory.ConsensusParams())
+	network := p2p.MakeTestNetwork(t, p2p.TestNetworkOptions{NumNodes: 1})
+	r := makeReactor(ctx, t, genDoc, network.Node(network.NodeIDs()[0]).Router, false, func() {}, config.DefaultSelfRemediationConfig())
+	syncer := r.syncer.OrPanic("syncer should be configured in tests")
+
+	t.Log("archive a block with a commit for a different block")
+	state, err := r.stateStore.Load()
+	require.NoError(t, err)
+	block, _, _, _ := makeNextBlock(ctx, t, state, privVals[0], 1, &types.Commit{})
+	other := block.Header
+	other.Time = other.Time.Add(time.Second)
+	otherBlock := &types.Block{Header: other, Data: block.Data, LastCommit: block.LastCommit}
+	otherParts, err := otherBlock.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, err)
+	otherID := types.BlockID{Hash: otherBlock.Hash(), PartSetHeader: otherParts.Header()}
+	vote, err := factory.MakeVote(ctx, privVals[0], block.ChainID, 0, 1, 0, 2, otherID, time.Now())
+	require.NoError(t, err)
+	commit := &types.Commit{Height: 1, BlockID: otherID, Signatures: []types.CommitSig{vote.CommitSig()}}
+
+	dir := t.TempDir()
+	archive, err := OpenArchive(dir, true)
+	require.NoError(t, err)
+	require.NoError(t, archive.Put(block, commit))
+	require.NoError(t, archive.Close())
+
+	syncer.archiveDir = dir
+	_, blocksSynced, err := syncer.importArchive(ctx, state)
+	require.Error(t, err)
+	require.Equal(t, uint64(0), blocksSynced)
+	require.Equal(t, int64(0), r.store.Height())
+}
+
+func TestArchive_OpenRequiresCreate(t *testing.T) {
+	dir := t.TempDir()
+	_, err := OpenArchive(dir, false)
+	require.Error(t, err)
+	_, err = OpenArchive(filepath.Join(dir, "missing"), false)
+	require.Error(t, err)
+
+	archive, err := OpenArchive(dir, true)
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+	archive, err = OpenArchive(dir, false)
+	require.NoError(t, err)
+	require.NoError(t, archive.Close())
+}
@@ -23,5 +23,10 @@ Active syncing itself is handled by a separate sync controller that manages the
 block pool, requests blocks, applies them locally, and hands off to consensus
 once caught up. Sync-specific responses received on the shared channel are
 forwarded into that controller.
+
+A node may also catch up from a block archive (see Archive), written offline by
+the export-blocks command. When configured, the sync controller applies the
+blocks available in the archive, verified the same way as blocks received from
+peers, before requesting the remaining blocks from the network.
 */
 package blocksync
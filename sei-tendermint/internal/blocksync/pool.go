@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/flowrate"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/scope"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
@@ -166,7 +167,7 @@ func (pool *BlockPool) removeTimedoutPeers() {
 	var errsToSend []peerError
 	defer func() {
 		for _, pe := range errsToSend {
-			pool.sendError(pe.err, pe.peerID)
+			pool.reportErr(pe)
 		}
 	}()
 	pool.mtx.Lock()
@@ -179,7 +180,7 @@ func (pool *BlockPool) removeTimedoutPeers() {
 			// curRate can be 0 on start
 			if curRate != 0 && curRate < minRecvRate {
 				err := errors.New("peer is not sending us data fast enough")
-				errsToSend = append(errsToSend, peerError{err, peer.id})
+				errsToSend = append(errsToSend, peerError{utils.Some(p2p.PeerSlowResponse), err, peer.id})
 				logger.Error("SendTimeout", "peer", peer.id,
 					"reason", err,
 					"curRate-kbps", curRate/1024,
@@ -240,11 +241,13 @@ func (pool *BlockPool) PeekTwoBlocks() (first, second *types.Block) {
 
 // PopRequest pops the first block at pool.height.
 // It must have been validated by the second Commit from PeekTwoBlocks.
-func (pool *BlockPool) PopRequest() {
+// Returns the peer which sent the block.
+func (pool *BlockPool) PopRequest() utils.Option[types.NodeID] {
 	pool.mtx.Lock()
 	defer pool.mtx.Unlock()
 
 	if r, ok := pool.requesters[pool.height]; ok {
+		peerID := r.getPeerID()
 		for inner, ctrl := range r.inner.Lock() {
 			inner.done = true
 			ctrl.Updated()
@@ -264,7 +267,7 @@ func (pool *BlockPool) PopRequest() {
 			}
 			pool.lastHundredBlockTimeStamp = time.Now()
 		}
-
+		return peerID
 	} else {
 		panic(fmt.Sprintf("Expected requester to pop, got nothing at height %v", pool.height))
 	}
@@ -305,7 +308,8 @@ func (pool *BlockPool) AddBlock(peerID types.NodeID, block *types.Block, blockSi
 	)
 	defer func() {
 		if pendingErr != nil {
-			pool.sendError(pendingErr, pendingPeerID)
+			// A block arriving after its request was redone is not a misbehavior.
+			pool.reportErr(peerError{utils.None[p2p.PeerBehavior](), pendingErr, pendingPeerID})
 		}
 	}()
 	pool.mtx.Lock()
@@ -495,8 +499,8 @@ func (pool *BlockPool) requestersLen() int64 {
 	return int64(len(pool.requesters))
 }
 
-func (pool *BlockPool) sendError(err error, peerID types.NodeID) {
-	pool.reportErr(peerError{err, peerID})
+func (pool *BlockPool) sendError(b p2p.PeerBehavior, err error, peerID types.NodeID) {
+	pool.reportErr(peerError{utils.Some(b), err, peerID})
 }
 
 func (pool *BlockPool) targetSyncBlocks() int64 {
@@ -568,7 +572,7 @@ func (peer *bpPeer) onTimeout() {
 
 	err := errors.New("peer did not send us anything")
 	logger.Error("SendTimeout", "id", peer.id, "reason", err, "timeout", peerTimeout)
-	peer.pool.sendError(err, peer.id)
+	peer.pool.sendError(p2p.PeerSlowResponse, err, peer.id)
 }
 
 //-------------------------------------
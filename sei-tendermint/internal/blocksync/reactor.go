@@ -76,8 +76,10 @@ type ConsensusReactor interface {
 }
 
 type peerError struct {
-	err    error
-	peerID types.NodeID
+	// The behavior to score the peer for, if any.
+	behavior utils.Option[p2p.PeerBehavior]
+	err      error
+	peerID   types.NodeID
 }
 
 func (e peerError) Error() string {
@@ -109,6 +111,9 @@ type SyncerConfig struct {
 	RestartEvent          func()
 	SelfRemediationConfig *config.SelfRemediationConfig
 	FreezeHeight          uint64
+	// ArchiveDir is the block archive (see Archive) to import blocks from
+	// before fetching the rest from peers. Empty disables the import.
+	ArchiveDir string
 }
 
 // Reactor owns the blocksync channel and always-on query serving path, while
@@ -157,6 +162,9 @@ type syncController struct {
 	restartCooldownSeconds    uint64
 	freezeHeight              uint64
 
+	// Block archive to catch up from before syncing with peers.
+	archiveDir string
+
 	// blocksyncReady fires when the active sync routines should begin processing
 	// work, either during OnStart or later via SwitchToBlockSync.
 	blocksyncReady utils.AtomicSend[utils.Option[blocksyncResult]]
@@ -189,6 +197,7 @@ func NewReactor(
 			blocksBehindCheckInterval: time.Duration(cfg.SelfRemediationConfig.BlocksBehindCheckIntervalSeconds) * time.Second, //nolint:gosec // validated in config.ValidateBasic against MaxInt64
 			restartCooldownSeconds:    cfg.SelfRemediationConfig.RestartCooldownSeconds,
 			freezeHeight:              cfg.FreezeHeight,
+			archiveDir:                cfg.ArchiveDir,
 			blocksyncReady:            utils.NewAtomicSend(utils.None[blocksyncResult]()),
 			startInBlockSync:          cfg.BlockSync,
 		}
@@ -359,6 +368,14 @@ func (s *syncController) run(ctx context.Context) error {
 			}
 		}
 
+		var archived uint64
+		if s.archiveDir != "" {
+			res.state, archived, err = s.importArchive(ctx, res.state)
+			if err != nil {
+				return fmt.Errorf("importArchive(): %w", err)
+			}
+		}
+
 		pool := NewBlockPool(startHeightForState(res.state), s.router)
 		s.pool.Store(pool)
 		sc.SpawnNamed("requestRoutine", func() error { return s.requestRoutine(ctx, pool) })
@@ -373,6 +390,7 @@ func (s *syncController) run(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		handoff.blocksSynced += archived
 
 		s.blockSync.Store(false)
 		if r, ok := s.consReactor.Get(); ok {
@@ -456,7 +474,12 @@ func (s *syncController) requestRoutine(ctx context.Context, pool *BlockPool) er
 		case request := <-pool.Requests():
 			s.channel.Send(wrap(&pb.BlockRequest{Height: request.Height}), request.PeerID)
 		case pErr := <-pool.Errors():
-			s.router.Evict(pErr.peerID, fmt.Errorf("blocksync.request: %w", pErr.err))
+			err := fmt.Errorf("blocksync.request: %w", pErr.err)
+			if b, ok := pErr.behavior.Get(); ok {
+				s.router.Disconnect(pErr.peerID, b, err)
+			} else {
+				s.router.Evict(pErr.peerID, err)
+			}
 		case <-statusUpdateTicker.C:
 			s.channel.Broadcast(wrap(&pb.StatusRequest{}))
 		}
@@ -552,12 +575,16 @@ func (s *syncController) poolRoutine(ctx context.Context, pool *BlockPool, initi
 
 			firstID := types.BlockID{Hash: first.Hash(), PartSetHeader: firstParts.Header()}
 
+			// Whether first or the commit of it in second is at fault is known only once the commit
+			// verifies: then first is an invalid block.
+			invalidFirst := false
 			err = state.Validators.VerifyCommitLight(chainID, firstID, first.Height, second.LastCommit)
 			if err != nil {
 				err = types.DefaultConsensusPolicy().HandleError(fmt.Errorf("%w: %w", types.ErrLastCommitVerify, err))
 			}
 			if err == nil {
 				err = s.blockExec.ValidateBlock(ctx, state, first)
+				invalidFirst = err != nil
 			}
 			if err != nil {
 				logger.Error(
@@ -569,7 +596,11 @@ func (s *syncController) poolRoutine(ctx context.Context, pool *BlockPool, initi
 				)
 
 				if peerID, ok := pool.RedoRequest(first.Height).Get(); ok {
-					s.router.Evict(peerID, fmt.Errorf("blocksync: %w", err))
+					if invalidFirst {
+						s.router.Disconnect(peerID, p2p.PeerBadBlock, fmt.Errorf("blocksync: %w", err))
+					} else {
+						s.router.Evict(peerID, fmt.Errorf("blocksync: %w", err))
+					}
 				}
 				if peerID, ok := pool.RedoRequest(second.Height).Get(); ok {
 					s.router.Evict(peerID, fmt.Errorf("blocksync: %w", err))
@@ -577,7 +608,9 @@ func (s *syncController) poolRoutine(ctx context.Context, pool *BlockPool, initi
 				continue
 			}
 
-			pool.PopRequest()
+			if peerID, ok := pool.PopRequest().Get(); ok {
+				s.router.ReportPeer(peerID, p2p.PeerUsefulBlock)
+			}
 			s.store.SaveBlock(first, firstParts, second.LastCommit)
 
 			logger.Info("Requesting block from peer", "block", first.Height, "took", time.Since(lastApplyBlockTime))
@@ -0,0 +1,96 @@
+package flightrec
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventbus"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventlog"
+	tmpubsub "github.com/sei-protocol/sei-chain/sei-tendermint/internal/pubsub"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/pubsub/query"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+	"github.com/sei-protocol/seilog"
+)
+
+var logger = seilog.NewLogger("tendermint", "internal", "flightrec")
+
+const subscriberID = "flight-recorder"
+
+// resubscribeDelay is the delay before resubscribing after the subscription failed.
+const resubscribeDelay = time.Second
+
+// RunConsensus records the consensus events published on the event bus until
+// ctx is cancelled. If the subscription fails, e.g. because the recorder fell
+// behind, the failure is logged and the recorder resubscribes.
+func (r *Recorder) RunConsensus(ctx context.Context, bus *eventbus.EventBus) error {
+	for {
+		err := r.runConsensus(ctx, bus)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		logger.Error("flight recorder subscription failed, resubscribing", "err", err)
+		if err := utils.Sleep(ctx, resubscribeDelay); err != nil {
+			return err
+		}
+	}
+}
+
+func (r *Recorder) runConsensus(ctx context.Context, bus *eventbus.EventBus) error {
+	// Like the event log, use a "normal" subscription with a big buffer
+	// allowance, since the observer is taken by the indexer.
+	sub, err := bus.SubscribeWithArgs(ctx, tmpubsub.SubscribeArgs{
+		ClientID: subscriberID,
+		Query:    query.All,
+		Limit:    1 << 16,
+	})
+	if err != nil {
+		return fmt.Errorf("flight recorder subscribe: %w", err)
+	}
+	// N.B. Use background for unsubscribe, ctx is already terminated.
+	defer func() { _ = bus.UnsubscribeAll(context.Background(), subscriberID) }()
+	for {
+		msg, err := sub.Next(ctx)
+		if err != nil {
+			return err
+		}
+		etype, ok := eventlog.FindType(msg.Events())
+		if !ok {
+			continue
+		}
+		if e, ok := consensusEvent(Kind(etype), msg.Data()).Get(); ok {
+			r.record(e)
+		}
+	}
+}
+
+// consensusEvent converts an event bus event into a recorded event, if it is a
+// consensus event.
+func consensusEvent(kind Kind, data types.EventData) utils.Option[Event] {
+	e := Event{Time: time.Now(), Kind: kind}
+	switch d := data.(type) {
+	case types.EventDataRoundState:
+		e.Height, e.Round, e.Detail = d.Height, d.Round, d.Step
+	case types.EventDataNewRound:
+		e.Height, e.Round = d.Height, d.Round
+		e.Detail = fmt.Sprintf("proposer=%v index=%d", d.Proposer.Address, d.Proposer.Index)
+	case types.EventDataCompleteProposal:
+		e.Height, e.Round = d.Height, d.Round
+		e.Detail = fmt.Sprintf("block=%v", d.BlockID)
+	case types.EventDataVote:
+		if d.Vote == nil {
+			return utils.None[Event]()
+		}
+		e.Height, e.Round, e.Detail = d.Vote.Height, d.Vote.Round, d.Vote.String()
+	case types.EventDataNewBlock:
+		if d.Block == nil {
+			return utils.None[Event]()
+		}
+		e.Height = d.Block.Height
+		e.Detail = fmt.Sprintf("block=%v txs=%d", d.BlockID, len(d.Block.Data.Txs))
+	default:
+		return utils.None[Event]()
+	}
+	return utils.Some(e)
+}
@@ -0,0 +1,137 @@
+// Package flightrec implements an always-on flight recorder of consensus and
+// mempool events. The recorder keeps the most recent events in a bounded ring
+// buffer, so that after a round escalation or a missed block the sequence of
+// proposals, votes, timeouts and mempool decisions which led to it can be
+// dumped (see the dump_flight_recorder RPC) and analysed offline.
+package flightrec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+)
+
+// Kind is the kind of a recorded event. Consensus events are recorded with the
+// type of the corresponding event bus event (e.g. types.EventVoteValue).
+type Kind string
+
+// Mempool event kinds.
+const (
+	// A transaction was admitted to the mempool.
+	MempoolAdmit Kind = "MempoolAdmit"
+	// A transaction was rejected by the mempool.
+	MempoolReject Kind = "MempoolReject"
+	// An admitted transaction was evicted, expired or found invalid.
+	MempoolEvict Kind = "MempoolEvict"
+)
+
+// DefaultCapacity is the number of events kept by the recorder of a node.
+const DefaultCapacity = 1 << 16
+
+// Event is a single recorded event.
+type Event struct {
+	Time time.Time `json:"time"`
+	Kind Kind      `json:"kind"`
+	// Height and Round of the consensus event. Zero for mempool events.
+	Height int64 `json:"height,string,omitempty"`
+	Round  int32 `json:"round,omitempty"`
+	// Human readable description of the event.
+	Detail string `json:"detail"`
+
+	// Produces Detail when the events are read, if set.
+	describe func() string
+}
+
+type ring struct {
+	events []Event
+	// Index of the oldest event, once the buffer is full.
+	next int
+}
+
+// Recorder is a bounded ring buffer of events. It is safe for concurrent use.
+type Recorder struct {
+	capacity int
+	ring     utils.Mutex[*ring]
+}
+
+// NewRecorder constructs a recorder keeping the last capacity events.
+func NewRecorder(capacity int) *Recorder {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("invalid flight recorder capacity %d", capacity))
+	}
+	return &Recorder{
+		capacity: capacity,
+		ring:     utils.NewMutex(&ring{events: make([]Event, 0, capacity)}),
+	}
+}
+
+// Record records an event, overwriting the oldest one if the buffer is full.
+func (r *Recorder) Record(kind Kind, height int64, round int32, detail string) {
+	r.record(Event{Time: time.Now(), Kind: kind, Height: height, Round: round, Detail: detail})
+}
+
+// RecordLazy records an event like Record, with the description produced by
+// describe only when the events are read. It is meant for frequent events,
+// like mempool decisions, most of which are never read. describe should not
+// retain large objects, since it is kept until the event is overwritten.
+func (r *Recorder) RecordLazy(kind Kind, describe func() string) {
+	r.record(Event{Time: time.Now(), Kind: kind, describe: describe})
+}
+
+func (r *Recorder) record(e Event) {
+	for ring := range r.ring.Lock() {
+		if len(ring.events) < r.capacity {
+			ring.events = append(ring.events, e)
+			return
+		}
+		ring.events[ring.next] = e
+		ring.next = (ring.next + 1) % r.capacity
+	}
+}
+
+// Events returns the recorded events, oldest first.
+func (r *Recorder) Events() []Event {
+	var events []Event
+	for ring := range r.ring.Lock() {
+		events = append(append(make([]Event, 0, len(ring.events)), ring.events[ring.next:]...), ring.events[:ring.next]...)
+	}
+	for i := range events {
+		if events[i].describe != nil {
+			events[i].Detail = events[i].describe()
+			events[i].describe = nil
+		}
+	}
+	return events
+}
+
+// Dump returns the recorded events encoded as a JSON array.
+func (r *Recorder) Dump() (json.RawMessage, error) {
+	return json.Marshal(r.Events())
+}
+
+// Timeline writes a human readable timeline of the events to w. Events are
+// grouped by the consensus height and round they occurred in, with times
+// relative to the first event of the group.
+func Timeline(events []Event, w io.Writer) error {
+	var height int64
+	var round int32
+	var start time.Time
+	for i, e := range events {
+		if i == 0 || (e.Height != 0 && (e.Height != height || e.Round != round)) {
+			if e.Height != 0 {
+				height, round = e.Height, e.Round
+			}
+			start = e.Time
+			if _, err := fmt.Fprintf(w, "height %d round %d @ %s\n", height, round, start.UTC().Format(time.RFC3339Nano)); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "  %+10.3fms  %-16s %s\n", float64(e.Time.Sub(start).Microseconds())/1000, e.Kind, e.Detail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package flightrec
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+func TestRecorder_KeepsLastEvents(t *testing.T) {
+	r := NewRecorder(3)
+	require.Empty(t, r.Events())
+	for i := range 5 {
+		r.Record(MempoolAdmit, 0, 0, string(rune('a'+i)))
+	}
+	require.Equal(t, []string{"c", "d", "e"}, details(r))
+}
+
+func TestRecorder_RecordLazy(t *testing.T) {
+	r := NewRecorder(2)
+	calls := 0
+	for i := range 3 {
+		r.RecordLazy(MempoolReject, func() string {
+			calls++
+			return string(rune('a' + i))
+		})
+	}
+	require.Zero(t, calls)
+	require.Equal(t, []string{"b", "c"}, details(r))
+	require.Equal(t, 2, calls)
+}
+
+func details(r *Recorder) []string {
+	var ds []string
+	for _, e := range r.Events() {
+		ds = append(ds, e.Detail)
+	}
+	return ds
+}
+
+func TestRecorder_DumpRoundTrip(t *testing.T) {
+	r := NewRecorder(10)
+	r.Record(types.EventNewRoundStepValue, 5, 1, "RoundStepPropose")
+	r.RecordLazy(MempoolEvict, func() string { return "tx=AB" })
+	raw, err := r.Dump()
+	require.NoError(t, err)
+	var events []Event
+	require.NoError(t, json.Unmarshal(raw, &events))
+	require.Len(t, events, 2)
+	for i, want := range r.Events() {
+		require.True(t, want.Time.Equal(events[i].Time))
+		events[i].Time = want.Time
+		require.Equal(t, want, events[i])
+	}
+}
+
+func TestConsensusEvent(t *testing.T) {
+	e, ok := consensusEvent(types.EventTimeoutProposeValue, types.EventDataRoundState{Height: 7, Round: 2, Step: "RoundStepPropose"}).Get()
+	require.True(t, ok)
+	require.Equal(t, Kind(types.EventTimeoutProposeValue), e.Kind)
+	require.Equal(t, int64(7), e.Height)
+	require.Equal(t, int32(2), e.Round)
+	require.Equal(t, "RoundStepPropose", e.Detail)
+
+	_, ok = consensusEvent(types.EventTxValue, types.EventDataTx{}).Get()
+	require.False(t, ok)
+}
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []Event{
+		{Time: start, Kind: types.EventNewRoundValue, Height: 10, Round: 0, Detail: "proposer"},
+		{Time: start.Add(1500 * time.Microsecond), Kind: MempoolAdmit, Detail: "tx=AB"},
+		{Time: start.Add(3 * time.Second), Kind: types.EventTimeoutProposeValue, Height: 10, Round: 0, Detail: "RoundStepPropose"},
+		{Time: start.Add(4 * time.Second), Kind: types.EventNewRoundValue, Height: 10, Round: 1, Detail: "proposer"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, Timeline(events, &buf))
+	require.Equal(t, ""+
+		"height 10 round 0 @ 2024-01-01T00:00:00Z\n"+
+		"      +0.000ms  NewRound         proposer\n"+
+		"      +1.500ms  MempoolAdmit     tx=AB\n"+
+		"   +3000.000ms  TimeoutPropose   RoundStepPropose\n"+
+		"height 10 round 1 @ 2024-01-01T00:00:04Z\n"+
+		"      +0.000ms  NewRound         proposer\n",
+		buf.String())
+}
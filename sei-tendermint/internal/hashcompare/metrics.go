@@ -0,0 +1,29 @@
+package hashcompare
+
+import tmprometheus "github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/prometheus"
+
+const (
+	// MetricsNamespace is the namespace shared by all Tendermint Prometheus metrics.
+	MetricsNamespace = "tendermint"
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "hash_compare"
+)
+
+//go:generate go run ../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+// see MetricsProvider for descriptions.
+type Metrics struct {
+	// Number of blocks whose hashes matched the peer's.
+	ComparedBlocks tmprometheus.CounterIntVec `metrics_labels:"peer_id"`
+
+	// Highest block compared with the peer.
+	LastComparedHeight tmprometheus.GaugeIntVec `metrics_labels:"peer_id"`
+
+	// Number of divergences from a peer, by the first hash category that differed.
+	Divergences tmprometheus.CounterIntVec `metrics_labels:"peer_id, category"`
+
+	// Height of the first block at which the peer diverged; 0 while it agrees.
+	DivergentHeight tmprometheus.GaugeIntVec `metrics_labels:"peer_id"`
+}
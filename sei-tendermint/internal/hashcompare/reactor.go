@@ -0,0 +1,350 @@
+package hashcompare
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
+	tmos "github.com/sei-protocol/sei-chain/sei-tendermint/libs/os"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/service"
+	pb "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/hashcompare"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+	"github.com/sei-protocol/seilog"
+)
+
+var logger = seilog.NewLogger("tendermint", "internal", "hashcompare")
+
+var _ service.Service = (*Reactor)(nil)
+
+const (
+	HashCompareChannel = p2p.ChannelID(0x70)
+
+	maxMsgSize = 1048576 // 1MB
+
+	// Bounds on a peer's message, well above what the hash logger records per block.
+	maxCategories = 1024
+	maxHashSize   = 64
+
+	// maxBlocksPerBroadcast caps how many blocks are sent to one peer per broadcast, so a backlog is
+	// worked off over several intervals rather than in one burst.
+	maxBlocksPerBroadcast = 100
+
+	// maxPendingBlocks caps how many of a peer's blocks are held while waiting for this node to log
+	// the same block. Blocks beyond it are dropped uncompared.
+	maxPendingBlocks = 1000
+)
+
+// GetChannelDescriptor produces an instance of a descriptor for this
+// package's required channels.
+func GetChannelDescriptor() p2p.ChannelDescriptor[*pb.BlockHashes] {
+	return p2p.ChannelDescriptor[*pb.BlockHashes]{
+		ID:                  HashCompareChannel,
+		MessageType:         new(pb.BlockHashes),
+		Priority:            1,
+		RecvMessageCapacity: maxMsgSize,
+		RecvBufferCapacity:  128,
+		Name:                "hashcompare",
+	}
+}
+
+// Source provides the hashes this node logged for recent blocks. *hashlog.RecentHashes implements
+// it.
+type Source interface {
+	Get(blockNumber uint64) (*hashlog.HashLog, bool)
+	Latest() (uint64, bool)
+}
+
+// peerState tracks the exchange with one peer.
+type peerState struct {
+	// The highest block sent to the peer; zero before the first.
+	lastSent uint64
+
+	// The peer's blocks that this node has not logged yet, by height.
+	pending map[uint64]*pb.BlockHashes
+
+	// Set at the first divergence. The peer's later blocks are not compared: once state has
+	// diverged, every later block would only report the same divergence again.
+	diverged bool
+}
+
+// Reactor exchanges the hashes this node logs for each block with its peers and compares them,
+// reporting the first hash category and height at which a peer diverges.
+//
+// Both sides push: every broadcast interval each node sends the blocks it has logged since its last
+// send. A received block is compared right away if this node has logged it too, and otherwise held
+// until it has. Only categories both nodes recorded are compared, so nodes running different
+// storage backends can still be compared on what they have in common.
+type Reactor struct {
+	service.BaseService
+
+	cfg     *config.HashCompareConfig
+	source  Source
+	router  *p2p.Router
+	channel *p2p.Channel[*pb.BlockHashes]
+	metrics *Metrics
+
+	// The configured peers. Empty means every peer running the service.
+	allowed map[types.NodeID]struct{}
+
+	// Called on divergence when HaltOnDivergence is set. Shuts the node down, except in tests.
+	halt     func(msg string)
+	haltOnce sync.Once
+
+	mtx   sync.Mutex
+	peers map[types.NodeID]*peerState
+}
+
+// NewReactor returns a reference to a new hash comparison reactor, which
+// implements the service.Service interface.
+func NewReactor(cfg *config.HashCompareConfig, source Source, router *p2p.Router) (*Reactor, error) {
+	ids, err := cfg.PeerIDs()
+	if err != nil {
+		return nil, err
+	}
+	channel, err := p2p.OpenChannel(router, GetChannelDescriptor())
+	if err != nil {
+		return nil, fmt.Errorf("router.OpenChannel(): %w", err)
+	}
+	r := &Reactor{
+		cfg:     cfg,
+		source:  source,
+		router:  router,
+		channel: channel,
+		metrics: Global,
+		allowed: make(map[types.NodeID]struct{}, len(ids)),
+		peers:   map[types.NodeID]*peerState{},
+	}
+	r.halt = r.shutdownNode
+	for _, id := range ids {
+		r.allowed[id] = struct{}{}
+	}
+	r.BaseService = *service.NewBaseService("HashCompare", r)
+	return r, nil
+}
+
+// shutdownNode stops the node the way an operator's SIGTERM does, so that every service and store
+// is closed cleanly. Only the first call signals.
+func (r *Reactor) shutdownNode(msg string) {
+	r.haltOnce.Do(func() {
+		logger.Error(msg)
+		if err := tmos.Kill(); err != nil {
+			logger.Error("failed to signal node shutdown; stop the node manually", "err", err)
+		}
+	})
+}
+
+// OnStart starts the goroutines that receive peers' hashes, track peers and
+// broadcast this node's hashes. No error is returned.
+func (r *Reactor) OnStart(ctx context.Context) error {
+	r.SpawnCritical("processHashCompareCh", func(ctx context.Context) error { return r.processHashCompareCh(ctx) })
+	r.SpawnCritical("processPeerUpdates", func(ctx context.Context) error { return r.processPeerUpdates(ctx) })
+	r.SpawnCritical("broadcastRoutine", func(ctx context.Context) error { return r.broadcastRoutine(ctx) })
+	return nil
+}
+
+// OnStop stops the reactor by signaling to all spawned goroutines to exit and
+// blocking until they all exit.
+func (r *Reactor) OnStop() {}
+
+func (r *Reactor) isAllowed(id types.NodeID) bool {
+	if len(r.allowed) == 0 {
+		return true
+	}
+	_, ok := r.allowed[id]
+	return ok
+}
+
+func (r *Reactor) processPeerUpdates(ctx context.Context) error {
+	recv := r.router.Subscribe()
+	for {
+		update, err := recv.Recv(ctx)
+		if err != nil {
+			return err
+		}
+		r.processPeerUpdate(update)
+	}
+}
+
+func (r *Reactor) processPeerUpdate(update p2p.PeerUpdate) {
+	if !r.isAllowed(update.NodeID) {
+		return
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	switch update.Status {
+	case p2p.PeerStatusUp:
+		if _, ok := r.peers[update.NodeID]; !ok {
+			r.peers[update.NodeID] = &peerState{pending: map[uint64]*pb.BlockHashes{}}
+		}
+	case p2p.PeerStatusDown:
+		delete(r.peers, update.NodeID)
+	}
+}
+
+func (r *Reactor) processHashCompareCh(ctx context.Context) error {
+	for {
+		m, err := r.channel.Recv(ctx)
+		if err != nil {
+			return err
+		}
+		if err := validateBlockHashes(m.Message); err != nil {
+			r.router.Evict(m.From, fmt.Errorf("hashcompare: %w", err))
+			continue
+		}
+		r.handleBlockHashes(m.From, m.Message)
+	}
+}
+
+func validateBlockHashes(msg *pb.BlockHashes) error {
+	if msg.Height == 0 {
+		return errors.New("height cannot be 0")
+	}
+	if len(msg.Hashes) > maxCategories {
+		return fmt.Errorf("%d hash categories, at most %d allowed", len(msg.Hashes), maxCategories)
+	}
+	seen := make(map[string]struct{}, len(msg.Hashes))
+	for _, h := range msg.Hashes {
+		if h.Category == "" {
+			return errors.New("empty hash category")
+		}
+		if len(h.Hash) > maxHashSize {
+			return fmt.Errorf("hash for %q is %d bytes, at most %d allowed", h.Category, len(h.Hash), maxHashSize)
+		}
+		if _, ok := seen[h.Category]; ok {
+			return fmt.Errorf("duplicate hash category %q", h.Category)
+		}
+		seen[h.Category] = struct{}{}
+	}
+	return nil
+}
+
+// handleBlockHashes compares a peer's block with this node's, or holds it until this node has
+// logged the block.
+func (r *Reactor) handleBlockHashes(from types.NodeID, msg *pb.BlockHashes) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	st, ok := r.peers[from]
+	if !ok || st.diverged {
+		return
+	}
+	if local, ok := r.source.Get(msg.Height); ok {
+		r.compare(from, st, local, msg)
+		return
+	}
+	// Blocks this node has already logged but no longer holds cannot be compared.
+	if latest, ok := r.source.Latest(); ok && msg.Height <= latest {
+		return
+	}
+	if len(st.pending) < maxPendingBlocks {
+		st.pending[msg.Height] = msg
+	}
+}
+
+func (r *Reactor) broadcastRoutine(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.BroadcastInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			r.broadcast()
+		}
+	}
+}
+
+// broadcast sends every peer the blocks logged since its last send, then compares the peers'
+// blocks that were waiting for this node to catch up.
+func (r *Reactor) broadcast() {
+	latest, ok := r.source.Latest()
+	if !ok {
+		return
+	}
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for id, st := range r.peers {
+		from := st.lastSent + 1
+		if st.lastSent == 0 || latest < st.lastSent {
+			// A new peer starts at the newest block, and so does every peer after a rollback.
+			from = latest
+		}
+		for height := from; height <= latest && height < from+maxBlocksPerBroadcast; height++ {
+			if log, ok := r.source.Get(height); ok {
+				r.channel.Send(toProto(log), id)
+			}
+			st.lastSent = height
+		}
+
+		for height, msg := range st.pending {
+			if height > latest {
+				continue
+			}
+			delete(st.pending, height)
+			if st.diverged {
+				continue
+			}
+			if local, ok := r.source.Get(height); ok {
+				r.compare(id, st, local, msg)
+			}
+		}
+	}
+}
+
+// compare checks a peer's block against this node's, in category order, and reports the first
+// category that differs. Must be called with mtx held.
+func (r *Reactor) compare(peer types.NodeID, st *peerState, local *hashlog.HashLog, remote *pb.BlockHashes) {
+	remoteHashes := make(map[string][]byte, len(remote.Hashes))
+	for _, h := range remote.Hashes {
+		remoteHashes[h.Category] = h.Hash
+	}
+	categories := make([]string, 0, len(local.Hashes))
+	for category, hash := range local.Hashes {
+		if len(hash) > 0 && len(remoteHashes[category]) > 0 {
+			categories = append(categories, category)
+		}
+	}
+	slices.Sort(categories)
+
+	peerLabel := string(peer)
+	for _, category := range categories {
+		if bytes.Equal(local.Hashes[category], remoteHashes[category]) {
+			continue
+		}
+		st.diverged = true
+		clear(st.pending)
+		r.metrics.DivergencesAt(peerLabel, category).Add(1)
+		r.metrics.DivergentHeightAt(peerLabel).Set(int64(remote.Height)) //nolint:gosec // block heights fit in int64
+		logger.Error("state hash divergence from peer",
+			"peer", peer,
+			"height", remote.Height,
+			"category", category,
+			"local", fmt.Sprintf("%X", local.Hashes[category]),
+			"peer_hash", fmt.Sprintf("%X", remoteHashes[category]),
+		)
+		if r.cfg.HaltOnDivergence {
+			r.halt(fmt.Sprintf("FATAL: state hash for %q at height %d diverges from peer %s (local %X, peer %X); "+
+				"halting. Compare the hash logs of both nodes before restarting.",
+				category, remote.Height, peer, local.Hashes[category], remoteHashes[category]))
+		}
+		return
+	}
+	r.metrics.ComparedBlocksAt(peerLabel).Add(1)
+	r.metrics.LastComparedHeightAt(peerLabel).Set(int64(remote.Height)) //nolint:gosec // block heights fit in int64
+}
+
+func toProto(log *hashlog.HashLog) *pb.BlockHashes {
+	msg := &pb.BlockHashes{Height: log.BlockNumber, Hashes: make([]*pb.CategoryHash, 0, len(log.Hashes))}
+	for category, hash := range log.Hashes {
+		msg.Hashes = append(msg.Hashes, &pb.CategoryHash{Category: category, Hash: hash})
+	}
+	slices.SortFunc(msg.Hashes, func(a, b *pb.CategoryHash) int { return strings.Compare(a.Category, b.Category) })
+	return msg
+}
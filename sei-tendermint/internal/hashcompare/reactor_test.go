@@ -0,0 +1,148 @@
+package hashcompare
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fortytw2/leaktest"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/sei-db/state_db/sc/hashlog"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
+	pb "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/hashcompare"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+type reactorTestSuite struct {
+	network  *p2p.TestNetwork
+	nodes    []*p2p.TestNode
+	sources  map[types.NodeID]*hashlog.RecentHashes
+	reactors map[types.NodeID]*Reactor
+	halts    chan string
+}
+
+func setup(t *testing.T, numNodes int, haltOnDivergence bool) *reactorTestSuite {
+	t.Helper()
+	rts := &reactorTestSuite{
+		network:  p2p.MakeTestNetwork(t, p2p.TestNetworkOptions{NumNodes: numNodes}),
+		sources:  make(map[types.NodeID]*hashlog.RecentHashes, numNodes),
+		reactors: make(map[types.NodeID]*Reactor, numNodes),
+		halts:    make(chan string, numNodes),
+	}
+	cfg := config.DefaultHashCompareConfig()
+	cfg.Enable = true
+	cfg.HaltOnDivergence = haltOnDivergence
+	cfg.BroadcastInterval = 10 * time.Millisecond
+
+	for _, node := range rts.network.Nodes() {
+		rts.nodes = append(rts.nodes, node)
+		rts.sources[node.NodeID] = hashlog.NewRecentHashes(100)
+		reactor, err := NewReactor(cfg, rts.sources[node.NodeID], node.Router)
+		require.NoError(t, err)
+		reactor.halt = func(msg string) { rts.halts <- msg }
+		rts.reactors[node.NodeID] = reactor
+		require.NoError(t, reactor.Start(t.Context()))
+	}
+
+	t.Cleanup(func() {
+		for _, r := range rts.reactors {
+			if r.IsRunning() {
+				r.Stop()
+				r.Wait()
+			}
+		}
+	})
+	t.Cleanup(leaktest.Check(t))
+
+	rts.network.Start(t)
+	return rts
+}
+
+func gaugeValue(t *testing.T, g interface{ Write(*dto.Metric) error }) float64 {
+	t.Helper()
+	var m dto.Metric
+	require.NoError(t, g.Write(&m))
+	return m.GetGauge().GetValue()
+}
+
+func TestReactorMatchingHashes(t *testing.T) {
+	rts := setup(t, 2, true)
+	for block := uint64(1); block <= 5; block++ {
+		for _, node := range rts.nodes {
+			rts.sources[node.NodeID].Add(&hashlog.HashLog{
+				BlockNumber: block,
+				Hashes:      map[string][]byte{"evm": {byte(block)}, "bank": {0x01}},
+			})
+		}
+	}
+
+	a, b := rts.nodes[0].NodeID, rts.nodes[1].NodeID
+	require.Eventually(t, func() bool {
+		return gaugeValue(t, Global.LastComparedHeightAt(string(b))) == 5 &&
+			gaugeValue(t, Global.LastComparedHeightAt(string(a))) == 5
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// Blocks logged later are sent and compared too.
+	for _, node := range rts.nodes {
+		rts.sources[node.NodeID].Add(&hashlog.HashLog{BlockNumber: 6, Hashes: map[string][]byte{"evm": {0x06}}})
+	}
+	require.Eventually(t, func() bool {
+		return gaugeValue(t, Global.LastComparedHeightAt(string(b))) == 6
+	}, 10*time.Second, 10*time.Millisecond)
+	require.Empty(t, rts.halts)
+}
+
+func TestReactorDivergence(t *testing.T) {
+	rts := setup(t, 2, true)
+	a, b := rts.nodes[0].NodeID, rts.nodes[1].NodeID
+
+	// The nodes agree on "evm", disagree on "bank", and only one records "wasm", which is skipped.
+	rts.sources[a].Add(&hashlog.HashLog{
+		BlockNumber: 7,
+		Hashes:      map[string][]byte{"evm": {0x01}, "bank": {0x02}, "wasm": {0x03}},
+	})
+	rts.sources[b].Add(&hashlog.HashLog{
+		BlockNumber: 7,
+		Hashes:      map[string][]byte{"evm": {0x01}, "bank": {0x04}},
+	})
+
+	select {
+	case msg := <-rts.halts:
+		require.Contains(t, msg, `"bank"`)
+		require.Contains(t, msg, "height 7")
+	case <-time.After(10 * time.Second):
+		t.Fatal("no halt on divergence")
+	}
+	require.Eventually(t, func() bool {
+		return gaugeValue(t, Global.DivergentHeightAt(string(b))) == 7
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestValidateBlockHashes(t *testing.T) {
+	hash := func(category string, size int) *pb.CategoryHash {
+		return &pb.CategoryHash{Category: category, Hash: make([]byte, size)}
+	}
+	testCases := []struct {
+		name  string
+		msg   *pb.BlockHashes
+		valid bool
+	}{
+		{"valid", &pb.BlockHashes{Height: 1, Hashes: []*pb.CategoryHash{hash("evm", 32), hash("bank", 0)}}, true},
+		{"zero height", &pb.BlockHashes{Hashes: []*pb.CategoryHash{hash("evm", 32)}}, false},
+		{"empty category", &pb.BlockHashes{Height: 1, Hashes: []*pb.CategoryHash{hash("", 32)}}, false},
+		{"oversized hash", &pb.BlockHashes{Height: 1, Hashes: []*pb.CategoryHash{hash("evm", maxHashSize+1)}}, false},
+		{"duplicate category", &pb.BlockHashes{Height: 1, Hashes: []*pb.CategoryHash{hash("evm", 32), hash("evm", 32)}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateBlockHashes(tc.msg)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package mempool
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-db/common/unit"
+	"github.com/sei-protocol/sei-chain/sei-db/seiwal"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// journalFileSize is the size a journal file may reach before it is sealed. Pruning deletes whole sealed
+// files only, so this bounds how much of the journal outlives the transactions it records.
+const journalFileSize = 16 * unit.MB
+
+type journalKind byte
+
+const (
+	// A transaction was admitted to the mempool.
+	journalAdd journalKind = iota + 1
+	// An admitted transaction left the mempool: it was included in a block, expired, evicted, replaced or
+	// found invalid.
+	journalRemove
+	// The mempool was flushed.
+	journalClear
+)
+
+// journalRecord is one entry of the mempool journal.
+type journalRecord struct {
+	kind journalKind
+
+	// Set for journalAdd: the transaction and the time and height it was admitted at, which its TTL
+	// counts from.
+	tx        types.Tx
+	timestamp time.Time
+	height    int64
+
+	// Set for journalRemove.
+	hash types.TxHash
+}
+
+func (r journalRecord) marshal() []byte {
+	switch r.kind {
+	case journalAdd:
+		buf := make([]byte, 0, 1+8+8+len(r.tx))
+		buf = append(buf, byte(r.kind))
+		buf = binary.BigEndian.AppendUint64(buf, uint64(r.timestamp.UnixNano())) //nolint:gosec // round-trips through int64
+		buf = binary.BigEndian.AppendUint64(buf, uint64(r.height))               //nolint:gosec // round-trips through int64
+		return append(buf, r.tx...)
+	case journalRemove:
+		return append([]byte{byte(r.kind)}, r.hash[:]...)
+	default:
+		return []byte{byte(r.kind)}
+	}
+}
+
+func unmarshalJournalRecord(bz []byte) (journalRecord, error) {
+	if len(bz) == 0 {
+		return journalRecord{}, errors.New("empty journal record")
+	}
+	r := journalRecord{kind: journalKind(bz[0])}
+	bz = bz[1:]
+	switch r.kind {
+	case journalAdd:
+		if len(bz) < 16 {
+			return journalRecord{}, fmt.Errorf("journal add record too short: %d bytes", len(bz))
+		}
+		r.timestamp = time.Unix(0, int64(binary.BigEndian.Uint64(bz[:8]))).UTC() //nolint:gosec // round-trips through int64
+		r.height = int64(binary.BigEndian.Uint64(bz[8:16]))                      //nolint:gosec // round-trips through int64
+		r.tx = types.Tx(bz[16:])
+	case journalRemove:
+		if len(bz) != len(r.hash) {
+			return journalRecord{}, fmt.Errorf("journal remove record has a %d byte hash", len(bz))
+		}
+		copy(r.hash[:], bz)
+	case journalClear:
+	default:
+		return journalRecord{}, fmt.Errorf("unknown journal record kind %d", r.kind)
+	}
+	return r, nil
+}
+
+// journal records the transactions admitted to and removed from the mempool in a WAL, so that a restarted
+// node can re-admit the transactions it held when it stopped.
+//
+// The WAL is append-only; the journal keeps it bounded by pruning every record older than the oldest
+// transaction still in the mempool. A removal is always journaled after the admission it refers to, so
+// pruning a prefix never leaves a removal whose admission survives.
+//
+// Not safe for concurrent use: txStore only calls it with its lock held. Appends become durable on sync
+// or close. A crash loses at most the records since the last sync, which costs only a few transactions
+// or resurrects a few that CheckTx then rejects.
+type journal struct {
+	wal seiwal.WAL[journalRecord]
+
+	// The index of the next record.
+	next uint64
+
+	// Set once the WAL fails. Journaling stops from then on rather than failing the mempool.
+	failed bool
+}
+
+// openJournal opens (or creates) the mempool journal in dir.
+func openJournal(dir string) (*journal, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create dir %s: %w", dir, err)
+	}
+	config := seiwal.DefaultConfig(dir, "mempool")
+	config.TargetFileSize = journalFileSize
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid mempool journal config: %w", err)
+	}
+	wal, err := seiwal.NewGenericWAL[journalRecord](
+		config,
+		func(r journalRecord) ([]byte, error) { return r.marshal(), nil },
+		unmarshalJournalRecord,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("open mempool journal in %s: %w", dir, err)
+	}
+	j := &journal{wal: wal, next: 1}
+	ok, _, last, err := wal.Bounds()
+	if err != nil {
+		_ = wal.Close()
+		return nil, fmt.Errorf("read mempool journal bounds: %w", err)
+	}
+	if ok {
+		j.next = last + 1
+	}
+	return j, nil
+}
+
+// replay returns the admission records of the transactions that were still in the mempool when the
+// journal was last written, in admission order.
+func (j *journal) replay() (adds []journalRecord, err error) {
+	ok, first, last, err := j.wal.Bounds()
+	if err != nil {
+		return nil, fmt.Errorf("read mempool journal bounds: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	it, err := j.wal.Iterator(first, last)
+	if err != nil {
+		return nil, fmt.Errorf("open mempool journal iterator over [%d, %d]: %w", first, last, err)
+	}
+	defer func() {
+		if closeErr := it.Close(); closeErr != nil && err == nil {
+			adds, err = nil, fmt.Errorf("close mempool journal iterator: %w", closeErr)
+		}
+	}()
+
+	live := map[types.TxHash]uint64{}
+	byIndex := map[uint64]journalRecord{}
+	var order []uint64
+	for {
+		more, err := it.Next()
+		if err != nil {
+			return nil, fmt.Errorf("advance mempool journal iterator: %w", err)
+		}
+		if !more {
+			break
+		}
+		index, r := it.Entry()
+		switch r.kind {
+		case journalAdd:
+			// The payload is owned by the WAL.
+			r.tx = append(types.Tx(nil), r.tx...)
+			hash := r.tx.Hash()
+			if old, ok := live[hash]; ok {
+				delete(byIndex, old)
+			}
+			live[hash] = index
+			byIndex[index] = r
+			order = append(order, index)
+		case journalRemove:
+			if index, ok := live[r.hash]; ok {
+				delete(live, r.hash)
+				delete(byIndex, index)
+			}
+		case journalClear:
+			clear(live)
+			clear(byIndex)
+		}
+	}
+	for _, index := range order {
+		if r, ok := byIndex[index]; ok {
+			adds = append(adds, r)
+		}
+	}
+	return adds, nil
+}
+
+func (j *journal) append(r journalRecord) {
+	if j.failed {
+		return
+	}
+	if err := j.wal.Append(j.next, r); err != nil {
+		j.fail(err)
+		return
+	}
+	j.next++
+}
+
+func (j *journal) fail(err error) {
+	j.failed = true
+	logger.Error("mempool journal failed; transactions admitted from now on will not survive a restart", "err", err)
+}
+
+// add journals an admitted transaction.
+func (j *journal) add(wtx *WrappedTx) {
+	wtx.journalIndex = j.next
+	j.append(journalRecord{kind: journalAdd, tx: wtx.Tx(), timestamp: wtx.timestamp, height: wtx.height})
+}
+
+// remove journals that an admitted transaction left the mempool.
+func (j *journal) remove(wtx *WrappedTx) {
+	if wtx.journalIndex == 0 {
+		// Admitted while journaling was off.
+		return
+	}
+	j.append(journalRecord{kind: journalRemove, hash: wtx.Hash()})
+}
+
+// clear journals that the mempool was flushed.
+func (j *journal) clear() {
+	j.append(journalRecord{kind: journalClear})
+}
+
+// sync makes the records appended so far durable, and prunes those older than oldestLive, the index of the
+// oldest transaction still in the mempool.
+func (j *journal) sync(oldestLive uint64) {
+	if j.failed {
+		return
+	}
+	if err := j.wal.Flush(); err != nil {
+		j.fail(err)
+		return
+	}
+	if err := j.wal.PruneBefore(oldestLive); err != nil {
+		j.fail(err)
+	}
+}
+
+func (j *journal) close() error {
+	return j.wal.Close()
+}
@@ -0,0 +1,116 @@
+package mempool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/abci/example/kvstore"
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// restart closes txmp's journal and opens a fresh mempool over the same journal, as a node restart would.
+func restart(ctx context.Context, t *testing.T, txmp *TxMempool, lastHeight int64) *TxMempool {
+	t.Helper()
+	txmp.closeJournal()
+	client := &application{Application: kvstore.NewApplication()}
+	restarted := setup(txmp.config, proxy.New(client), NopTxConstraintsFetcher)
+	require.NoError(t, restarted.RestoreJournal(ctx, lastHeight))
+	t.Cleanup(restarted.closeJournal)
+	return restarted
+}
+
+func mempoolTxs(txmp *TxMempool) map[types.TxHash]bool {
+	txs, _ := txmp.ReapTxs(ReapLimits{}, false)
+	res := map[types.TxHash]bool{}
+	for _, tx := range txs {
+		res[tx.Hash()] = true
+	}
+	return res
+}
+
+func TestTxMempool_JournalRestore(t *testing.T) {
+	ctx := t.Context()
+
+	client := &application{Application: kvstore.NewApplication()}
+	cfg := TestConfig()
+	cfg.JournalDir = utils.Some(t.TempDir())
+	txmp := setup(cfg, proxy.New(client), NopTxConstraintsFetcher)
+	require.NoError(t, txmp.RestoreJournal(ctx, 0))
+
+	txs := checkTxs(ctx, t, txmp, 20)
+	rawTxs := make([]types.Tx, len(txs))
+	for i, tx := range txs {
+		rawTxs[i] = tx.tx
+	}
+	responses := make([]*abci.ExecTxResult, 5)
+	for i := range responses {
+		responses[i] = &abci.ExecTxResult{Code: abci.CodeTypeOK}
+	}
+	txmp.Lock()
+	require.NoError(t, txmp.Update(ctx, 1, rawTxs[:5], responses, utils.OrPanic1(txmp.txConstraintsFetcher()), true))
+	txmp.Unlock()
+	want := mempoolTxs(txmp)
+	require.Equal(t, 15, len(want))
+
+	// The transactions left in the mempool come back; the ones included in the block do not.
+	txmp = restart(ctx, t, txmp, 1)
+	require.Equal(t, want, mempoolTxs(txmp))
+
+	// Restored transactions are journaled anew, so they survive another restart.
+	txmp = restart(ctx, t, txmp, 1)
+	require.Equal(t, want, mempoolTxs(txmp))
+
+	// A flushed mempool stays empty.
+	txmp.Flush()
+	txmp = restart(ctx, t, txmp, 1)
+	require.Zero(t, txmp.Size())
+}
+
+func TestTxMempool_JournalRestoreExpiry(t *testing.T) {
+	ctx := t.Context()
+
+	client := &application{Application: kvstore.NewApplication()}
+	cfg := TestConfig()
+	cfg.JournalDir = utils.Some(t.TempDir())
+	cfg.TTLNumBlocks = utils.Some(int64(10))
+	txmp := setup(cfg, proxy.New(client), NopTxConstraintsFetcher)
+	require.NoError(t, txmp.RestoreJournal(ctx, 100))
+	txmp.height = 100
+	_ = checkTxs(ctx, t, txmp, 10)
+
+	// Still within TTLNumBlocks of the height they were admitted at.
+	txmp = restart(ctx, t, txmp, 105)
+	require.Equal(t, 10, txmp.Size())
+
+	// TTLNumBlocks has passed since.
+	txmp = restart(ctx, t, txmp, 120)
+	require.Zero(t, txmp.Size())
+
+	// So has TTLDuration.
+	cfg.TTLNumBlocks = utils.None[int64]()
+	txmp.height = 120
+	_ = checkTxs(ctx, t, txmp, 10)
+	cfg.TTLDuration = utils.Some(time.Nanosecond)
+	txmp = restart(ctx, t, txmp, 120)
+	require.Zero(t, txmp.Size())
+}
+
+func TestJournalRecordRoundTrip(t *testing.T) {
+	records := []journalRecord{
+		{kind: journalAdd, tx: types.Tx("sender=key=1"), timestamp: time.Unix(0, 12345).UTC(), height: 42},
+		{kind: journalRemove, hash: types.Tx("sender=key=1").Hash()},
+		{kind: journalClear},
+	}
+	for _, want := range records {
+		got, err := unmarshalJournalRecord(want.marshal())
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := unmarshalJournalRecord([]byte{byte(journalRemove), 1, 2})
+	require.Error(t, err)
+}
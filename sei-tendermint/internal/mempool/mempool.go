@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/clist"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
@@ -119,6 +120,51 @@ type Config struct {
 	//
 	// See DropUtilisationThreshold and DropPriorityThreshold.
 	DropPriorityReservoirSize int `mapstructure:"drop-priority-reservoir-size"`
+
+	// PriceBump is the minimum percentage by which an EVM transaction's priority
+	// (its gas price) must exceed that of the transaction it replaces, i.e. the
+	// transaction from the same sender with the same nonce. With 0, any higher
+	// priority replaces.
+	PriceBump uint64
+
+	// MaxReadyTxsPerSender, if non-zero, limits the number of ready transactions
+	// an EVM sender may have in the mempool. Transactions already admitted that
+	// become ready when a nonce gap is filled may exceed it until the next block.
+	MaxReadyTxsPerSender int
+
+	// MaxPendingTxsPerSender, if non-zero, limits the number of pending
+	// transactions (nonce-gapped, or not yet affordable) an EVM sender may have in
+	// the mempool.
+	MaxPendingTxsPerSender int
+
+	// EvictLowestPricedTail, if true, keeps the mempool within Size+PendingSize
+	// (and MaxTxsBytes+MaxPendingTxsBytes) on every insertion: to admit a
+	// transaction into a full mempool, the last transaction of the lowest-priced
+	// sender is evicted, provided it is priced below the new one. Otherwise the
+	// mempool may grow to twice these limits before the lowest-priority
+	// transactions are pruned in bulk.
+	EvictLowestPricedTail bool
+
+	// OrderingPolicy names the policy that orders the transactions a proposer
+	// reaps; see OrderingPolicyNames. Compaction and eviction always go by
+	// priority.
+	OrderingPolicy string
+
+	// TimeBoostMax is the most the timeboost ordering policy moves a
+	// transaction ahead of its arrival time, given to the highest priority.
+	TimeBoostMax time.Duration
+
+	// InterleaveSenders, if true, reorders reaped transactions so that EVM
+	// senders take turns, each contributing its next transaction per round.
+	InterleaveSenders bool
+
+	// OrderingAuditSize is the number of recent ordering decisions kept for the
+	// proposal_ordering RPC. With 0, none are recorded.
+	OrderingAuditSize int
+
+	// JournalDir, if set, is the directory of the journal that records admitted and
+	// removed transactions, so that they survive a restart. See RestoreJournal.
+	JournalDir utils.Option[string]
 }
 
 func DefaultConfig() *Config {
@@ -139,6 +185,14 @@ func DefaultConfig() *Config {
 		DropPriorityThreshold:     0.1,
 		DropUtilisationThreshold:  1.0,
 		DropPriorityReservoirSize: 10_240,
+		PriceBump:                 0,
+		MaxReadyTxsPerSender:      0,
+		MaxPendingTxsPerSender:    0,
+		EvictLowestPricedTail:     false,
+		OrderingPolicy:            OrderingPriority,
+		TimeBoostMax:              250 * time.Millisecond,
+		InterleaveSenders:         false,
+		OrderingAuditSize:         16,
 	}
 }
 
@@ -194,6 +248,11 @@ type TxMempool struct {
 	// the mempool via Update().
 	mtx                  sync.RWMutex
 	txConstraintsFetcher TxConstraintsFetcher
+
+	// The journal opened by RestoreJournal. Closed when Run returns.
+	journal utils.Option[*journal]
+	// Records admitted and rejected transactions, if set.
+	recorder utils.Option[*flightrec.Recorder]
 }
 
 func (txmp *TxMempool) Size() int                 { return txmp.txStore.State().total.count }
@@ -226,6 +285,21 @@ func NewTxMempool(
 	return txmp
 }
 
+// SetFlightRecorder starts recording admitted, rejected and evicted transactions to r.
+// It must be called before the mempool is used.
+func (txmp *TxMempool) SetFlightRecorder(r *flightrec.Recorder) {
+	txmp.recorder = utils.Some(r)
+	txmp.txStore.SetFlightRecorder(r)
+}
+
+// record records a mempool event of tx in the flight recorder, if recording is on.
+// describe gets the tx hash and should capture only small values.
+func (txmp *TxMempool) record(kind flightrec.Kind, hash types.TxHash, describe func() string) {
+	if r, ok := txmp.recorder.Get(); ok {
+		r.RecordLazy(kind, func() string { return fmt.Sprintf("tx=%X %s", hash, describe()) })
+	}
+}
+
 func (txmp *TxMempool) Config() *Config   { return txmp.config }
 func (txmp *TxMempool) App() *proxy.Proxy { return txmp.app }
 func (txmp *TxMempool) EvmNextPendingNonce(addr common.Address) uint64 {
@@ -240,6 +314,12 @@ func (txmp *TxMempool) EvmTxByHash(hash common.Hash) (types.Tx, bool) {
 // NOTE: it is NOT the current state of the mempool most of the time.
 func (txmp *TxMempool) RecentSnapshot() types.Txs { return txmp.txStore.RecentSnapshot() }
 
+// OrderingDecisions returns the most recent orders in which transactions were
+// reaped for a proposal, oldest first. See Config.OrderingAuditSize.
+func (txmp *TxMempool) OrderingDecisions() []OrderingDecision {
+	return txmp.txStore.OrderingDecisions()
+}
+
 func (txmp *TxMempool) WaitForTxs(ctx context.Context) error {
 	return txmp.txStore.WaitForTxs(ctx)
 }
@@ -287,6 +367,17 @@ func (txmp *TxMempool) TxsAvailable() <-chan struct{} { return txmp.txsAvailable
 // - The applications' CheckTx implementation may panic.
 // - The caller is not to explicitly require any locks for executing CheckTx.
 func (txmp *TxMempool) CheckTx(ctx context.Context, tx types.Tx) (*abci.ResponseCheckTx, error) {
+	return txmp.checkTx(ctx, tx, time.Now().UTC(), utils.None[int64]())
+}
+
+// checkTx implements CheckTx for a transaction received at timestamp. admittedAt is the
+// height a restored transaction was first admitted at, which its TTL counts from.
+func (txmp *TxMempool) checkTx(
+	ctx context.Context,
+	tx types.Tx,
+	timestamp time.Time,
+	admittedAt utils.Option[int64],
+) (*abci.ResponseCheckTx, error) {
 	txmp.mtx.RLock()
 	defer txmp.mtx.RUnlock()
 
@@ -348,6 +439,8 @@ func (txmp *TxMempool) CheckTx(ctx context.Context, tx types.Tx) (*abci.Response
 		return nil, err
 	}
 	if !res.IsOK() {
+		code := res.Code
+		txmp.record(flightrec.MempoolReject, hTx.Hash(), func() string { return fmt.Sprintf("code=%d", code) })
 		return res.ResponseCheckTx, nil
 	}
 	Global.NumberOfSuccessfulCheckTxsAt().Add(1)
@@ -360,8 +453,8 @@ func (txmp *TxMempool) CheckTx(ctx context.Context, tx types.Tx) (*abci.Response
 	}
 	wtx := &WrappedTx{
 		hashedTx:     hTx,
-		timestamp:    time.Now().UTC(),
-		height:       txmp.height,
+		timestamp:    timestamp,
+		height:       admittedAt.Or(txmp.height),
 		priority:     res.Priority,
 		estimatedGas: estimatedGas,
 		gasWanted:    res.GasWanted,
@@ -381,15 +474,20 @@ func (txmp *TxMempool) CheckTx(ctx context.Context, tx types.Tx) (*abci.Response
 		logger.Info("rejected bad transaction", "priority", wtx.priority, "tx", wtx.Hash(), "post_check_err", err)
 		txmp.txStore.MarkInvalid(hTx.Hash())
 		Global.FailedTxsAt().Add(1)
+		txmp.record(flightrec.MempoolReject, hTx.Hash(), func() string { return fmt.Sprintf("err=%v", err) })
 		return nil, err
 	}
 
 	if err := txmp.txStore.Insert(wtx); err != nil {
 		Global.RejectedTxsAt().Add(1)
+		priority := wtx.priority
+		txmp.record(flightrec.MempoolReject, hTx.Hash(), func() string { return fmt.Sprintf("priority=%d err=%v", priority, err) })
 		return nil, err
 	}
 
 	Global.InsertedTxsAt().Add(1)
+	priority, height := wtx.priority, wtx.height
+	txmp.record(flightrec.MempoolAdmit, hTx.Hash(), func() string { return fmt.Sprintf("priority=%d height=%d", priority, height) })
 	Global.TxSizeBytesAt().Add(int64(wtx.Size())) //nolint:gosec // metric precision is not security-sensitive; overflow is acceptable here
 	Global.SizeAt().Set(int64(txmp.NumTxsNotPending()))
 	Global.PendingSizeAt().Set(int64(txmp.PendingSize()))
@@ -507,10 +605,86 @@ func (txmp *TxMempool) notifyTxsAvailable() {
 	}
 }
 
+// RestoreJournal opens the journal configured by JournalDir, if any, and re-admits the
+// transactions it recorded through CheckTx, in their original admission order. Transactions
+// whose TTL has run out since, counting from when they were first admitted, are dropped.
+// lastHeight is the height of the last committed block. From then on, admitted and removed
+// transactions are journaled until Run returns.
+//
+// Must be called at most once, before Run.
+func (txmp *TxMempool) RestoreJournal(ctx context.Context, lastHeight int64) error {
+	dir, ok := txmp.config.JournalDir.Get()
+	if !ok {
+		return nil
+	}
+	j, err := openJournal(dir)
+	if err != nil {
+		return err
+	}
+	adds, err := j.replay()
+	if err != nil {
+		_ = j.close()
+		return err
+	}
+	firstNew := j.next
+	txmp.journal = utils.Some(j)
+	txmp.txStore.SetJournal(utils.Some(j))
+
+	// Mirror the expiry Update applies with the next block.
+	now := time.Now()
+	restored, expired := 0, 0
+	for _, r := range adds {
+		if d, ok := txmp.config.TTLDuration.Get(); ok && r.timestamp.Before(now.Add(-d)) {
+			expired++
+			continue
+		}
+		if ttl, ok := txmp.config.TTLNumBlocks.Get(); ok && lastHeight+1 > ttl && r.height < lastHeight+1-ttl {
+			expired++
+			continue
+		}
+		res, err := txmp.checkTx(ctx, r.tx, r.timestamp, utils.Some(r.height))
+		if ctx.Err() != nil {
+			txmp.closeJournal()
+			return ctx.Err()
+		}
+		if err != nil || !res.IsOK() {
+			continue
+		}
+		restored++
+	}
+	// The restored transactions were journaled anew, so the old records are no longer needed.
+	if err := j.wal.PruneBefore(firstNew); err != nil {
+		txmp.closeJournal()
+		return fmt.Errorf("prune mempool journal: %w", err)
+	}
+	logger.Info("restored mempool from journal",
+		"journaled", len(adds), "restored", restored, "expired", expired, "rejected", len(adds)-restored-expired)
+	return nil
+}
+
+// closeJournal stops journaling and closes the journal, if there is one.
+func (txmp *TxMempool) closeJournal() {
+	j, ok := txmp.journal.Get()
+	if !ok {
+		return
+	}
+	txmp.txStore.SetJournal(utils.None[*journal]())
+	txmp.journal = utils.None[*journal]()
+	if err := j.close(); err != nil {
+		logger.Error("failed to close mempool journal", "err", err)
+	}
+}
+
 // Run executes mempool background tasks.
 func (txmp *TxMempool) Run(ctx context.Context) error {
+	defer txmp.closeJournal()
 	c, ok := txmp.duplicateTxsCache.Get()
 	if !ok {
+		if txmp.journal.IsPresent() {
+			// Keep the journal open until shutdown.
+			<-ctx.Done()
+			return ctx.Err()
+		}
 		return nil
 	}
 	return scope.Run(ctx, func(ctx context.Context, s scope.Scope) error {
@@ -20,6 +20,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/abci/example/code"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/abci/example/kvstore"
 	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
@@ -291,6 +292,23 @@ func TestTxMempool_Size(t *testing.T) {
 	require.Equal(t, totalRawTxSizeBytes(rawTxs[50:]), txmp.SizeBytes())
 }
 
+func TestTxMempool_FlightRecorder(t *testing.T) {
+	ctx := t.Context()
+
+	client := &application{Application: kvstore.NewApplication()}
+	txmp := setup(TestConfig(), proxy.New(client), NopTxConstraintsFetcher)
+	recorder := flightrec.NewRecorder(10)
+	txmp.SetFlightRecorder(recorder)
+	txs := checkTxs(ctx, t, txmp, 3)
+
+	events := recorder.Events()
+	require.Len(t, events, len(txs))
+	for i, e := range events {
+		require.Equal(t, flightrec.MempoolAdmit, e.Kind)
+		require.True(t, strings.HasPrefix(e.Detail, fmt.Sprintf("tx=%X ", txs[i].tx.Hash())))
+	}
+}
+
 func TestTxMempool_Flush(t *testing.T) {
 	ctx := t.Context()
 
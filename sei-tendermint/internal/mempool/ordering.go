@@ -0,0 +1,199 @@
+package mempool
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// OrderingPolicy decides the order in which a proposer includes the transactions it reaps.
+type OrderingPolicy interface {
+	// Name is the policy's name in the mempool config and in recorded ordering decisions.
+	Name() string
+	// Scores returns the score of each of txs; lower scores are included first. Whatever the scores, an EVM
+	// sender's transactions are included in nonce order, none before the score of a lower nonce allows.
+	Scores(txs []*WrappedTx) []int64
+}
+
+// Ordering policy names.
+const (
+	OrderingPriority  = "priority"
+	OrderingFIFO      = "fifo"
+	OrderingTimeBoost = "timeboost"
+)
+
+var orderingPolicies = map[string]func(cfg *Config) OrderingPolicy{
+	OrderingPriority:  func(*Config) OrderingPolicy { return priorityOrdering{} },
+	OrderingFIFO:      func(*Config) OrderingPolicy { return fifoOrdering{} },
+	OrderingTimeBoost: func(cfg *Config) OrderingPolicy { return timeBoostOrdering{maxBoost: cfg.TimeBoostMax} },
+}
+
+// OrderingPolicyNames returns the names of the available ordering policies, sorted.
+func OrderingPolicyNames() []string {
+	return slices.Sorted(maps.Keys(orderingPolicies))
+}
+
+// NewOrderingPolicy returns the ordering policy cfg.OrderingPolicy names.
+func NewOrderingPolicy(cfg *Config) (OrderingPolicy, error) {
+	newPolicy, ok := orderingPolicies[cfg.OrderingPolicy]
+	if !ok {
+		return nil, fmt.Errorf("unknown ordering policy %q, want one of %v", cfg.OrderingPolicy, OrderingPolicyNames())
+	}
+	return newPolicy(cfg), nil
+}
+
+// priorityOrdering includes the highest priority first, priority being the EVM gas price or the priority
+// CheckTx assigned.
+type priorityOrdering struct{}
+
+func (priorityOrdering) Name() string { return OrderingPriority }
+
+func (priorityOrdering) Scores(txs []*WrappedTx) []int64 {
+	scores := make([]int64, len(txs))
+	for i, wtx := range txs {
+		// ^p orders like -p without overflowing for the lowest priority.
+		scores[i] = ^wtx.priority
+	}
+	return scores
+}
+
+// fifoOrdering includes transactions in the order they arrived.
+type fifoOrdering struct{}
+
+func (fifoOrdering) Name() string { return OrderingFIFO }
+
+func (fifoOrdering) Scores(txs []*WrappedTx) []int64 {
+	scores := make([]int64, len(txs))
+	for i, wtx := range txs {
+		scores[i] = wtx.timestamp.UnixNano()
+	}
+	return scores
+}
+
+// timeBoostOrdering includes transactions in the order they arrived, each moved earlier in proportion to its
+// priority: by maxBoost for the highest priority being ordered, and not at all for priority 0. Paying more buys
+// a bounded head start rather than the front of the block.
+type timeBoostOrdering struct {
+	maxBoost time.Duration
+}
+
+func (timeBoostOrdering) Name() string { return OrderingTimeBoost }
+
+func (p timeBoostOrdering) Scores(txs []*WrappedTx) []int64 {
+	var maxPriority int64
+	for _, wtx := range txs {
+		maxPriority = max(maxPriority, wtx.priority)
+	}
+	scores := make([]int64, len(txs))
+	for i, wtx := range txs {
+		scores[i] = wtx.timestamp.UnixNano()
+		if maxPriority > 0 && wtx.priority > 0 {
+			scores[i] -= int64(float64(p.maxBoost) * float64(wtx.priority) / float64(maxPriority))
+		}
+	}
+	return scores
+}
+
+// orderTxs sorts txs by the policy's scores, keeping each EVM sender's transactions in nonce order: a
+// transaction's effective score is the highest score among it and the sender's lower nonces. accScore carries
+// each sender's highest score so far across calls, so that txs can follow an earlier batch of the same senders.
+// It returns the effective score of every transaction.
+func orderTxs(policy OrderingPolicy, accScore map[common.Address]int64, txs []*WrappedTx) map[*WrappedTx]int64 {
+	slices.SortFunc(txs, func(a, b *WrappedTx) int { return cmp.Compare(a.EVMNonce(), b.EVMNonce()) })
+	raw := policy.Scores(txs)
+	scores := make(map[*WrappedTx]int64, len(txs))
+	for i, wtx := range txs {
+		score := raw[i]
+		if evm, ok := wtx.evm.Get(); ok {
+			if acc, ok := accScore[evm.address]; ok && acc > score {
+				score = acc
+			}
+			accScore[evm.address] = score
+		}
+		scores[wtx] = score
+	}
+	// Stable sort by effective score - it preserves the nonce ordering.
+	slices.SortStableFunc(txs, func(a, b *WrappedTx) int { return cmp.Compare(scores[a], scores[b]) })
+	return scores
+}
+
+// interleaveSenders reorders txs in rounds, each taking the next transaction of every sender with one left,
+// senders in the order of their first transaction. No sender then gets two consecutive slots while another
+// sender waits, which keeps a sender from bracketing someone else's transaction with its own. Non-EVM
+// transactions count as senders of their own.
+func interleaveSenders(txs []*WrappedTx) []*WrappedTx {
+	var queues [][]*WrappedTx
+	bySender := map[common.Address]int{}
+	for _, wtx := range txs {
+		evm, ok := wtx.evm.Get()
+		if !ok {
+			queues = append(queues, []*WrappedTx{wtx})
+			continue
+		}
+		i, ok := bySender[evm.address]
+		if !ok {
+			i = len(queues)
+			bySender[evm.address] = i
+			queues = append(queues, nil)
+		}
+		queues[i] = append(queues[i], wtx)
+	}
+	res := make([]*WrappedTx, 0, len(txs))
+	for len(queues) > 0 {
+		left := queues[:0]
+		for _, q := range queues {
+			res = append(res, q[0])
+			if len(q) > 1 {
+				left = append(left, q[1:])
+			}
+		}
+		queues = left
+	}
+	return res
+}
+
+// OrderingDecision records the order in which a reap returned transactions for a proposal.
+type OrderingDecision struct {
+	// Height is the height of the block being proposed, as of the mempool's last update.
+	Height            int64
+	Time              time.Time
+	Policy            string
+	InterleaveSenders bool
+	// Txs are the reaped transactions in the order they were returned.
+	Txs []OrderedTx
+}
+
+// OrderedTx is a transaction of an OrderingDecision, with the inputs its position was decided on.
+type OrderedTx struct {
+	Hash types.TxHash
+	// Sender and Nonce are set for EVM transactions.
+	Sender    utils.Option[common.Address]
+	Nonce     uint64
+	Priority  int64
+	ArrivedAt time.Time
+	// Score is the transaction's effective score under Policy.
+	Score int64
+}
+
+// orderingLog keeps the most recent ordering decisions.
+type orderingLog struct {
+	size      int
+	decisions []OrderingDecision
+}
+
+func (l *orderingLog) add(d OrderingDecision) {
+	if l.size <= 0 {
+		return
+	}
+	if len(l.decisions) == l.size {
+		l.decisions = append(l.decisions[:0], l.decisions[1:]...)
+	}
+	l.decisions = append(l.decisions, d)
+}
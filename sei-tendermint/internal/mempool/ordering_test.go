@@ -0,0 +1,125 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+)
+
+func reapAll(s *txStore) []*WrappedTx {
+	txs, _ := s.Reap(ReapLimits{}, false)
+	var wtxs []*WrappedTx
+	for _, tx := range txs {
+		wtx, ok := s.ByHash(tx.Hash())
+		if !ok {
+			panic("reaped tx missing from the store")
+		}
+		wtxs = append(wtxs, wtx)
+	}
+	return wtxs
+}
+
+func TestTxStore_OrderingPolicies(t *testing.T) {
+	rng := utils.TestRng()
+	a, b, c := genEvmAddress(rng), genEvmAddress(rng), genEvmAddress(rng)
+	start := time.Now()
+	at := func(wtx *WrappedTx, d time.Duration) *WrappedTx {
+		wtx.timestamp = start.Add(d)
+		return wtx
+	}
+	a0 := at(makeEvmTxForTest(rng, a, 0, 10, 0), 0)
+	b0 := at(makeEvmTxForTest(rng, b, 0, 50, 0), time.Second)
+	c0 := at(makeEvmTxForTest(rng, c, 0, 1, 0), 2*time.Second)
+	a1 := at(makeEvmTxForTest(rng, a, 1, 100, 0), 3*time.Second)
+
+	for _, tc := range []struct {
+		policy string
+		want   []*WrappedTx
+	}{
+		// a1 pays the most but cannot go before a0.
+		{OrderingPriority, []*WrappedTx{b0, a0, a1, c0}},
+		{OrderingFIFO, []*WrappedTx{a0, b0, c0, a1}},
+		// a1 gets the full 2s head start and b0 half of it, which puts b0 level with a0's arrival,
+		// behind a0's own small boost.
+		{OrderingTimeBoost, []*WrappedTx{a0, b0, a1, c0}},
+	} {
+		t.Run(tc.policy, func(t *testing.T) {
+			cfg := TestConfig()
+			cfg.OrderingPolicy = tc.policy
+			cfg.TimeBoostMax = 2 * time.Second
+			txStore := NewTxStore(cfg, proxy.New(newEVMNonceApp()))
+			for _, wtx := range []*WrappedTx{a0, b0, c0, a1} {
+				require.NoError(t, txStore.Insert(wtx))
+			}
+			require.Equal(t, toTxs(tc.want), toTxs(reapAll(txStore)))
+		})
+	}
+}
+
+func TestNewOrderingPolicy_Unknown(t *testing.T) {
+	cfg := TestConfig()
+	cfg.OrderingPolicy = "lifo"
+	_, err := NewOrderingPolicy(cfg)
+	require.Error(t, err)
+}
+
+func TestTxStore_InterleaveSenders(t *testing.T) {
+	rng := utils.TestRng()
+	cfg := TestConfig()
+	cfg.InterleaveSenders = true
+	txStore := NewTxStore(cfg, proxy.New(newEVMNonceApp()))
+	a, b := genEvmAddress(rng), genEvmAddress(rng)
+	a0 := makeEvmTxForTest(rng, a, 0, 100, 0)
+	a1 := makeEvmTxForTest(rng, a, 1, 100, 0)
+	a2 := makeEvmTxForTest(rng, a, 2, 100, 0)
+	b0 := makeEvmTxForTest(rng, b, 0, 10, 0)
+	b1 := makeEvmTxForTest(rng, b, 1, 10, 0)
+	// Not ready: nonce 4 follows a gap.
+	a4 := makeEvmTxForTest(rng, a, 4, 100, 0)
+	for _, wtx := range []*WrappedTx{a0, a1, a2, a4, b0, b1} {
+		require.NoError(t, txStore.Insert(wtx))
+	}
+	require.Equal(t, toTxs([]*WrappedTx{a0, b0, a1, b1, a2}), toTxs(reapAll(txStore)))
+}
+
+func TestTxStore_OrderingDecisions(t *testing.T) {
+	rng := utils.TestRng()
+	cfg := TestConfig()
+	cfg.OrderingAuditSize = 2
+	txStore := NewTxStore(cfg, proxy.New(newEVMNonceApp()))
+	a := genEvmAddress(rng)
+	a0 := makeEvmTxForTest(rng, a, 0, 10, 0)
+	a1 := makeEvmTxForTest(rng, a, 1, 20, 0)
+	require.NoError(t, txStore.Insert(a0))
+	require.NoError(t, txStore.Insert(a1))
+
+	for range 3 {
+		reapAll(txStore)
+	}
+	decisions := txStore.OrderingDecisions()
+	require.Equal(t, 2, len(decisions))
+	d := decisions[1]
+	require.Equal(t, OrderingPriority, d.Policy)
+	require.Equal(t, int64(1), d.Height)
+	require.Equal(t, 2, len(d.Txs))
+	for i, wtx := range []*WrappedTx{a0, a1} {
+		got := d.Txs[i]
+		require.Equal(t, wtx.Hash(), got.Hash)
+		require.Equal(t, utils.Some(a), got.Sender)
+		require.Equal(t, uint64(i), got.Nonce)
+		require.Equal(t, wtx.priority, got.Priority)
+		// a1 may go no earlier than a0.
+		require.Equal(t, ^a0.priority, got.Score)
+	}
+
+	// Nothing is recorded with the audit disabled.
+	cfg = TestConfig()
+	cfg.OrderingAuditSize = 0
+	txStore = NewTxStore(cfg, proxy.New(newEVMNonceApp()))
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, a, 0, 10, 0)))
+	reapAll(txStore)
+	require.Equal(t, 0, len(txStore.OrderingDecisions()))
+}
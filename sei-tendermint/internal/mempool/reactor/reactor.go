@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/clist"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/mempool"
@@ -112,7 +113,8 @@ func (r *Reactor) handleMempoolMessage(ctx context.Context, m p2p.RecvMsg[*pb.Me
 		}
 		protoTxs := msg.Txs.GetTxs()
 		for _, tx := range protoTxs {
-			if _, err := r.mempool.CheckTx(ctx, tx); err != nil {
+			res, err := r.mempool.CheckTx(ctx, tx)
+			if err != nil {
 				r.accountFailedCheckTx(m.From, err)
 				if errors.Is(err, mempool.ErrTxInCache) {
 					// If the tx is in the cache, then we've been gossiped a tx
@@ -131,6 +133,12 @@ func (r *Reactor) handleMempoolMessage(ctx context.Context, m p2p.RecvMsg[*pb.Me
 					"tx", types.Tx(tx).Hash(),
 					"peer", m.From,
 					"err", err)
+				continue
+			}
+			if res.IsOK() {
+				r.router.ReportPeer(m.From, p2p.PeerUsefulTx)
+			} else if isMalformedTx(res) {
+				r.router.ReportPeer(m.From, p2p.PeerInvalidTx)
 			}
 		}
 
@@ -141,6 +149,19 @@ func (r *Reactor) handleMempoolMessage(ctx context.Context, m p2p.RecvMsg[*pb.Me
 	return nil
 }
 
+// Code of the ABCI application's root codespace for transactions that do not decode.
+const (
+	sdkCodespace    = "sdk"
+	sdkCodeTxDecode = 2
+)
+
+// isMalformedTx reports whether CheckTx rejected a tx that no honest peer relays: one that does not
+// decode. Other rejections, such as of a signature, a nonce or a fee, may depend on state that
+// differs between the peer and this node.
+func isMalformedTx(res *abci.ResponseCheckTx) bool {
+	return res.Codespace == sdkCodespace && res.Code == sdkCodeTxDecode
+}
+
 func (r *Reactor) accountFailedCheckTx(nodeID types.NodeID, err error) {
 	if !r.cfg.CheckTxErrorBlacklistEnabled || !errors.Is(err, mempool.ErrTxTooLarge) {
 		return
@@ -0,0 +1,104 @@
+package mempool
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/btree"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+)
+
+// tailIndex orders the txs that no other tx depends on, i.e. the candidates for eviction by
+// EvictLowestPricedTail: non-EVM txs and the highest-nonce tx of each EVM sender.
+// Priorities of indexed txs must not change; compact rebuilds the index along with the others.
+type tailIndex struct {
+	// EVM txs by sender, then nonce. Used to find a sender's next tail once its tail is removed.
+	bySender *btree.BTreeG[*WrappedTx]
+	// Tails by priority, then hash.
+	byPriority *btree.BTreeG[*WrappedTx]
+}
+
+func newTailIndex() *tailIndex {
+	return &tailIndex{
+		bySender:   btree.NewG(2, senderNonceLess),
+		byPriority: btree.NewG(2, priorityLess),
+	}
+}
+
+func senderNonceLess(a, b *WrappedTx) bool {
+	ea, eb := a.evm.OrPanic("non-evm tx"), b.evm.OrPanic("non-evm tx")
+	if c := bytes.Compare(ea.address[:], eb.address[:]); c != 0 {
+		return c < 0
+	}
+	return ea.nonce < eb.nonce
+}
+
+func priorityLess(a, b *WrappedTx) bool {
+	if a.priority != b.priority {
+		return a.priority < b.priority
+	}
+	ha, hb := a.Hash(), b.Hash()
+	return bytes.Compare(ha[:], hb[:]) < 0
+}
+
+// senderTail returns the highest-nonce tx of addr. O(log m).
+func (t *tailIndex) senderTail(addr common.Address) utils.Option[*WrappedTx] {
+	pivot := &WrappedTx{evm: utils.Some(evmTx{address: addr, nonce: math.MaxUint64})}
+	tail := utils.None[*WrappedTx]()
+	t.bySender.DescendLessOrEqual(pivot, func(wtx *WrappedTx) bool {
+		if wtx.evm.OrPanic("non-evm tx").address == addr {
+			tail = utils.Some(wtx)
+		}
+		return false
+	})
+	return tail
+}
+
+// add indexes a tx inserted into the store. O(log m).
+func (t *tailIndex) add(wtx *WrappedTx) {
+	evm, ok := wtx.evm.Get()
+	if !ok {
+		t.byPriority.ReplaceOrInsert(wtx)
+		return
+	}
+	if tail, ok := t.senderTail(evm.address).Get(); ok {
+		if tail.EVMNonce() > evm.nonce {
+			t.bySender.ReplaceOrInsert(wtx)
+			return
+		}
+		t.byPriority.Delete(tail)
+	}
+	t.bySender.ReplaceOrInsert(wtx)
+	t.byPriority.ReplaceOrInsert(wtx)
+}
+
+// remove unindexes a tx removed from the store, promoting the next tail of its sender. O(log m).
+func (t *tailIndex) remove(wtx *WrappedTx) {
+	evm, ok := wtx.evm.Get()
+	if !ok {
+		t.byPriority.Delete(wtx)
+		return
+	}
+	t.bySender.Delete(wtx)
+	if _, wasTail := t.byPriority.Delete(wtx); wasTail {
+		if tail, ok := t.senderTail(evm.address).Get(); ok {
+			t.byPriority.ReplaceOrInsert(tail)
+		}
+	}
+}
+
+// lowest returns the lowest-priority tail, ignoring the tail of exclude. O(log m).
+func (t *tailIndex) lowest(exclude utils.Option[common.Address]) utils.Option[*WrappedTx] {
+	lowest := utils.None[*WrappedTx]()
+	t.byPriority.Ascend(func(wtx *WrappedTx) bool {
+		if evm, ok := wtx.evm.Get(); ok {
+			if addr, ok := exclude.Get(); ok && addr == evm.address {
+				return true
+			}
+		}
+		lowest = utils.Some(wtx)
+		return false
+	})
+	return lowest
+}
@@ -1,15 +1,17 @@
 package mempool
 
 import (
-	"cmp"
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math/big"
 	"slices"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/holiman/uint256"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/clist"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/reservoir"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
@@ -20,7 +22,10 @@ import (
 var errDuplicateTx = errors.New("duplicate tx")
 var errOldNonce = errors.New("nonce too old")
 var errSameNonce = errors.New("tx with this nonce already in mempool")
+var errReplacementUnderpriced = fmt.Errorf("%w: replacement transaction underpriced", errSameNonce)
 var errMempoolFull = errors.New("mempool full")
+var errSenderReadyLimit = errors.New("sender has too many ready txs in mempool")
+var errSenderPendingLimit = errors.New("sender has too many pending txs in mempool")
 
 type evmAddrNonce struct {
 	Address common.Address
@@ -55,6 +60,7 @@ type WrappedTx struct {
 	priority     int64               // ResponseCheckTx.priority
 	timestamp    time.Time           // time at which the transaction was received
 	evm          utils.Option[evmTx] // evm transaction info
+	journalIndex uint64              // index of the transaction's admission record in the journal; 0 if not journaled
 
 	readyEl utils.Option[*clist.CElement[types.Tx]]
 }
@@ -91,8 +97,14 @@ type evmAccount struct {
 	balance    uint256.Int
 	firstNonce uint64
 	nextNonce  uint64
+	// Number of the account's txs in the mempool, ready or pending.
+	txCount int
 }
 
+// readyCount is the number of the account's ready txs: nonces firstNonce to nextNonce-1 are all
+// in the mempool and ready.
+func (a *evmAccount) readyCount() int { return int(a.nextNonce - a.firstNonce) } //nolint:gosec // bounded by txCount
+
 type txCounter struct {
 	count int
 	bytes uint64
@@ -126,14 +138,19 @@ type txStoreInner struct {
 	byEvmHash map[common.Hash]*WrappedTx
 	byNonce   map[evmAddrNonce]*WrappedTx
 	accounts  map[common.Address]*evmAccount
+	tails     *tailIndex
 
 	softLimit txCounter
 	hardLimit txCounter
 	state     utils.AtomicSend[txStoreState]
 
 	// Snapshot of the mempool state: transactions in inclusion order.
-	// Recomputed every time inInclusionOrder is called.
+	// Recomputed every time inOrder is called.
 	snapshot types.Txs
+	// Height of the last block the store was updated with.
+	height int64
+	// Recent ordering decisions of Reap.
+	orderings orderingLog
 	// Cache of known txs, reducess pressure on app. It contains:
 	// * known unconditionally invalid txs
 	// * metadata allowing to do initial tx assessment before calling app.CheckTx
@@ -143,6 +160,10 @@ type txStoreInner struct {
 	// Tracks transactions which already failed execution once
 	// but are eligible for reexecution (not added yet to cache)
 	failedTxs *lruCache[types.TxHash, struct{}]
+	// Records admitted and removed transactions, if journaling is on.
+	journal utils.Option[*journal]
+	// Records evicted transactions, if set.
+	recorder utils.Option[*flightrec.Recorder]
 }
 
 // Properties:
@@ -156,8 +177,9 @@ type txStoreInner struct {
 //   - we reap by highest prio, while respecting nonces.
 //   - non-evm txs are always ready
 type txStore struct {
-	config *Config
-	app    *proxy.Proxy
+	config   *Config
+	app      *proxy.Proxy
+	ordering OrderingPolicy
 
 	inner utils.RWMutex[*txStoreInner]
 	state utils.AtomicRecv[txStoreState]
@@ -171,6 +193,10 @@ type txStore struct {
 }
 
 func NewTxStore(cfg *Config, app *proxy.Proxy) *txStore {
+	ordering, err := NewOrderingPolicy(cfg)
+	if err != nil {
+		panic(err)
+	}
 	softLimit := txCounter{count: cfg.Size + cfg.PendingSize, bytes: utils.Clamp[uint64](cfg.MaxTxsBytes + cfg.MaxPendingTxsBytes)}
 	hardLimit := txCounter{count: 2 * softLimit.count, bytes: 2 * softLimit.bytes}
 	inner := &txStoreInner{
@@ -178,15 +204,18 @@ func NewTxStore(cfg *Config, app *proxy.Proxy) *txStore {
 		byEvmHash: map[common.Hash]*WrappedTx{},
 		byNonce:   map[evmAddrNonce]*WrappedTx{},
 		accounts:  map[common.Address]*evmAccount{},
+		tails:     newTailIndex(),
 		softLimit: softLimit,
 		hardLimit: hardLimit,
 		state:     utils.NewAtomicSend(txStoreState{}),
 		cache:     newLRUCache[types.TxHash, utils.Option[cacheEvm]](cfg.CacheSize),
 		failedTxs: newLRUCache[types.TxHash, struct{}](cfg.CacheSize),
+		orderings: orderingLog{size: cfg.OrderingAuditSize},
 	}
 	return &txStore{
 		config:            cfg,
 		app:               app,
+		ordering:          ordering,
 		inner:             utils.NewRWMutex(inner),
 		state:             inner.state.Subscribe(),
 		readyTxs:          clist.New[types.Tx](),
@@ -203,15 +232,33 @@ func (s *txStore) Clear() {
 		inner.byEvmHash = map[common.Hash]*WrappedTx{}
 		inner.byNonce = map[evmAddrNonce]*WrappedTx{}
 		inner.accounts = map[common.Address]*evmAccount{}
+		inner.tails = newTailIndex()
 		inner.state.Store(txStoreState{})
 		s.readyTxs.Clear()
+		if j, ok := inner.journal.Get(); ok {
+			j.clear()
+		}
+	}
+}
+
+// SetJournal starts journaling admitted and removed transactions to j, or stops journaling if j is None.
+func (s *txStore) SetJournal(j utils.Option[*journal]) {
+	for inner := range s.inner.Lock() {
+		inner.journal = j
+	}
+}
+
+// SetFlightRecorder starts recording evicted transactions to r.
+func (s *txStore) SetFlightRecorder(r *flightrec.Recorder) {
+	for inner := range s.inner.Lock() {
+		inner.recorder = utils.Some(r)
 	}
 }
 
 // Checks if tx should be immediately rejected.
 func (s *txStore) ShouldReject(txHash types.TxHash) bool {
 	for inner := range s.inner.RLock() {
-		return inner.shouldReject(txHash)
+		return s.shouldReject(inner, txHash)
 	}
 	panic("unreachable")
 }
@@ -309,7 +356,33 @@ func (s *txStore) SafeGetTxsForHashes(txHashes []types.TxHash) (types.Txs, []typ
 	return got, missing
 }
 
-func (inner *txStoreInner) shouldReject(txHash types.TxHash) bool {
+// outbids reports whether a tx with the given priority may replace a tx with the same sender and
+// nonce and priority old: it must be higher by at least PriceBump percent.
+func (s *txStore) outbids(priority, old int64) bool {
+	if priority <= old {
+		return false
+	}
+	// priority*100 >= old*(100+PriceBump), without overflowing.
+	lhs := new(big.Int).Mul(big.NewInt(priority), big.NewInt(100))
+	rhs := new(big.Int).Mul(big.NewInt(old), new(big.Int).SetUint64(100+s.config.PriceBump))
+	return lhs.Cmp(rhs) >= 0
+}
+
+// checkSenderLimits returns an error if admitting a tx with a new nonce would take its sender
+// past MaxReadyTxsPerSender or MaxPendingTxsPerSender.
+func (s *txStore) checkSenderLimits(account *evmAccount, evm evmTx) error {
+	ready := account.readyCount()
+	if evm.nonce == account.nextNonce && account.balance.Cmp(&evm.requiredBalance) >= 0 {
+		if limit := s.config.MaxReadyTxsPerSender; limit > 0 && ready >= limit {
+			return errSenderReadyLimit
+		}
+	} else if limit := s.config.MaxPendingTxsPerSender; limit > 0 && account.txCount-ready >= limit {
+		return errSenderPendingLimit
+	}
+	return nil
+}
+
+func (s *txStore) shouldReject(inner *txStoreInner, txHash types.TxHash) bool {
 	// Already in mempool => true
 	if _, ok := inner.byHash[txHash]; ok {
 		return true
@@ -345,12 +418,8 @@ func (inner *txStoreInner) shouldReject(txHash types.TxHash) bool {
 	if oldReady && account.balance.Cmp(&evm.requiredBalance) < 0 {
 		return true
 	}
-	// If the old tx has >= priority, then reject new tx.
-	if old.priority >= evm.priority {
-		return true
-	}
-	// otherwise => false
-	return false
+	// If the new tx does not outbid the old tx, then reject new tx.
+	return !s.outbids(evm.priority, old.priority)
 }
 
 func (s *txStore) insert(inner *txStoreInner, wtx *WrappedTx) error {
@@ -365,7 +434,7 @@ func (s *txStore) insert(inner *txStoreInner, wtx *WrappedTx) error {
 			// TODO(gprusak): consider whether we should move these queries out of the mutex.
 			b := s.app.EvmBalance(evm.address, evm.seiAddress)
 			n := s.app.EvmNonce(evm.address)
-			account = &evmAccount{b, n, n}
+			account = &evmAccount{balance: b, firstNonce: n, nextNonce: n}
 			inner.accounts[evm.address] = account
 		}
 		// Reject transactions with old nonces.
@@ -391,14 +460,17 @@ func (s *txStore) insert(inner *txStoreInner, wtx *WrappedTx) error {
 			if oldReady && account.balance.Cmp(&evm.requiredBalance) < 0 {
 				return errSameNonce
 			}
-			// If the old tx has >= priority, then reject new tx.
-			if old.priority >= wtx.priority {
-				return errSameNonce
+			// If the new tx does not outbid the old tx, then reject new tx.
+			if !s.outbids(wtx.priority, old.priority) {
+				return errReplacementUnderpriced
 			}
 			// Remove the old transaction.
 			delete(inner.byHash, old.Hash())
 			delete(inner.byEvmHash, oldEvm.hash)
+			inner.tails.remove(old)
 			Global.RemovedTxsAt().Add(1)
+			inner.journalRemove(old)
+			inner.recordEvict(old, "replaced")
 			state.total.Dec(old.Size())
 			if el, ok := old.readyEl.Get(); ok {
 				s.readyTxs.Remove(el)
@@ -409,6 +481,11 @@ func (s *txStore) insert(inner *txStoreInner, wtx *WrappedTx) error {
 				s.priorityReservoir.Add(wtx.priority)
 				wtx.readyEl = utils.Some(s.readyTxs.PushBack(wtx.Tx()))
 			}
+		} else {
+			if err := s.checkSenderLimits(account, evm); err != nil {
+				return err
+			}
+			account.txCount += 1
 		}
 		state.total.Inc(wtx.Size())
 		inner.byEvmHash[evm.hash] = wtx
@@ -441,22 +518,48 @@ func (s *txStore) insert(inner *txStoreInner, wtx *WrappedTx) error {
 		}
 	}
 	inner.byHash[wtx.Hash()] = wtx
+	inner.tails.add(wtx)
 	inner.state.Store(state)
 	return nil
 }
 
+// journalRemove journals that wtx left the mempool, if journaling is on.
+func (inner *txStoreInner) journalRemove(wtx *WrappedTx) {
+	if j, ok := inner.journal.Get(); ok {
+		j.remove(wtx)
+	}
+}
+
+// recordEvict records in the flight recorder that wtx was evicted, if recording is on.
+func (inner *txStoreInner) recordEvict(wtx *WrappedTx, reason string) {
+	if r, ok := inner.recorder.Get(); ok {
+		// Capture the fields rather than wtx, which holds the tx bytes.
+		hash, priority := wtx.Hash(), wtx.priority
+		r.RecordLazy(flightrec.MempoolEvict, func() string {
+			return fmt.Sprintf("tx=%X priority=%d reason=%s", hash, priority, reason)
+		})
+	}
+}
+
 // WARNING: works only if wtx has been already inserted.
 func (inner *txStoreInner) isReady(wtx *WrappedTx) bool {
 	evm, ok := wtx.evm.Get()
 	return !ok || evm.nonce < inner.accounts[evm.address].nextNonce
 }
 
-// Sorts transactions in inclusion order. Here we effectively simulate the following:
-// * find account with the highest priority lowest nonce ready transaction and pop this transaction
-// * repeat until no ready transactions are available
-// * then repeat the same but for pending transactions (i.e. again in per-account nonce order, high priority first, just ignoring readiness)
-// Cosmos transactions are all considered ready and from different accounts, so only priority is relevant.
+// Sorts transactions in inclusion order by priority. See inOrder.
 func (inner *txStoreInner) inInclusionOrder() []*WrappedTx {
+	res, _ := inner.inOrder(priorityOrdering{})
+	return res
+}
+
+// Sorts transactions in inclusion order under policy. Here we effectively simulate the following:
+// * find account with the best scoring lowest nonce ready transaction and pop this transaction
+// * repeat until no ready transactions are available
+// * then repeat the same but for pending transactions (i.e. again in per-account nonce order, best score first, just ignoring readiness)
+// Cosmos transactions are all considered ready and from different accounts, so only their score is relevant.
+// Returns the transactions and their effective scores (see orderTxs).
+func (inner *txStoreInner) inOrder(policy OrderingPolicy) ([]*WrappedTx, map[*WrappedTx]int64) {
 	// Split txs into ready and pending.
 	var ready, pending []*WrappedTx
 	for _, wtx := range inner.byHash {
@@ -466,41 +569,31 @@ func (inner *txStoreInner) inInclusionOrder() []*WrappedTx {
 			pending = append(pending, wtx)
 		}
 	}
-	// To achieve the desired txs order, we assign a new priority to each transaction:
-	//   new priority of tx = min over old priorities of all txs with lower or equal nonces of this account
-	// If we just sort all txs by (new priority, nonce) we will obtain the desired ordering.
-	// To compute the new priority we first sort all txs by nonce.
-	// We use accPrio to accumulate min priority of all txs of each account occurred so far.
-	accPrio := make(map[common.Address]int64, len(inner.accounts))
-	for _, txs := range utils.Slice(ready, pending) {
-		slices.SortFunc(txs, func(a, b *WrappedTx) int { return cmp.Compare(a.EVMNonce(), b.EVMNonce()) })
-		txPrio := make(map[*WrappedTx]int64, len(txs))
-		for _, tx := range txs {
-			if evm, ok := tx.evm.Get(); ok {
-				if prio, ok := accPrio[evm.address]; !ok || prio > tx.priority {
-					accPrio[evm.address] = tx.priority
-				}
-				txPrio[tx] = accPrio[evm.address]
-			} else {
-				txPrio[tx] = tx.priority
-			}
-		}
-		// Stable sort by capped priority - it preserves the nonce ordering.
-		slices.SortStableFunc(txs, func(a, b *WrappedTx) int { return -cmp.Compare(txPrio[a], txPrio[b]) })
-	}
+	// To achieve the desired txs order, we assign a new score to each transaction:
+	//   new score of tx = max over old scores of all txs with lower or equal nonces of this account
+	// If we just sort all txs by (new score, nonce) we will obtain the desired ordering.
+	// Pending txs of an account also go no earlier than its ready txs allow.
+	accScore := make(map[common.Address]int64, len(inner.accounts))
+	scores := orderTxs(policy, accScore, ready)
+	maps.Copy(scores, orderTxs(policy, accScore, pending))
 	res := append(ready, pending...)
 	// Update the snapshot.
 	inner.snapshot = make(types.Txs, len(res))
 	for i := range inner.snapshot {
 		inner.snapshot[i] = res[i].Tx()
 	}
-	return res
+	return res, scores
 }
 
 // Inserts a new transaction to txStore.
 // txStore takes ownership of wtx.
 func (s *txStore) Insert(wtx *WrappedTx) error {
 	for inner := range s.inner.Lock() {
+		if s.config.EvictLowestPricedTail {
+			if err := s.makeRoom(inner, wtx); err != nil {
+				return err
+			}
+		}
 		if err := s.insert(inner, wtx); err != nil {
 			return err
 		}
@@ -513,11 +606,73 @@ func (s *txStore) Insert(wtx *WrappedTx) error {
 				return errMempoolFull
 			}
 		}
+		if j, ok := inner.journal.Get(); ok {
+			j.add(wtx)
+		}
 		Global.CacheSizeAt().Set(int64(inner.cache.Size()))
 	}
 	return nil
 }
 
+// makeRoom evicts the lowest-priced tail tx, repeatedly, until wtx fits within softLimit.
+// Fails with errMempoolFull if wtx is not priced above the tx that would be evicted.
+// O(log m) per eviction.
+func (s *txStore) makeRoom(inner *txStoreInner, wtx *WrappedTx) error {
+	if _, ok := inner.byHash[wtx.Hash()]; ok {
+		return nil
+	}
+	sender := utils.None[common.Address]()
+	if evm, ok := wtx.evm.Get(); ok {
+		if _, ok := inner.byNonce[evmAddrNonce{evm.address, evm.nonce}]; ok {
+			// A replacement does not add a tx.
+			return nil
+		}
+		sender = utils.Some(evm.address)
+	}
+	for {
+		total := inner.state.Load().total
+		total.Inc(wtx.Size())
+		if total.LessEqual(&inner.softLimit) {
+			return nil
+		}
+		victim, ok := inner.tails.lowest(sender).Get()
+		if !ok || victim.priority >= wtx.priority {
+			return errMempoolFull
+		}
+		s.evict(inner, victim)
+	}
+}
+
+// evict removes a tail tx, as returned by tailIndex.lowest.
+func (s *txStore) evict(inner *txStoreInner, wtx *WrappedTx) {
+	state := inner.state.Load()
+	ready := inner.isReady(wtx)
+	if evm, ok := wtx.evm.Get(); ok {
+		account := inner.accounts[evm.address]
+		delete(inner.byEvmHash, evm.hash)
+		delete(inner.byNonce, evmAddrNonce{evm.address, evm.nonce})
+		account.txCount -= 1
+		if ready {
+			// wtx was the last ready tx of its sender.
+			account.nextNonce = evm.nonce
+		}
+	}
+	delete(inner.byHash, wtx.Hash())
+	inner.tails.remove(wtx)
+	state.total.Dec(wtx.Size())
+	if ready {
+		state.ready.Dec(wtx.Size())
+	}
+	if el, ok := wtx.readyEl.Get(); ok {
+		s.readyTxs.Remove(el)
+	}
+	inner.state.Store(state)
+	Global.RemovedTxsAt().Add(1)
+	Global.EvictedTxsAt().Add(1)
+	inner.journalRemove(wtx)
+	inner.recordEvict(wtx, "lowest_priced")
+}
+
 // O(m log m), prunes transactions above softLimit and recomputes all the indices.
 func (s *txStore) compact(inner *txStoreInner, clearAccounts bool) {
 	// Order all txs by priority.
@@ -527,11 +682,13 @@ func (s *txStore) compact(inner *txStoreInner, clearAccounts bool) {
 	inner.byHash = map[types.TxHash]*WrappedTx{}
 	inner.byEvmHash = map[common.Hash]*WrappedTx{}
 	inner.byNonce = map[evmAddrNonce]*WrappedTx{}
+	inner.tails = newTailIndex()
 	if clearAccounts {
 		inner.accounts = map[common.Address]*evmAccount{}
 	}
 	for _, account := range inner.accounts {
 		account.nextNonce = account.firstNonce
+		account.txCount = 0
 	}
 	for _, wtx := range wtxs {
 		total := inner.state.Load().total
@@ -541,6 +698,8 @@ func (s *txStore) compact(inner *txStoreInner, clearAccounts bool) {
 		if !limitOk || s.insert(inner, wtx) != nil {
 			Global.RemovedTxsAt().Add(1)
 			Global.EvictedTxsAt().Add(1)
+			inner.journalRemove(wtx)
+			inner.recordEvict(wtx, "full")
 			if el, ok := wtx.readyEl.Get(); ok {
 				s.readyTxs.Remove(el)
 			}
@@ -577,6 +736,7 @@ func (s *txStore) Update(spec updateSpec) {
 		return false
 	}
 	for inner := range s.inner.Lock() {
+		inner.height = spec.Height
 		// Successfully executed txs cannot be reexecuted, so we mark them as invalid.
 		// Failed txs are given a second chance.
 		for txHash, success := range spec.TxResults {
@@ -603,6 +763,14 @@ func (s *txStore) Update(spec updateSpec) {
 				}
 				delete(inner.byHash, txHash)
 				Global.RemovedTxsAt().Add(1)
+				inner.journalRemove(wtx)
+				if !executed {
+					reason := "expired"
+					if invalid {
+						reason = "invalid"
+					}
+					inner.recordEvict(wtx, reason)
+				}
 				if el, ok := wtx.readyEl.Get(); ok {
 					s.readyTxs.Remove(el)
 				}
@@ -614,6 +782,16 @@ func (s *txStore) Update(spec updateSpec) {
 		s.compact(inner, true)
 		otelMetrics.compactTotal.Add(context.Background(), 1, triggerUpdateAttr)
 		otelMetrics.compactDurationSeconds.Record(context.Background(), time.Since(start).Seconds())
+		if j, ok := inner.journal.Get(); ok {
+			// Records older than the oldest transaction left are of no use to a restart.
+			oldestLive := j.next
+			for _, wtx := range inner.byHash {
+				if wtx.journalIndex != 0 {
+					oldestLive = min(oldestLive, wtx.journalIndex)
+				}
+			}
+			j.sync(oldestLive)
+		}
 	}
 }
 
@@ -647,9 +825,20 @@ func (s *txStore) Reap(l ReapLimits, remove bool) (types.Txs, int64) {
 	totalSize := int64(0)
 
 	var wtxs []*WrappedTx
+	var scores map[*WrappedTx]int64
 	for inner := range s.inner.Lock() {
 		if uint64(inner.state.Load().ready.count) >= s.config.TxNotifyThreshold { //nolint:gosec // count is non-negative
-			for _, wtx := range inner.inInclusionOrder() {
+			var ordered []*WrappedTx
+			ordered, scores = inner.inOrder(s.ordering)
+			if s.config.InterleaveSenders {
+				// Ready transactions come first, and only they are reaped.
+				readyCount := 0
+				for readyCount < len(ordered) && inner.isReady(ordered[readyCount]) {
+					readyCount++
+				}
+				ordered = interleaveSenders(ordered[:readyCount])
+			}
+			for _, wtx := range ordered {
 				if uint64(len(wtxs)) >= maxTxs || !inner.isReady(wtx) {
 					break
 				}
@@ -673,6 +862,7 @@ func (s *txStore) Reap(l ReapLimits, remove bool) (types.Txs, int64) {
 			for _, wtx := range wtxs {
 				delete(inner.byHash, wtx.Hash())
 				Global.RemovedTxsAt().Add(1)
+				inner.journalRemove(wtx)
 				if el, ok := wtx.readyEl.Get(); ok {
 					s.readyTxs.Remove(el)
 				}
@@ -685,13 +875,54 @@ func (s *txStore) Reap(l ReapLimits, remove bool) (types.Txs, int64) {
 	}
 
 	// EVM txs go first.
-	var evmTxs, nonEvmTxs types.Txs
+	var evmTxs, nonEvmTxs []*WrappedTx
 	for _, wtx := range wtxs {
 		if wtx.evm.IsPresent() {
-			evmTxs = append(evmTxs, wtx.Tx())
+			evmTxs = append(evmTxs, wtx)
 		} else {
-			nonEvmTxs = append(nonEvmTxs, wtx.Tx())
+			nonEvmTxs = append(nonEvmTxs, wtx)
 		}
 	}
-	return append(evmTxs, nonEvmTxs...), totalGasEstimated
+	wtxs = append(evmTxs, nonEvmTxs...)
+	if len(wtxs) > 0 {
+		s.recordOrdering(wtxs, scores)
+	}
+	txs := make(types.Txs, len(wtxs))
+	for i, wtx := range wtxs {
+		txs[i] = wtx.Tx()
+	}
+	return txs, totalGasEstimated
+}
+
+// recordOrdering records the order in which Reap returned wtxs.
+func (s *txStore) recordOrdering(wtxs []*WrappedTx, scores map[*WrappedTx]int64) {
+	if s.config.OrderingAuditSize <= 0 {
+		return
+	}
+	d := OrderingDecision{
+		Time:              time.Now(),
+		Policy:            s.ordering.Name(),
+		InterleaveSenders: s.config.InterleaveSenders,
+		Txs:               make([]OrderedTx, len(wtxs)),
+	}
+	for i, wtx := range wtxs {
+		tx := OrderedTx{Hash: wtx.Hash(), Priority: wtx.priority, ArrivedAt: wtx.timestamp, Score: scores[wtx]}
+		if evm, ok := wtx.evm.Get(); ok {
+			tx.Sender = utils.Some(evm.address)
+			tx.Nonce = evm.nonce
+		}
+		d.Txs[i] = tx
+	}
+	for inner := range s.inner.Lock() {
+		d.Height = inner.height + 1
+		inner.orderings.add(d)
+	}
+}
+
+// OrderingDecisions returns the recorded ordering decisions, oldest first.
+func (s *txStore) OrderingDecisions() []OrderingDecision {
+	for inner := range s.inner.RLock() {
+		return slices.Clone(inner.orderings.decisions)
+	}
+	panic("unreachable")
 }
@@ -789,3 +789,111 @@ func TestTxStore_InsertCompactionKeepsReadyListInSync(t *testing.T) {
 		require.ElementsMatch(t, toTxs(expected), listed)
 	}
 }
+
+func TestTxStore_ReplacementRequiresPriceBump(t *testing.T) {
+	rng := utils.TestRng()
+	app := newEVMNonceApp()
+	cfg := TestConfig()
+	cfg.PriceBump = 10
+	txStore := NewTxStore(cfg, proxy.New(app))
+	address := genEvmAddress(rng)
+	app.setNonce(address, 7)
+	app.setBalance(address, 100)
+
+	old := makeEvmTxForTest(rng, address, 7, 100, 0)
+	require.NoError(t, txStore.Insert(old))
+
+	// 5% above the old priority is not enough.
+	underpriced := makeEvmTxForTest(rng, address, 7, 105, 0)
+	err := txStore.Insert(underpriced)
+	require.ErrorIs(t, err, errReplacementUnderpriced)
+	require.ErrorIs(t, err, errSameNonce)
+	require.True(t, txStore.ShouldReject(underpriced.Hash()))
+	_, ok := txStore.ByHash(old.Hash())
+	require.True(t, ok)
+
+	replacement := makeEvmTxForTest(rng, address, 7, 110, 0)
+	require.NoError(t, txStore.Insert(replacement))
+	_, ok = txStore.ByHash(old.Hash())
+	require.False(t, ok)
+	_, ok = txStore.ByHash(replacement.Hash())
+	require.True(t, ok)
+	require.Equal(t, 1, txStore.State().ready.count)
+}
+
+func TestTxStore_SenderLimits(t *testing.T) {
+	rng := utils.TestRng()
+	app := newEVMNonceApp()
+	cfg := TestConfig()
+	cfg.MaxReadyTxsPerSender = 2
+	cfg.MaxPendingTxsPerSender = 1
+	txStore := NewTxStore(cfg, proxy.New(app))
+	address := genEvmAddress(rng)
+	app.setNonce(address, 7)
+	app.setBalance(address, 100)
+
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, address, 7, 10, 0)))
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, address, 8, 10, 0)))
+	require.ErrorIs(t, txStore.Insert(makeEvmTxForTest(rng, address, 9, 10, 0)), errSenderReadyLimit)
+
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, address, 11, 10, 0)))
+	require.ErrorIs(t, txStore.Insert(makeEvmTxForTest(rng, address, 12, 10, 0)), errSenderPendingLimit)
+
+	// Replacements do not count against the limits.
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, address, 8, 20, 0)))
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, address, 11, 20, 0)))
+
+	// Other senders have their own limits.
+	other := genEvmAddress(rng)
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, other, 0, 10, 0)))
+
+	require.Equal(t, 4, txStore.State().total.count)
+	require.Equal(t, 3, txStore.State().ready.count)
+}
+
+func TestTxStore_EvictLowestPricedTail(t *testing.T) {
+	rng := utils.TestRng()
+	app := newEVMNonceApp()
+	cfg := TestConfig()
+	cfg.Size = 3
+	cfg.PendingSize = 0
+	cfg.EvictLowestPricedTail = true
+	txStore := NewTxStore(cfg, proxy.New(app))
+	cheap, dear := genEvmAddress(rng), genEvmAddress(rng)
+
+	cheap0 := makeEvmTxForTest(rng, cheap, 0, 10, 0)
+	cheap1 := makeEvmTxForTest(rng, cheap, 1, 50, 0)
+	dear0 := makeEvmTxForTest(rng, dear, 0, 40, 0)
+	for _, wtx := range []*WrappedTx{cheap0, cheap1, dear0} {
+		require.NoError(t, txStore.Insert(wtx))
+	}
+
+	// Priced below every tail, so there is no room for it.
+	lowest := makeEvmTxForTest(rng, genEvmAddress(rng), 0, 30, 0)
+	require.ErrorIs(t, txStore.Insert(lowest), errMempoolFull)
+
+	// The tail of a sender goes first, even if an earlier tx of the sender is priced lower.
+	newcomer := makeEvmTxForTest(rng, genEvmAddress(rng), 0, 55, 0)
+	require.NoError(t, txStore.Insert(newcomer))
+	_, ok := txStore.ByHash(dear0.Hash())
+	require.False(t, ok)
+	for _, wtx := range []*WrappedTx{cheap0, cheap1, newcomer} {
+		_, ok := txStore.ByHash(wtx.Hash())
+		require.True(t, ok)
+	}
+	require.Equal(t, 3, txStore.State().total.count)
+	require.Equal(t, 3, txStore.State().ready.count)
+
+	// Evicting a sender's last ready tx leaves the rest of its txs ready.
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, genEvmAddress(rng), 0, 60, 0)))
+	_, ok = txStore.ByHash(cheap1.Hash())
+	require.False(t, ok)
+	require.Equal(t, 3, txStore.State().ready.count)
+	require.Equal(t, uint64(1), txStore.NextNonce(cheap))
+
+	// The sender's previous tx is its tail now.
+	require.NoError(t, txStore.Insert(makeEvmTxForTest(rng, genEvmAddress(rng), 0, 70, 0)))
+	_, ok = txStore.ByHash(cheap0.Hash())
+	require.False(t, ok)
+	require.Equal(t, uint64(0), txStore.NextNonce(cheap))
+}
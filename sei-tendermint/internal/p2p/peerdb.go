@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"fmt"
 	"iter"
+	"math"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -62,9 +63,28 @@ func (r peerDBRow) ToProto() *p2pproto.PeerInfo {
 	}
 }
 
+func peerScoreFromBytes(buf []byte) (types.NodeID, peerScore, error) {
+	var msg p2pproto.PeerScore
+	if err := proto.Unmarshal(buf, &msg); err != nil {
+		return "", peerScore{}, fmt.Errorf("invalid peer score Protobuf data: %w", err)
+	}
+	id := types.NodeID(msg.ID)
+	if err := id.Validate(); err != nil {
+		return "", peerScore{}, err
+	}
+	if msg.Updated == nil {
+		return "", peerScore{}, fmt.Errorf("missing Updated")
+	}
+	if math.IsNaN(msg.Score) || math.Abs(msg.Score) > maxPeerScore {
+		return "", peerScore{}, fmt.Errorf("score %v out of range", msg.Score)
+	}
+	return id, peerScore{Value: msg.Score, Updated: *msg.Updated}, nil
+}
+
 // Database key prefixes.
 const (
-	prefixPeerInfo int64 = 1
+	prefixPeerInfo  int64 = 1
+	prefixPeerScore int64 = 2
 )
 
 // keyPeerInfo generates a peerInfo database key.
@@ -89,6 +109,28 @@ func keyPeerInfoRange() ([]byte, []byte) {
 	return start, end
 }
 
+// keyPeerScore generates a peerScore database key.
+func keyPeerScore(id types.NodeID) []byte {
+	key, err := orderedcode.Append(nil, prefixPeerScore, string(id))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// keyPeerScoreRange generates start/end keys for the entire peerScore key range.
+func keyPeerScoreRange() ([]byte, []byte) {
+	start, err := orderedcode.Append(nil, prefixPeerScore, "")
+	if err != nil {
+		panic(err)
+	}
+	end, err := orderedcode.Append(nil, prefixPeerScore, orderedcode.Infinity)
+	if err != nil {
+		panic(err)
+	}
+	return start, end
+}
+
 type peerDBRow struct {
 	LastConnected time.Time
 	Addr          NodeAddress
@@ -175,6 +217,55 @@ func (db *peerDB) Insert(addr NodeAddress, lastConnected time.Time) error {
 	return nil
 }
 
+// Scores loads the persisted peer scores.
+// Scores are stored separately from peerDBRows, so that scores of peers which
+// we never connected to (and in particular banned peers) are retained.
+// The number of scores is bounded by peerScores instead.
+func (db *peerDB) Scores() (map[types.NodeID]peerScore, error) {
+	scores := map[types.NodeID]peerScore{}
+	start, end := keyPeerScoreRange()
+	iter, err := db.db.Iterator(start, end)
+	if err != nil {
+		return nil, fmt.Errorf("db.Iterator(): %w", err)
+	}
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		id, score, err := peerScoreFromBytes(iter.Value())
+		if err != nil {
+			// Prune invalid data.
+			if err := db.db.Delete(iter.Key()); err != nil {
+				return nil, fmt.Errorf("failed to delete invalid peer score: %w", err)
+			}
+			continue
+		}
+		scores[id] = score
+	}
+	if iter.Error() != nil {
+		return nil, iter.Error()
+	}
+	return scores, nil
+}
+
+// SetScore persists the score of the peer, or deletes it if score is None.
+func (db *peerDB) SetScore(id types.NodeID, score utils.Option[peerScore]) error {
+	s, ok := score.Get()
+	if !ok {
+		if err := db.db.Delete(keyPeerScore(id)); err != nil {
+			return fmt.Errorf("Delete(): %w", err)
+		}
+		return nil
+	}
+	msg := &p2pproto.PeerScore{ID: string(id), Score: s.Value, Updated: utils.Alloc(s.Updated)}
+	bz, err := msg.Marshal()
+	if err != nil {
+		panic(fmt.Errorf("msg.Marshal(): %w", err))
+	}
+	if err := db.db.Set(keyPeerScore(id), bz); err != nil {
+		return fmt.Errorf("Set(): %w", err)
+	}
+	return nil
+}
+
 func (db *peerDB) truncate() error {
 	var toPrune []types.NodeID
 	db.byLastConnected.Ascend(func(r peerDBRow) bool {
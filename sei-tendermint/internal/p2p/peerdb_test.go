@@ -12,6 +12,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
 )
 
 func justKeys[K comparable, V any](m map[K]V) map[K]bool {
@@ -73,3 +74,33 @@ func TestPeerDB(t *testing.T) {
 		}
 	}
 }
+
+func TestPeerDB_Scores(t *testing.T) {
+	rng := utils.TestRng()
+	db := dbm.NewMemDB()
+	peerDB, err := newPeerDB(db, 10)
+	require.NoError(t, err)
+
+	want := map[types.NodeID]peerScore{}
+	for i := range 20 {
+		id := makeNodeID(rng)
+		want[id] = peerScore{Value: float64(i - 10), Updated: utils.GenTimestamp(rng)}
+		require.NoError(t, peerDB.SetScore(id, utils.Some(want[id])))
+	}
+	for id := range want {
+		require.NoError(t, peerDB.SetScore(id, utils.None[peerScore]()))
+		delete(want, id)
+		break
+	}
+
+	t.Log("scores survive a restart")
+	peerDB, err = newPeerDB(db, 10)
+	require.NoError(t, err)
+	got, err := peerDB.Scores()
+	require.NoError(t, err)
+	require.Equal(t, len(want), len(got))
+	for id, score := range want {
+		require.Equal(t, score.Value, got[id].Value)
+		require.True(t, score.Updated.Equal(got[id].Updated))
+	}
+}
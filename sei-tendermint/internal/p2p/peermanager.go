@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"maps"
 	"slices"
+	"time"
 
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/im"
@@ -79,6 +80,7 @@ type peerManager[C peerConn] struct {
 	options         *RouterOptions
 	isBlockSyncPeer map[types.NodeID]bool
 	isPrivate       map[types.NodeID]bool
+	scores          *peerScores
 
 	inner utils.Watch[*peerManagerInner[C]]
 	// Receiver of the inner.conns. It is copyable and allows accessing connections
@@ -140,6 +142,7 @@ func newPeerManager[C peerConn](selfID types.NodeID, options *RouterOptions) *pe
 		}
 	}
 
+	scores := newPeerScores(options.peerScoreHalfLife())
 	inner := &peerManagerInner[C]{
 		isPersistent: isPersistent,
 		conns:        utils.NewAtomicSend(im.NewMap[connID, C]()),
@@ -158,6 +161,8 @@ func newPeerManager[C peerConn](selfID types.NodeID, options *RouterOptions) *pe
 			InPool: func(id types.NodeID) bool {
 				return id != selfID && !isPersistent[id]
 			},
+			Score:  func(id types.NodeID) float64 { return scores.Score(id, time.Now()) },
+			Banned: func(id types.NodeID) bool { return scores.Banned(id, time.Now()) },
 		}),
 	}
 	return &peerManager[C]{
@@ -165,6 +170,7 @@ func newPeerManager[C peerConn](selfID types.NodeID, options *RouterOptions) *pe
 		options:         options,
 		isBlockSyncPeer: isBlockSyncPeer,
 		isPrivate:       isPrivate,
+		scores:          scores,
 		inner:           utils.NewWatch(inner),
 		conns:           inner.conns.Subscribe(),
 	}
@@ -316,6 +322,34 @@ func (m *peerManager[C]) Evict(id types.NodeID) {
 	}
 }
 
+// Report adjusts the score of the peer for the behavior.
+// Evicts the peer if it got banned. Persistent peers are never banned,
+// since they have been configured explicitly.
+// The dialing order only changes with the score tier, so reports within
+// a tier, like the stream of useful txs, do not touch the peer manager state.
+func (m *peerManager[C]) Report(id types.NodeID, b PeerBehavior) {
+	old, score := m.scores.Report(id, b, time.Now())
+	if scoreTier(old) == scoreTier(score) && score > BanPeerScore {
+		return
+	}
+	for inner, ctrl := range m.inner.Lock() {
+		if inner.isPersistent[id] {
+			return
+		}
+		inner.regular.Rescore(id)
+		ctrl.Updated()
+	}
+	if score <= BanPeerScore {
+		logger.Info("banning peer", "peer", id, "score", score, "behavior", b)
+		m.Evict(id)
+	}
+}
+
+// Scores returns the current scores of peers.
+func (m *peerManager[C]) Scores() map[types.NodeID]float64 {
+	return m.scores.All(time.Now())
+}
+
 func (m *peerManager[C]) IsBlockSyncPeer(id types.NodeID) bool {
 	return len(m.isBlockSyncPeer) == 0 || m.isBlockSyncPeer[id]
 }
@@ -83,6 +83,15 @@ type poolConfig struct {
 	MaxOut     int                     // Maximal number of outbound connections.
 	FixedAddrs []NodeAddress           // Addresses which are always available for dialing.
 	InPool     func(types.NodeID) bool // InPool(id) <=> id belongs to this pool.
+	// Optional. Score(id) is the reputation of the peer. Peers in a higher score
+	// tier are preferred for dialing.
+	Score func(types.NodeID) float64
+	// Optional. Banned(id) <=> peer is not allowed to connect at the moment.
+	Banned func(types.NodeID) bool
+}
+
+func (c *poolConfig) banned(id types.NodeID) bool {
+	return c.Banned != nil && c.Banned(id)
 }
 
 type poolManager struct {
@@ -103,7 +112,7 @@ func newPoolManager(cfg *poolConfig) *poolManager {
 	// PRF defining peer priority.
 	// It makes the global topology converge to an uniformly random graph
 	// of a bounded degree.
-	priority := func(id types.NodeID) uint64 {
+	prf := func(id types.NodeID) uint64 {
 		// NOTE: theoretically it would be more efficient to create a hasher once
 		// (sha256.New), then push seed to it (via hash.Write), then copy the hasher
 		// at every call to priority and push the id afterwards. However the difference
@@ -112,6 +121,10 @@ func newPoolManager(cfg *poolConfig) *poolManager {
 		hash := sha256.Sum256(append([]byte(id), seed[:]...))
 		return binary.LittleEndian.Uint64(hash[:])
 	}
+	priority := prf
+	if cfg.Score != nil {
+		priority = func(id types.NodeID) uint64 { return scorePriority(cfg.Score(id), prf(id)) }
+	}
 	p := &poolManager{
 		cfg:           cfg,
 		priority:      priority,
@@ -213,6 +226,9 @@ func (p *poolManager) TryStartDial() ([]NodeAddress, bool) {
 				if _, ok := p.dialing[addr.NodeID]; ok {
 					continue
 				}
+				if p.cfg.banned(addr.NodeID) {
+					continue
+				}
 				if _, ok := p.dialHistory[addr.NodeID]; ok {
 					clearRecent = true
 					continue
@@ -260,6 +276,14 @@ func (p *poolManager) PushPex(sender utils.Option[types.NodeID], addrs []NodeAdd
 	}
 }
 
+// Rescore updates the priority of an outbound peer after its score has changed,
+// so that the peer is the first to be replaced by an upgrade if it has fallen behind.
+func (p *poolManager) Rescore(id types.NodeID) {
+	if _, ok := p.out[id]; ok {
+		p.out[id] = p.priority(id)
+	}
+}
+
 func (p *poolManager) ClearPex(sender types.NodeID) {
 	delete(p.pex.bySender, sender)
 }
@@ -277,6 +301,7 @@ func (p *poolManager) DialFailed(id types.NodeID) error {
 var errUnexpectedPeer = errors.New("unexpected peer")
 var errTooManyPeers = errors.New("too many peers")
 var errNotInPool = errors.New("peer does not belong to the pool")
+var errBannedPeer = errors.New("peer is banned")
 
 // Connect registers a new connection.
 // Returns an error if the connection was rejected.
@@ -320,6 +345,9 @@ func (p *poolManager) Connect(id connID) (utils.Option[connID], error) {
 		if !p.cfg.InPool(id.NodeID) {
 			return none, errNotInPool
 		}
+		if p.cfg.banned(id.NodeID) {
+			return none, errBannedPeer
+		}
 		p.in[id.NodeID] = struct{}{}
 		return none, nil
 	}
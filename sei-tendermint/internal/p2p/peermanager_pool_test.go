@@ -511,3 +511,31 @@ func TestPoolManager_ClearPex(t *testing.T) {
 		}
 	}
 }
+
+// Test checking that peer scores drive the pool:
+//   - banned peers are not dialed and their inbound connections are rejected.
+//   - peers with higher scores are dialed first.
+func TestPoolManager_Scores(t *testing.T) {
+	rng := utils.TestRng()
+	addrs := utils.GenSliceN(rng, 3, makeAddr)
+	banned, good, neutral := addrs[0].NodeID, addrs[1].NodeID, addrs[2].NodeID
+	scores := map[types.NodeID]float64{banned: -60, good: 20}
+	pool := newPoolManager(&poolConfig{
+		MaxIn:      10,
+		MaxOut:     10,
+		FixedAddrs: addrs,
+		InPool:     inPoolAll,
+		Score:      func(id types.NodeID) float64 { return scores[id] },
+		Banned:     func(id types.NodeID) bool { return scores[id] <= BanPeerScore },
+	})
+	_, err := pool.Connect(connID{banned, false})
+	require.ErrorIs(t, err, errBannedPeer)
+
+	for _, want := range utils.Slice(good, neutral) {
+		got, ok := pool.TryStartDial()
+		require.True(t, ok)
+		require.Equal(t, want, got[0].NodeID)
+	}
+	_, ok := pool.TryStartDial()
+	require.False(t, ok)
+}
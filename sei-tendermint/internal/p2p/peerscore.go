@@ -0,0 +1,229 @@
+package p2p
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// PeerBehavior is an outcome of an exchange with a peer, reported by a reactor
+// to the router. Outcomes adjust the peer's score, which decays back to zero
+// over time. The score drives dialing priority, and peers whose score falls to
+// BanPeerScore are disconnected and neither dialed nor accepted until it decays
+// back above.
+type PeerBehavior int
+
+const (
+	// PeerBadMessage is reported for a malformed or invalid message.
+	// It is what Router.Evict reports.
+	PeerBadMessage PeerBehavior = iota
+	// PeerInvalidTx is reported for a gossiped transaction which does not decode.
+	PeerInvalidTx
+	// PeerBadBlock is reported for a block which failed validation.
+	PeerBadBlock
+	// PeerBadChunk is reported for a snapshot chunk which failed to apply.
+	PeerBadChunk
+	// PeerSlowResponse is reported for a request which timed out.
+	PeerSlowResponse
+	// PeerUsefulTx is reported for a gossiped transaction new to the mempool.
+	PeerUsefulTx
+	// PeerUsefulBlock is reported for a requested block which was applied.
+	PeerUsefulBlock
+	// PeerUsefulChunk is reported for a snapshot chunk which was applied.
+	PeerUsefulChunk
+)
+
+func (b PeerBehavior) String() string {
+	switch b {
+	case PeerBadMessage:
+		return "bad_message"
+	case PeerInvalidTx:
+		return "invalid_tx"
+	case PeerBadBlock:
+		return "bad_block"
+	case PeerBadChunk:
+		return "bad_chunk"
+	case PeerSlowResponse:
+		return "slow_response"
+	case PeerUsefulTx:
+		return "useful_tx"
+	case PeerUsefulBlock:
+		return "useful_block"
+	case PeerUsefulChunk:
+		return "useful_chunk"
+	default:
+		return fmt.Sprintf("PeerBehavior(%d)", int(b))
+	}
+}
+
+// delta is the score adjustment for the behavior.
+// A single bad message, block or chunk gets the peer banned, while
+// it takes a sustained stream of invalid transactions or timeouts.
+func (b PeerBehavior) delta() float64 {
+	switch b {
+	case PeerBadMessage, PeerBadBlock, PeerBadChunk:
+		return -60
+	case PeerInvalidTx:
+		return -2
+	case PeerSlowResponse:
+		return -5
+	case PeerUsefulTx:
+		return 0.1
+	case PeerUsefulBlock, PeerUsefulChunk:
+		return 1
+	default:
+		return 0
+	}
+}
+
+const (
+	// Scores are clamped to [-maxPeerScore,maxPeerScore], so that a long history
+	// of good behavior does not buy a peer immunity from a ban.
+	maxPeerScore = 100.
+	// Peers with score at or below BanPeerScore are banned.
+	BanPeerScore = -50.
+	// Width of the score tiers, which order peers for dialing.
+	// Peers within the same tier are ordered randomly, so that the topology still
+	// converges to a random graph rather than everyone dialing the same top peers.
+	peerScoreTier = 10.
+	// Bound on the number of scores kept. Scores closest to zero are dropped first.
+	maxPeerScores = 1000
+)
+
+// peerScore is a score of a peer as of the Updated time.
+type peerScore struct {
+	Value   float64
+	Updated time.Time
+}
+
+// At returns the score decayed to now.
+func (s peerScore) At(now time.Time, halfLife time.Duration) float64 {
+	dt := now.Sub(s.Updated)
+	if dt <= 0 {
+		return s.Value
+	}
+	return s.Value * math.Exp2(-float64(dt)/float64(halfLife))
+}
+
+// scorePriority combines the dialing priority of a peer with its score:
+// peers in a higher score tier always take priority.
+func scorePriority(score float64, priority uint64) uint64 {
+	return scoreTier(score)<<56 | priority>>8
+}
+
+// scoreTier is the dialing tier of the score.
+func scoreTier(score float64) uint64 {
+	return uint64(math.Floor((score + maxPeerScore) / peerScoreTier)) // nolint:gosec // score is clamped.
+}
+
+type peerScoresInner struct {
+	scores map[types.NodeID]peerScore
+	// Peers with scores updated since the last TakeDirty call.
+	dirty map[types.NodeID]struct{}
+}
+
+// peerScores keeps scores of peers. Scores decay exponentially to zero with the
+// configured half-life, so that peers recover from transient misbehavior and
+// past merits expire.
+type peerScores struct {
+	halfLife time.Duration
+	inner    utils.Mutex[*peerScoresInner]
+}
+
+func newPeerScores(halfLife time.Duration) *peerScores {
+	return &peerScores{
+		halfLife: halfLife,
+		inner: utils.NewMutex(&peerScoresInner{
+			scores: map[types.NodeID]peerScore{},
+			dirty:  map[types.NodeID]struct{}{},
+		}),
+	}
+}
+
+// Load inserts scores loaded from the peer DB.
+func (s *peerScores) Load(scores map[types.NodeID]peerScore) {
+	for inner := range s.inner.Lock() {
+		for id, score := range scores {
+			inner.scores[id] = score
+		}
+		s.truncate(inner, time.Now())
+	}
+}
+
+// Report adjusts the score of the peer for the behavior and returns the score before and after.
+func (s *peerScores) Report(id types.NodeID, b PeerBehavior, now time.Time) (old float64, v float64) {
+	for inner := range s.inner.Lock() {
+		old = inner.scores[id].At(now, s.halfLife)
+		v = max(-maxPeerScore, min(maxPeerScore, old+b.delta()))
+		inner.scores[id] = peerScore{Value: v, Updated: now}
+		inner.dirty[id] = struct{}{}
+		s.truncate(inner, now)
+		return old, v
+	}
+	panic("unreachable")
+}
+
+// Score returns the current score of the peer.
+func (s *peerScores) Score(id types.NodeID, now time.Time) float64 {
+	for inner := range s.inner.Lock() {
+		return inner.scores[id].At(now, s.halfLife)
+	}
+	panic("unreachable")
+}
+
+// Banned checks whether the peer is banned at the moment.
+func (s *peerScores) Banned(id types.NodeID, now time.Time) bool {
+	return s.Score(id, now) <= BanPeerScore
+}
+
+// All returns the current scores of all peers with a score.
+func (s *peerScores) All(now time.Time) map[types.NodeID]float64 {
+	for inner := range s.inner.Lock() {
+		all := make(map[types.NodeID]float64, len(inner.scores))
+		for id, score := range inner.scores {
+			all[id] = score.At(now, s.halfLife)
+		}
+		return all
+	}
+	panic("unreachable")
+}
+
+// TakeDirty returns the scores updated since the last call, for persisting.
+// Scores which were dropped are returned as None.
+func (s *peerScores) TakeDirty() map[types.NodeID]utils.Option[peerScore] {
+	for inner := range s.inner.Lock() {
+		dirty := make(map[types.NodeID]utils.Option[peerScore], len(inner.dirty))
+		for id := range inner.dirty {
+			if score, ok := inner.scores[id]; ok {
+				dirty[id] = utils.Some(score)
+			} else {
+				dirty[id] = utils.None[peerScore]()
+			}
+		}
+		clear(inner.dirty)
+		return dirty
+	}
+	panic("unreachable")
+}
+
+// truncate drops the scores closest to zero, once there are more than maxPeerScores.
+func (s *peerScores) truncate(inner *peerScoresInner, now time.Time) {
+	if len(inner.scores) <= maxPeerScores {
+		return
+	}
+	ids := make([]types.NodeID, 0, len(inner.scores))
+	for id := range inner.scores {
+		ids = append(ids, id)
+	}
+	abs := func(id types.NodeID) float64 { return math.Abs(inner.scores[id].At(now, s.halfLife)) }
+	slices.SortFunc(ids, func(a, b types.NodeID) int { return cmp.Compare(abs(a), abs(b)) })
+	for _, id := range ids[:len(ids)-maxPeerScores] {
+		delete(inner.scores, id)
+		inner.dirty[id] = struct{}{}
+	}
+}
@@ -0,0 +1,82 @@
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+func TestPeerScores_Decay(t *testing.T) {
+	rng := utils.TestRng()
+	const halfLife = time.Hour
+	scores := newPeerScores(halfLife)
+	id := makeNodeID(rng)
+	now := time.Now()
+
+	t.Log("a single bad message gets the peer banned")
+	old, score := scores.Report(id, PeerBadMessage, now)
+	require.Equal(t, 0., old)
+	require.Equal(t, PeerBadMessage.delta(), score)
+	require.True(t, scores.Banned(id, now))
+
+	t.Log("the ban expires as the score decays")
+	require.Equal(t, PeerBadMessage.delta()/2, scores.Score(id, now.Add(halfLife)))
+	require.False(t, scores.Banned(id, now.Add(halfLife)))
+
+	t.Log("scores are clamped")
+	for range 1000 {
+		scores.Report(id, PeerUsefulBlock, now)
+	}
+	require.Equal(t, maxPeerScore, scores.Score(id, now))
+	for range 100 {
+		scores.Report(id, PeerBadBlock, now)
+	}
+	require.Equal(t, -maxPeerScore, scores.Score(id, now))
+
+	t.Log("unknown peers have a neutral score")
+	require.Equal(t, 0., scores.Score(makeNodeID(rng), now))
+}
+
+func TestPeerScores_Truncate(t *testing.T) {
+	rng := utils.TestRng()
+	scores := newPeerScores(time.Hour)
+	now := time.Now()
+	banned := makeNodeID(rng)
+	scores.Report(banned, PeerBadMessage, now)
+	for range maxPeerScores {
+		scores.Report(makeNodeID(rng), PeerUsefulTx, now)
+	}
+	dirty := scores.TakeDirty()
+	require.Equal(t, maxPeerScores+1, len(dirty))
+	require.Equal(t, maxPeerScores, len(scores.All(now)))
+
+	t.Log("the score furthest from zero is retained")
+	require.True(t, scores.Banned(banned, now))
+	var dropped []types.NodeID
+	for id, score := range dirty {
+		if !score.IsPresent() {
+			dropped = append(dropped, id)
+		}
+	}
+	require.Equal(t, 1, len(dropped))
+	require.Equal(t, 0., scores.Score(dropped[0], now))
+
+	t.Log("dirty scores are taken once")
+	require.Equal(t, 0, len(scores.TakeDirty()))
+}
+
+func TestScorePriority(t *testing.T) {
+	rng := utils.TestRng()
+	for range 100 {
+		a, b := rng.Uint64(), rng.Uint64()
+		// Higher score tier wins regardless of the random priority.
+		require.True(t, scorePriority(10, a) > scorePriority(-10, b))
+		// Within the same tier, the random priority decides.
+		if a>>8 != b>>8 {
+			require.Equal(t, a > b, scorePriority(1, a) > scorePriority(2, b))
+		}
+	}
+}
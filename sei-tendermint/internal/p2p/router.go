@@ -83,8 +83,13 @@ func NewRouter(
 		}
 		initialAddrs = append(initialAddrs, addr)
 	}
+	scores, err := peerDB.Scores()
+	if err != nil {
+		return nil, fmt.Errorf("peerDB.Scores(): %w", err)
+	}
 	selfID := privKey.Public().NodeID()
 	peerManager := newPeerManager[*ConnV2](selfID, options)
+	peerManager.scores.Load(scores)
 	// initialAddrs will stay around util pex table fills the whole "extra" cache.
 	if err := peerManager.PushPex(utils.None[types.NodeID](), initialAddrs); err != nil {
 		return nil, fmt.Errorf("peerManager.PushPex(initialAddrs): %w", err)
@@ -337,8 +342,9 @@ func (r *Router) dialPeersRoutine(ctx context.Context) error {
 	})
 }
 
-// storePeersRoutine periodically snapshots the current connection set to disk,
-// so that peers are immediately rediscovered on restart.
+// storePeersRoutine periodically snapshots the current connection set and the
+// updated peer scores to disk, so that peers are immediately rediscovered on restart,
+// and misbehaving peers stay banned.
 func (r *Router) storePeersRoutine(ctx context.Context) error {
 	storeInterval := r.options.peerStoreInterval()
 	for {
@@ -346,7 +352,8 @@ func (r *Router) storePeersRoutine(ctx context.Context) error {
 			// Mark connections as still available.
 			now := time.Now()
 			conns := r.peerManager.Conns()
-			if conns.Len() > 0 {
+			scores := r.peerManager.scores.TakeDirty()
+			if conns.Len() > 0 || len(scores) > 0 {
 				ctrl.Updated()
 			}
 			for _, conn := range conns.All() {
@@ -356,6 +363,11 @@ func (r *Router) storePeersRoutine(ctx context.Context) error {
 					}
 				}
 			}
+			for id, score := range scores {
+				if err := db.SetScore(id, score); err != nil {
+					return fmt.Errorf("db.SetScore(): %w", err)
+				}
+			}
 		}
 		if err := utils.Sleep(ctx, storeInterval); err != nil {
 			return err
@@ -377,12 +389,29 @@ func (r *Router) metricsRoutine(ctx context.Context) error {
 	}
 }
 
-// Evict reports a peer misbehavior and forces peer to be disconnected.
+// Evict forces peer to be disconnected, without affecting its score.
 func (r *Router) Evict(id types.NodeID, err error) {
 	logger.Warn("evicting", "peer", id, "err", err)
 	r.peerManager.Evict(id)
 }
 
+// Disconnect reports the behavior of the peer and forces peer to be disconnected.
+func (r *Router) Disconnect(id types.NodeID, b PeerBehavior, err error) {
+	logger.Warn("evicting", "peer", id, "behavior", b, "err", err)
+	r.peerManager.Report(id, b)
+	r.peerManager.Evict(id)
+}
+
+// ReportPeer records an outcome of an exchange with a peer in the peer's score.
+// A peer whose score falls low enough is banned and disconnected.
+func (r *Router) ReportPeer(id types.NodeID, b PeerBehavior) {
+	r.peerManager.Report(id, b)
+}
+
+// PeerScores returns the current scores of peers.
+// Used by peer_scores endpoint.
+func (r *Router) PeerScores() map[types.NodeID]float64 { return r.peerManager.Scores() }
+
 func (r *Router) IsBlockSyncPeer(id types.NodeID) bool {
 	return r.peerManager.IsBlockSyncPeer(id)
 }
@@ -651,8 +651,11 @@ func TestRouter_EvictPeers(t *testing.T) {
 				NodeID: peerID,
 				Status: PeerStatusUp,
 			})
-			t.Log("Report the peer as bad.")
+			t.Log("Evict the peer.")
 			r.Evict(peerID, errors.New("boom"))
+			if score, ok := r.peerManager.Scores()[peerID]; ok && score != 0 {
+				return fmt.Errorf("Evict changed the peer score to %v", score)
+			}
 			return nil
 		})
 		if err := tcpConn.Run(ctx); utils.IgnoreCancel(err) == nil {
@@ -124,6 +124,11 @@ type RouterOptions struct {
 	// Frequency of dumping connected peers list to the db.
 	// Defaults to 10s.
 	PeerStoreInterval utils.Option[time.Duration]
+
+	// Half-life of the peer scores: the time it takes for a peer to recover
+	// half of its score lost to misbehavior, or to lose half of its merits.
+	// Defaults to 1h.
+	PeerScoreHalfLife utils.Option[time.Duration]
 }
 
 func (o *RouterOptions) maxAccepts() int  { return o.MaxConcurrentAccepts.Or(10) }
@@ -134,6 +139,10 @@ func (o *RouterOptions) peerStoreInterval() time.Duration {
 	return o.PeerStoreInterval.Or(10 * time.Second)
 }
 
+func (o *RouterOptions) peerScoreHalfLife() time.Duration {
+	return o.PeerScoreHalfLife.Or(time.Hour)
+}
+
 // Validate validates the options.
 func (o *RouterOptions) Validate() error {
 	if o.MaxDialRate <= 0 {
@@ -142,6 +151,9 @@ func (o *RouterOptions) Validate() error {
 	if o.MaxAcceptRate <= 0 {
 		return fmt.Errorf("MaxAcceptRate = %v, want > 0", o.MaxAcceptRate)
 	}
+	if d, ok := o.PeerScoreHalfLife.Get(); ok && d <= 0 {
+		return fmt.Errorf("PeerScoreHalfLife = %v, want > 0", d)
+	}
 	for _, addr := range o.BootstrapPeers {
 		if err := addr.Validate(); err != nil {
 			return fmt.Errorf("invalid BoodstrapPeer address %v: %w", addr, err)
@@ -140,6 +140,22 @@ func (env *Environment) DumpConsensusState(ctx context.Context) (*coretypes.Resu
 	}, nil
 }
 
+// DumpFlightRecorder dumps the recent consensus and mempool events kept by the
+// flight recorder, oldest first.
+// UNSTABLE
+// More: https://docs.tendermint.com/master/rpc/#/Info/dump_flight_recorder
+func (env *Environment) DumpFlightRecorder(ctx context.Context) (*coretypes.ResultFlightRecorder, error) {
+	r, err := env.requireFlightRecorder()
+	if err != nil {
+		return nil, err
+	}
+	events, err := r.Dump()
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultFlightRecorder{Events: events}, nil
+}
+
 // ConsensusState returns a concise summary of the consensus state.
 // UNSTABLE
 // More: https://docs.tendermint.com/master/rpc/#/Info/consensus_state
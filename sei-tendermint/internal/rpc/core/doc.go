@@ -18,9 +18,12 @@ curl 'localhost:26657'
 Available endpoints:
 /abci_info
 /dump_consensus_state
+/dump_flight_recorder
 /genesis
 /net_info
 /num_unconfirmed_txs
+/peer_scores
+/proposal_ordering
 /status
 /lag_status
 /health
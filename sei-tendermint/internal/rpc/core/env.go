@@ -17,6 +17,7 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/consensus"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventbus"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventlog"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/mempool"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
@@ -87,6 +88,7 @@ type Environment struct {
 	EventSinks       []indexer.EventSink
 	EventBus         *eventbus.EventBus // thread safe
 	EventLog         utils.Option[*eventlog.Log]
+	FlightRecorder   utils.Option[*flightrec.Recorder]
 	Mempool          utils.Option[*mempool.TxMempool]
 	StateSyncReactor utils.Option[statesync.Reactor]
 
@@ -236,6 +238,13 @@ func (env *Environment) requireEventLog() (*eventlog.Log, error) {
 	return nil, fmt.Errorf("event log is not enabled")
 }
 
+func (env *Environment) requireFlightRecorder() (*flightrec.Recorder, error) {
+	if r, ok := env.FlightRecorder.Get(); ok {
+		return r, nil
+	}
+	return nil, fmt.Errorf("flight recorder is not available")
+}
+
 func (env *Environment) requireEvidencePool() (sm.EvidencePool, error) {
 	if pool, ok := env.EvidencePool.Get(); ok {
 		return pool, nil
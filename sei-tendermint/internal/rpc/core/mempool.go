@@ -293,6 +293,41 @@ func (env *Environment) NumUnconfirmedTxs(ctx context.Context) (*coretypes.Resul
 	}, nil
 }
 
+// ProposalOrdering returns the most recent orders in which the mempool handed
+// transactions to a proposal, oldest first, with the inputs of each decision.
+// UNSTABLE
+func (env *Environment) ProposalOrdering(ctx context.Context) (*coretypes.ResultProposalOrdering, error) {
+	mp, err := env.requireMempool()
+	if err != nil {
+		return nil, err
+	}
+	decisions := mp.OrderingDecisions()
+	res := &coretypes.ResultProposalOrdering{Decisions: make([]coretypes.OrderingDecision, len(decisions))}
+	for i, d := range decisions {
+		txs := make([]coretypes.OrderedTx, len(d.Txs))
+		for j, tx := range d.Txs {
+			txs[j] = coretypes.OrderedTx{
+				Hash:      tx.Hash[:],
+				Nonce:     tx.Nonce,
+				Priority:  tx.Priority,
+				ArrivedAt: tx.ArrivedAt,
+				Score:     tx.Score,
+			}
+			if sender, ok := tx.Sender.Get(); ok {
+				txs[j].Sender = sender.Hex()
+			}
+		}
+		res.Decisions[i] = coretypes.OrderingDecision{
+			Height:            d.Height,
+			Time:              d.Time,
+			Policy:            d.Policy,
+			InterleaveSenders: d.InterleaveSenders,
+			Txs:               txs,
+		}
+	}
+	return res, nil
+}
+
 // CheckTx checks the transaction without executing it. The transaction won't
 // be added to the mempool either.
 // More: https://docs.tendermint.com/master/rpc/#/Tx/check_tx
@@ -1,16 +1,24 @@
 package core
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"maps"
+	"math"
 	"slices"
 
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/coretypes"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
 )
 
+// netInfoScoreBase is the net_info score of a peer with a neutral p2p score. net_info reported a fixed
+// score of 100 before peers were scored, so scores are shifted to keep that scale: 0 to 200, with
+// peers at or below 100+p2p.BanPeerScore banned.
+const netInfoScoreBase = 100
+
 // NetInfo returns network info.
 // More: https://docs.tendermint.com/master/rpc/#/Info/net_info
 func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error) {
@@ -21,6 +29,7 @@ func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo,
 		}
 		peers[addr.NodeID] = coretypes.Peer{ID: addr.NodeID, URL: addr.String()}
 	}
+	scores := env.Router.PeerScores()
 	peerConnections := map[types.NodeID]coretypes.PeerConnection{}
 	for _, info := range env.Router.ConnInfos() {
 		if _, ok := peerConnections[info.ID]; ok {
@@ -29,7 +38,7 @@ func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo,
 		peerConnections[info.ID] = coretypes.PeerConnection{
 			ID:    info.ID,
 			State: "ready,connected",
-			Score: 100,
+			Score: netInfoScoreBase + int(math.Round(scores[info.ID])),
 		}
 	}
 
@@ -42,6 +51,22 @@ func (env *Environment) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo,
 	}, nil
 }
 
+// PeerScores returns the scores of peers, which reflect their past behavior.
+// Peers with a score at or below p2p.BanPeerScore are banned.
+func (env *Environment) PeerScores(ctx context.Context) (*coretypes.ResultPeerScores, error) {
+	if env.Router == nil {
+		return nil, errors.New("p2p router is not available")
+	}
+	scores := []coretypes.PeerScore{}
+	for id, score := range env.Router.PeerScores() {
+		scores = append(scores, coretypes.PeerScore{ID: id, Score: score, Banned: score <= p2p.BanPeerScore})
+	}
+	slices.SortFunc(scores, func(a, b coretypes.PeerScore) int {
+		return cmp.Or(cmp.Compare(a.Score, b.Score), cmp.Compare(a.ID, b.ID))
+	})
+	return &coretypes.ResultPeerScores{Scores: scores}, nil
+}
+
 // Genesis returns genesis file.
 // More: https://docs.tendermint.com/master/rpc/#/Info/genesis
 func (env *Environment) Genesis(ctx context.Context) (*coretypes.ResultGenesis, error) {
@@ -38,6 +38,7 @@ func NewRoutesMap(svc RPCService, opts *RouteOptions) RoutesMap {
 		"status":               rpc.NewRPCFunc(svc.Status),
 		"lag_status":           rpc.NewRPCFunc(svc.LagStatus),
 		"net_info":             rpc.NewRPCFunc(svc.NetInfo),
+		"peer_scores":          rpc.NewRPCFunc(svc.PeerScores),
 		"blockchain":           rpc.NewRPCFunc(svc.BlockchainInfo),
 		"genesis":              rpc.NewRPCFunc(svc.Genesis),
 		"genesis_chunked":      rpc.NewRPCFunc(svc.GenesisChunked),
@@ -53,10 +54,12 @@ func NewRoutesMap(svc RPCService, opts *RouteOptions) RoutesMap {
 		"block_search":         rpc.NewRPCFunc(svc.BlockSearch),
 		"validators":           rpc.NewRPCFunc(svc.Validators),
 		"dump_consensus_state": rpc.NewRPCFunc(svc.DumpConsensusState),
+		"dump_flight_recorder": rpc.NewRPCFunc(svc.DumpFlightRecorder),
 		"consensus_state":      rpc.NewRPCFunc(svc.GetConsensusState),
 		"consensus_params":     rpc.NewRPCFunc(svc.ConsensusParams),
 		"unconfirmed_txs":      rpc.NewRPCFunc(svc.UnconfirmedTxs),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(svc.NumUnconfirmedTxs),
+		"proposal_ordering":    rpc.NewRPCFunc(svc.ProposalOrdering),
 
 		// tx broadcast API
 		"broadcast_tx": rpc.NewRPCFunc(svc.BroadcastTx),
@@ -98,6 +101,7 @@ type RPCService interface {
 	Commit(ctx context.Context, req *coretypes.RequestBlockInfo) (*coretypes.ResultCommit, error)
 	ConsensusParams(ctx context.Context, req *coretypes.RequestConsensusParams) (*coretypes.ResultConsensusParams, error)
 	DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error)
+	DumpFlightRecorder(ctx context.Context) (*coretypes.ResultFlightRecorder, error)
 	Events(ctx context.Context, req *coretypes.RequestEvents) (*coretypes.ResultEvents, error)
 	Genesis(ctx context.Context) (*coretypes.ResultGenesis, error)
 	GenesisChunked(ctx context.Context, req *coretypes.RequestGenesisChunked) (*coretypes.ResultGenesisChunk, error)
@@ -107,6 +111,8 @@ type RPCService interface {
 	Health(ctx context.Context) (*coretypes.ResultHealth, error)
 	NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error)
 	NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error)
+	PeerScores(ctx context.Context) (*coretypes.ResultPeerScores, error)
+	ProposalOrdering(ctx context.Context) (*coretypes.ResultProposalOrdering, error)
 	Status(ctx context.Context) (*coretypes.ResultStatus, error)
 	LagStatus(ctx context.Context) (*coretypes.ResultLagStatus, error)
 	Subscribe(ctx context.Context, req *coretypes.RequestSubscribe) (*coretypes.ResultSubscribe, error)
@@ -493,6 +493,12 @@ func (blockExec *BlockExecutor) SafeGetTxsByHashes(txHashes []types.TxHash) (typ
 	return blockExec.mempool.SafeGetTxsForHashes(txHashes)
 }
 
+// BuildLastCommitInfo returns the DecidedLastCommit that FinalizeBlock is called with for block, for tools
+// that re-execute stored blocks outside of consensus.
+func BuildLastCommitInfo(block *types.Block, store Store, initialHeight int64) abci.CommitInfo {
+	return buildLastCommitInfo(block, store, initialHeight)
+}
+
 func buildLastCommitInfo(block *types.Block, store Store, initialHeight int64) abci.CommitInfo {
 	if block.Height == initialHeight {
 		// there is no last commit for the initial height.
@@ -1,10 +1,21 @@
 /*
 Package indexer defines Tendermint's block and transaction event indexing logic.
 
-Tendermint supports two primary means of block and transaction event indexing:
-
-1. A key-value sink via an embedded database with a proprietary query language.
-2. A Postgres-based sink.
+Tendermint supports these means of block and transaction event indexing:
+
+ 1. A key-value sink via an embedded database with a proprietary query language.
+ 2. A Postgres-based sink.
+ 3. A SQLite-based sink, using the Postgres sink's schema in an embedded database
+    file (see tx-index.sqlite-path). It needs no separate database server, and
+    creates its schema itself. It links SQLite through cgo, so it is only
+    available in binaries built with -tags=sqliteSink.
+ 4. A Parquet export sink, which writes events to one Parquet file per range of
+    tx-index.parquet-blocks-per-file heights under tx-index.parquet-path, with
+    the columns of the block_events and tx_events views.
+
+Like the Postgres sink, the SQLite and Parquet sinks prohibit queries via RPC.
+The reindex-event command fills any of the sinks from the block and state
+stores, for example to export a height range of an existing node to Parquet.
 
 An ABCI application can emit events during block and transaction execution in the form
 
@@ -11,9 +11,11 @@ import (
 type EventSinkType string
 
 const (
-	NULL EventSinkType = "null"
-	KV   EventSinkType = "kv"
-	PSQL EventSinkType = "psql"
+	NULL    EventSinkType = "null"
+	KV      EventSinkType = "kv"
+	PSQL    EventSinkType = "psql"
+	SQLITE  EventSinkType = "sqlite"
+	PARQUET EventSinkType = "parquet"
 )
 
 //go:generate ../../../scripts/mockery_generate.sh EventSink
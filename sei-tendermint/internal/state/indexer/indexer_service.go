@@ -155,7 +155,8 @@ func KVSinkEnabled(sinks []EventSink) bool {
 // IndexingEnabled returns the given eventSinks is supporting the indexing services.
 func IndexingEnabled(sinks []EventSink) bool {
 	for _, sink := range sinks {
-		if sink.Type() == KV || sink.Type() == PSQL {
+		switch sink.Type() {
+		case KV, PSQL, SQLITE, PARQUET:
 			return true
 		}
 	}
@@ -0,0 +1,295 @@
+// Package parquet implements an event sink that exports block and transaction
+// events to Parquet files, one file per range of block heights.
+package parquet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/pubsub/query"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// Row is one row of an exported file: an indexed event attribute, or an event
+// without indexed attributes. It carries the columns of the psql sink's
+// block_events and tx_events views, so the same queries apply to both.
+type Row struct {
+	Height  int64  `parquet:"height,delta"`
+	ChainID string `parquet:"chain_id,dict"`
+	// The transaction the event belongs to. Both are null for block events.
+	TxIndex *int64  `parquet:"index,optional"`
+	TxHash  *string `parquet:"tx_hash,optional"`
+	// The position of the event among those of its block or transaction, which
+	// groups the attributes of one event.
+	EventIndex int64  `parquet:"event_index"`
+	Type       string `parquet:"type,dict"`
+	// Null for an event without indexed attributes.
+	Key          *string `parquet:"key,optional,dict"`
+	CompositeKey *string `parquet:"composite_key,optional,dict"`
+	Value        *string `parquet:"value,optional"`
+	// When the event was written to the sink, in UTC.
+	CreatedAt time.Time `parquet:"created_at,timestamp"`
+}
+
+// EventSink is an indexer backend that exports events to Parquet files in a
+// directory. Each file covers a range of BlocksPerFile heights aligned to a
+// multiple of it, and is named events-<first>-<last>.parquet after the heights
+// it actually holds. Events are buffered in memory until the block that starts
+// the next range is indexed, or the sink stops, and only then written out, so
+// a file never appears half-written.
+//
+// A range cut short by a stop is written as a partial file. When indexing
+// resumes at the next height, the partial file is read back and completed
+// rather than leaving the range split across files.
+//
+// Buffered events are lost if the node exits without stopping the sink, e.g.
+// on a crash: up to BlocksPerFile heights, those of the range being buffered.
+// Indexing then carries on at the next height, leaving a gap that the
+// reindex-event command fills.
+type EventSink struct {
+	dir           string
+	chainID       string
+	blocksPerFile int64
+
+	// Guards the fields below: the indexer service and Stop run concurrently.
+	mtx sync.Mutex
+	// The events of the range being buffered, covering heights [first, last].
+	// Empty when no block has been indexed since the last flush.
+	rows        []Row
+	first, last int64
+	// Events indexed so far at height last, to number the next ones.
+	eventIndex int64
+	// A partial file of the range that rows were read back from. It is removed
+	// once the completed range is written.
+	partial string
+}
+
+// NewEventSink constructs an event sink writing files of blocksPerFile heights
+// to dir, creating dir if it does not exist. Events written to the sink are
+// attributed to the specified chainID.
+func NewEventSink(dir, chainID string, blocksPerFile int64) (*EventSink, error) {
+	if blocksPerFile <= 0 {
+		return nil, fmt.Errorf("blocks per file must be positive, got %d", blocksPerFile)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("creating directory %s: %w", dir, err)
+	}
+	return &EventSink{dir: dir, chainID: chainID, blocksPerFile: blocksPerFile}, nil
+}
+
+// Type returns the structure type for this sink, which is Parquet.
+func (es *EventSink) Type() indexer.EventSinkType { return indexer.PARQUET }
+
+// rangeOf returns the index of the height range containing height.
+func (es *EventSink) rangeOf(height int64) int64 { return (height - 1) / es.blocksPerFile }
+
+// fileName returns the name of the file holding heights [first, last].
+func fileName(first, last int64) string {
+	return fmt.Sprintf("events-%012d-%012d.parquet", first, last)
+}
+
+// IndexBlockEvents buffers the events of the specified block header, part of
+// the indexer.EventSink interface. A block in a different range than the
+// buffered ones, or not directly following them, first flushes the buffer.
+func (es *EventSink) IndexBlockEvents(h types.EventDataNewBlockHeader) error {
+	height := h.Header.Height
+	if height <= 0 {
+		return fmt.Errorf("invalid block height %d", height)
+	}
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+	if len(es.rows) > 0 && (height != es.last+1 || es.rangeOf(height) != es.rangeOf(es.first)) {
+		if err := es.flush(); err != nil {
+			return err
+		}
+	}
+	if len(es.rows) == 0 {
+		if err := es.resume(height); err != nil {
+			return err
+		}
+		if len(es.rows) == 0 {
+			es.first = height
+		}
+	}
+	es.last = height
+	es.eventIndex = 0
+
+	ts := time.Now().UTC()
+	es.appendEvents(height, nil, nil, ts, []abci.Event{
+		makeIndexedEvent(types.BlockHeightKey, fmt.Sprint(height)),
+	})
+	es.appendEvents(height, nil, nil, ts, h.ResultFinalizeBlock.Events)
+	return nil
+}
+
+// IndexTxEvents buffers the events of the specified transaction results, part
+// of the indexer.EventSink interface. Their block must have been indexed just
+// before.
+func (es *EventSink) IndexTxEvents(txrs []*abci.TxResultV2) error {
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+	ts := time.Now().UTC()
+	for _, txr := range txrs {
+		if len(es.rows) == 0 || txr.Height != es.last {
+			return fmt.Errorf("tx at height %d does not belong to the last indexed block", txr.Height)
+		}
+		index := int64(txr.Index)
+		txHash := fmt.Sprintf("%X", types.Tx(txr.Tx).Hash())
+		es.eventIndex = 0
+		es.appendEvents(txr.Height, &index, &txHash, ts, []abci.Event{
+			makeIndexedEvent(types.TxHashKey, txHash),
+			makeIndexedEvent(types.TxHeightKey, fmt.Sprint(txr.Height)),
+		})
+		es.appendEvents(txr.Height, &index, &txHash, ts, txr.Result.Events)
+	}
+	return nil
+}
+
+// appendEvents buffers a row per indexed attribute of evts, or a single row
+// for an event without any.
+func (es *EventSink) appendEvents(height int64, txIndex *int64, txHash *string, ts time.Time, evts []abci.Event) {
+	for _, evt := range evts {
+		// Skip events with an empty type, as the psql sink does.
+		if evt.Type == "" {
+			continue
+		}
+		row := Row{
+			Height:     height,
+			ChainID:    es.chainID,
+			TxIndex:    txIndex,
+			TxHash:     txHash,
+			EventIndex: es.eventIndex,
+			Type:       evt.Type,
+			CreatedAt:  ts,
+		}
+		es.eventIndex++
+		indexed := false
+		for _, attr := range evt.Attributes {
+			if !attr.Index {
+				continue
+			}
+			key, value := string(attr.Key), string(attr.Value)
+			compositeKey := evt.Type + "." + key
+			r := row
+			r.Key, r.CompositeKey, r.Value = &key, &compositeKey, &value
+			es.rows = append(es.rows, r)
+			indexed = true
+		}
+		if !indexed {
+			es.rows = append(es.rows, row)
+		}
+	}
+}
+
+// resume reads back the partial file of height's range that ends just before
+// height, if there is one, so that the range is completed in a single file.
+func (es *EventSink) resume(height int64) error {
+	rangeFirst := es.rangeOf(height)*es.blocksPerFile + 1
+	for first := rangeFirst; first < height; first++ {
+		path := filepath.Join(es.dir, fileName(first, height-1))
+		rows, err := parquet.ReadFile[Row](path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("reading partial file %s: %w", path, err)
+		}
+		es.rows, es.first, es.partial = rows, first, path
+		return nil
+	}
+	return nil
+}
+
+// flush writes the buffered range to its file and empties the buffer.
+func (es *EventSink) flush() error {
+	if len(es.rows) == 0 {
+		return nil
+	}
+	path := filepath.Join(es.dir, fileName(es.first, es.last))
+	if err := writeFile(path, es.rows); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	if es.partial != "" && es.partial != path {
+		if err := os.Remove(es.partial); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("removing partial file %s: %w", es.partial, err)
+		}
+	}
+	es.rows, es.partial = nil, ""
+	return nil
+}
+
+// writeFile writes rows to a Parquet file at path, replacing it atomically.
+func writeFile(path string, rows []Row) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp) //nolint:gosec // path is built from the configured directory
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp) }()
+	w := parquet.NewGenericWriter[Row](f, parquet.Compression(&parquet.Zstd))
+	if _, err := w.Write(rows); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// makeIndexedEvent constructs an event from the specified composite key and
+// value, as the psql sink does for its meta-events.
+func makeIndexedEvent(compositeKey, value string) abci.Event {
+	i := strings.Index(compositeKey, ".")
+	if i < 0 {
+		return abci.Event{Type: compositeKey}
+	}
+	return abci.Event{Type: compositeKey[:i], Attributes: []abci.EventAttribute{
+		{Key: []byte(compositeKey[i+1:]), Value: []byte(value), Index: true},
+	}}
+}
+
+// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]int64, error) {
+	return nil, errors.New("block search is not supported via the parquet event sink")
+}
+
+// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query, opts indexer.SearchOptions) ([]*abci.TxResultV2, error) {
+	return nil, errors.New("tx search is not supported via the parquet event sink")
+}
+
+// GetTxByHash is not implemented by this sink, and reports an error for all queries.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResultV2, error) {
+	return nil, errors.New("getTxByHash is not supported via the parquet event sink")
+}
+
+// HasBlock is not implemented by this sink, and reports an error for all queries.
+func (es *EventSink) HasBlock(h int64) (bool, error) {
+	return false, errors.New("hasBlock is not supported via the parquet event sink")
+}
+
+// Stop writes out the buffered events, leaving a partial file if the range is
+// incomplete.
+func (es *EventSink) Stop() error {
+	es.mtx.Lock()
+	defer es.mtx.Unlock()
+	return es.flush()
+}
@@ -0,0 +1,148 @@
+package parquet
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// Verify that the type satisfies the EventSink interface.
+var _ indexer.EventSink = (*EventSink)(nil)
+
+const chainID = "test-chainID"
+
+// indexBlock indexes a block at height with one transaction.
+func indexBlock(t *testing.T, es *EventSink, height int64) {
+	t.Helper()
+	require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: height},
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+			Events: []abci.Event{makeIndexedEvent("finalize_event.proposer", "FCAA001")},
+		},
+	}))
+	require.NoError(t, es.IndexTxEvents([]*abci.TxResultV2{{
+		Height: height,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{Events: []abci.Event{
+			makeIndexedEvent("account.owner", "Ivan"),
+			{Type: "ping"},
+		}},
+	}}))
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	return names
+}
+
+func TestRollsFilesByHeightRange(t *testing.T) {
+	dir := t.TempDir()
+	es, err := NewEventSink(dir, chainID, 2)
+	require.NoError(t, err)
+	for height := int64(1); height <= 5; height++ {
+		indexBlock(t, es, height)
+	}
+	// The range of height 5 is still buffered.
+	require.Equal(t, []string{fileName(1, 2), fileName(3, 4)}, listFiles(t, dir))
+	require.NoError(t, es.Stop())
+	require.Equal(t, []string{fileName(1, 2), fileName(3, 4), fileName(5, 5)}, listFiles(t, dir))
+
+	rows, err := parquet.ReadFile[Row](filepath.Join(dir, fileName(1, 2)))
+	require.NoError(t, err)
+	// Per height: block.height and finalize_event.proposer for the block, and
+	// tx.hash, tx.height, account.owner and the attribute-less ping for the tx.
+	require.Len(t, rows, 12)
+	block, tx := rows[1], rows[4]
+	require.Equal(t, int64(1), block.Height)
+	require.Nil(t, block.TxIndex)
+	require.Equal(t, "finalize_event.proposer", *block.CompositeKey)
+	require.Equal(t, "FCAA001", *block.Value)
+	require.Equal(t, int64(0), *tx.TxIndex)
+	require.Equal(t, "account.owner", *tx.CompositeKey)
+	require.Equal(t, int64(2), tx.EventIndex)
+	require.Equal(t, chainID, tx.ChainID)
+	require.Equal(t, "ping", rows[5].Type)
+	require.Nil(t, rows[5].Key)
+
+	// Resuming mid-range completes the partial file rather than splitting the range.
+	es, err = NewEventSink(dir, chainID, 2)
+	require.NoError(t, err)
+	indexBlock(t, es, 6)
+	require.NoError(t, es.Stop())
+	require.Equal(t, []string{fileName(1, 2), fileName(3, 4), fileName(5, 6)}, listFiles(t, dir))
+	rows, err = parquet.ReadFile[Row](filepath.Join(dir, fileName(5, 6)))
+	require.NoError(t, err)
+	require.Len(t, rows, 12)
+	require.Equal(t, int64(5), rows[0].Height)
+	require.Equal(t, int64(6), rows[len(rows)-1].Height)
+}
+
+// heights returns the distinct heights of the rows of the file at path, in order.
+func heights(t *testing.T, path string) []int64 {
+	t.Helper()
+	rows, err := parquet.ReadFile[Row](path)
+	require.NoError(t, err)
+	var hs []int64
+	for _, row := range rows {
+		if len(hs) == 0 || hs[len(hs)-1] != row.Height {
+			hs = append(hs, row.Height)
+		}
+	}
+	return hs
+}
+
+func TestResumesAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	es, err := NewEventSink(dir, chainID, 4)
+	require.NoError(t, err)
+	indexBlock(t, es, 1)
+	indexBlock(t, es, 2)
+	require.NoError(t, es.Stop())
+	require.Equal(t, []string{fileName(1, 2)}, listFiles(t, dir))
+
+	// A restarted node completes the partial range, and removes the partial file.
+	es, err = NewEventSink(dir, chainID, 4)
+	require.NoError(t, err)
+	for height := int64(3); height <= 5; height++ {
+		indexBlock(t, es, height)
+	}
+	require.Equal(t, []string{fileName(1, 4)}, listFiles(t, dir))
+	require.Equal(t, []int64{1, 2, 3, 4}, heights(t, filepath.Join(dir, fileName(1, 4))))
+
+	// A crash loses the buffered range, and indexing carries on past the gap.
+	es, err = NewEventSink(dir, chainID, 4)
+	require.NoError(t, err)
+	indexBlock(t, es, 6)
+	require.NoError(t, es.Stop())
+	require.Equal(t, []string{fileName(1, 4), fileName(6, 6)}, listFiles(t, dir))
+	require.Equal(t, []int64{6}, heights(t, filepath.Join(dir, fileName(6, 6))))
+
+	// Resuming at a height that does not follow the partial file starts a new one.
+	es, err = NewEventSink(dir, chainID, 4)
+	require.NoError(t, err)
+	indexBlock(t, es, 8)
+	require.NoError(t, es.Stop())
+	require.Equal(t, []string{fileName(1, 4), fileName(6, 6), fileName(8, 8)}, listFiles(t, dir))
+}
+
+func TestRejectsTxsOfOtherBlocks(t *testing.T) {
+	es, err := NewEventSink(t.TempDir(), chainID, 10)
+	require.NoError(t, err)
+	require.Error(t, es.IndexTxEvents([]*abci.TxResultV2{{Height: 1}}))
+	indexBlock(t, es, 1)
+	require.Error(t, es.IndexTxEvents([]*abci.TxResultV2{{Height: 2}}))
+	require.NoError(t, es.Stop())
+}
@@ -8,7 +8,9 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/kv"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/null"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/parquet"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/psql"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer/sink/sqlite"
 )
 
 // EventSinksFromConfig constructs a slice of indexer.EventSink using the provided
@@ -57,6 +59,20 @@ func EventSinksFromConfig(cfg *config.Config, dbProvider config.DBProvider, chai
 				return nil, err
 			}
 			eventSinks = append(eventSinks, es)
+
+		case indexer.SQLITE:
+			es, err := sqlite.NewEventSink(cfg.TxIndex.SqliteFile(), chainID)
+			if err != nil {
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
+
+		case indexer.PARQUET:
+			es, err := parquet.NewEventSink(cfg.TxIndex.ParquetDir(), chainID, cfg.TxIndex.ParquetBlocksPerFile)
+			if err != nil {
+				return nil, err
+			}
+			eventSinks = append(eventSinks, es)
 		default:
 			return nil, errors.New("unsupported event sink type")
 		}
@@ -0,0 +1,6 @@
+// Package sqlite implements an event sink backed by an embedded SQLite
+// database, using the schema of the psql sink.
+//
+// The SQLite driver links the SQLite C library through cgo, so the sink is
+// only built with -tags=sqliteSink; without it NewEventSink returns an error.
+package sqlite
@@ -0,0 +1,13 @@
+//go:build !sqliteSink
+
+package sqlite
+
+import (
+	"errors"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
+)
+
+func NewEventSink(_, _ string) (indexer.EventSink, error) {
+	return nil, errors.New("sqlite event sink not available: rebuild with -tags=sqliteSink")
+}
@@ -0,0 +1,106 @@
+//go:build sqliteSink
+
+package sqlite
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/state/indexer"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// Verify that the type satisfies the EventSink interface.
+var _ indexer.EventSink = (*EventSink)(nil)
+
+const chainID = "test-chainID"
+
+func newTestSink(t *testing.T) *EventSink {
+	t.Helper()
+	es, err := NewEventSink(filepath.Join(t.TempDir(), "data", "tx_index.sqlite"), chainID)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = es.Stop() })
+	return es
+}
+
+func TestType(t *testing.T) {
+	assert.Equal(t, indexer.SQLITE, newTestSink(t).Type())
+}
+
+func TestIndexing(t *testing.T) {
+	es := newTestSink(t)
+
+	require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: 1},
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{
+			Events: []abci.Event{
+				makeIndexedEvent("finalize_event.proposer", "FCAA001"),
+				makeIndexedEvent("thingy.whatzit", "O.O"),
+			},
+		},
+	}))
+	txResult := &abci.TxResultV2{
+		Height: 1,
+		Tx:     types.Tx("HELLO WORLD"),
+		Result: abci.ExecTxResult{
+			Code: abci.CodeTypeOK,
+			Events: []abci.Event{
+				makeIndexedEvent("account.number", "1"),
+				makeIndexedEvent("account.owner", "Ivan"),
+				{Type: "", Attributes: []abci.EventAttribute{{Key: []byte("not_allowed"), Value: []byte("Vlad"), Index: true}}},
+			},
+		},
+	}
+	require.NoError(t, es.IndexTxEvents([]*abci.TxResultV2{txResult}))
+
+	// Reindexing the same block and transaction quietly succeeds.
+	require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockHeader{Header: types.Header{Height: 1}}))
+	require.NoError(t, es.IndexTxEvents([]*abci.TxResultV2{txResult}))
+
+	// The views of the psql schema answer the same queries.
+	var value string
+	require.NoError(t, es.DB().QueryRow(
+		`SELECT value FROM block_events WHERE height = ? AND composite_key = ?;`,
+		1, "thingy.whatzit").Scan(&value))
+	assert.Equal(t, "O.O", value)
+
+	var count int
+	require.NoError(t, es.DB().QueryRow(
+		`SELECT COUNT(*) FROM tx_events WHERE height = ? AND type = ?;`, 1, "account").Scan(&count))
+	assert.Equal(t, 2, count)
+	require.NoError(t, es.DB().QueryRow(`SELECT COUNT(*) FROM `+tableTxResults+`;`).Scan(&count))
+	assert.Equal(t, 1, count)
+
+	var resultData []byte
+	require.NoError(t, es.DB().QueryRow(
+		`SELECT tx_result FROM `+tableTxResults+` WHERE tx_hash = ?;`,
+		fmt.Sprintf("%X", types.Tx(txResult.Tx).Hash())).Scan(&resultData))
+	txr := new(abci.TxResult)
+	require.NoError(t, proto.Unmarshal(resultData, txr))
+	assert.Equal(t, txResult.Tx, txr.Tx)
+
+	// A transaction of a block that was never indexed is rejected.
+	require.Error(t, es.IndexTxEvents([]*abci.TxResultV2{{Height: 2, Tx: types.Tx("LOST")}}))
+}
+
+func TestReopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tx_index.sqlite")
+	es, err := NewEventSink(path, chainID)
+	require.NoError(t, err)
+	require.NoError(t, es.IndexBlockEvents(types.EventDataNewBlockHeader{Header: types.Header{Height: 7}}))
+	require.NoError(t, es.Stop())
+
+	// Reopening keeps the schema and the rows.
+	es, err = NewEventSink(path, chainID)
+	require.NoError(t, err)
+	defer es.Stop()
+	var height int64
+	require.NoError(t, es.DB().QueryRow(`SELECT height FROM `+tableBlocks+`;`).Scan(&height))
+	assert.Equal(t, int64(7), height)
+}
@@ -0,0 +1,312 @@
+package statesync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+)
+
+// archivePeerID is the sender of snapshots and chunks fetched from a snapshot archive.
+const archivePeerID = "archive"
+
+const (
+	archiveIndexPath     = "index.json"
+	archiveManifestsPath = "manifests"
+	archiveChunksPath    = "chunks"
+
+	// archiveRequestTimeout bounds each request to an HTTP snapshot archive.
+	archiveRequestTimeout = 2 * time.Minute
+	// maxArchiveObjectSize bounds the size of objects fetched from an HTTP snapshot archive.
+	// Chunks are bounded like those served by peers.
+	maxArchiveObjectSize = chunkMsgSize
+)
+
+// errArchiveNotFound is returned by archive backends for missing objects.
+var errArchiveNotFound = errors.New("not found in snapshot archive")
+
+// SnapshotManifest describes a snapshot published to a snapshot archive.
+// Chunks are content-addressed by their sha256 hash, so that a chunk fetched
+// from an untrusted archive can be checked against the manifest. The manifest
+// itself is untrusted too: the restored state is verified against the app hash
+// obtained through the light client.
+type SnapshotManifest struct {
+	Height   uint64 `json:"height"`
+	Format   uint32 `json:"format"`
+	Hash     []byte `json:"hash"`
+	Metadata []byte `json:"metadata"`
+	// Chunks are the hex-encoded sha256 hashes of the snapshot chunks, in order.
+	Chunks []string `json:"chunks"`
+}
+
+func (m *SnapshotManifest) path() string {
+	return fmt.Sprintf("%s/%d-%d.json", archiveManifestsPath, m.Height, m.Format)
+}
+
+func (m *SnapshotManifest) snapshot() (*snapshot, error) {
+	hashes := make([][]byte, len(m.Chunks))
+	for i, h := range m.Chunks {
+		hash, err := hex.DecodeString(h)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("invalid hash of chunk %d: %q", i, h)
+		}
+		hashes[i] = hash
+	}
+	return &snapshot{
+		Height:      m.Height,
+		Format:      m.Format,
+		Chunks:      uint32(len(m.Chunks)), //nolint:gosec // the number of chunks is bounded by the app.
+		Hash:        m.Hash,
+		Metadata:    m.Metadata,
+		chunkHashes: hashes,
+	}, nil
+}
+
+// snapshotIndex lists the manifests published to a snapshot archive.
+type snapshotIndex struct {
+	Manifests []string `json:"manifests"`
+}
+
+// archiveBackend is an object store holding a snapshot archive.
+type archiveBackend interface {
+	get(ctx context.Context, path string) ([]byte, error)
+	put(ctx context.Context, path string, data []byte) error
+}
+
+// SnapshotArchive is a snapshot distribution point, which nodes can state sync
+// from without depending on peers serving snapshots. It is either a local
+// directory or an HTTP server such as a public-read S3 bucket. Requests are not
+// signed, so publishing over HTTP requires a server accepting anonymous PUTs;
+// otherwise publish to a directory and upload it with the object store tools.
+// Layout relative to the root:
+//
+//	index.json                        - list of published manifests
+//	manifests/<height>-<format>.json  - SnapshotManifest of each snapshot
+//	chunks/<sha256 hex>               - chunk contents
+type SnapshotArchive struct {
+	backend archiveBackend
+}
+
+// OpenSnapshotArchive opens the snapshot archive at location, which is either
+// the http(s) URL of a publicly readable bucket or a local directory.
+func OpenSnapshotArchive(location string) (*SnapshotArchive, error) {
+	if location == "" {
+		return nil, errors.New("empty snapshot archive location")
+	}
+	if u, err := url.Parse(location); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		u.Path = strings.TrimSuffix(u.Path, "/")
+		return &SnapshotArchive{backend: &httpArchive{base: u, client: &http.Client{Timeout: archiveRequestTimeout}}}, nil
+	}
+	return &SnapshotArchive{backend: dirArchive(strings.TrimPrefix(location, "file://"))}, nil
+}
+
+// checkArchived checks that the snapshot lists the hash of each of its chunks,
+// which is needed to fetch them from an archive.
+func checkArchived(s *snapshot) error {
+	if len(s.chunkHashes) != int(s.Chunks) {
+		return fmt.Errorf("snapshot at height %d lists %d chunk hashes for %d chunks",
+			s.Height, len(s.chunkHashes), s.Chunks)
+	}
+	return nil
+}
+
+// Snapshots returns the manifests of the published snapshots.
+// Manifests which cannot be fetched or decoded are logged and skipped,
+// so that one bad upload does not hide the other snapshots.
+func (a *SnapshotArchive) Snapshots(ctx context.Context) ([]*SnapshotManifest, error) {
+	idx, err := a.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var manifests []*SnapshotManifest
+	for _, path := range idx.Manifests {
+		raw, err := a.backend.get(ctx, path)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			logger.Error("skipping snapshot manifest", "path", path, "err", err)
+			continue
+		}
+		var m SnapshotManifest
+		if err := json.Unmarshal(raw, &m); err != nil {
+			logger.Error("skipping invalid snapshot manifest", "path", path, "err", err)
+			continue
+		}
+		manifests = append(manifests, &m)
+	}
+	return manifests, nil
+}
+
+// Chunk fetches the content of the chunk with the given hash.
+func (a *SnapshotArchive) Chunk(ctx context.Context, hash []byte) ([]byte, error) {
+	data, err := a.backend.get(ctx, archiveChunksPath+"/"+hex.EncodeToString(hash))
+	if err != nil {
+		return nil, err
+	}
+	if got := sha256.Sum256(data); !bytes.Equal(got[:], hash) {
+		return nil, fmt.Errorf("chunk hash mismatch: expected %X, got %X", hash, got)
+	}
+	return data, nil
+}
+
+// Publish uploads the snapshot, with chunks provided by loadChunk, and adds it
+// to the index. Chunks are uploaded before the manifest, and the manifest
+// before the index, so that readers never observe a partially published
+// snapshot. Publishing is not safe for concurrent use with other publishers
+// of the same archive.
+func (a *SnapshotArchive) Publish(
+	ctx context.Context,
+	s *abci.Snapshot,
+	loadChunk func(index uint32) ([]byte, error),
+) (*SnapshotManifest, error) {
+	m := &SnapshotManifest{
+		Height:   s.Height,
+		Format:   s.Format,
+		Hash:     s.Hash,
+		Metadata: s.Metadata,
+		Chunks:   make([]string, 0, s.Chunks),
+	}
+	for i := range s.Chunks {
+		data, err := loadChunk(i)
+		if err != nil {
+			return nil, fmt.Errorf("loadChunk(%d): %w", i, err)
+		}
+		hash := sha256.Sum256(data)
+		m.Chunks = append(m.Chunks, hex.EncodeToString(hash[:]))
+		if err := a.backend.put(ctx, archiveChunksPath+"/"+m.Chunks[i], data); err != nil {
+			return nil, fmt.Errorf("put(chunk %d): %w", i, err)
+		}
+	}
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.backend.put(ctx, m.path(), raw); err != nil {
+		return nil, fmt.Errorf("put(%q): %w", m.path(), err)
+	}
+	idx, err := a.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(idx.Manifests, m.path()) {
+		idx.Manifests = append(idx.Manifests, m.path())
+	}
+	raw, err = json.Marshal(idx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.backend.put(ctx, archiveIndexPath, raw); err != nil {
+		return nil, fmt.Errorf("put(%q): %w", archiveIndexPath, err)
+	}
+	return m, nil
+}
+
+// index returns the archive index. A missing index means an empty archive.
+func (a *SnapshotArchive) index(ctx context.Context) (*snapshotIndex, error) {
+	raw, err := a.backend.get(ctx, archiveIndexPath)
+	if errors.Is(err, errArchiveNotFound) {
+		return &snapshotIndex{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get(%q): %w", archiveIndexPath, err)
+	}
+	var idx snapshotIndex
+	if err := json.Unmarshal(raw, &idx); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", archiveIndexPath, err)
+	}
+	return &idx, nil
+}
+
+// dirArchive is a snapshot archive in a local directory.
+type dirArchive string
+
+func (d dirArchive) get(_ context.Context, path string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(string(d), filepath.FromSlash(path)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", path, errArchiveNotFound)
+	}
+	return data, err
+}
+
+func (d dirArchive) put(_ context.Context, path string, data []byte) error {
+	full := filepath.Join(string(d), filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(full), 0o750); err != nil {
+		return err
+	}
+	// Write to a temporary file and rename, so that readers never see a partial object.
+	tmp := full + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, full)
+}
+
+// httpArchive is a snapshot archive served over HTTP, e.g. by a public-read S3
+// bucket: objects are fetched with GET and uploaded with unsigned PUTs,
+// relative to the base URL.
+type httpArchive struct {
+	base   *url.URL
+	client *http.Client
+}
+
+func (h *httpArchive) url(path string) string {
+	return h.base.JoinPath(path).String()
+}
+
+func (h *httpArchive) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.url(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		data, err := io.ReadAll(io.LimitReader(resp.Body, int64(maxArchiveObjectSize)+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(data) > maxArchiveObjectSize {
+			return nil, fmt.Errorf("GET %s: object larger than %d bytes", path, maxArchiveObjectSize)
+		}
+		return data, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", path, errArchiveNotFound)
+	default:
+		return nil, fmt.Errorf("GET %s: %s", path, resp.Status)
+	}
+}
+
+func (h *httpArchive) put(ctx context.Context, path string, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, h.url(path), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(data))
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("PUT %s: %s", path, resp.Status)
+	}
+	return nil
+}
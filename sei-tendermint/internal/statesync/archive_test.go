@@ -0,0 +1,173 @@
+package statesync
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
+)
+
+// newTestObjectStore starts an HTTP stand-in for an S3-compatible bucket.
+func newTestObjectStore(t *testing.T) (string, map[string][]byte) {
+	var mtx sync.Mutex
+	objects := map[string][]byte{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			data, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(data)
+		case http.MethodPut:
+			data, err := io.ReadAll(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			objects[r.URL.Path] = data
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL + "/bucket", objects
+}
+
+func publishTestSnapshot(t *testing.T, archive *SnapshotArchive, chunks [][]byte) *SnapshotManifest {
+	m, err := archive.Publish(t.Context(), &abci.Snapshot{
+		Height:   10,
+		Format:   1,
+		Chunks:   uint32(len(chunks)),
+		Hash:     []byte{1, 2, 3},
+		Metadata: []byte{4},
+	}, func(index uint32) ([]byte, error) { return chunks[index], nil })
+	require.NoError(t, err)
+	return m
+}
+
+func TestSnapshotArchive_Publish(t *testing.T) {
+	url, _ := newTestObjectStore(t)
+	for name, location := range map[string]string{"dir": t.TempDir(), "http": url} {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			archive, err := OpenSnapshotArchive(location)
+			require.NoError(t, err)
+
+			manifests, err := archive.Snapshots(ctx)
+			require.NoError(t, err)
+			require.Empty(t, manifests)
+
+			chunks := [][]byte{[]byte("a"), []byte("b"), []byte("a")}
+			published := publishTestSnapshot(t, archive, chunks)
+			// Publishing again does not duplicate the index entry.
+			publishTestSnapshot(t, archive, chunks)
+
+			manifests, err = archive.Snapshots(ctx)
+			require.NoError(t, err)
+			require.Equal(t, []*SnapshotManifest{published}, manifests)
+
+			snap, err := manifests[0].snapshot()
+			require.NoError(t, err)
+			require.Equal(t, uint32(3), snap.Chunks)
+			for i, want := range chunks {
+				got, err := archive.Chunk(ctx, snap.chunkHashes[i])
+				require.NoError(t, err)
+				require.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func TestSnapshotArchive_SkipsBadManifests(t *testing.T) {
+	url, objects := newTestObjectStore(t)
+	archive, err := OpenSnapshotArchive(url)
+	require.NoError(t, err)
+	published := publishTestSnapshot(t, archive, [][]byte{[]byte("a")})
+
+	// The index lists a corrupt and a missing manifest next to the good one.
+	var idx snapshotIndex
+	require.NoError(t, json.Unmarshal(objects["/bucket/"+archiveIndexPath], &idx))
+	idx.Manifests = append(idx.Manifests, archiveManifestsPath+"/bad.json", archiveManifestsPath+"/missing.json")
+	raw, err := json.Marshal(idx)
+	require.NoError(t, err)
+	objects["/bucket/"+archiveIndexPath] = raw
+	objects["/bucket/"+archiveManifestsPath+"/bad.json"] = []byte("{")
+
+	manifests, err := archive.Snapshots(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []*SnapshotManifest{published}, manifests)
+}
+
+func TestSnapshotArchive_RejectsCorruptChunk(t *testing.T) {
+	url, objects := newTestObjectStore(t)
+	archive, err := OpenSnapshotArchive(url)
+	require.NoError(t, err)
+	m := publishTestSnapshot(t, archive, [][]byte{[]byte("a")})
+	objects["/bucket/chunks/"+m.Chunks[0]] = []byte("b")
+
+	snap, err := m.snapshot()
+	require.NoError(t, err)
+	_, err = archive.Chunk(t.Context(), snap.chunkHashes[0])
+	require.Error(t, err)
+}
+
+func TestSyncer_FetchArchiveChunks(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	archive, err := OpenSnapshotArchive(t.TempDir())
+	require.NoError(t, err)
+	chunks := [][]byte{[]byte("a"), []byte("b"), []byte("c")}
+	m := publishTestSnapshot(t, archive, chunks)
+	snap, err := m.snapshot()
+	require.NoError(t, err)
+
+	queue, err := newChunkQueue(snap, t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = queue.Close() }()
+	s := &syncer{archive: archive, retryTimeout: time.Second, chunks: queue}
+	go s.fetchArchiveChunks(ctx, snap, queue)
+
+	for i, want := range chunks {
+		c, err := queue.Next()
+		require.NoError(t, err)
+		require.Equal(t, uint32(i), c.Index)
+		require.Equal(t, want, c.Chunk)
+		require.Equal(t, archivePeerID, string(c.Sender))
+	}
+}
+
+func TestSnapshotArchive_RejectsOversizedObject(t *testing.T) {
+	url, objects := newTestObjectStore(t)
+	archive, err := OpenSnapshotArchive(url)
+	require.NoError(t, err)
+	objects["/bucket/"+archiveIndexPath] = make([]byte, maxArchiveObjectSize+1)
+
+	_, err = archive.Snapshots(t.Context())
+	require.ErrorContains(t, err, "larger than")
+}
+
+func TestCheckArchived(t *testing.T) {
+	archive, err := OpenSnapshotArchive(t.TempDir())
+	require.NoError(t, err)
+	m := publishTestSnapshot(t, archive, [][]byte{[]byte("a"), []byte("b")})
+	snap, err := m.snapshot()
+	require.NoError(t, err)
+	require.NoError(t, checkArchived(snap))
+
+	// A snapshot advertised by peers has no chunk hashes to fetch its chunks with.
+	snap.chunkHashes = nil
+	require.Error(t, checkArchived(snap))
+}
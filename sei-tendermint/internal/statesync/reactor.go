@@ -323,12 +323,13 @@ func (r *Reactor) OnStart(ctx context.Context) error {
 			fetchers:         r.cfg.Fetchers,
 			retryTimeout:     r.cfg.ChunkRequestTimeout,
 			useLocalSnapshot: r.cfg.UseLocalSnapshot,
+			reportPeer:       r.router.ReportPeer,
 		}
 	}
 	r.dispatcher = NewDispatcher(r.lightBlockChannel)
 	r.requestSnaphot = func() error {
 		// request snapshots from all currently connected peers
-		if !r.cfg.UseLocalSnapshot {
+		if !r.cfg.UseLocalSnapshot && r.cfg.SnapshotArchive == "" {
 			r.snapshotChannel.Broadcast(wrap(&pb.SnapshotsRequest{}))
 		}
 		return nil
@@ -377,7 +378,7 @@ func (r *Reactor) Sync(ctx context.Context) (sm.State, error) {
 		}
 	}
 
-	if !r.cfg.UseLocalSnapshot {
+	if !r.cfg.UseLocalSnapshot && (r.cfg.SnapshotArchive == "" || r.cfg.UseP2P) {
 		// We need at least two peers (for cross-referencing of light blocks) before we can
 		// begin state sync
 		if err := r.waitForEnoughPeers(ctx, 2); err != nil {
@@ -386,6 +387,14 @@ func (r *Reactor) Sync(ctx context.Context) (sm.State, error) {
 		logger.Info("Finished waiting for 2 peers to start state sync")
 	}
 
+	var archive *SnapshotArchive
+	if r.cfg.SnapshotArchive != "" {
+		var err error
+		if archive, err = OpenSnapshotArchive(r.cfg.SnapshotArchive); err != nil {
+			return sm.State{}, err
+		}
+	}
+
 	r.mtx.Lock()
 	if r.syncer != nil {
 		r.mtx.Unlock()
@@ -398,6 +407,7 @@ func (r *Reactor) Sync(ctx context.Context) (sm.State, error) {
 	}
 
 	r.syncer = r.initSyncer()
+	r.syncer.archive = archive
 	r.mtx.Unlock()
 
 	defer func() {
@@ -419,6 +429,12 @@ func (r *Reactor) Sync(ctx context.Context) (sm.State, error) {
 		}
 	}
 
+	if r.syncer.archive != nil {
+		if err := r.addArchivedSnapshots(ctx); err != nil {
+			return sm.State{}, err
+		}
+	}
+
 	state, commit, err := r.syncer.SyncAny(ctx, r.cfg.DiscoveryTime, r.requestSnaphot)
 	logger.Info("Finished state sync, fetching state and commit to bootstrap the node")
 	if err != nil {
@@ -1091,6 +1107,26 @@ func (r *Reactor) recentSnapshots(ctx context.Context, n uint32) ([]*snapshot, e
 	return snapshots, nil
 }
 
+// addArchivedSnapshots adds the snapshots published to the snapshot archive to the syncer.
+// Invalid manifests are skipped, since the archive is not trusted.
+func (r *Reactor) addArchivedSnapshots(ctx context.Context) error {
+	manifests, err := r.syncer.archive.Snapshots(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list archived snapshots: %w", err)
+	}
+	for _, m := range manifests {
+		snap, err := m.snapshot()
+		if err != nil {
+			logger.Error("skipping invalid archived snapshot", "height", m.Height, "format", m.Format, "err", err)
+			continue
+		}
+		if _, err := r.syncer.AddSnapshot(archivePeerID, snap); err != nil {
+			logger.Error("skipping invalid archived snapshot", "height", m.Height, "format", m.Format, "err", err)
+		}
+	}
+	return nil
+}
+
 // fetchLightBlock works out whether the node has a light block at a particular
 // height and if so returns it so it can be gossiped to peers
 func (r *Reactor) fetchLightBlock(height uint64) (*types.LightBlock, error) {
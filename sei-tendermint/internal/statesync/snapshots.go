@@ -22,7 +22,8 @@ type snapshot struct {
 	Hash     []byte
 	Metadata []byte
 
-	trustedAppHash []byte // populated by light client
+	trustedAppHash []byte   // populated by light client
+	chunkHashes    [][]byte // populated from a snapshot archive manifest
 }
 
 // Key generates a snapshot key, used for lookups. It takes into account not only the height and
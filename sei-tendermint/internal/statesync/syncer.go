@@ -66,6 +66,20 @@ type syncer struct {
 	lastSyncedSnapshotHeight int64
 	processingSnapshot       *snapshot
 	useLocalSnapshot         bool
+	// archive, if set, is the source of snapshots and chunks instead of peers.
+	archive *SnapshotArchive
+
+	// reportPeer feeds the outcomes of chunk requests to the peer scores.
+	// Optional.
+	reportPeer func(types.NodeID, p2p.PeerBehavior)
+}
+
+func (s *syncer) report(peerID types.NodeID, b p2p.PeerBehavior) {
+	// Chunks of a local snapshot are sent by "self", and of an archived one by "archive".
+	if s.reportPeer == nil || peerID == "" || peerID == "self" || peerID == archivePeerID {
+		return
+	}
+	s.reportPeer(peerID, b)
 }
 
 // AddChunk adds a chunk to the chunk queue, if any. It returns false if the chunk has already
@@ -107,6 +121,10 @@ func (s *syncer) AddSnapshot(peerID types.NodeID, snapshot *snapshot) (bool, err
 // AddPeer adds a peer to the pool. For now we just keep it simple and send a
 // single request to discover snapshots, later we may want to do retries and stuff.
 func (s *syncer) AddPeer(peerID types.NodeID) {
+	if s.archive != nil {
+		// Snapshots are fetched from the archive only.
+		return
+	}
 	logger.Info("Requesting snapshots from peer", "peer", peerID)
 	s.snapshotCh.Send(wrap(&pb.SnapshotsRequest{}), peerID)
 }
@@ -129,7 +147,7 @@ func (s *syncer) SyncAny(
 		discoveryTime = minimumDiscoveryTime
 	}
 
-	if discoveryTime > 0 && !s.useLocalSnapshot {
+	if discoveryTime > 0 && !s.useLocalSnapshot && s.archive == nil {
 		if err := requestSnapshots(); err != nil {
 			return sm.State{}, nil, err
 		}
@@ -210,6 +228,11 @@ func (s *syncer) SyncAny(
 			logger.Info("Snapshot senders rejected", "height", snapshot.Height, "format", snapshot.Format,
 				"hash", snapshot.Hash)
 			for _, peer := range s.snapshots.GetPeers(snapshot) {
+				if peer == archivePeerID {
+					// The archive serves every archived snapshot; reject just this one.
+					s.snapshots.Reject(snapshot)
+					continue
+				}
 				s.snapshots.RejectPeer(peer)
 				logger.Info("Snapshot sender rejected", "peer", peer)
 			}
@@ -244,6 +267,14 @@ func (s *syncer) Sync(ctx context.Context, snapshot *snapshot, chunks *chunkQueu
 		s.mtx.Unlock()
 	}()
 
+	if s.archive != nil && !s.useLocalSnapshot {
+		if err := checkArchived(snapshot); err != nil {
+			logger.Info("Snapshot cannot be fetched from the archive. Dropping snapshot and trying again",
+				"err", err, "height", snapshot.Height)
+			return sm.State{}, nil, fmt.Errorf("%w: %w", errRejectSnapshot, err)
+		}
+	}
+
 	hctx, hcancel := context.WithTimeout(ctx, 30*time.Second)
 	defer hcancel()
 
@@ -276,9 +307,12 @@ func (s *syncer) Sync(ctx context.Context, snapshot *snapshot, chunks *chunkQueu
 	defer cancel()
 	fetchStartTime := time.Now()
 	for i := int32(0); i < s.fetchers; i++ {
-		if s.useLocalSnapshot {
+		switch {
+		case s.useLocalSnapshot:
 			go s.fetchLocalChunks(fetchCtx, snapshot, chunks)
-		} else {
+		case s.archive != nil:
+			go s.fetchArchiveChunks(fetchCtx, snapshot, chunks)
+		default:
 			go s.fetchChunks(fetchCtx, snapshot, chunks)
 		}
 	}
@@ -400,20 +434,29 @@ func (s *syncer) applyChunks(ctx context.Context, chunks *chunkQueue, start time
 			}
 		}
 
-		// Reject any senders as requested by the app
+		// Reject any senders as requested by the app. The archive serves every
+		// archived snapshot, so a rejected archive chunk rejects just this snapshot.
+		rejectArchive := false
 		for _, sender := range resp.RejectSenders {
-			if sender != "" {
+			if sender == archivePeerID {
+				rejectArchive = true
+			} else if sender != "" {
 				peerID := types.NodeID(sender)
 				s.snapshots.RejectPeer(peerID)
+				s.report(peerID, p2p.PeerBadChunk)
 
 				if err := chunks.DiscardSender(peerID); err != nil {
 					return fmt.Errorf("failed to reject sender: %w", err)
 				}
 			}
 		}
+		if rejectArchive {
+			return errRejectSnapshot
+		}
 
 		switch resp.Result {
 		case abci.ResponseApplySnapshotChunk_ACCEPT:
+			s.report(chunk.Sender, p2p.PeerUsefulChunk)
 			Global.SnapshotChunkAt().Add(1)
 			s.avgChunkTime = time.Since(start).Nanoseconds() / int64(chunks.numChunksReturned())
 			Global.ChunkProcessAvgTimeAt().Set(float64(s.avgChunkTime))
@@ -477,6 +520,57 @@ func (s *syncer) fetchLocalChunks(ctx context.Context, snapshot *snapshot, chunk
 	}
 }
 
+// fetchArchiveChunks fetches chunks from the snapshot archive, receiving allocations from the
+// chunk queue. Chunks which fail to download or do not match the manifest are retried after
+// the chunk request timeout.
+func (s *syncer) fetchArchiveChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
+	for {
+		index, err := chunks.Allocate()
+		if errors.Is(err, errDone) {
+			// Keep checking until the context is canceled (restore is done), in case any
+			// chunks need to be refetched.
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(2 * time.Second):
+				continue
+			}
+		}
+		if err != nil {
+			logger.Error("Failed to allocate chunk from queue", "err", err)
+			return
+		}
+		for {
+			logger.Info("Fetching archived snapshot chunk", "height", snapshot.Height, "chunk", index, "total", chunks.Size())
+			// Sync checks that the manifest lists every chunk.
+			data, err := s.archive.Chunk(ctx, snapshot.chunkHashes[index])
+			if err == nil {
+				_, err = s.AddChunk(&chunk{
+					Height: snapshot.Height,
+					Format: snapshot.Format,
+					Index:  index,
+					Chunk:  data,
+					Sender: archivePeerID,
+				})
+				if err != nil {
+					logger.Error("Failed to add archived snapshot chunk", "err", err)
+					return
+				}
+				break
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			logger.Error("Failed to fetch archived snapshot chunk", "height", snapshot.Height, "chunk", index, "err", err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(s.retryTimeout):
+			}
+		}
+	}
+}
+
 // fetchChunks requests chunks from peers, receiving allocations from the chunk queue. Chunks
 // will be received from the reactor via syncer.AddChunks() to chunkQueue.Add().
 func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *chunkQueue) {
@@ -510,13 +604,14 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 		ticker := time.NewTicker(s.retryTimeout)
 		defer ticker.Stop()
 
-		s.requestChunk(snapshot, index)
+		peer := s.requestChunk(snapshot, index)
 
 		select {
 		case <-chunks.WaitFor(index):
 			next = true
 
 		case <-ticker.C:
+			s.report(peer, p2p.PeerSlowResponse)
 			next = false
 
 		case <-ctx.Done():
@@ -529,15 +624,14 @@ func (s *syncer) fetchChunks(ctx context.Context, snapshot *snapshot, chunks *ch
 
 // requestChunk requests a chunk from a peer.
 //
-// returns nil if there are no peers for the given snapshot or the
-// request is successfully made and an error if the request cannot be
-// completed
-func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
+// returns the peer the chunk was requested from, or an empty NodeID if
+// there are no peers for the given snapshot
+func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) types.NodeID {
 	peer := s.snapshots.GetPeer(snapshot)
 	if peer == "" {
 		logger.Error("No valid peers found for snapshot", "height", snapshot.Height,
 			"format", snapshot.Format, "hash", snapshot.Hash)
-		return
+		return ""
 	}
 
 	logger.Debug(
@@ -554,6 +648,7 @@ func (s *syncer) requestChunk(snapshot *snapshot, chunk uint32) {
 		Index:  chunk,
 	}
 	s.chunkCh.Send(wrap(msg), peer)
+	return peer
 }
 
 // verifyApp verifies the sync, checking the app hash, last block height and app version
@@ -742,6 +742,41 @@ func TestSyncer_applyChunks_RejectSenders(t *testing.T) {
 	}
 }
 
+func TestSyncer_applyChunks_RejectArchive(t *testing.T) {
+	ctx := t.Context()
+
+	stateProvider := &mocks.StateProvider{}
+	stateProvider.On("AppHash", mock.Anything, mock.Anything).Return([]byte("app_hash"), nil)
+
+	rts := setup(t, nil, stateProvider, true)
+	app := rts.conn
+
+	// The archive serves two snapshots, and the app rejects it as the sender of a chunk of one.
+	s1 := &snapshot{Height: 1, Format: 1, Chunks: 1}
+	s2 := &snapshot{Height: 2, Format: 1, Chunks: 1}
+	_, err := rts.reactor.syncer.AddSnapshot(archivePeerID, s1)
+	require.NoError(t, err)
+	_, err = rts.reactor.syncer.AddSnapshot(archivePeerID, s2)
+	require.NoError(t, err)
+
+	chunks, err := newChunkQueue(s1, t.TempDir())
+	require.NoError(t, err)
+	defer func() { _ = chunks.Close() }()
+	added, err := chunks.Add(&chunk{Height: 1, Format: 1, Index: 0, Chunk: []byte{0}, Sender: archivePeerID})
+	require.True(t, added)
+	require.NoError(t, err)
+	app.applySnapshotChunk.Push(mkHandler(
+		&abci.RequestApplySnapshotChunk{Index: 0, Chunk: []byte{0}, Sender: archivePeerID},
+		&abci.ResponseApplySnapshotChunk{Result: abci.ResponseApplySnapshotChunk_ACCEPT, RejectSenders: []string{archivePeerID}},
+	))
+
+	// Only the snapshot being restored is rejected; the archive still serves the other one.
+	err = rts.reactor.syncer.applyChunks(ctx, chunks, time.Now())
+	require.ErrorIs(t, err, errRejectSnapshot)
+	require.Equal(t, []types.NodeID{archivePeerID}, rts.reactor.syncer.snapshots.GetPeers(s2))
+	app.AssertExpectations(t)
+}
+
 func toABCI(s *snapshot) *abci.Snapshot {
 	return &abci.Snapshot{
 		Height:   s.Height,
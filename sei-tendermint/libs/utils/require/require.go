@@ -77,6 +77,9 @@ var NoFileExists = require.NoFileExists
 // FileExists .
 var FileExists = require.FileExists
 
+// NoDirExists .
+var NoDirExists = require.NoDirExists
+
 // Positive .
 func Positive[T cmp.Ordered](t TestingT, e T, msgAndArgs ...any) {
 	require.Positive(t, e, msgAndArgs...)
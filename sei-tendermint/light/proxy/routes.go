@@ -80,6 +80,10 @@ func (p proxyService) DumpConsensusState(ctx context.Context) (*coretypes.Result
 	return p.Client.DumpConsensusState(ctx)
 }
 
+func (p proxyService) DumpFlightRecorder(ctx context.Context) (*coretypes.ResultFlightRecorder, error) {
+	return p.Client.DumpFlightRecorder(ctx)
+}
+
 func (p proxyService) Events(ctx context.Context, req *coretypes.RequestEvents) (*coretypes.ResultEvents, error) {
 	return p.Client.Events(ctx, req)
 }
@@ -112,10 +116,18 @@ func (p proxyService) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, er
 	return p.Client.NetInfo(ctx)
 }
 
+func (p proxyService) PeerScores(ctx context.Context) (*coretypes.ResultPeerScores, error) {
+	return p.Client.PeerScores(ctx)
+}
+
 func (p proxyService) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
 	return p.Client.NumUnconfirmedTxs(ctx)
 }
 
+func (p proxyService) ProposalOrdering(ctx context.Context) (*coretypes.ResultProposalOrdering, error) {
+	return p.Client.ProposalOrdering(ctx)
+}
+
 func (p proxyService) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
 	return p.Client.Status(ctx)
 }
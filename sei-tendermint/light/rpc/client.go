@@ -244,6 +244,10 @@ func (c *Client) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconf
 	return c.next.NumUnconfirmedTxs(ctx)
 }
 
+func (c *Client) ProposalOrdering(ctx context.Context) (*coretypes.ResultProposalOrdering, error) {
+	return c.next.ProposalOrdering(ctx)
+}
+
 func (c *Client) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	return c.next.CheckTx(ctx, tx)
 }
@@ -252,10 +256,18 @@ func (c *Client) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error)
 	return c.next.NetInfo(ctx)
 }
 
+func (c *Client) PeerScores(ctx context.Context) (*coretypes.ResultPeerScores, error) {
+	return c.next.PeerScores(ctx)
+}
+
 func (c *Client) DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	return c.next.DumpConsensusState(ctx)
 }
 
+func (c *Client) DumpFlightRecorder(ctx context.Context) (*coretypes.ResultFlightRecorder, error) {
+	return c.next.DumpFlightRecorder(ctx)
+}
+
 func (c *Client) ConsensusState(ctx context.Context) (*coretypes.ResultConsensusState, error) {
 	return c.next.ConsensusState(ctx)
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventbus"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/eventlog"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/evidence"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/flightrec"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/hashcompare"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/mempool"
 	mempoolreactor "github.com/sei-protocol/sei-chain/sei-tendermint/internal/mempool/reactor"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/p2p"
@@ -219,6 +221,7 @@ func makeNode(
 			return nil, fmt.Errorf("initializing event log: %w", err)
 		}
 	}
+	flightRecorder := flightrec.NewRecorder(flightrec.DefaultCapacity)
 	eventSinks, err := sink.EventSinksFromConfig(cfg, dbProvider, genDoc.ChainID)
 	if err != nil {
 		return nil, fmt.Errorf("sink.EventSinksFromConfig(): %w", err)
@@ -273,6 +276,8 @@ func makeNode(
 			EventBus:   eventBus,
 			EventLog:   eventLogOpt,
 			Config:     *cfg.RPC,
+
+			FlightRecorder: utils.Some(flightRecorder),
 		},
 	}
 
@@ -318,6 +323,18 @@ func makeNode(
 	node.rpcEnv.EvidencePool = utils.Some[sm.EvidencePool](evPool)
 	node.evPool = utils.Some(evPool)
 
+	if cfg.HashCompare.Enable {
+		if opts.hashSource == nil {
+			logger.Warn("hash comparison is enabled but the application does not log block hashes; not starting it")
+		} else {
+			hcReactor, err := hashcompare.NewReactor(cfg.HashCompare, opts.hashSource, node.router)
+			if err != nil {
+				return nil, fmt.Errorf("hashcompare.NewReactor(): %w", err)
+			}
+			node.services = append(node.services, hcReactor)
+		}
+	}
+
 	if cfg.P2P.PexReactor {
 		pxReactor, err := pex.NewReactor(
 			node.router,
@@ -331,6 +348,7 @@ func makeNode(
 
 	if !gigaEnabled {
 		mp := mempool.NewTxMempool(cfg.Mempool.ToMempoolConfig(), proxyApp, sm.TxConstraintsFetcherFromStore(stateStore))
+		mp.SetFlightRecorder(flightRecorder)
 		node.mempool = utils.Some(mp)
 		node.rpcEnv.Mempool = utils.Some(mp)
 		mpReactor, err := mempoolreactor.NewReactor(cfg.Mempool, mp, router)
@@ -416,6 +434,7 @@ func makeNode(
 				RestartEvent:          restartEvent,
 				SelfRemediationConfig: cfg.SelfRemediation,
 				FreezeHeight:          opts.freezeHeight,
+				ArchiveDir:            cfg.BlockSyncArchivePath(),
 			}),
 		)
 		if err != nil {
@@ -532,6 +551,11 @@ func (n *nodeImpl) OnStart(ctx context.Context) (err error) {
 	if err = n.rpcEnv.EventBus.Start(ctx); err != nil {
 		return err
 	}
+	if r, ok := n.rpcEnv.FlightRecorder.Get(); ok {
+		n.Spawn("flightrec", func(ctx context.Context) error {
+			return r.RunConsensus(ctx, n.rpcEnv.EventBus)
+		})
+	}
 
 	if err = n.indexerService.Start(ctx); err != nil {
 		return err
@@ -668,6 +692,9 @@ func (n *nodeImpl) OnStart(ctx context.Context) (err error) {
 	}
 	n.rpcEnv.IsListening = true
 	if m, ok := n.mempool.Get(); ok {
+		if err = m.RestoreJournal(ctx, state.LastBlockHeight); err != nil {
+			return fmt.Errorf("mempool.RestoreJournal(): %w", err)
+		}
 		n.SpawnCritical("mempool", m.Run)
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	abci "github.com/sei-protocol/sei-chain/sei-tendermint/abci/types"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/config"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/hashcompare"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/proxy"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/privval"
 	"github.com/sei-protocol/sei-chain/sei-tendermint/rpc/client/local"
@@ -19,6 +20,7 @@ var logger = seilog.NewLogger("tendermint", "node")
 
 type options struct {
 	freezeHeight uint64
+	hashSource   hashcompare.Source
 }
 
 // Option configures optional node behavior.
@@ -31,6 +33,14 @@ func WithFreezeHeight(height uint64) Option {
 	}
 }
 
+// WithHashSource supplies the per-block state hashes the application logs, which the [hash-compare]
+// service exchanges with peers. The service stays off without one.
+func WithHashSource(source hashcompare.Source) Option {
+	return func(opts *options) {
+		opts.hashSource = source
+	}
+}
+
 func resolveOptions(nodeOptions ...Option) options {
 	var opts options
 	for _, apply := range nodeOptions {
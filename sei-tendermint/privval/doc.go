@@ -23,5 +23,12 @@ SignerDialerEndpoint is a simple wrapper around a net.Conn. It's used by both IP
 SignerClient handles remote validator connections that provide signing services.
 In production, it's recommended to wrap it with RetrySignerClient to avoid
 termination in case of temporary errors.
+
+# ThresholdSigner
+
+ThresholdSigner signs with a validator key split t-of-n between cosigner
+processes (see SplitFilePVKey): a signature takes t cosigners, and each
+LocalCosigner persists its own last sign state, so double signing takes t
+cosigners to misbehave. It serves the node like any other remote signer.
 */
 package privval
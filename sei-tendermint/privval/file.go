@@ -362,6 +362,22 @@ func (pv *FilePV) String() string {
 // It may need to set the timestamp as well if the vote is otherwise the same as
 // a previously signed vote (ie. we crashed after signing but before the vote hit the WAL).
 func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
+	return pv.LastSignState.signVote(chainID, vote, pv.sign)
+}
+
+// signProposal checks if the proposal is good to sign and sets the proposal signature.
+func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error {
+	return pv.LastSignState.signProposal(chainID, proposal, pv.sign)
+}
+
+func (pv *FilePV) sign(signBytes []byte) ([]byte, error) {
+	return pv.Key.PrivKey.Sign(signBytes).Bytes(), nil
+}
+
+// signVote checks the vote against the last sign state, signs it with sign and
+// persists the new state. If the HRS was already signed, it reuses the last
+// signature instead (see FilePV.signVote).
+func (lss *FilePVLastSignState) signVote(chainID string, vote *tmproto.Vote, sign func([]byte) ([]byte, error)) error {
 	step, err := voteToStep(vote)
 	if err != nil {
 		return err
@@ -369,7 +385,6 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 
 	height := vote.Height
 	round := vote.Round
-	lss := pv.LastSignState
 
 	sameHRS, err := lss.checkHRS(height, round, step)
 	if err != nil {
@@ -405,9 +420,11 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	}
 
 	// It passed the checks. Sign the vote
-	sig := pv.Key.PrivKey.Sign(signBytes)
-	sigBytes := sig.Bytes()
-	if err := pv.saveSigned(height, round, step, signBytes, sigBytes); err != nil {
+	sigBytes, err := sign(signBytes)
+	if err != nil {
+		return err
+	}
+	if err := lss.saveSigned(height, round, step, signBytes, sigBytes); err != nil {
 		return err
 	}
 	vote.Signature = sigBytes
@@ -415,12 +432,11 @@ func (pv *FilePV) signVote(chainID string, vote *tmproto.Vote) error {
 	return nil
 }
 
-// signProposal checks if the proposal is good to sign and sets the proposal signature.
-func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error {
+// signProposal checks the proposal against the last sign state, signs it with
+// sign and persists the new state.
+func (lss *FilePVLastSignState) signProposal(chainID string, proposal *tmproto.Proposal, sign func([]byte) ([]byte, error)) error {
 	height, round, step := proposal.Height, proposal.Round, stepPropose
 
-	lss := pv.LastSignState
-
 	sameHRS, err := lss.checkHRS(height, round, step)
 	if err != nil {
 		return err
@@ -440,9 +456,11 @@ func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error
 	}
 
 	// It passed the checks. Sign the proposal
-	sig := pv.Key.PrivKey.Sign(signBytes)
-	sigBytes := sig.Bytes()
-	if err := pv.saveSigned(height, round, step, signBytes, sigBytes); err != nil {
+	sigBytes, err := sign(signBytes)
+	if err != nil {
+		return err
+	}
+	if err := lss.saveSigned(height, round, step, signBytes, sigBytes); err != nil {
 		return err
 	}
 	proposal.Signature = sigBytes
@@ -450,13 +468,13 @@ func (pv *FilePV) signProposal(chainID string, proposal *tmproto.Proposal) error
 }
 
 // Persist height/round/step and signature
-func (pv *FilePV) saveSigned(height int64, round int32, step int8, signBytes []byte, sig []byte) error {
-	pv.LastSignState.Height = height
-	pv.LastSignState.Round = round
-	pv.LastSignState.Step = step
-	pv.LastSignState.Signature = sig
-	pv.LastSignState.SignBytes = signBytes
-	return pv.LastSignState.Save()
+func (lss *FilePVLastSignState) saveSigned(height int64, round int32, step int8, signBytes []byte, sig []byte) error {
+	lss.Height = height
+	lss.Round = round
+	lss.Step = step
+	lss.Signature = sig
+	lss.SignBytes = signBytes
+	return lss.Save()
 }
 
 //-----------------------------------------------------------------------------------------
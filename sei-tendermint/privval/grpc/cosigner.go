@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"filippo.io/edwards25519"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/frost"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/privval"
+	privvalproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/privval"
+)
+
+// CosignerServer implements ThresholdCosignerAPIServer (generated via protobuf services).
+// Serves a local cosigner to the threshold signers of the other cosigners.
+type CosignerServer struct {
+	cosigner *privval.LocalCosigner
+}
+
+func NewCosignerServer(cosigner *privval.LocalCosigner) *CosignerServer {
+	return &CosignerServer{cosigner: cosigner}
+}
+
+var _ privvalproto.ThresholdCosignerAPIServer = (*CosignerServer)(nil)
+
+// Commit receives a request to commit to a nonce for signing sign bytes
+// returns the commitment on success and error on failure
+func (cs *CosignerServer) Commit(ctx context.Context, req *privvalproto.ThresholdCommitRequest) (
+	*privvalproto.ThresholdCommitResponse, error) {
+	commitment, err := cs.cosigner.Commit(ctx, req.ChainId, req.SignBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error committing: %v", err)
+	}
+	return &privvalproto.ThresholdCommitResponse{Commitment: commitmentToProto(commitment)}, nil
+}
+
+// Sign receives a request for a signature share of sign bytes
+// returns the signature share on success and error on failure
+func (cs *CosignerServer) Sign(ctx context.Context, req *privvalproto.ThresholdSignRequest) (
+	*privvalproto.ThresholdSignResponse, error) {
+	commitments := make([]frost.Commitment, len(req.Commitments))
+	for i, c := range req.Commitments {
+		var err error
+		if commitments[i], err = commitmentFromProto(c); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid commitment: %v", err)
+		}
+	}
+	share, err := cs.cosigner.Sign(ctx, req.ChainId, req.SignBytes, commitments)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error signing: %v", err)
+	}
+	logger.Info("CosignerServer: Sign Success", "id", cs.cosigner.ID())
+	return &privvalproto.ThresholdSignResponse{Share: share.Bytes()}, nil
+}
+
+// CosignerClient implements privval.Cosigner.
+// Handles the connection to the cosigner server of another cosigner
+type CosignerClient struct {
+	id     frost.Identifier
+	client privvalproto.ThresholdCosignerAPIClient
+}
+
+var _ privval.Cosigner = (*CosignerClient)(nil)
+
+// NewCosignerClient returns a client of the cosigner holding the share id.
+func NewCosignerClient(conn *grpc.ClientConn, id frost.Identifier) *CosignerClient {
+	return &CosignerClient{
+		id:     id,
+		client: privvalproto.NewThresholdCosignerAPIClient(conn),
+	}
+}
+
+// ID implements privval.Cosigner.
+func (cc *CosignerClient) ID() frost.Identifier { return cc.id }
+
+// Commit implements privval.Cosigner.
+func (cc *CosignerClient) Commit(ctx context.Context, chainID string, signBytes []byte) (frost.Commitment, error) {
+	resp, err := cc.client.Commit(ctx, &privvalproto.ThresholdCommitRequest{ChainId: chainID, SignBytes: signBytes})
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		return frost.Commitment{}, errStatus.Err()
+	}
+	commitment, err := commitmentFromProto(resp.Commitment)
+	if err != nil {
+		return frost.Commitment{}, err
+	}
+	if commitment.ID != cc.id {
+		return frost.Commitment{}, errors.New("commitment of another cosigner")
+	}
+	return commitment, nil
+}
+
+// Sign implements privval.Cosigner.
+func (cc *CosignerClient) Sign(ctx context.Context, chainID string, signBytes []byte, commitments []frost.Commitment) (*edwards25519.Scalar, error) {
+	req := &privvalproto.ThresholdSignRequest{ChainId: chainID, SignBytes: signBytes}
+	for _, c := range commitments {
+		req.Commitments = append(req.Commitments, commitmentToProto(c))
+	}
+	resp, err := cc.client.Sign(ctx, req)
+	if err != nil {
+		errStatus, _ := status.FromError(err)
+		return nil, errStatus.Err()
+	}
+	return edwards25519.NewScalar().SetCanonicalBytes(resp.Share)
+}
+
+func commitmentToProto(c frost.Commitment) *privvalproto.ThresholdCommitment {
+	return &privvalproto.ThresholdCommitment{Id: uint32(c.ID), Hiding: c.Hiding.Bytes(), Binding: c.Binding.Bytes()}
+}
+
+func commitmentFromProto(c *privvalproto.ThresholdCommitment) (frost.Commitment, error) {
+	if c == nil {
+		return frost.Commitment{}, errors.New("missing commitment")
+	}
+	hiding, err := new(edwards25519.Point).SetBytes(c.Hiding)
+	if err != nil {
+		return frost.Commitment{}, err
+	}
+	binding, err := new(edwards25519.Point).SetBytes(c.Binding)
+	if err != nil {
+		return frost.Commitment{}, err
+	}
+	return frost.Commitment{ID: frost.Identifier(c.Id), Hiding: hiding, Binding: binding}, nil
+}
@@ -0,0 +1,300 @@
+package privval
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"filippo.io/edwards25519"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/ed25519"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/frost"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/tempfile"
+	tmos "github.com/sei-protocol/sei-chain/sei-tendermint/libs/os"
+	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+// ThresholdKey is a cosigner's share of a validator key split t-of-n: the secret share, and the
+// public keys needed to verify the shares of the other cosigners and the aggregated signatures.
+type ThresholdKey struct {
+	Group *frost.GroupKey
+	Share *frost.KeyShare
+}
+
+type thresholdKeyJSON struct {
+	PubKey       crypto.PubKey   `json:"pub_key"`
+	Threshold    int             `json:"threshold"`
+	ID           uint32          `json:"id"`
+	Share        []byte          `json:"share"`
+	SharePubKeys []crypto.PubKey `json:"share_pub_keys"`
+}
+
+func (k ThresholdKey) MarshalJSON() ([]byte, error) {
+	j := thresholdKeyJSON{
+		PubKey:    k.Group.Ed25519(),
+		Threshold: k.Group.Threshold,
+		ID:        uint32(k.Share.ID),
+		Share:     k.Share.Secret.Bytes(),
+	}
+	for id := 1; id <= len(k.Group.Shares); id++ {
+		pub, ok := k.Group.Shares[frost.Identifier(id)] //nolint:gosec // ids are bounded by the number of shares
+		if !ok {
+			return nil, fmt.Errorf("missing public key of share %d", id)
+		}
+		key, err := ed25519.PublicKeyFromBytes(pub.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		j.SharePubKeys = append(j.SharePubKeys, key)
+	}
+	return json.Marshal(j)
+}
+
+func (k *ThresholdKey) UnmarshalJSON(data []byte) error {
+	var j thresholdKeyJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Threshold < 1 || j.Threshold > len(j.SharePubKeys) {
+		return fmt.Errorf("invalid threshold %d of %d shares", j.Threshold, len(j.SharePubKeys))
+	}
+	if j.ID < 1 || int(j.ID) > len(j.SharePubKeys) {
+		return fmt.Errorf("invalid share id %d", j.ID)
+	}
+	pub, err := new(edwards25519.Point).SetBytes(j.PubKey.Bytes())
+	if err != nil {
+		return fmt.Errorf("decoding pub_key: %w", err)
+	}
+	group := &frost.GroupKey{Threshold: j.Threshold, PublicKey: pub, Shares: map[frost.Identifier]*edwards25519.Point{}}
+	for i, key := range j.SharePubKeys {
+		p, err := new(edwards25519.Point).SetBytes(key.Bytes())
+		if err != nil {
+			return fmt.Errorf("decoding share_pub_keys[%d]: %w", i, err)
+		}
+		group.Shares[frost.Identifier(i+1)] = p //nolint:gosec // bounded by the number of shares
+	}
+	secret, err := edwards25519.NewScalar().SetCanonicalBytes(j.Share)
+	if err != nil {
+		return fmt.Errorf("decoding share: %w", err)
+	}
+	share := &frost.KeyShare{ID: frost.Identifier(j.ID), Secret: secret}
+	if new(edwards25519.Point).ScalarBaseMult(secret).Equal(group.Shares[share.ID]) != 1 {
+		return fmt.Errorf("share does not match share_pub_keys[%d]", j.ID-1)
+	}
+	k.Group, k.Share = group, share
+	return nil
+}
+
+// Save persists the ThresholdKey to filePath.
+func (k *ThresholdKey) Save(filePath string) error {
+	data, err := json.MarshalIndent(k, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filePath, data, 0600)
+}
+
+// LoadThresholdKey loads a ThresholdKey from filePath.
+func LoadThresholdKey(filePath string) (*ThresholdKey, error) {
+	data, err := os.ReadFile(filepath.Clean(filePath))
+	if err != nil {
+		return nil, err
+	}
+	var k ThresholdKey
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("error reading threshold key from %v: %w", filePath, err)
+	}
+	return &k, nil
+}
+
+// SplitFilePVKey splits the key of a FilePV into n ThresholdKeys, any threshold of which sign for the
+// same public key. The key must be deleted once the shares are distributed to the cosigners.
+func SplitFilePVKey(key FilePVKey, threshold, n int) ([]*ThresholdKey, error) {
+	group, shares, err := frost.Split(key.PrivKey, threshold, n)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*ThresholdKey, n)
+	for i, share := range shares {
+		keys[i] = &ThresholdKey{Group: group, Share: share}
+	}
+	return keys, nil
+}
+
+//-------------------------------------------------------------------------------
+
+// ThresholdSigner implements PrivValidator by having threshold of the cosigners of a split key sign
+// together. Any cosigner process may run a ThresholdSigner, serving the node through a SignerServer
+// like any remote signer; each cosigner guards against double signing on its own, so several
+// ThresholdSigners may serve the same node.
+//
+// Like FilePV, it persists its last signature to resign an HRS it already signed.
+type ThresholdSigner struct {
+	group     *frost.GroupKey
+	cosigners []Cosigner
+	timeout   time.Duration
+
+	mtx           sync.Mutex
+	lastSignState FilePVLastSignState
+}
+
+var _ types.PrivValidator = (*ThresholdSigner)(nil)
+
+// NewThresholdSigner returns a ThresholdSigner signing with the cosigners, which should include the
+// local one, and giving up on a signature after timeout. The last sign state is loaded from
+// stateFilePath if it exists.
+func NewThresholdSigner(group *frost.GroupKey, cosigners []Cosigner, stateFilePath string, timeout time.Duration) (*ThresholdSigner, error) {
+	if len(cosigners) < group.Threshold {
+		return nil, fmt.Errorf("%d cosigners, need at least %d", len(cosigners), group.Threshold)
+	}
+	seen := map[frost.Identifier]bool{}
+	for _, c := range cosigners {
+		if _, ok := group.Shares[c.ID()]; !ok || seen[c.ID()] {
+			return nil, fmt.Errorf("unknown or duplicate cosigner %d", c.ID())
+		}
+		seen[c.ID()] = true
+	}
+	lss, err := loadLastSignState(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &ThresholdSigner{
+		group:         group,
+		cosigners:     cosigners,
+		timeout:       timeout,
+		lastSignState: lss,
+	}, nil
+}
+
+// loadLastSignState loads the FilePVLastSignState from stateFilePath, or returns an empty one if the
+// file does not exist yet.
+func loadLastSignState(stateFilePath string) (FilePVLastSignState, error) {
+	lss := FilePVLastSignState{filePath: stateFilePath}
+	if !tmos.FileExists(stateFilePath) {
+		return lss, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(stateFilePath))
+	if err != nil {
+		return lss, err
+	}
+	if err := json.Unmarshal(data, &lss); err != nil {
+		return lss, fmt.Errorf("error reading PrivValidator state from %v: %w", stateFilePath, err)
+	}
+	return lss, nil
+}
+
+// GetPubKey returns the public key of the split validator key.
+// Implements PrivValidator.
+func (ts *ThresholdSigner) GetPubKey(ctx context.Context) (crypto.PubKey, error) {
+	return ts.group.Ed25519(), nil
+}
+
+// SignVote signs a canonical representation of the vote with threshold cosigners.
+// Implements PrivValidator.
+func (ts *ThresholdSigner) SignVote(ctx context.Context, chainID string, vote *tmproto.Vote) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	if err := ts.lastSignState.signVote(chainID, vote, ts.signer(ctx, chainID)); err != nil {
+		return fmt.Errorf("error signing vote: %w", err)
+	}
+	return nil
+}
+
+// SignProposal signs a canonical representation of the proposal with threshold cosigners.
+// Implements PrivValidator.
+func (ts *ThresholdSigner) SignProposal(ctx context.Context, chainID string, proposal *tmproto.Proposal) error {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	if err := ts.lastSignState.signProposal(chainID, proposal, ts.signer(ctx, chainID)); err != nil {
+		return fmt.Errorf("error signing proposal: %w", err)
+	}
+	return nil
+}
+
+func (ts *ThresholdSigner) signer(ctx context.Context, chainID string) func([]byte) ([]byte, error) {
+	return func(signBytes []byte) ([]byte, error) {
+		ctx, cancel := context.WithTimeout(ctx, ts.timeout)
+		defer cancel()
+		return ts.sign(ctx, chainID, signBytes)
+	}
+}
+
+// sign runs both FROST rounds: it collects nonce commitments from the first threshold cosigners to
+// answer, then their signature shares, and aggregates them.
+func (ts *ThresholdSigner) sign(ctx context.Context, chainID string, signBytes []byte) ([]byte, error) {
+	type commitResult struct {
+		cosigner   Cosigner
+		commitment frost.Commitment
+		err        error
+	}
+	commits := make(chan commitResult, len(ts.cosigners))
+	for _, c := range ts.cosigners {
+		go func() {
+			commitment, err := c.Commit(ctx, chainID, signBytes)
+			commits <- commitResult{c, commitment, err}
+		}()
+	}
+	var signers []Cosigner
+	var commitments []frost.Commitment
+	var errs []error
+	for range ts.cosigners {
+		r := <-commits
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("cosigner %d: %w", r.cosigner.ID(), r.err))
+			continue
+		}
+		signers = append(signers, r.cosigner)
+		commitments = append(commitments, r.commitment)
+		if len(signers) == ts.group.Threshold {
+			break
+		}
+	}
+	if len(signers) < ts.group.Threshold {
+		return nil, fmt.Errorf("%d of %d cosigners committed: %w", len(signers), ts.group.Threshold, errors.Join(errs...))
+	}
+	slices.SortFunc(commitments, func(a, b frost.Commitment) int { return cmp.Compare(a.ID, b.ID) })
+
+	type signResult struct {
+		id    frost.Identifier
+		share *edwards25519.Scalar
+		err   error
+	}
+	results := make(chan signResult, len(signers))
+	for _, c := range signers {
+		go func() {
+			share, err := c.Sign(ctx, chainID, signBytes, commitments)
+			results <- signResult{c.ID(), share, err}
+		}()
+	}
+	shares := map[frost.Identifier]*edwards25519.Scalar{}
+	for range signers {
+		r := <-results
+		if r.err != nil {
+			errs = append(errs, fmt.Errorf("cosigner %d: %w", r.id, r.err))
+			continue
+		}
+		shares[r.id] = r.share
+	}
+	if len(shares) < len(signers) {
+		return nil, fmt.Errorf("%d of %d cosigners signed: %w", len(shares), len(signers), errors.Join(errs...))
+	}
+	sig, err := frost.Aggregate(ts.group, signBytes, commitments, shares)
+	if err != nil {
+		return nil, err
+	}
+	return sig.Bytes(), nil
+}
+
+// String returns a string representation of the ThresholdSigner.
+func (ts *ThresholdSigner) String() string {
+	return fmt.Sprintf("ThresholdSigner{%v %d/%d}", ts.group.Ed25519().Address(), ts.group.Threshold, len(ts.group.Shares))
+}
@@ -0,0 +1,203 @@
+package privval
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"filippo.io/edwards25519"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/frost"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/internal/libs/protoio"
+	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+)
+
+// maxPendingNonces bounds the nonces a cosigner keeps for signatures that were committed to but not
+// signed yet.
+const maxPendingNonces = 1024
+
+// Cosigner holds a share of a threshold validator key, and signs with it in the two rounds of a
+// threshold signature: it first commits to a nonce for the sign bytes, then signs them given the
+// commitments of all the cosigners taking part.
+type Cosigner interface {
+	// ID returns the identifier of the cosigner's share.
+	ID() frost.Identifier
+	// Commit returns the commitment to a fresh nonce for signing signBytes.
+	Commit(ctx context.Context, chainID string, signBytes []byte) (frost.Commitment, error)
+	// Sign returns the signature share of signBytes, given the commitments of the signing cosigners
+	// sorted by identifier.
+	Sign(ctx context.Context, chainID string, signBytes []byte, commitments []frost.Commitment) (*edwards25519.Scalar, error)
+}
+
+// LocalCosigner is a Cosigner holding its ThresholdKey in process. It refuses to sign anything that
+// conflicts with what it signed before, persisting its last sign state like FilePV does, so that
+// double signing needs threshold of the cosigners to misbehave.
+type LocalCosigner struct {
+	chainID string
+	key     *ThresholdKey
+
+	mtx           sync.Mutex
+	lastSignState FilePVLastSignState
+	// Nonces committed to, by their hiding commitment.
+	nonces map[[32]byte]*pendingNonce
+}
+
+type pendingNonce struct {
+	nonce     *frost.Nonce
+	height    int64
+	signBytes []byte
+}
+
+var _ Cosigner = (*LocalCosigner)(nil)
+
+// NewLocalCosigner returns a cosigner signing for chainID with key. The last sign state is loaded
+// from stateFilePath if it exists.
+func NewLocalCosigner(chainID string, key *ThresholdKey, stateFilePath string) (*LocalCosigner, error) {
+	lss, err := loadLastSignState(stateFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return &LocalCosigner{
+		chainID:       chainID,
+		key:           key,
+		lastSignState: lss,
+		nonces:        map[[32]byte]*pendingNonce{},
+	}, nil
+}
+
+// ID implements Cosigner.
+func (c *LocalCosigner) ID() frost.Identifier { return c.key.Share.ID }
+
+// Commit implements Cosigner.
+func (c *LocalCosigner) Commit(ctx context.Context, chainID string, signBytes []byte) (frost.Commitment, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	height, _, _, err := c.checkSignBytes(chainID, signBytes)
+	if err != nil {
+		return frost.Commitment{}, err
+	}
+	// Consensus does not go back in height, so nonces for lower heights will not be asked for.
+	for k, n := range c.nonces {
+		if n.height < height {
+			delete(c.nonces, k)
+		}
+	}
+	if len(c.nonces) >= maxPendingNonces {
+		return frost.Commitment{}, errors.New("too many pending signatures")
+	}
+	nonce, err := frost.NewNonce(c.key.Share)
+	if err != nil {
+		return frost.Commitment{}, err
+	}
+	commitment := nonce.Commitment()
+	c.nonces[[32]byte(commitment.Hiding.Bytes())] = &pendingNonce{nonce: nonce, height: height, signBytes: signBytes}
+	return commitment, nil
+}
+
+// Sign implements Cosigner.
+func (c *LocalCosigner) Sign(ctx context.Context, chainID string, signBytes []byte, commitments []frost.Commitment) (*edwards25519.Scalar, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	i := slices.IndexFunc(commitments, func(cm frost.Commitment) bool { return cm.ID == c.ID() })
+	if i < 0 || commitments[i].Hiding == nil {
+		return nil, errors.New("own commitment is missing")
+	}
+	key := [32]byte(commitments[i].Hiding.Bytes())
+	pending, ok := c.nonces[key]
+	if !ok {
+		return nil, errors.New("unknown or already used commitment")
+	}
+	// A nonce signs once: signing again, with other commitments, would reveal the key share.
+	delete(c.nonces, key)
+	if !bytes.Equal(pending.signBytes, signBytes) {
+		return nil, errors.New("commitment was made for other sign bytes")
+	}
+
+	height, round, step, err := c.checkSignBytes(chainID, signBytes)
+	if err != nil {
+		return nil, err
+	}
+	share, err := frost.Sign(c.key.Group, c.key.Share, pending.nonce, signBytes, commitments)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.lastSignState.saveSigned(height, round, step, signBytes, share.Bytes()); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+// checkSignBytes checks that signBytes are for chainID and do not conflict with the last sign state,
+// and returns their HRS. Unlike FilePV, a cosigner does not sign a vote again with another timestamp:
+// that is left to the ThresholdSigner holding the previous signature.
+func (c *LocalCosigner) checkSignBytes(chainID string, signBytes []byte) (int64, int32, int8, error) {
+	if chainID != c.chainID {
+		return 0, 0, 0, fmt.Errorf("chain ID %q, cosigner signs for %q", chainID, c.chainID)
+	}
+	signedChainID, height, round, step, err := signBytesHRS(signBytes)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if signedChainID != c.chainID {
+		return 0, 0, 0, fmt.Errorf("sign bytes are for chain ID %q, cosigner signs for %q", signedChainID, c.chainID)
+	}
+	sameHRS, err := c.lastSignState.checkHRS(height, round, step)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if sameHRS && !bytes.Equal(signBytes, c.lastSignState.SignBytes) {
+		return 0, 0, 0, errors.New("conflicting data")
+	}
+	return height, round, step, nil
+}
+
+// signBytesHRS decodes the sign bytes of a vote or proposal, and returns the chain ID and the height,
+// round and step they are for.
+func signBytesHRS(signBytes []byte) (string, int64, int32, int8, error) {
+	// The message type is the first field of both canonical votes and proposals.
+	_, n := protowire.ConsumeVarint(signBytes)
+	if n < 0 {
+		return "", 0, 0, 0, errors.New("invalid sign bytes")
+	}
+	num, typ, m := protowire.ConsumeTag(signBytes[n:])
+	if m < 0 || num != 1 || typ != protowire.VarintType {
+		return "", 0, 0, 0, errors.New("sign bytes have no message type")
+	}
+	msgType, k := protowire.ConsumeVarint(signBytes[n+m:])
+	if k < 0 {
+		return "", 0, 0, 0, errors.New("invalid sign bytes")
+	}
+
+	var chainID string
+	var height, round int64
+	var step int8
+	switch tmproto.SignedMsgType(msgType) { //nolint:gosec // checked by the switch
+	case tmproto.PrevoteType, tmproto.PrecommitType:
+		var vote tmproto.CanonicalVote
+		if err := protoio.UnmarshalDelimited(signBytes, &vote); err != nil {
+			return "", 0, 0, 0, fmt.Errorf("decoding vote: %w", err)
+		}
+		chainID, height, round = vote.ChainID, vote.Height, vote.Round
+		step = stepPrevote
+		if vote.Type == tmproto.PrecommitType {
+			step = stepPrecommit
+		}
+	case tmproto.ProposalType:
+		var proposal tmproto.CanonicalProposal
+		if err := protoio.UnmarshalDelimited(signBytes, &proposal); err != nil {
+			return "", 0, 0, 0, fmt.Errorf("decoding proposal: %w", err)
+		}
+		chainID, height, round, step = proposal.ChainID, proposal.Height, proposal.Round, stepPropose
+	default:
+		return "", 0, 0, 0, fmt.Errorf("unknown message type: %v", msgType)
+	}
+	if round < 0 || round > math.MaxInt32 {
+		return "", 0, 0, 0, fmt.Errorf("invalid round %d", round)
+	}
+	return chainID, height, int32(round), step, nil
+}
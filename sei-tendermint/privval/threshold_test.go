@@ -0,0 +1,195 @@
+package privval
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/edwards25519"
+
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/ed25519"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/crypto/frost"
+	tmrand "github.com/sei-protocol/sei-chain/sei-tendermint/libs/rand"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/libs/utils/require"
+	tmproto "github.com/sei-protocol/sei-chain/sei-tendermint/proto/tendermint/types"
+	"github.com/sei-protocol/sei-chain/sei-tendermint/types"
+)
+
+const thresholdChainID = "mychainid"
+
+// offlineCosigner is a cosigner that cannot be reached.
+type offlineCosigner struct{ id frost.Identifier }
+
+func (c offlineCosigner) ID() frost.Identifier { return c.id }
+
+func (c offlineCosigner) Commit(context.Context, string, []byte) (frost.Commitment, error) {
+	return frost.Commitment{}, errors.New("offline")
+}
+
+func (c offlineCosigner) Sign(context.Context, string, []byte, []frost.Commitment) (*edwards25519.Scalar, error) {
+	return nil, errors.New("offline")
+}
+
+// newThresholdSetup splits the key of a new FilePV threshold-of-n, and returns the FilePV, the group
+// key and a local cosigner per share.
+func newThresholdSetup(t *testing.T, threshold, n int) (*FilePV, *frost.GroupKey, []*LocalCosigner) {
+	pv, _, _ := newTestFilePV(t)
+	keys, err := SplitFilePVKey(pv.Key, threshold, n)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	var cosigners []*LocalCosigner
+	for i, key := range keys {
+		c, err := NewLocalCosigner(thresholdChainID, key, filepath.Join(dir, fmt.Sprintf("cosigner_state_%d.json", i)))
+		require.NoError(t, err)
+		cosigners = append(cosigners, c)
+	}
+	return pv, keys[0].Group, cosigners
+}
+
+func newThresholdSigner(t *testing.T, group *frost.GroupKey, cosigners ...Cosigner) *ThresholdSigner {
+	ts, err := NewThresholdSigner(group, cosigners, filepath.Join(t.TempDir(), "signer_state.json"), time.Second)
+	require.NoError(t, err)
+	return ts
+}
+
+func verifySig(t *testing.T, pubKey crypto.PubKey, signBytes, sig []byte) {
+	t.Helper()
+	s, err := ed25519.SignatureFromBytes(sig)
+	require.NoError(t, err)
+	require.NoError(t, pubKey.Verify(signBytes, s))
+}
+
+func TestThresholdKeyJSON(t *testing.T) {
+	pv, _, _ := newTestFilePV(t)
+	keys, err := SplitFilePVKey(pv.Key, 2, 3)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "threshold_key.json")
+	require.NoError(t, keys[1].Save(path))
+
+	key, err := LoadThresholdKey(path)
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, key.Group.Ed25519())
+	require.Equal(t, frost.Identifier(2), key.Share.ID)
+	require.Equal(t, 1, key.Share.Secret.Equal(keys[1].Share.Secret))
+
+	// A share that does not match its public key is rejected.
+	data, err := json.Marshal(keys[1])
+	require.NoError(t, err)
+	var j thresholdKeyJSON
+	require.NoError(t, json.Unmarshal(data, &j))
+	j.ID = 1
+	data, err = json.Marshal(j)
+	require.NoError(t, err)
+	require.Error(t, json.Unmarshal(data, &ThresholdKey{}))
+}
+
+func TestThresholdSignVoteAndProposal(t *testing.T) {
+	ctx := t.Context()
+	pv, group, cosigners := newThresholdSetup(t, 2, 3)
+	// One cosigner is down; the other two are enough.
+	ts := newThresholdSigner(t, group, cosigners[0], offlineCosigner{id: 2}, cosigners[2])
+
+	pubKey, err := ts.GetPubKey(ctx)
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey, pubKey)
+
+	blockID := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize), PartSetHeader: types.PartSetHeader{}}
+	vote := newVote(pv.Key.Address, 0, 10, 1, tmproto.PrevoteType, blockID).ToProto()
+	require.NoError(t, ts.SignVote(ctx, thresholdChainID, vote))
+	verifySig(t, pubKey, types.VoteSignBytes(thresholdChainID, vote), vote.Signature)
+
+	proposal := newProposal(11, 0, blockID, time.Now()).ToProto()
+	require.NoError(t, ts.SignProposal(ctx, thresholdChainID, proposal))
+	verifySig(t, pubKey, types.ProposalSignBytes(thresholdChainID, proposal), proposal.Signature)
+
+	// Signing the same proposal again reuses the signature, and another proposal is refused.
+	sig := proposal.Signature
+	require.NoError(t, ts.SignProposal(ctx, thresholdChainID, proposal))
+	require.Equal(t, sig, proposal.Signature)
+	other := newProposal(11, 0, types.BlockID{Hash: tmrand.Bytes(crypto.HashSize)}, time.Now()).ToProto()
+	require.Error(t, ts.SignProposal(ctx, thresholdChainID, other))
+
+	// Without threshold cosigners, nothing is signed.
+	ts = newThresholdSigner(t, group, cosigners[0], offlineCosigner{id: 2}, offlineCosigner{id: 3})
+	vote = newVote(pv.Key.Address, 0, 12, 0, tmproto.PrevoteType, blockID).ToProto()
+	require.Error(t, ts.SignVote(ctx, thresholdChainID, vote))
+}
+
+func TestThresholdCosignersPreventDoubleSigning(t *testing.T) {
+	ctx := t.Context()
+	pv, group, cosigners := newThresholdSetup(t, 2, 3)
+	// Two signers, which do not share their last sign state, serve the same validator.
+	ts1 := newThresholdSigner(t, group, cosigners[0], cosigners[1], cosigners[2])
+	ts2 := newThresholdSigner(t, group, cosigners[2], cosigners[1], cosigners[0])
+
+	blockID := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize), PartSetHeader: types.PartSetHeader{}}
+	vote := newVote(pv.Key.Address, 0, 10, 0, tmproto.PrecommitType, blockID).ToProto()
+	require.NoError(t, ts1.SignVote(ctx, thresholdChainID, vote))
+
+	// The cosigners refuse a conflicting vote at the same HRS, and an earlier height.
+	conflicting := newVote(pv.Key.Address, 0, 10, 0, tmproto.PrecommitType, types.BlockID{}).ToProto()
+	require.Error(t, ts2.SignVote(ctx, thresholdChainID, conflicting))
+	earlier := newVote(pv.Key.Address, 0, 9, 0, tmproto.PrecommitType, blockID).ToProto()
+	require.Error(t, ts2.SignVote(ctx, thresholdChainID, earlier))
+
+	// The same vote can be signed again, by any signer.
+	again := *vote
+	again.Signature = nil
+	require.NoError(t, ts2.SignVote(ctx, thresholdChainID, &again))
+	verifySig(t, pv.Key.PubKey, types.VoteSignBytes(thresholdChainID, &again), again.Signature)
+
+	// A cosigner signs only for its chain.
+	next := newVote(pv.Key.Address, 0, 11, 0, tmproto.PrevoteType, blockID).ToProto()
+	require.Error(t, ts1.SignVote(ctx, "otherchain", next))
+
+	// The cosigner state survives a restart.
+	restarted, err := NewLocalCosigner(thresholdChainID, cosigners[0].key, cosigners[0].lastSignState.filePath)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), restarted.lastSignState.Height)
+	_, err = restarted.Commit(ctx, thresholdChainID, types.VoteSignBytes(thresholdChainID, earlier))
+	require.Error(t, err)
+}
+
+func TestCosignerNonceIsUsedOnce(t *testing.T) {
+	ctx := t.Context()
+	pv, _, cosigners := newThresholdSetup(t, 2, 2)
+	blockID := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize), PartSetHeader: types.PartSetHeader{}}
+	signBytes := types.VoteSignBytes(thresholdChainID, newVote(pv.Key.Address, 0, 10, 0, tmproto.PrevoteType, blockID).ToProto())
+
+	c1, err := cosigners[0].Commit(ctx, thresholdChainID, signBytes)
+	require.NoError(t, err)
+	c2, err := cosigners[1].Commit(ctx, thresholdChainID, signBytes)
+	require.NoError(t, err)
+	commitments := []frost.Commitment{c1, c2}
+	_, err = cosigners[0].Sign(ctx, thresholdChainID, signBytes, commitments)
+	require.NoError(t, err)
+	_, err = cosigners[0].Sign(ctx, thresholdChainID, signBytes, commitments)
+	require.Error(t, err)
+}
+
+func TestSignBytesHRS(t *testing.T) {
+	blockID := types.BlockID{Hash: tmrand.Bytes(crypto.HashSize), PartSetHeader: types.PartSetHeader{}}
+	precommit := newVote(nil, 0, 10, 2, tmproto.PrecommitType, blockID).ToProto()
+	chainID, height, round, step, err := signBytesHRS(types.VoteSignBytes(thresholdChainID, precommit))
+	require.NoError(t, err)
+	require.Equal(t, thresholdChainID, chainID)
+	require.Equal(t, int64(10), height)
+	require.Equal(t, int32(2), round)
+	require.Equal(t, stepPrecommit, step)
+
+	// A proposal with POL round 0, whose sign bytes decode as a vote too.
+	proposal := newProposal(7, 1, types.BlockID{}, time.Now()).ToProto()
+	_, height, round, step, err = signBytesHRS(types.ProposalSignBytes(thresholdChainID, proposal))
+	require.NoError(t, err)
+	require.Equal(t, int64(7), height)
+	require.Equal(t, int32(1), round)
+	require.Equal(t, stepPropose, step)
+
+	_, _, _, _, err = signBytesHRS([]byte("garbage"))
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@
 package p2p
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -367,57 +368,121 @@ func (m *PeerAddressInfo) GetDialFailures() uint32 {
 	return 0
 }
 
+// PeerScore is the reputation of a peer, as of the updated time.
+type PeerScore struct {
+	ID      string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score   float64    `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Updated *time.Time `protobuf:"bytes,3,opt,name=updated,proto3,stdtime" json:"updated,omitempty"`
+}
+
+func (m *PeerScore) Reset()         { *m = PeerScore{} }
+func (m *PeerScore) String() string { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()    {}
+func (*PeerScore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8a29e659aeca578, []int{5}
+}
+func (m *PeerScore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeerScore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeerScore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeerScore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerScore.Merge(m, src)
+}
+func (m *PeerScore) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeerScore) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerScore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerScore proto.InternalMessageInfo
+
+func (m *PeerScore) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *PeerScore) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerScore) GetUpdated() *time.Time {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ProtocolVersion)(nil), "tendermint.p2p.ProtocolVersion")
 	proto.RegisterType((*NodeInfo)(nil), "tendermint.p2p.NodeInfo")
 	proto.RegisterType((*NodeInfoOther)(nil), "tendermint.p2p.NodeInfoOther")
 	proto.RegisterType((*PeerInfo)(nil), "tendermint.p2p.PeerInfo")
 	proto.RegisterType((*PeerAddressInfo)(nil), "tendermint.p2p.PeerAddressInfo")
+	proto.RegisterType((*PeerScore)(nil), "tendermint.p2p.PeerScore")
 }
 
 func init() { proto.RegisterFile("tendermint/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 619 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
-	0x14, 0xc5, 0x40, 0x80, 0x5c, 0x42, 0xc8, 0x37, 0x8a, 0x3e, 0x39, 0x48, 0xc5, 0x11, 0xd9, 0x64,
-	0x53, 0x5b, 0xa2, 0xab, 0x2e, 0xe3, 0xa4, 0xad, 0x90, 0xaa, 0x16, 0xb9, 0x51, 0x17, 0xed, 0xc2,
-	0x32, 0x9e, 0x01, 0x46, 0x31, 0x33, 0x23, 0x7b, 0x68, 0xd3, 0xb7, 0xc8, 0x9b, 0xf4, 0x31, 0x9a,
-	0x65, 0x96, 0x5d, 0xd1, 0xca, 0xd9, 0xf6, 0x21, 0xaa, 0x99, 0xb1, 0x43, 0x40, 0x5d, 0x64, 0x77,
-	0xcf, 0x3d, 0x73, 0xce, 0xfd, 0xf1, 0x78, 0xa0, 0x27, 0x09, 0xc3, 0x24, 0x5d, 0x50, 0x26, 0x3d,
-	0x31, 0x14, 0x9e, 0xfc, 0x26, 0x48, 0xe6, 0x8a, 0x94, 0x4b, 0x8e, 0xf6, 0xd7, 0x9c, 0x2b, 0x86,
-	0xa2, 0x77, 0x38, 0xe3, 0x33, 0xae, 0x29, 0x4f, 0x45, 0xe6, 0x54, 0xcf, 0x99, 0x71, 0x3e, 0x4b,
-	0x88, 0xa7, 0xd1, 0x64, 0x39, 0xf5, 0x24, 0x5d, 0x90, 0x4c, 0x46, 0x0b, 0x61, 0x0e, 0x0c, 0x2e,
-	0xa1, 0x3b, 0x56, 0x41, 0xcc, 0x93, 0x8f, 0x24, 0xcd, 0x28, 0x67, 0xe8, 0x08, 0x6a, 0x62, 0x28,
-	0x6c, 0xeb, 0xd8, 0x3a, 0xad, 0xfb, 0xcd, 0x7c, 0xe5, 0xd4, 0xc6, 0xc3, 0x71, 0xa0, 0x72, 0xe8,
-	0x10, 0x76, 0x26, 0x09, 0x8f, 0xaf, 0xec, 0xaa, 0x22, 0x03, 0x03, 0xd0, 0x01, 0xd4, 0x22, 0x21,
-	0xec, 0x9a, 0xce, 0xa9, 0x70, 0xf0, 0xa3, 0x0a, 0xad, 0x77, 0x1c, 0x93, 0x11, 0x9b, 0x72, 0x34,
-	0x86, 0x03, 0x51, 0x94, 0x08, 0xbf, 0x98, 0x1a, 0xda, 0xbc, 0x3d, 0x74, 0xdc, 0xcd, 0x21, 0xdc,
-	0xad, 0x56, 0xfc, 0xfa, 0xed, 0xca, 0xa9, 0x04, 0x5d, 0xb1, 0xd5, 0xe1, 0x09, 0x34, 0x19, 0xc7,
-	0x24, 0xa4, 0x58, 0x37, 0xb2, 0xeb, 0x43, 0xbe, 0x72, 0x1a, 0xba, 0xe0, 0x45, 0xd0, 0x50, 0xd4,
-	0x08, 0x23, 0x07, 0xda, 0x09, 0xcd, 0x24, 0x61, 0x61, 0x84, 0x71, 0xaa, 0xbb, 0xdb, 0x0d, 0xc0,
-	0xa4, 0xce, 0x30, 0x4e, 0x91, 0x0d, 0x4d, 0x46, 0xe4, 0x57, 0x9e, 0x5e, 0xd9, 0x75, 0x4d, 0x96,
-	0x50, 0x31, 0x65, 0xa3, 0x3b, 0x86, 0x29, 0x20, 0xea, 0x41, 0x2b, 0x9e, 0x47, 0x8c, 0x91, 0x24,
-	0xb3, 0x1b, 0xc7, 0xd6, 0xe9, 0x5e, 0xf0, 0x80, 0x95, 0x6a, 0xc1, 0x19, 0xbd, 0x22, 0xa9, 0xdd,
-	0x34, 0xaa, 0x02, 0xa2, 0x97, 0xb0, 0xc3, 0xe5, 0x9c, 0xa4, 0x76, 0x4b, 0x8f, 0xfd, 0x6c, 0x7b,
-	0xec, 0x72, 0x55, 0xef, 0xd5, 0xa1, 0x62, 0x68, 0xa3, 0x18, 0x7c, 0x86, 0xce, 0x06, 0x8b, 0x8e,
-	0xa0, 0x25, 0xaf, 0x43, 0xca, 0x30, 0xb9, 0xd6, 0x5b, 0xdc, 0x0d, 0x9a, 0xf2, 0x7a, 0xa4, 0x20,
-	0xf2, 0xa0, 0x9d, 0x8a, 0x58, 0x8f, 0x4b, 0xb2, 0xac, 0x58, 0xcd, 0x7e, 0xbe, 0x72, 0x20, 0x18,
-	0x9f, 0x9f, 0x99, 0x6c, 0x00, 0xa9, 0x88, 0x8b, 0x78, 0xf0, 0xdd, 0x82, 0xd6, 0x98, 0x90, 0x54,
-	0x7f, 0xa6, 0xff, 0xa1, 0x4a, 0xb1, 0xb1, 0xf4, 0x1b, 0xf9, 0xca, 0xa9, 0x8e, 0x2e, 0x82, 0x2a,
-	0xc5, 0xc8, 0x87, 0xbd, 0xc2, 0x31, 0xa4, 0x6c, 0xca, 0xed, 0xea, 0x71, 0xed, 0x9f, 0x9f, 0x8e,
-	0x90, 0xb4, 0xf0, 0x55, 0x76, 0x41, 0x3b, 0x5a, 0x03, 0xf4, 0x06, 0xf6, 0x93, 0x28, 0x93, 0x61,
-	0xcc, 0x19, 0x23, 0xb1, 0x24, 0x58, 0x7f, 0x8e, 0xf6, 0xb0, 0xe7, 0x9a, 0xfb, 0xe9, 0x96, 0xf7,
-	0xd3, 0xbd, 0x2c, 0xef, 0xa7, 0x5f, 0xbf, 0xf9, 0xe5, 0x58, 0x41, 0x47, 0xe9, 0xce, 0x4b, 0xd9,
-	0xe0, 0x8f, 0x05, 0xdd, 0xad, 0x4a, 0x6a, 0xef, 0xe5, 0xc8, 0xc5, 0x42, 0x0a, 0x88, 0xde, 0xc2,
-	0x7f, 0xba, 0x2c, 0xa6, 0x51, 0x12, 0x66, 0xcb, 0x38, 0x2e, 0xd7, 0xf2, 0x94, 0xca, 0x5d, 0x25,
-	0xbd, 0xa0, 0x51, 0xf2, 0xc1, 0x08, 0x37, 0xdd, 0xa6, 0x11, 0x4d, 0x96, 0x29, 0x79, 0xf2, 0x1c,
-	0x0f, 0x6e, 0xaf, 0x8d, 0x10, 0x9d, 0x40, 0xe7, 0xb1, 0x51, 0xa6, 0xef, 0x60, 0x27, 0xd8, 0xc3,
-	0xeb, 0x33, 0x99, 0x1f, 0xde, 0xe6, 0x7d, 0xeb, 0x2e, 0xef, 0x5b, 0xbf, 0xf3, 0xbe, 0x75, 0x73,
-	0xdf, 0xaf, 0xdc, 0xdd, 0xf7, 0x2b, 0x3f, 0xef, 0xfb, 0x95, 0x4f, 0xaf, 0x66, 0x54, 0xce, 0x97,
-	0x13, 0x37, 0xe6, 0x0b, 0x2f, 0x23, 0xf4, 0x79, 0xf9, 0x8b, 0x68, 0x10, 0xcf, 0x23, 0xca, 0x74,
-	0xf4, 0xf8, 0x01, 0xd1, 0x6f, 0xc3, 0xe6, 0x8b, 0x32, 0x69, 0xe8, 0xec, 0x8b, 0xbf, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xa2, 0x71, 0x1a, 0x41, 0x6a, 0x04, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8f, 0xf3, 0x3f, 0x93, 0xa6, 0xe9, 0xb7, 0xaa, 0x3e, 0xb9, 0x91, 0xbe, 0xb8, 0x4a, 0x2f,
+	0xbd, 0x7c, 0x8e, 0x14, 0x4e, 0x70, 0x6b, 0x5a, 0x40, 0x91, 0x10, 0x44, 0x6e, 0xc5, 0x01, 0x0e,
+	0x96, 0xe3, 0xdd, 0x24, 0xab, 0x3a, 0xbb, 0xab, 0xf5, 0x06, 0xca, 0x5b, 0xf4, 0x4d, 0x78, 0x0c,
+	0x7a, 0xec, 0x91, 0x53, 0x40, 0xe9, 0x95, 0x87, 0x40, 0xbb, 0x6b, 0xd3, 0x26, 0x02, 0x89, 0xde,
+	0xe6, 0x37, 0x33, 0xbf, 0x99, 0xf9, 0xcd, 0x8e, 0x0d, 0x1d, 0x45, 0x18, 0x26, 0x72, 0x41, 0x99,
+	0xea, 0x8b, 0x81, 0xe8, 0xab, 0x4f, 0x82, 0xa4, 0xbe, 0x90, 0x5c, 0x71, 0xb4, 0x7b, 0x1f, 0xf3,
+	0xc5, 0x40, 0x74, 0xf6, 0x67, 0x7c, 0xc6, 0x4d, 0xa8, 0xaf, 0x2d, 0x9b, 0xd5, 0xf1, 0x66, 0x9c,
+	0xcf, 0x12, 0xd2, 0x37, 0x68, 0xb2, 0x9c, 0xf6, 0x15, 0x5d, 0x90, 0x54, 0x45, 0x0b, 0x61, 0x13,
+	0x7a, 0x17, 0xd0, 0x1e, 0x6b, 0x23, 0xe6, 0xc9, 0x5b, 0x22, 0x53, 0xca, 0x19, 0x3a, 0x80, 0x92,
+	0x18, 0x08, 0xd7, 0x39, 0x74, 0x8e, 0xcb, 0xc3, 0xda, 0x7a, 0xe5, 0x95, 0xc6, 0x83, 0x71, 0xa0,
+	0x7d, 0x68, 0x1f, 0x2a, 0x93, 0x84, 0xc7, 0x97, 0x6e, 0x51, 0x07, 0x03, 0x0b, 0xd0, 0x1e, 0x94,
+	0x22, 0x21, 0xdc, 0x92, 0xf1, 0x69, 0xb3, 0xf7, 0xa5, 0x08, 0xf5, 0xd7, 0x1c, 0x93, 0x11, 0x9b,
+	0x72, 0x34, 0x86, 0x3d, 0x91, 0xb5, 0x08, 0x3f, 0xd8, 0x1e, 0xa6, 0x78, 0x73, 0xe0, 0xf9, 0x9b,
+	0x22, 0xfc, 0xad, 0x51, 0x86, 0xe5, 0x9b, 0x95, 0x57, 0x08, 0xda, 0x62, 0x6b, 0xc2, 0x23, 0xa8,
+	0x31, 0x8e, 0x49, 0x48, 0xb1, 0x19, 0xa4, 0x31, 0x84, 0xf5, 0xca, 0xab, 0x9a, 0x86, 0x67, 0x41,
+	0x55, 0x87, 0x46, 0x18, 0x79, 0xd0, 0x4c, 0x68, 0xaa, 0x08, 0x0b, 0x23, 0x8c, 0xa5, 0x99, 0xae,
+	0x11, 0x80, 0x75, 0x9d, 0x60, 0x2c, 0x91, 0x0b, 0x35, 0x46, 0xd4, 0x47, 0x2e, 0x2f, 0xdd, 0xb2,
+	0x09, 0xe6, 0x50, 0x47, 0xf2, 0x41, 0x2b, 0x36, 0x92, 0x41, 0xd4, 0x81, 0x7a, 0x3c, 0x8f, 0x18,
+	0x23, 0x49, 0xea, 0x56, 0x0f, 0x9d, 0xe3, 0x9d, 0xe0, 0x17, 0xd6, 0xac, 0x05, 0x67, 0xf4, 0x92,
+	0x48, 0xb7, 0x66, 0x59, 0x19, 0x44, 0x4f, 0xa1, 0xc2, 0xd5, 0x9c, 0x48, 0xb7, 0x6e, 0x64, 0xff,
+	0xb7, 0x2d, 0x3b, 0x5f, 0xd5, 0x1b, 0x9d, 0x94, 0x89, 0xb6, 0x8c, 0xde, 0x7b, 0x68, 0x6d, 0x44,
+	0xd1, 0x01, 0xd4, 0xd5, 0x55, 0x48, 0x19, 0x26, 0x57, 0x66, 0x8b, 0x8d, 0xa0, 0xa6, 0xae, 0x46,
+	0x1a, 0xa2, 0x3e, 0x34, 0xa5, 0x88, 0x8d, 0x5c, 0x92, 0xa6, 0xd9, 0x6a, 0x76, 0xd7, 0x2b, 0x0f,
+	0x82, 0xf1, 0xe9, 0x89, 0xf5, 0x06, 0x20, 0x45, 0x9c, 0xd9, 0xbd, 0xcf, 0x0e, 0xd4, 0xc7, 0x84,
+	0x48, 0xf3, 0x4c, 0xff, 0x42, 0x91, 0x62, 0x5b, 0x72, 0x58, 0x5d, 0xaf, 0xbc, 0xe2, 0xe8, 0x2c,
+	0x28, 0x52, 0x8c, 0x86, 0xb0, 0x93, 0x55, 0x0c, 0x29, 0x9b, 0x72, 0xb7, 0x78, 0x58, 0xfa, 0xed,
+	0xd3, 0x11, 0x22, 0xb3, 0xba, 0xba, 0x5c, 0xd0, 0x8c, 0xee, 0x01, 0x7a, 0x09, 0xbb, 0x49, 0x94,
+	0xaa, 0x30, 0xe6, 0x8c, 0x91, 0x58, 0x11, 0x6c, 0x9e, 0xa3, 0x39, 0xe8, 0xf8, 0xf6, 0x3e, 0xfd,
+	0xfc, 0x3e, 0xfd, 0x8b, 0xfc, 0x3e, 0x87, 0xe5, 0xeb, 0x6f, 0x9e, 0x13, 0xb4, 0x34, 0xef, 0x34,
+	0xa7, 0xf5, 0x7e, 0x38, 0xd0, 0xde, 0xea, 0xa4, 0xf7, 0x9e, 0x4b, 0xce, 0x16, 0x92, 0x41, 0xf4,
+	0x0a, 0xfe, 0x31, 0x6d, 0x31, 0x8d, 0x92, 0x30, 0x5d, 0xc6, 0x71, 0xbe, 0x96, 0xbf, 0xe9, 0xdc,
+	0xd6, 0xd4, 0x33, 0x1a, 0x25, 0xe7, 0x96, 0xb8, 0x59, 0x6d, 0x1a, 0xd1, 0x64, 0x29, 0x89, 0x5b,
+	0x7a, 0x6c, 0xb5, 0x17, 0x96, 0x88, 0x8e, 0xa0, 0xf5, 0xb0, 0x50, 0x6a, 0x6e, 0xb0, 0x15, 0xec,
+	0xe0, 0xfb, 0x9c, 0xb4, 0xb7, 0x84, 0x86, 0x56, 0x7b, 0x1e, 0x73, 0x49, 0xfe, 0xf8, 0x40, 0xfb,
+	0x50, 0x49, 0x75, 0x82, 0x51, 0xe6, 0x04, 0x16, 0xa0, 0x67, 0x50, 0x5b, 0x0a, 0x1c, 0x3d, 0x66,
+	0xd7, 0x39, 0x61, 0x18, 0xde, 0xac, 0xbb, 0xce, 0xed, 0xba, 0xeb, 0x7c, 0x5f, 0x77, 0x9d, 0xeb,
+	0xbb, 0x6e, 0xe1, 0xf6, 0xae, 0x5b, 0xf8, 0x7a, 0xd7, 0x2d, 0xbc, 0x7b, 0x3e, 0xa3, 0x6a, 0xbe,
+	0x9c, 0xf8, 0x31, 0x5f, 0xf4, 0x53, 0x42, 0xff, 0xcf, 0xbf, 0x4c, 0x03, 0xe2, 0x79, 0x44, 0x99,
+	0xb1, 0x1e, 0xfe, 0xb7, 0xcc, 0x2f, 0x69, 0xf3, 0x47, 0x36, 0xa9, 0x1a, 0xef, 0x93, 0x9f, 0x03,
+	0x00, 0xe6, 0x3f, 0xaa, 0x55, 0xe1, 0x04, 0x00, 0x00,
 }
 
 func (m *ProtocolVersion) Marshal() (dAtA []byte, err error) {
@@ -689,6 +754,52 @@ func (m *PeerAddressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeerScore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerScore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerScore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Updated != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Updated, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintTypes(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Score != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -819,6 +930,26 @@ func (m *PeerAddressInfo) Size() (n int) {
 	return n
 }
 
+func (m *PeerScore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.Updated != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Updated)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1681,6 +1812,135 @@ func (m *PeerAddressInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PeerScore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeerScore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeerScore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Updated, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -49,4 +49,11 @@ func init() {
 		4: {MaxCount: 1},
 	})
 
+	// Register the wireguard.Schema generated for tendermint.p2p.PeerScore.
+	runtime.MustRegister[*PeerScore](runtime.Schema{
+		1: {MaxCount: 1},
+		2: {MaxCount: 1},
+		3: {MaxCount: 1, Nested: utils.Some(reflect.TypeFor[*timestamppb.Timestamp]())},
+	})
+
 }
@@ -0,0 +1,1403 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/privval/threshold.proto
+
+package privval
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ThresholdCommitment is a cosigner's commitment to the nonces of a signing session.
+type ThresholdCommitment struct {
+	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Hiding  []byte `protobuf:"bytes,2,opt,name=hiding,proto3" json:"hiding,omitempty"`
+	Binding []byte `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+}
+
+func (m *ThresholdCommitment) Reset()         { *m = ThresholdCommitment{} }
+func (m *ThresholdCommitment) String() string { return proto.CompactTextString(m) }
+func (*ThresholdCommitment) ProtoMessage()    {}
+func (*ThresholdCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94624154567152fe, []int{0}
+}
+func (m *ThresholdCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdCommitment.Merge(m, src)
+}
+func (m *ThresholdCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdCommitment proto.InternalMessageInfo
+
+func (m *ThresholdCommitment) GetId() uint32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ThresholdCommitment) GetHiding() []byte {
+	if m != nil {
+		return m.Hiding
+	}
+	return nil
+}
+
+func (m *ThresholdCommitment) GetBinding() []byte {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+// ThresholdCommitRequest asks a cosigner to commit to fresh nonces for signing sign_bytes.
+type ThresholdCommitRequest struct {
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SignBytes []byte `protobuf:"bytes,2,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+}
+
+func (m *ThresholdCommitRequest) Reset()         { *m = ThresholdCommitRequest{} }
+func (m *ThresholdCommitRequest) String() string { return proto.CompactTextString(m) }
+func (*ThresholdCommitRequest) ProtoMessage()    {}
+func (*ThresholdCommitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94624154567152fe, []int{1}
+}
+func (m *ThresholdCommitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdCommitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdCommitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdCommitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdCommitRequest.Merge(m, src)
+}
+func (m *ThresholdCommitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdCommitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdCommitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdCommitRequest proto.InternalMessageInfo
+
+func (m *ThresholdCommitRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ThresholdCommitRequest) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+type ThresholdCommitResponse struct {
+	Commitment *ThresholdCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *ThresholdCommitResponse) Reset()         { *m = ThresholdCommitResponse{} }
+func (m *ThresholdCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*ThresholdCommitResponse) ProtoMessage()    {}
+func (*ThresholdCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94624154567152fe, []int{2}
+}
+func (m *ThresholdCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdCommitResponse.Merge(m, src)
+}
+func (m *ThresholdCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdCommitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdCommitResponse proto.InternalMessageInfo
+
+func (m *ThresholdCommitResponse) GetCommitment() *ThresholdCommitment {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// ThresholdSignRequest asks a cosigner for its signature share of sign_bytes, given the
+// commitments of all the signing cosigners.
+type ThresholdSignRequest struct {
+	ChainId     string                 `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	SignBytes   []byte                 `protobuf:"bytes,2,opt,name=sign_bytes,json=signBytes,proto3" json:"sign_bytes,omitempty"`
+	Commitments []*ThresholdCommitment `protobuf:"bytes,3,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (m *ThresholdSignRequest) Reset()         { *m = ThresholdSignRequest{} }
+func (m *ThresholdSignRequest) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSignRequest) ProtoMessage()    {}
+func (*ThresholdSignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94624154567152fe, []int{3}
+}
+func (m *ThresholdSignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdSignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdSignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSignRequest.Merge(m, src)
+}
+func (m *ThresholdSignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdSignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSignRequest proto.InternalMessageInfo
+
+func (m *ThresholdSignRequest) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ThresholdSignRequest) GetSignBytes() []byte {
+	if m != nil {
+		return m.SignBytes
+	}
+	return nil
+}
+
+func (m *ThresholdSignRequest) GetCommitments() []*ThresholdCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+type ThresholdSignResponse struct {
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (m *ThresholdSignResponse) Reset()         { *m = ThresholdSignResponse{} }
+func (m *ThresholdSignResponse) String() string { return proto.CompactTextString(m) }
+func (*ThresholdSignResponse) ProtoMessage()    {}
+func (*ThresholdSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94624154567152fe, []int{4}
+}
+func (m *ThresholdSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdSignResponse.Merge(m, src)
+}
+func (m *ThresholdSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdSignResponse proto.InternalMessageInfo
+
+func (m *ThresholdSignResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ThresholdCommitment)(nil), "tendermint.privval.ThresholdCommitment")
+	proto.RegisterType((*ThresholdCommitRequest)(nil), "tendermint.privval.ThresholdCommitRequest")
+	proto.RegisterType((*ThresholdCommitResponse)(nil), "tendermint.privval.ThresholdCommitResponse")
+	proto.RegisterType((*ThresholdSignRequest)(nil), "tendermint.privval.ThresholdSignRequest")
+	proto.RegisterType((*ThresholdSignResponse)(nil), "tendermint.privval.ThresholdSignResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/privval/threshold.proto", fileDescriptor_94624154567152fe)
+}
+
+var fileDescriptor_94624154567152fe = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcf, 0x4e, 0xfa, 0x40,
+	0x10, 0xc7, 0x29, 0xfc, 0x7e, 0x20, 0x03, 0x7a, 0x58, 0x11, 0x2b, 0x89, 0x0d, 0xe9, 0x45, 0xd4,
+	0xd0, 0x26, 0xf8, 0x04, 0xc2, 0xc1, 0xf4, 0x66, 0xaa, 0x89, 0x89, 0x1e, 0x48, 0xff, 0x6c, 0xda,
+	0x4d, 0xe8, 0x2e, 0x76, 0x17, 0x12, 0xdf, 0xc2, 0xbb, 0x2f, 0xe4, 0x91, 0x93, 0xf1, 0x68, 0xe0,
+	0x45, 0x4c, 0x97, 0x02, 0x15, 0x8c, 0xc1, 0x78, 0xeb, 0x77, 0xe7, 0x3b, 0x33, 0x9f, 0xd9, 0xee,
+	0x80, 0x2e, 0x30, 0xf5, 0x71, 0x1c, 0x11, 0x2a, 0xcc, 0x61, 0x4c, 0xc6, 0x63, 0x67, 0x60, 0x8a,
+	0x30, 0xc6, 0x3c, 0x64, 0x03, 0xdf, 0x18, 0xc6, 0x4c, 0x30, 0x84, 0x56, 0x1e, 0x23, 0xf5, 0xe8,
+	0x77, 0xb0, 0x7f, 0xbb, 0xb0, 0xf5, 0x58, 0x14, 0x11, 0x11, 0x61, 0x2a, 0xd0, 0x1e, 0xe4, 0x89,
+	0xaf, 0x2a, 0x4d, 0xa5, 0xb5, 0x6b, 0xe7, 0x89, 0x8f, 0xea, 0x50, 0x0c, 0x89, 0x4f, 0x68, 0xa0,
+	0xe6, 0x9b, 0x4a, 0xab, 0x6a, 0xa7, 0x0a, 0xa9, 0x50, 0x72, 0x09, 0x95, 0x81, 0x82, 0x0c, 0x2c,
+	0xa4, 0x6e, 0x43, 0x7d, 0xad, 0xb0, 0x8d, 0x1f, 0x47, 0x98, 0x0b, 0x74, 0x04, 0x3b, 0x5e, 0xe8,
+	0x10, 0xda, 0x4f, 0x3b, 0x94, 0xed, 0x92, 0xd4, 0x96, 0x8f, 0x8e, 0x01, 0x38, 0x09, 0x68, 0xdf,
+	0x7d, 0x12, 0x98, 0xa7, 0xad, 0xca, 0xc9, 0x49, 0x37, 0x39, 0xd0, 0x5d, 0x38, 0xdc, 0xa8, 0xc9,
+	0x87, 0x8c, 0x72, 0x8c, 0xae, 0x00, 0xbc, 0x25, 0xbe, 0x2c, 0x5b, 0xe9, 0x9c, 0x18, 0x9b, 0x03,
+	0x1b, 0xdf, 0x4c, 0x6b, 0x67, 0x52, 0xf5, 0x17, 0x05, 0x6a, 0x4b, 0xcf, 0x0d, 0x09, 0xe8, 0x9f,
+	0xb1, 0x91, 0x05, 0x95, 0x55, 0x03, 0xae, 0x16, 0x9a, 0x85, 0xdf, 0xc0, 0x65, 0x73, 0xf5, 0x36,
+	0x1c, 0xac, 0xc1, 0xa5, 0xf3, 0xd7, 0xe0, 0x3f, 0x0f, 0x9d, 0x18, 0x4b, 0xb4, 0xaa, 0x3d, 0x17,
+	0x9d, 0xb7, 0xec, 0x30, 0x3d, 0x96, 0x20, 0xe1, 0xf8, 0xf2, 0xda, 0x42, 0x0e, 0x14, 0xe7, 0x2d,
+	0xd0, 0xd9, 0x16, 0x1c, 0xe9, 0x15, 0x34, 0xce, 0xb7, 0xf2, 0xa6, 0x44, 0x0f, 0xf0, 0x2f, 0x21,
+	0x44, 0xad, 0x1f, 0x93, 0x32, 0x37, 0xdc, 0x38, 0xdd, 0xc2, 0x39, 0x2f, 0xde, 0xf5, 0x5e, 0xa7,
+	0x9a, 0x32, 0x99, 0x6a, 0xca, 0xc7, 0x54, 0x53, 0x9e, 0x67, 0x5a, 0x6e, 0x32, 0xd3, 0x72, 0xef,
+	0x33, 0x2d, 0x77, 0x6f, 0x05, 0x44, 0x84, 0x23, 0xd7, 0xf0, 0x58, 0x64, 0x72, 0x4c, 0xda, 0xf2,
+	0xe9, 0x7b, 0x6c, 0x20, 0x85, 0xfc, 0x61, 0xf2, 0xeb, 0xcb, 0xba, 0x30, 0xc1, 0xcc, 0xcd, 0xfd,
+	0x71, 0x8b, 0x32, 0x72, 0xf1, 0x39, 0x00, 0xe2, 0x50, 0x26, 0x5b, 0x5c, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ThresholdCosignerAPIClient is the client API for ThresholdCosignerAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ThresholdCosignerAPIClient interface {
+	Commit(ctx context.Context, in *ThresholdCommitRequest, opts ...grpc.CallOption) (*ThresholdCommitResponse, error)
+	Sign(ctx context.Context, in *ThresholdSignRequest, opts ...grpc.CallOption) (*ThresholdSignResponse, error)
+}
+
+type thresholdCosignerAPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewThresholdCosignerAPIClient(cc *grpc.ClientConn) ThresholdCosignerAPIClient {
+	return &thresholdCosignerAPIClient{cc}
+}
+
+func (c *thresholdCosignerAPIClient) Commit(ctx context.Context, in *ThresholdCommitRequest, opts ...grpc.CallOption) (*ThresholdCommitResponse, error) {
+	out := new(ThresholdCommitResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.privval.ThresholdCosignerAPI/Commit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thresholdCosignerAPIClient) Sign(ctx context.Context, in *ThresholdSignRequest, opts ...grpc.CallOption) (*ThresholdSignResponse, error) {
+	out := new(ThresholdSignResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.privval.ThresholdCosignerAPI/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ThresholdCosignerAPIServer is the server API for ThresholdCosignerAPI service.
+type ThresholdCosignerAPIServer interface {
+	Commit(context.Context, *ThresholdCommitRequest) (*ThresholdCommitResponse, error)
+	Sign(context.Context, *ThresholdSignRequest) (*ThresholdSignResponse, error)
+}
+
+// UnimplementedThresholdCosignerAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedThresholdCosignerAPIServer struct {
+}
+
+func (*UnimplementedThresholdCosignerAPIServer) Commit(ctx context.Context, req *ThresholdCommitRequest) (*ThresholdCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commit not implemented")
+}
+func (*UnimplementedThresholdCosignerAPIServer) Sign(ctx context.Context, req *ThresholdSignRequest) (*ThresholdSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+
+func RegisterThresholdCosignerAPIServer(s *grpc.Server, srv ThresholdCosignerAPIServer) {
+	s.RegisterService(&_ThresholdCosignerAPI_serviceDesc, srv)
+}
+
+func _ThresholdCosignerAPI_Commit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThresholdCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThresholdCosignerAPIServer).Commit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.privval.ThresholdCosignerAPI/Commit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThresholdCosignerAPIServer).Commit(ctx, req.(*ThresholdCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThresholdCosignerAPI_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThresholdSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThresholdCosignerAPIServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.privval.ThresholdCosignerAPI/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThresholdCosignerAPIServer).Sign(ctx, req.(*ThresholdSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ThresholdCosignerAPI_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.privval.ThresholdCosignerAPI",
+	HandlerType: (*ThresholdCosignerAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Commit",
+			Handler:    _ThresholdCosignerAPI_Commit_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _ThresholdCosignerAPI_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/privval/threshold.proto",
+}
+
+func (m *ThresholdCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Binding) > 0 {
+		i -= len(m.Binding)
+		copy(dAtA[i:], m.Binding)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.Binding)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hiding) > 0 {
+		i -= len(m.Hiding)
+		copy(dAtA[i:], m.Hiding)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.Hiding)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintThreshold(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdCommitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdCommitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdCommitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Commitment != nil {
+		{
+			size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintThreshold(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintThreshold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SignBytes) > 0 {
+		i -= len(m.SignBytes)
+		copy(dAtA[i:], m.SignBytes)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.SignBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ThresholdSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintThreshold(dAtA []byte, offset int, v uint64) int {
+	offset -= sovThreshold(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ThresholdCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovThreshold(uint64(m.Id))
+	}
+	l = len(m.Hiding)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	l = len(m.Binding)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdCommitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Commitment != nil {
+		l = m.Commitment.Size()
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	return n
+}
+
+func (m *ThresholdSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	l = len(m.SignBytes)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovThreshold(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ThresholdSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	return n
+}
+
+func sovThreshold(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozThreshold(x uint64) (n int) {
+	return sovThreshold(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ThresholdCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hiding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hiding = append(m.Hiding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hiding == nil {
+				m.Hiding = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binding", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binding = append(m.Binding[:0], dAtA[iNdEx:postIndex]...)
+			if m.Binding == nil {
+				m.Binding = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdCommitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdCommitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commitment == nil {
+				m.Commitment = &ThresholdCommitment{}
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignBytes = append(m.SignBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignBytes == nil {
+				m.SignBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &ThresholdCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ThresholdSignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdSignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipThreshold(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthThreshold
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupThreshold
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthThreshold
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthThreshold        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowThreshold          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupThreshold = fmt.Errorf("proto: unexpected end of group")
+)
@@ -282,6 +282,14 @@ func (c *baseRPCClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.Resul
 	return result, nil
 }
 
+func (c *baseRPCClient) ProposalOrdering(ctx context.Context) (*coretypes.ResultProposalOrdering, error) {
+	result := new(coretypes.ResultProposalOrdering)
+	if err := c.caller.Call(ctx, "proposal_ordering", nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) CheckTx(ctx context.Context, tx types.Tx) (*coretypes.ResultCheckTx, error) {
 	result := new(coretypes.ResultCheckTx)
 	if err := c.caller.Call(ctx, "check_tx", &coretypes.RequestCheckTx{Tx: tx}, result); err != nil {
@@ -298,6 +306,22 @@ func (c *baseRPCClient) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo,
 	return result, nil
 }
 
+func (c *baseRPCClient) PeerScores(ctx context.Context) (*coretypes.ResultPeerScores, error) {
+	result := new(coretypes.ResultPeerScores)
+	if err := c.caller.Call(ctx, "peer_scores", nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) DumpFlightRecorder(ctx context.Context) (*coretypes.ResultFlightRecorder, error) {
+	result := new(coretypes.ResultFlightRecorder)
+	if err := c.caller.Call(ctx, "dump_flight_recorder", nil, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	result := new(coretypes.ResultDumpConsensusState)
 	if err := c.caller.Call(ctx, "dump_consensus_state", nil, result); err != nil {
@@ -116,7 +116,9 @@ type StatusClient interface {
 // usually.
 type NetworkClient interface {
 	NetInfo(context.Context) (*coretypes.ResultNetInfo, error)
+	PeerScores(context.Context) (*coretypes.ResultPeerScores, error)
 	DumpConsensusState(context.Context) (*coretypes.ResultDumpConsensusState, error)
+	DumpFlightRecorder(context.Context) (*coretypes.ResultFlightRecorder, error)
 	ConsensusState(context.Context) (*coretypes.ResultConsensusState, error)
 	ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error)
 	Health(context.Context) (*coretypes.ResultHealth, error)
@@ -165,6 +167,7 @@ type MempoolClient interface {
 	UnconfirmedTxs(ctx context.Context, page, perPage *int) (*coretypes.ResultUnconfirmedTxs, error)
 	NumUnconfirmedTxs(context.Context) (*coretypes.ResultUnconfirmedTxs, error)
 	CheckTx(context.Context, types.Tx) (*coretypes.ResultCheckTx, error)
+	ProposalOrdering(context.Context) (*coretypes.ResultProposalOrdering, error)
 }
 
 // EvidenceClient is used for submitting an evidence of the malicious
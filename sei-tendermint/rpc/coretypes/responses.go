@@ -245,7 +245,20 @@ type Peer struct {
 type PeerConnection struct {
 	ID    types.NodeID `json:"node_id"`
 	State string       `json:"state"`
-	Score int          `json:"score,string"`
+	// Score is the peer's score shifted by 100, in [0,200]: 100 is neutral.
+	Score int `json:"score,string"`
+}
+
+// Scores of peers, lowest first
+type ResultPeerScores struct {
+	Scores []PeerScore `json:"scores"`
+}
+
+// A peer score
+type PeerScore struct {
+	ID     types.NodeID `json:"node_id"`
+	Score  float64      `json:"score"`
+	Banned bool         `json:"banned"`
 }
 
 // Validators for a height.
@@ -270,6 +283,12 @@ type ResultDumpConsensusState struct {
 	Peers      []PeerStateInfo `json:"peers"`
 }
 
+// Recent consensus and mempool events, oldest first.
+// UNSTABLE
+type ResultFlightRecorder struct {
+	Events json.RawMessage `json:"events"`
+}
+
 // UNSTABLE
 type PeerStateInfo struct {
 	NodeAddress string          `json:"node_address"`
@@ -333,6 +352,35 @@ type ResultUnconfirmedTxs struct {
 	Txs        []types.Tx `json:"txs"`
 }
 
+// Recent orders in which the mempool handed transactions to a proposal, oldest
+// first.
+// UNSTABLE
+type ResultProposalOrdering struct {
+	Decisions []OrderingDecision `json:"decisions"`
+}
+
+// UNSTABLE
+type OrderingDecision struct {
+	Height            int64       `json:"height,string"`
+	Time              time.Time   `json:"time"`
+	Policy            string      `json:"policy"`
+	InterleaveSenders bool        `json:"interleave_senders"`
+	Txs               []OrderedTx `json:"txs"`
+}
+
+// A transaction of an ordering decision, in proposal order. Sender and nonce are
+// set for EVM transactions. Score is the transaction's effective score under the
+// decision's policy; lower scores go first.
+// UNSTABLE
+type OrderedTx struct {
+	Hash      bytes.HexBytes `json:"hash"`
+	Sender    string         `json:"sender,omitempty"`
+	Nonce     uint64         `json:"nonce,string"`
+	Priority  int64          `json:"priority,string"`
+	ArrivedAt time.Time      `json:"arrived_at"`
+	Score     int64          `json:"score,string"`
+}
+
 // Info abci msg
 type ResultABCIInfo struct {
 	Response abci.ResponseInfo `json:"response"`
@@ -54,6 +54,5 @@ func TestBasicDecorator(t *testing.T) {
 	ctx, err = a.AnteHandle(ctx, &mockTx{msgs: []sdk.Msg{msg}}, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
 		return ctx, nil
 	})
-	require.NotNil(t, err)
-	require.Error(t, err, sdkerrors.ErrUnsupportedTxType)
+	require.ErrorIs(t, err, sdkerrors.ErrUnsupportedTxType)
 }
@@ -0,0 +1,123 @@
+package ante
+
+import (
+	"crypto/sha256"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	lru "github.com/hashicorp/golang-lru/v2"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
+
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	evmtypes "github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// verifiedSidecarCacheSize bounds the KZG verification cache; a block holds at most
+// MaxBlobsPerBlock blob transactions, so this covers a deep mempool.
+const verifiedSidecarCacheSize = 4096
+
+// verifiedSidecars maps the hash of a blob transaction whose KZG proofs verified to the digest
+// of the sidecar they verified for, so CheckTx, ReCheckTx and DeliverTx verify a transaction
+// once. The transaction hash does not cover the sidecar, hence the digest.
+var verifiedSidecars = newVerifiedSidecarCache()
+
+func newVerifiedSidecarCache() *lru.Cache[common.Hash, common.Hash] {
+	c, err := lru.New[common.Hash, common.Hash](verifiedSidecarCacheSize)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// ValidateBlobTx checks an EIP-4844 blob transaction; other transaction types pass. Until the
+// BlobTxsEnabled param is turned on, blob transactions are rejected with the root
+// ErrUnsupportedTxType, the result they had before blob support existed. Otherwise the sidecar
+// must match the transaction's blob hashes and carry valid KZG proofs, and in DeliverTx the
+// block must have room for the transaction's blob gas.
+func ValidateBlobTx(ctx sdk.Context, k *keeper.Keeper, etx *ethtypes.Transaction) error {
+	if etx.Type() != ethtypes.BlobTxType {
+		return nil
+	}
+	if !k.BlobTxsEnabled(ctx) {
+		return sdkerrors.ErrUnsupportedTxType
+	}
+	sidecar := etx.BlobTxSidecar()
+	if sidecar == nil {
+		return evmtypes.ErrBlobSidecarMissing
+	}
+	// Ensure the number of items in the blob transaction and various side
+	// data match up before doing any expensive validations
+	hashes := etx.BlobHashes()
+	if len(hashes) == 0 {
+		return evmtypes.ErrBlobTxNoBlobs
+	}
+	if len(hashes) > evmtypes.MaxBlobsPerBlock {
+		return sdkerrors.Wrapf(evmtypes.ErrBlobTxTooManyBlobs, "have %d, permitted %d", len(hashes), evmtypes.MaxBlobsPerBlock)
+	}
+	if err := validateBlobSidecar(etx.Hash(), hashes, sidecar); err != nil {
+		return err
+	}
+	if !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
+		if used := k.BlockBlobGasUsed(ctx); used+etx.BlobGas() > evmtypes.MaxBlobGasPerBlock {
+			return sdkerrors.Wrapf(evmtypes.ErrBlockBlobGasExceeded, "block has used %d of %d, tx needs %d", used, evmtypes.MaxBlobGasPerBlock, etx.BlobGas())
+		}
+	}
+	return nil
+}
+
+func validateBlobSidecar(txHash common.Hash, hashes []common.Hash, sidecar *ethtypes.BlobTxSidecar) error {
+	if len(sidecar.Blobs) != len(hashes) {
+		return sdkerrors.Wrapf(evmtypes.ErrBlobSidecarMismatch, "%d blobs for %d blob hashes", len(sidecar.Blobs), len(hashes))
+	}
+	if len(sidecar.Commitments) != len(hashes) {
+		return sdkerrors.Wrapf(evmtypes.ErrBlobSidecarMismatch, "%d commitments for %d blob hashes", len(sidecar.Commitments), len(hashes))
+	}
+	if len(sidecar.Proofs) != len(hashes) {
+		return sdkerrors.Wrapf(evmtypes.ErrBlobSidecarMismatch, "%d proofs for %d blob hashes", len(sidecar.Proofs), len(hashes))
+	}
+	// Blob quantities match up, validate that the commitments match the
+	// transaction's versioned hashes before getting to the cryptography
+	hasher := sha256.New()
+	for i, want := range hashes {
+		hasher.Write(sidecar.Commitments[i][:])
+		hash := hasher.Sum(nil)
+		hasher.Reset()
+
+		var vhash common.Hash
+		vhash[0] = params.BlobTxHashVersion
+		copy(vhash[1:], hash[1:])
+
+		if vhash != want {
+			return sdkerrors.Wrapf(evmtypes.ErrBlobSidecarMismatch, "blob %d: computed hash %#x mismatches transaction one %#x", i, vhash, want)
+		}
+	}
+	// Blob commitments match with the hashes in the transaction, verify the
+	// blobs themselves via KZG unless this sidecar already verified
+	digest := sidecarDigest(sidecar)
+	if verified, ok := verifiedSidecars.Get(txHash); ok && verified == digest {
+		return nil
+	}
+	for i := range sidecar.Blobs {
+		if err := kzg4844.VerifyBlobProof(&sidecar.Blobs[i], sidecar.Commitments[i], sidecar.Proofs[i]); err != nil {
+			return sdkerrors.Wrapf(evmtypes.ErrBlobInvalidProof, "blob %d: %v", i, err)
+		}
+	}
+	verifiedSidecars.Add(txHash, digest)
+	return nil
+}
+
+// sidecarDigest hashes the blobs and proofs of a sidecar; its commitments are checked against
+// the transaction on every call.
+func sidecarDigest(sidecar *ethtypes.BlobTxSidecar) common.Hash {
+	hasher := sha256.New()
+	for i := range sidecar.Blobs {
+		hasher.Write(sidecar.Blobs[i][:])
+	}
+	for i := range sidecar.Proofs {
+		hasher.Write(sidecar.Proofs[i][:])
+	}
+	return common.BytesToHash(hasher.Sum(nil))
+}
@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/holiman/uint256"
+	sdkerrors "github.com/sei-protocol/sei-chain/sei-cosmos/types/errors"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/ante"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func blobTxForTest(sidecar *ethtypes.BlobTxSidecar, hashes []common.Hash) *ethtypes.Transaction {
+	return ethtypes.NewTx(&ethtypes.BlobTx{
+		ChainID:    uint256.NewInt(713714),
+		Gas:        21000,
+		GasFeeCap:  uint256.NewInt(1000000000),
+		GasTipCap:  uint256.NewInt(1000000000),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: hashes,
+		Sidecar:    sidecar,
+	})
+}
+
+func validBlobSidecar(t *testing.T) (*ethtypes.BlobTxSidecar, []common.Hash) {
+	var blob kzg4844.Blob
+	blob[1] = 1
+	commitment, err := kzg4844.BlobToCommitment(&blob)
+	require.NoError(t, err)
+	proof, err := kzg4844.ComputeBlobProof(&blob, commitment)
+	require.NoError(t, err)
+	hash := common.Hash(kzg4844.CalcBlobHashV1(sha256.New(), &commitment))
+	return &ethtypes.BlobTxSidecar{
+		Blobs:       []kzg4844.Blob{blob},
+		Commitments: []kzg4844.Commitment{commitment},
+		Proofs:      []kzg4844.Proof{proof},
+	}, []common.Hash{hash}
+}
+
+func TestValidateBlobTx(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	sidecar, hashes := validBlobSidecar(t)
+	tx := blobTxForTest(sidecar, hashes)
+
+	// Rejected while the param is off.
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, tx), sdkerrors.ErrUnsupportedTxType)
+	// Other tx types are not its concern.
+	require.NoError(t, ante.ValidateBlobTx(ctx, k, ethtypes.NewTx(&ethtypes.DynamicFeeTx{})))
+
+	params := k.GetParams(ctx)
+	params.BlobTxsEnabled = true
+	k.SetParams(ctx, params)
+	require.NoError(t, ante.ValidateBlobTx(ctx, k, tx))
+
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, blobTxForTest(nil, hashes)), types.ErrBlobSidecarMissing)
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, blobTxForTest(sidecar, nil)), types.ErrBlobTxNoBlobs)
+	tooMany := make([]common.Hash, types.MaxBlobsPerBlock+1)
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, blobTxForTest(sidecar, tooMany)), types.ErrBlobTxTooManyBlobs)
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, blobTxForTest(sidecar, []common.Hash{{0x01}})), types.ErrBlobSidecarMismatch)
+
+	// The transaction hash does not cover the sidecar, so a bad proof is caught even though
+	// the same transaction verified above.
+	badProof := *sidecar
+	badProof.Proofs = []kzg4844.Proof{{}}
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx, k, blobTxForTest(&badProof, hashes)), types.ErrBlobInvalidProof)
+
+	// A block has room for MaxBlobsPerBlock blobs.
+	for i := 0; i < types.MaxBlobsPerBlock; i++ {
+		require.NoError(t, ante.ValidateBlobTx(ctx.WithTxIndex(i), k, tx))
+		require.NoError(t, k.RecordBlobTx(ctx.WithTxIndex(i), tx))
+	}
+	require.ErrorIs(t, ante.ValidateBlobTx(ctx.WithTxIndex(types.MaxBlobsPerBlock), k, tx), types.ErrBlockBlobGasExceeded)
+	// The block limit is left to DeliverTx.
+	require.NoError(t, ante.ValidateBlobTx(ctx.WithIsCheckTx(true), k, tx))
+}
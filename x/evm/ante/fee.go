@@ -4,7 +4,6 @@ import (
 	"math"
 	"math/big"
 
-	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -58,11 +57,9 @@ func (fc EVMFeeCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	ethCfg := evmtypes.DefaultChainConfig().EthereumConfig(fc.evmKeeper.ChainID(ctx))
 
 	// if EVM version is Cancun or later, and the transaction contains at least one blob, we need to
-	// make sure the transaction carries a non-zero blob fee cap.
+	// make sure the transaction's blob fee cap covers the current blob base fee.
 	if ver >= derived.Cancun && len(txData.GetBlobHashes()) > 0 {
-		// For now we are simply assuming excessive blob gas is 0. In the future we might change it to be
-		// dynamic based on prior block usage.
-		if txData.GetBlobFeeCap().Cmp(eip4844.CalcBlobFee(ethCfg, &ethtypes.Header{Time: uint64(ctx.BlockTime().Unix())})) < 0 { // nolint:gosec
+		if txData.GetBlobFeeCap().Cmp(fc.evmKeeper.GetBlobBaseFee(ctx)) < 0 {
 			return ctx, sdkerrors.ErrInsufficientFee
 		}
 	}
@@ -99,6 +96,11 @@ func (fc EVMFeeCheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		if err := fc.evmKeeper.AddAnteSurplus(ctx, etx.Hash(), surplus); err != nil {
 			return ctx, err
 		}
+		if etx.Type() == ethtypes.BlobTxType {
+			if err := fc.evmKeeper.RecordBlobTx(ctx, etx); err != nil {
+				return ctx, err
+			}
+		}
 	}
 
 	// calculate the priority by dividing the total fee with the native gas limit (i.e. the effective native gas price)
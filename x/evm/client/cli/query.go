@@ -55,6 +55,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryPointer())
 	cmd.AddCommand(CmdQueryPointerVersion())
 	cmd.AddCommand(CmdQueryPointee())
+	cmd.AddCommand(CmdQueryContractStorageUsage())
 	cmd.AddCommand(CmdQueryTxByHash())
 
 	return cmd
@@ -470,6 +471,32 @@ func CmdQueryPointee() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryContractStorageUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-usage [contract]",
+		Short: "Get the storage slots, bytes and last access height of an EVM contract (0x...)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractStorageUsage(cmd.Context(), &types.QueryContractStorageUsageRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryTxByHash() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tx [hash]",
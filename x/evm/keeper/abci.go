@@ -30,6 +30,7 @@ func (k *Keeper) BeginBlock(ctx sdk.Context) {
 		k.SetMsgs([]*types.MsgEVMTransaction{})
 		k.SetTxResults([]*abci.ExecTxResult{})
 		k.TrackBlockHash(ctx)
+		k.CacheBlockBlobBaseFee(ctx)
 	}
 	// mock beacon root if replaying
 	if k.EthReplayConfig.Enabled {
@@ -96,6 +97,9 @@ func (k *Keeper) EndBlock(ctx sdk.Context, height int64, blockGasUsed int64) {
 	if scanned, deleted := k.PruneZeroStorageSlots(ctx, ZeroStorageCleanupBatchSize); deleted > 0 {
 		logger.Info("pruned zero-value contract storage slots while scanning keys", "pruned-count", deleted, "key-count", scanned)
 	}
+	k.FoldStorageUsageDeltas(ctx)
+	k.FoldBlobTxs(ctx)
+	k.BackfillContractStorageUsage(ctx, ContractStorageUsageBackfillBatchSize)
 
 	newBaseFee := k.AdjustDynamicBaseFeePerGas(ctx, uint64(blockGasUsed)) // nolint:gosec
 	if newBaseFee != nil {
@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/prefix"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// Blob transactions (EIP-4844) are experimental and only admitted while the BlobTxsEnabled param is
+// on. Like storage usage, each blob transaction records its blob gas and sidecar under transient keys
+// of its own (BlobGasUsedPrefix and BlobSidecarPrefix | tx index), and EndBlock folds them: the blob
+// gas moves the excess blob gas that prices the next block's blobs, and the sidecars are staged in
+// the BlobSidecarStore, when one is configured, until the block is committed.
+//
+// The excess is only written once a block uses blob gas, so a chain that never enables blob
+// transactions keeps a blob base fee of 1 wei, as before.
+//
+// Sidecars travel inside the MsgEVMTransaction of their blob transaction, and so stay in the block
+// for as long as the block store keeps the block. This is intended: Sei has no separate channel
+// to propagate blobs, so the block is how every validator, and every node syncing the block later,
+// receives the sidecars to verify their KZG proofs against the blob hashes and to stage them in the
+// BlobSidecarStore. With at most MaxBlobsPerBlock blobs of 128 KiB, a block carries at most 1.125 MiB of them;
+// block pruning (min-retain-blocks) bounds how long they are kept, independently of
+// blob_sidecar_retention, which only governs the sidecars served by eth_getBlobSidecars.
+
+func (k *Keeper) BlobTxsEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).BlobTxsEnabled
+}
+
+func (k *Keeper) GetExcessBlobGas(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ExcessBlobGasKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k *Keeper) SetExcessBlobGas(ctx sdk.Context, excess uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if excess == 0 {
+		store.Delete(types.ExcessBlobGasKey)
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, excess)
+	store.Set(types.ExcessBlobGasKey, bz)
+}
+
+// GetBlobBaseFee returns the price of one unit of blob gas in the current block.
+func (k *Keeper) GetBlobBaseFee(ctx sdk.Context) *big.Int {
+	excess := k.GetExcessBlobGas(ctx)
+	if excess == 0 {
+		return utils.Big1
+	}
+	ethCfg := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
+	return eip4844.CalcBlobFee(ethCfg, &ethtypes.Header{Time: uint64(ctx.BlockTime().Unix()), ExcessBlobGas: &excess}) //nolint:gosec
+}
+
+// blockBlobBaseFee is the blob base fee of the block being delivered. The excess blob gas only
+// changes in EndBlock, so the fee is computed once per block instead of once per EVM transaction.
+type blockBlobBaseFee struct {
+	mtx    sync.RWMutex
+	height int64
+	fee    *big.Int
+}
+
+// CacheBlockBlobBaseFee computes the blob base fee of the block at BeginBlock.
+func (k *Keeper) CacheBlockBlobBaseFee(ctx sdk.Context) {
+	if k.blockBlobBaseFee == nil {
+		return
+	}
+	fee := k.GetBlobBaseFee(ctx)
+	k.blockBlobBaseFee.mtx.Lock()
+	defer k.blockBlobBaseFee.mtx.Unlock()
+	k.blockBlobBaseFee.height, k.blockBlobBaseFee.fee = ctx.BlockHeight(), fee
+}
+
+// clearBlockBlobBaseFee drops the cached fee before the block's excess blob gas is updated.
+func (k *Keeper) clearBlockBlobBaseFee() {
+	if k.blockBlobBaseFee == nil {
+		return
+	}
+	k.blockBlobBaseFee.mtx.Lock()
+	defer k.blockBlobBaseFee.mtx.Unlock()
+	k.blockBlobBaseFee.height, k.blockBlobBaseFee.fee = 0, nil
+}
+
+// BlockBlobBaseFee returns the blob base fee of the block of ctx: the fee cached at BeginBlock
+// while the block is delivered, and GetBlobBaseFee otherwise.
+func (k *Keeper) BlockBlobBaseFee(ctx sdk.Context) *big.Int {
+	if c := k.blockBlobBaseFee; c != nil {
+		c.mtx.RLock()
+		height, fee := c.height, c.fee
+		c.mtx.RUnlock()
+		if fee != nil && height == ctx.BlockHeight() {
+			return fee
+		}
+	}
+	return k.GetBlobBaseFee(ctx)
+}
+
+func blobTxKey(txIndex int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(txIndex)) //nolint:gosec
+	return key
+}
+
+// RecordBlobTx records the blob gas and sidecar of the blob transaction being delivered.
+func (k *Keeper) RecordBlobTx(ctx sdk.Context, etx *ethtypes.Transaction) error {
+	sidecar := etx.BlobTxSidecar()
+	if sidecar == nil {
+		return types.ErrBlobSidecarMissing
+	}
+	bz, err := rlp.EncodeToBytes(&BlobSidecar{
+		TxHash:      etx.Hash(),
+		TxIndex:     uint64(ctx.TxIndex()), //nolint:gosec
+		BlobHashes:  etx.BlobHashes(),
+		Blobs:       sidecar.Blobs,
+		Commitments: sidecar.Commitments,
+		Proofs:      sidecar.Proofs,
+	})
+	if err != nil {
+		return err
+	}
+	transient := ctx.TransientStore(k.transientStoreKey)
+	gas := make([]byte, 8)
+	binary.BigEndian.PutUint64(gas, etx.BlobGas())
+	prefix.NewStore(transient, types.BlobGasUsedPrefix).Set(blobTxKey(ctx.TxIndex()), gas)
+	prefix.NewStore(transient, types.BlobSidecarPrefix).Set(blobTxKey(ctx.TxIndex()), bz)
+	return nil
+}
+
+// BlockBlobGasUsed returns the blob gas used by the blob transactions delivered so far in the block.
+func (k *Keeper) BlockBlobGasUsed(ctx sdk.Context) uint64 {
+	iter := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.BlobGasUsedPrefix).Iterator(nil, nil)
+	defer func() { _ = iter.Close() }()
+	var used uint64
+	for ; iter.Valid(); iter.Next() {
+		used += binary.BigEndian.Uint64(iter.Value())
+	}
+	return used
+}
+
+// FoldBlobTxs updates the excess blob gas with the block's blob gas and stages the block's sidecars.
+func (k *Keeper) FoldBlobTxs(ctx sdk.Context) {
+	if !ctx.IsTracing() {
+		k.clearBlockBlobBaseFee()
+	}
+	excess := k.GetExcessBlobGas(ctx)
+	if next := types.NextExcessBlobGas(excess, k.BlockBlobGasUsed(ctx)); next != excess {
+		k.SetExcessBlobGas(ctx, next)
+	}
+	if k.blobSidecarStore == nil {
+		return
+	}
+	iter := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.BlobSidecarPrefix).Iterator(nil, nil)
+	var sidecars []*BlobSidecar
+	for ; iter.Valid(); iter.Next() {
+		sidecar := &BlobSidecar{}
+		if err := rlp.DecodeBytes(iter.Value(), sidecar); err != nil {
+			logger.Error("failed to decode blob sidecar", "height", ctx.BlockHeight(), "err", err)
+			continue
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	_ = iter.Close()
+	if len(sidecars) == 0 {
+		return
+	}
+	k.blobSidecarStore.Stage(ctx.BlockHeight(), ctx.HeaderHash(), sidecars)
+}
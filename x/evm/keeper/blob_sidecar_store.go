@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/litt"
+	"github.com/sei-protocol/sei-chain/sei-db/db_engine/litt/littbuilder"
+	litttypes "github.com/sei-protocol/sei-chain/sei-db/db_engine/litt/types"
+)
+
+// BlobSidecar is the sidecar of one executed blob transaction.
+type BlobSidecar struct {
+	TxHash      common.Hash
+	TxIndex     uint64
+	BlobHashes  []common.Hash
+	Blobs       []kzg4844.Blob
+	Commitments []kzg4844.Commitment
+	Proofs      []kzg4844.Proof
+}
+
+// BlobSidecarStore keeps the sidecars of executed blob transactions in a LittDB table at
+// <home>/data/blob_sidecars. Sidecars are not part of consensus state, so the store is local to the
+// node and drops them once the table's TTL expires. Each block with blob transactions is one value
+// keyed by its height, holding the RLP-encoded sidecars back to back in tx order; each tx hash is a
+// secondary key aliasing its own sidecar. The 8-byte height keys never collide with 32-byte hashes.
+//
+// EndBlock also runs for blocks that are never committed: optimistic processing of a proposal
+// that loses, and offline re-execution. It only stages a block's sidecars in memory, keyed by its
+// hash; FinalizeBlock keeps the staged block if it is the one being finalized, and Commit stores
+// it. Writes are flushed from a background goroutine every blobSidecarFlushInterval so Commit
+// never waits on an fsync; a crash can lose the most recent sidecars.
+type BlobSidecarStore struct {
+	db    litt.DB
+	table litt.Table
+
+	mu     sync.Mutex
+	staged *stagedSidecars
+
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	closeOnce sync.Once
+}
+
+const (
+	blobSidecarTableName     = "blob_sidecars"
+	blobSidecarFlushInterval = time.Second
+)
+
+func NewBlobSidecarStore(homeDir string, retention time.Duration) (*BlobSidecarStore, error) {
+	cfg, err := litt.DefaultConfig(filepath.Join(homeDir, "data", "blob_sidecars"))
+	if err != nil {
+		return nil, fmt.Errorf("build blob sidecar db config: %w", err)
+	}
+	db, err := littbuilder.NewDB(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("open blob sidecar db: %w", err)
+	}
+	tableCfg := litt.DefaultTableConfig(blobSidecarTableName)
+	tableCfg.TTL = retention
+	tableCfg.ShardingFactor = 1
+	table, err := db.BuildTable(tableCfg)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("open blob sidecar table: %w", err)
+	}
+	s := &BlobSidecarStore{db: db, table: table, stop: make(chan struct{})}
+	s.startFlusher()
+	return s, nil
+}
+
+func (s *BlobSidecarStore) startFlusher() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(blobSidecarFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.stop:
+				return
+			case <-ticker.C:
+				if err := s.table.Flush(); err != nil {
+					logger.Error("failed to flush blob sidecars", "err", err)
+				}
+			}
+		}
+	}()
+}
+
+func (s *BlobSidecarStore) Close() error {
+	if s == nil {
+		return nil
+	}
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.stop)
+		s.wg.Wait()
+		// litt's Close flushes the remaining writes.
+		err = s.db.Close()
+	})
+	return err
+}
+
+// stagedSidecars are the sidecars of the last executed block.
+type stagedSidecars struct {
+	height    int64
+	blockHash []byte
+	sidecars  []*BlobSidecar
+	finalized bool
+}
+
+// Stage holds the sidecars of the executed block at height with the given hash until the block is
+// finalized and committed. It replaces the block staged before.
+func (s *BlobSidecarStore) Stage(height int64, blockHash []byte, sidecars []*BlobSidecar) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.staged = &stagedSidecars{height: height, blockHash: common.CopyBytes(blockHash), sidecars: sidecars}
+}
+
+// Finalize keeps the staged block for PutFinalized if it is the finalized block with the given
+// hash, and drops it otherwise.
+func (s *BlobSidecarStore) Finalize(blockHash []byte) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.staged == nil {
+		return
+	}
+	if !bytes.Equal(s.staged.blockHash, blockHash) {
+		s.staged = nil
+		return
+	}
+	s.staged.finalized = true
+}
+
+// PutFinalized stores the sidecars of the finalized block once its state is committed.
+func (s *BlobSidecarStore) PutFinalized() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	staged := s.staged
+	if staged != nil && staged.finalized {
+		s.staged = nil
+	}
+	s.mu.Unlock()
+	if staged == nil || !staged.finalized {
+		return nil
+	}
+	return s.PutBlock(staged.height, staged.sidecars)
+}
+
+func blobSidecarBlockKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height)) //nolint:gosec
+	return key
+}
+
+// PutBlock stores the sidecars of the committed block at height. A block that is already stored
+// was committed before a restart, with the same sidecars, and is left as is.
+func (s *BlobSidecarStore) PutBlock(height int64, sidecars []*BlobSidecar) error {
+	key := blobSidecarBlockKey(height)
+	exists, err := s.table.Exists(key)
+	if err != nil || exists {
+		return err
+	}
+	var value []byte
+	secondaryKeys := make([]*litttypes.SecondaryKey, 0, len(sidecars))
+	for _, sidecar := range sidecars {
+		bz, err := rlp.EncodeToBytes(sidecar)
+		if err != nil {
+			return err
+		}
+		secondaryKeys = append(secondaryKeys, &litttypes.SecondaryKey{
+			Key:    common.CopyBytes(sidecar.TxHash[:]),
+			Offset: uint32(len(value)), //nolint:gosec
+			Length: uint32(len(bz)),    //nolint:gosec
+		})
+		value = append(value, bz...)
+	}
+	return s.table.Put(key, value, secondaryKeys...)
+}
+
+// GetBlock returns the sidecars of the block at height in tx order, or none if the block had no
+// blob transactions or has expired.
+func (s *BlobSidecarStore) GetBlock(height int64) ([]*BlobSidecar, error) {
+	value, exists, err := s.table.Get(blobSidecarBlockKey(height))
+	if err != nil || !exists {
+		return nil, err
+	}
+	var sidecars []*BlobSidecar
+	stream := rlp.NewStream(bytes.NewReader(value), uint64(len(value)))
+	for {
+		sidecar := &BlobSidecar{}
+		if err := stream.Decode(sidecar); err != nil {
+			if errors.Is(err, io.EOF) {
+				return sidecars, nil
+			}
+			return nil, fmt.Errorf("decode blob sidecars of block %d: %w", height, err)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+}
+
+// GetTx returns the sidecar of the blob transaction with the given hash.
+func (s *BlobSidecarStore) GetTx(txHash common.Hash) (*BlobSidecar, bool, error) {
+	value, exists, err := s.table.Get(txHash[:])
+	if err != nil || !exists {
+		return nil, false, err
+	}
+	sidecar := &BlobSidecar{}
+	if err := rlp.DecodeBytes(value, sidecar); err != nil {
+		return nil, false, fmt.Errorf("decode blob sidecar of tx %s: %w", txHash.Hex(), err)
+	}
+	return sidecar, true, nil
+}
@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/utils"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testBlobTx(nonce uint64, blobs int) *ethtypes.Transaction {
+	sidecar := &ethtypes.BlobTxSidecar{}
+	hashes := make([]common.Hash, blobs)
+	for i := range hashes {
+		hashes[i] = common.Hash{0x01, byte(nonce), byte(i)}
+		sidecar.Blobs = append(sidecar.Blobs, kzg4844.Blob{byte(nonce)})
+		sidecar.Commitments = append(sidecar.Commitments, kzg4844.Commitment{byte(i)})
+		sidecar.Proofs = append(sidecar.Proofs, kzg4844.Proof{byte(i)})
+	}
+	return ethtypes.NewTx(&ethtypes.BlobTx{
+		Nonce:      nonce,
+		GasFeeCap:  uint256.NewInt(1),
+		GasTipCap:  uint256.NewInt(1),
+		BlobFeeCap: uint256.NewInt(1),
+		BlobHashes: hashes,
+		Sidecar:    sidecar,
+	})
+}
+
+func TestFoldBlobTxs(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	require.Equal(t, utils.Big1, k.GetBlobBaseFee(ctx))
+
+	// An empty block leaves the excess unwritten.
+	k.FoldBlobTxs(ctx)
+	require.Zero(t, k.GetExcessBlobGas(ctx))
+
+	// Blob gas above the target carries over as excess and raises the blob base fee.
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.RecordBlobTx(ctx.WithTxIndex(i), testBlobTx(uint64(i), 3)))
+	}
+	require.Equal(t, uint64(9*params.BlobTxBlobGasPerBlob), k.BlockBlobGasUsed(ctx))
+	k.FoldBlobTxs(ctx)
+	require.Equal(t, types.MaxBlobGasPerBlock-types.TargetBlobGasPerBlock, k.GetExcessBlobGas(ctx))
+	require.Equal(t, 1, k.GetBlobBaseFee(ctx).Cmp(utils.Big1))
+
+	// It drains by the target each block without blobs.
+	k, ctx = testkeeper.MockEVMKeeper(t)
+	k.SetExcessBlobGas(ctx, types.TargetBlobGasPerBlock)
+	k.FoldBlobTxs(ctx)
+	require.Zero(t, k.GetExcessBlobGas(ctx))
+	require.Equal(t, utils.Big1, k.GetBlobBaseFee(ctx))
+}
+
+// blobFee is EIP-4844's fake_exponential(MIN_BASE_FEE_PER_BLOB_GAS, excess, update fraction).
+func blobFee(excess, fraction uint64) *big.Int {
+	denominator := new(big.Int).SetUint64(fraction)
+	numerator := new(big.Int).SetUint64(excess)
+	output := new(big.Int)
+	accum := new(big.Int).Set(denominator)
+	for i := int64(1); accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+		accum.Mul(accum, numerator)
+		accum.Div(accum, new(big.Int).Mul(denominator, big.NewInt(i)))
+	}
+	return output.Div(output, denominator)
+}
+
+func TestBlobBaseFeeAcrossBlocks(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	// runBlock delivers blobs in transactions of up to three and ends the block, carrying only the
+	// excess blob gas over to the next block.
+	runBlock := func(blobs int) {
+		blockCtx := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore())
+		for i := 0; blobs > 0; i++ {
+			n := min(blobs, 3)
+			require.NoError(t, k.RecordBlobTx(blockCtx.WithTxIndex(i), testBlobTx(uint64(i), n)))
+			blobs -= n
+		}
+		k.FoldBlobTxs(blockCtx)
+		k.SetExcessBlobGas(ctx, k.GetExcessBlobGas(blockCtx))
+	}
+	requireFee := func(excess uint64) *big.Int {
+		require.Equal(t, excess, k.GetExcessBlobGas(ctx))
+		fee := k.GetBlobBaseFee(ctx)
+		require.Equal(t, blobFee(excess, types.BlobConfig.UpdateFraction).String(), fee.String())
+		return fee
+	}
+
+	// Full blocks raise the excess by the blob gas above the target, and the fee with it.
+	var excess uint64
+	prev := requireFee(excess)
+	for i := 0; i < 30; i++ {
+		runBlock(types.MaxBlobsPerBlock)
+		excess += types.MaxBlobGasPerBlock - types.TargetBlobGasPerBlock
+		fee := requireFee(excess)
+		require.GreaterOrEqual(t, fee.Cmp(prev), 0)
+		prev = fee
+	}
+	require.Equal(t, 1, prev.Cmp(utils.Big1))
+
+	// Blocks at the target keep the excess, and the fee, where they are.
+	runBlock(types.TargetBlobsPerBlock)
+	require.Zero(t, prev.Cmp(requireFee(excess)))
+
+	// Empty blocks drain the excess by the target each block, back to the 1 wei floor.
+	for excess > 0 {
+		runBlock(0)
+		excess -= min(excess, types.TargetBlobGasPerBlock)
+		requireFee(excess)
+	}
+	require.Equal(t, utils.Big1, k.GetBlobBaseFee(ctx))
+}
+
+func TestBlockBlobBaseFee(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	k.SetExcessBlobGas(ctx, 10*types.TargetBlobGasPerBlock)
+	k.CacheBlockBlobBaseFee(ctx)
+	fee := k.GetBlobBaseFee(ctx)
+
+	// The block's fee is computed once, at BeginBlock.
+	k.SetExcessBlobGas(ctx, 0)
+	require.Equal(t, fee, k.BlockBlobBaseFee(ctx))
+	require.Equal(t, utils.Big1, k.BlockBlobBaseFee(ctx.WithBlockHeight(ctx.BlockHeight()+1)))
+
+	// EndBlock drops it along with the excess it was computed from.
+	k.FoldBlobTxs(ctx)
+	require.Equal(t, utils.Big1, k.BlockBlobBaseFee(ctx))
+}
+
+func TestBlobSidecarStore(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	store, err := keeper.NewBlobSidecarStore(t.TempDir(), time.Hour)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Close()) }()
+	k.SetBlobSidecarStore(store)
+	defer k.SetBlobSidecarStore(nil)
+
+	tx0, tx1 := testBlobTx(0, 1), testBlobTx(1, 2)
+	ctx = ctx.WithHeaderHash([]byte{0x01})
+	require.NoError(t, k.RecordBlobTx(ctx.WithTxIndex(0), tx0))
+	require.NoError(t, k.RecordBlobTx(ctx.WithTxIndex(3), tx1))
+	k.FoldBlobTxs(ctx)
+
+	// Sidecars are only stored once their block is finalized and committed.
+	sidecars, err := store.GetBlock(ctx.BlockHeight())
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+	store.Finalize([]byte{0x02})
+	require.NoError(t, store.PutFinalized())
+	sidecars, err = store.GetBlock(ctx.BlockHeight())
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+	_, stored, err := store.GetTx(tx0.Hash())
+	require.NoError(t, err)
+	require.False(t, stored)
+
+	k.FoldBlobTxs(ctx)
+	store.Finalize(ctx.HeaderHash())
+	require.NoError(t, store.PutFinalized())
+	sidecars, err = store.GetBlock(ctx.BlockHeight())
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+	require.Equal(t, tx0.Hash(), sidecars[0].TxHash)
+	require.Equal(t, tx1.Hash(), sidecars[1].TxHash)
+	require.Equal(t, uint64(3), sidecars[1].TxIndex)
+	require.Equal(t, tx1.BlobHashes(), sidecars[1].BlobHashes)
+	require.Equal(t, tx1.BlobTxSidecar().Blobs, sidecars[1].Blobs)
+
+	sidecar, found, err := store.GetTx(tx1.Hash())
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, sidecars[1], sidecar)
+
+	// The staged block is stored once.
+	require.NoError(t, store.PutFinalized())
+
+	// Storing a block again is a no-op.
+	require.NoError(t, store.PutBlock(ctx.BlockHeight(), sidecars[:1]))
+	sidecars, err = store.GetBlock(ctx.BlockHeight())
+	require.NoError(t, err)
+	require.Len(t, sidecars, 2)
+
+	sidecars, err = store.GetBlock(ctx.BlockHeight() + 1)
+	require.NoError(t, err)
+	require.Empty(t, sidecars)
+}
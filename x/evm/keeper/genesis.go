@@ -29,6 +29,7 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	k.accountKeeper.SetModuleAccount(ctx, moduleAcc)
 
 	k.SetParams(ctx, genState.Params)
+	k.StartContractStorageUsageTracking(ctx)
 
 	seiAddrFc := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName) // feeCollector == coinbase
 	k.SetAddressMapping(ctx, seiAddrFc, GetCoinbaseAddress())
@@ -67,7 +68,7 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		)
 	}
 
-	if k.EthReplayConfig.Enabled && !ethReplayInitialied {
+	if k.EthReplayConfig.Enabled && k.EthReplayConfig.EthDataDir != "" && !ethReplayInitialied {
 		header := k.OpenEthDatabase()
 		k.SetReplayInitialHeight(ctx, header.Number.Int64())
 		ethReplayInitialied = true
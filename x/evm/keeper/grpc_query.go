@@ -279,3 +279,18 @@ func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*ty
 		return nil, errors.ErrUnsupported
 	}
 }
+
+func (q Querier) ContractStorageUsage(c context.Context, req *types.QueryContractStorageUsageRequest) (*types.QueryContractStorageUsageResponse, error) {
+	if !common.IsHexAddress(req.Address) {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	usage, _ := q.GetContractStorageUsage(ctx, common.HexToAddress(req.Address))
+	return &types.QueryContractStorageUsageResponse{
+		Slots:            usage.Slots,
+		Bytes:            usage.Bytes,
+		LastAccessHeight: usage.LastAccessHeight,
+		Complete:         q.ContractStorageUsageComplete(ctx),
+		Dormant:          q.IsContractStorageDormant(ctx, usage),
+	}, nil
+}
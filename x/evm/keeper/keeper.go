@@ -77,6 +77,7 @@ type Keeper struct {
 	// only used during ETH replay. Not used in chain critical path.
 	EthClient       *ethclient.Client
 	EthReplayConfig replay.Config
+	ReplayBlocks    replay.BlockSource
 
 	// only used during blocktest. Not used in chain critical path.
 	EthBlockTestConfig blocktest.Config
@@ -104,13 +105,21 @@ type Keeper struct {
 	// against in-memory state instead of SS-pebble. nil-safe.
 	traceSnapshotStore   *TraceSnapshotStore
 	traceSnapshotCapture func() sctypes.Committer
+
+	// blobSidecarStore, when non-nil, keeps the sidecars of executed blob
+	// transactions for eth_getBlobSidecars. nil-safe.
+	blobSidecarStore *BlobSidecarStore
+
+	// blockBlobBaseFee holds the blob base fee of the block being delivered,
+	// computed once at BeginBlock for every EVM tx's block context. nil-safe.
+	blockBlobBaseFee *blockBlobBaseFee
 }
 
 // only used during ETH replay
 type ReplayChainContext struct {
-	ethClient *ethclient.Client
-	chainID   *big.Int
-	params    types.Params
+	blocks  replay.BlockSource
+	chainID *big.Int
+	params  types.Params
 }
 
 func (ctx *ReplayChainContext) Engine() consensus.Engine {
@@ -118,7 +127,7 @@ func (ctx *ReplayChainContext) Engine() consensus.Engine {
 }
 
 func (ctx *ReplayChainContext) GetHeader(hash common.Hash, number uint64) *ethtypes.Header {
-	res, err := ctx.ethClient.BlockByNumber(context.Background(), big.NewInt(int64(number))) //nolint:gosec
+	res, err := ctx.blocks.BlockByNumber(context.Background(), number)
 	if err != nil || res.Header_.Hash() != hash {
 		return nil
 	}
@@ -151,6 +160,7 @@ func NewKeeper(
 		cachedFeeCollectorAddressMtx: &sync.RWMutex{},
 		blockHashCache:               newBlockHashCache(),
 		receiptStore:                 receiptStore,
+		blockBlobBaseFee:             &blockBlobBaseFee{},
 	}
 	return k
 }
@@ -158,6 +168,9 @@ func NewKeeper(
 func (k *Keeper) SetTraceDB(c *TraceDB) { k.traceDB = c }
 func (k *Keeper) TraceDB() *TraceDB     { return k.traceDB }
 
+func (k *Keeper) SetBlobSidecarStore(s *BlobSidecarStore) { k.blobSidecarStore = s }
+func (k *Keeper) BlobSidecarStore() *BlobSidecarStore     { return k.blobSidecarStore }
+
 func (k *Keeper) SetTraceSnapshotStore(s *TraceSnapshotStore) { k.traceSnapshotStore = s }
 func (k *Keeper) TraceSnapshotStore() *TraceSnapshotStore     { return k.traceSnapshotStore }
 func (k *Keeper) SetTraceSnapshotCapture(f func() sctypes.Committer) {
@@ -311,7 +324,7 @@ func (k *Keeper) GetVMBlockContext(ctx sdk.Context, gp core.GasPool) (*vm.BlockC
 		Time:        uint64(ctx.BlockHeader().Time.Unix()), //nolint:gosec
 		Difficulty:  utils.Big0,                            // only needed for PoW
 		BaseFee:     baseFee,
-		BlobBaseFee: utils.Big1, // Cancun not enabled
+		BlobBaseFee: k.BlockBlobBaseFee(ctx),
 		Random:      &rh,
 	}, nil
 }
@@ -369,7 +382,8 @@ func (k *Keeper) SetMsgs(msgs []*types.MsgEVMTransaction) {
 
 // Only used in ETH replay
 func (k *Keeper) PrepareReplayedAddr(ctx sdk.Context, addr common.Address) {
-	if !k.EthReplayConfig.Enabled {
+	// a replay seeded from an alloc has no eth database to read accounts from
+	if !k.EthReplayConfig.Enabled || k.Trie == nil {
 		return
 	}
 	store := k.PrefixStore(ctx, types.ReplaySeenAddrPrefix)
@@ -502,7 +516,7 @@ func (k *Keeper) getBlockTestBlockCtx(ctx sdk.Context) (*vm.BlockContext, error)
 
 func (k *Keeper) getReplayBlockCtx(ctx sdk.Context) (*vm.BlockContext, error) {
 	header := k.ReplayBlock.Header_
-	replayCtx := &ReplayChainContext{ethClient: k.EthClient, chainID: k.ChainID(ctx), params: k.GetParams(ctx)}
+	replayCtx := &ReplayChainContext{blocks: k.ReplayBlocks, chainID: k.ChainID(ctx), params: k.GetParams(ctx)}
 	getHash := core.GetHashFn(header, replayCtx)
 	var (
 		baseFee     *big.Int
@@ -10,7 +10,7 @@ import (
 func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, hash common.Hash) common.Hash {
 	val := k.PrefixStore(ctx, types.StateKey(addr)).Get(hash[:])
 	if val == nil {
-		if k.EthReplayConfig.Enabled {
+		if k.EthReplayConfig.Enabled && k.DB != nil {
 			// try to get from eth DB
 			tr, err := k.DB.OpenStorageTrie(k.Root, addr, common.BytesToHash(k.PrefixStore(ctx, types.ReplaySeenAddrPrefix).Get(addr[:])), k.Trie)
 			if err != nil {
@@ -29,11 +29,42 @@ func (k *Keeper) GetState(ctx sdk.Context, addr common.Address, hash common.Hash
 
 func (k *Keeper) SetState(ctx sdk.Context, addr common.Address, key common.Hash, val common.Hash) {
 	store := k.PrefixStore(ctx, types.StateKey(addr))
+	// The old value is only read when usage accounting needs it, to keep it out of the read set.
+	checkpoint := k.storageUsageCheckpoint(ctx)
+	covered := checkpoint != nil && storageUsageCovered(checkpoint, addr, key[:])
+	var old, newVal []byte
+	if covered {
+		old = store.Get(key[:])
+	}
 	if val == (common.Hash{}) {
 		store.Delete(key[:])
-		return
+	} else {
+		newVal = val[:]
+		store.Set(key[:], newVal)
+	}
+	if checkpoint != nil {
+		k.recordStorageWrite(ctx, addr, covered, old, newVal)
+	}
+}
+
+// DeleteAllState deletes every storage slot of addr, accounting for them in the contract's storage
+// usage.
+func (k *Keeper) DeleteAllState(ctx sdk.Context, addr common.Address) {
+	if checkpoint := k.storageUsageCheckpoint(ctx); checkpoint != nil {
+		store := k.PrefixStore(ctx, types.StateKey(addr))
+		iter := store.Iterator(nil, nil)
+		var slots [][]byte
+		for ; iter.Valid(); iter.Next() {
+			slots = append(slots, append([]byte(nil), iter.Key()...))
+		}
+		_ = iter.Close()
+		for _, slot := range slots {
+			if old := store.Get(slot); old != nil {
+				k.recordStorageWrite(ctx, addr, storageUsageCovered(checkpoint, addr, slot), old, nil)
+			}
+		}
 	}
-	store.Set(key[:], val[:])
+	k.PurgePrefix(ctx, types.StateKey(addr))
 }
 
 func (k *Keeper) IterateState(ctx sdk.Context, cb func(addr common.Address, key common.Hash, val common.Hash) bool) {
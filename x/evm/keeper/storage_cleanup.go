@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 
+	"github.com/ethereum/go-ethereum/common"
 	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
 	seimetrics "github.com/sei-protocol/sei-chain/utils/metrics"
 	"github.com/sei-protocol/sei-chain/x/evm/types"
@@ -63,6 +64,9 @@ func (k *Keeper) PruneZeroStorageSlots(ctx sdk.Context, limit int) (int, int) {
 		val := store.Get(key)
 		if val != nil && isZeroStorageValue(val) {
 			store.Delete(key)
+			if k.storageUsageCovered(ctx, key) {
+				k.applyStorageUsageDelta(ctx, common.BytesToAddress(key[:common.AddressLength]), types.StorageWriteDelta(val, nil), 0)
+			}
 			deleted++
 			deletedMetric++
 			bytesPruned += uint64(len(key)) + uint64(len(val))
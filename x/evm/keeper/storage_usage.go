@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/sei-cosmos/store/prefix"
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// Contract storage usage is kept per contract under ContractStorageUsagePrefix. Transactions do not
+// write those records directly: every transaction touching a popular contract would then conflict
+// on its record under OCC. Each transaction instead accumulates its changes under a transient key of
+// its own (StorageUsageDeltaPrefix | tx index | address), and EndBlock folds them into the records.
+//
+// Tracking is off until ContractStorageUsageBackfillKey is set, by genesis on a new chain and by the
+// v22 migration on an existing one, so nodes that have not upgraded and nodes that have agree on
+// state. Storage that predates tracking is then counted by a backfill that walks StateKeyPrefix a
+// batch per block behind that checkpoint. A slot's writes are only accounted once the backfill has
+// passed it; slots ahead of the checkpoint are counted in whatever state the backfill finds them.
+
+const ContractStorageUsageBackfillBatchSize = 1000
+
+func (k *Keeper) GetContractStorageUsage(ctx sdk.Context, addr common.Address) (types.ContractStorageUsage, bool) {
+	usage := types.ContractStorageUsage{}
+	bz := ctx.KVStore(k.storeKey).Get(types.ContractStorageUsageKey(addr))
+	if bz == nil {
+		return usage, false
+	}
+	if err := usage.Unmarshal(bz); err != nil {
+		logger.Error("failed to unmarshal contract storage usage", "address", addr.Hex(), "err", err)
+		return types.ContractStorageUsage{}, false
+	}
+	return usage, true
+}
+
+// applyStorageUsageDelta adds d to addr's usage record, stamping it with accessHeight when that is
+// positive. A contract left without storage has its record removed.
+func (k *Keeper) applyStorageUsageDelta(ctx sdk.Context, addr common.Address, d types.StorageUsageDelta, accessHeight int64) {
+	usage, found := k.GetContractStorageUsage(ctx, addr)
+	usage.Slots = addStorageUsage(usage.Slots, d.Slots, addr, "slots")
+	usage.Bytes = addStorageUsage(usage.Bytes, d.Bytes, addr, "bytes")
+	if accessHeight > 0 {
+		usage.LastAccessHeight = accessHeight
+	}
+	store := ctx.KVStore(k.storeKey)
+	if usage.Slots == 0 {
+		if found {
+			store.Delete(types.ContractStorageUsageKey(addr))
+		}
+		return
+	}
+	bz, err := usage.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ContractStorageUsageKey(addr), bz)
+}
+
+func addStorageUsage(v uint64, d int64, addr common.Address, field string) uint64 {
+	if d >= 0 {
+		return v + uint64(d)
+	}
+	if uint64(-d) > v {
+		// Only reachable if a write bypassed the accounting; clamp rather than wrap.
+		logger.Error("contract storage usage underflow", "address", addr.Hex(), "field", field, "usage", v, "delta", d)
+		return 0
+	}
+	return v - uint64(-d)
+}
+
+// StartContractStorageUsageTracking turns contract storage usage tracking on; the backfill starts
+// counting existing storage in the next EndBlock. It is a no-op once tracking has started.
+func (k *Keeper) StartContractStorageUsageTracking(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ContractStorageUsageBackfillKey) {
+		store.Set(types.ContractStorageUsageBackfillKey, types.StorageUsageBackfillStarted)
+	}
+}
+
+// ContractStorageUsageTracked reports whether contract storage usage tracking has started.
+func (k *Keeper) ContractStorageUsageTracked(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ContractStorageUsageBackfillKey)
+}
+
+// ContractStorageUsageComplete reports whether storage that predates usage tracking has been fully
+// counted.
+func (k *Keeper) ContractStorageUsageComplete(ctx sdk.Context) bool {
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(types.ContractStorageUsageBackfillKey), types.StorageUsageBackfillComplete)
+}
+
+// storageUsageCheckpoint returns the backfill checkpoint, nil while usage tracking is off. It is
+// written only at EndBlock, so reading it adds no conflicts between transactions.
+func (k *Keeper) storageUsageCheckpoint(ctx sdk.Context) []byte {
+	return ctx.KVStore(k.storeKey).Get(types.ContractStorageUsageBackfillKey)
+}
+
+// storageUsageCovered reports whether the backfill has counted slot of addr, and so whether changes
+// to it must be accounted from the value it held.
+func storageUsageCovered(checkpoint []byte, addr common.Address, slot []byte) bool {
+	return types.StorageUsageCovered(checkpoint, append(addr.Bytes(), slot...))
+}
+
+func (k *Keeper) addStorageUsageDelta(ctx sdk.Context, addr common.Address, d types.StorageUsageDelta) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.StorageUsageDeltaPrefix)
+	key := types.StorageUsageDeltaKey(ctx.TxIndex(), addr)
+	store.Set(key, types.UnmarshalStorageUsageDelta(store.Get(key)).Add(d).Marshal())
+}
+
+// recordStorageWrite accounts for a write to a storage slot of addr while usage tracking is on. A slot
+// the backfill has covered changes from old to val (nil meaning absent); the write counts as an access
+// even when it leaves the slot unchanged.
+func (k *Keeper) recordStorageWrite(ctx sdk.Context, addr common.Address, covered bool, old, val []byte) {
+	k.touchContractStorage(ctx, addr)
+	if !covered {
+		return
+	}
+	if d := types.StorageWriteDelta(old, val); !d.IsZero() {
+		k.addStorageUsageDelta(ctx, addr, d)
+	}
+}
+
+// TouchContractStorage marks addr's storage as accessed by the current transaction, so that the
+// contract's last access height moves to this block. It does nothing while usage tracking is off.
+func (k *Keeper) TouchContractStorage(ctx sdk.Context, addr common.Address) {
+	if k.storageUsageCheckpoint(ctx) == nil {
+		return
+	}
+	k.touchContractStorage(ctx, addr)
+}
+
+func (k *Keeper) touchContractStorage(ctx sdk.Context, addr common.Address) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.StorageUsageDeltaPrefix)
+	key := types.StorageUsageDeltaKey(ctx.TxIndex(), addr)
+	if !store.Has(key) {
+		store.Set(key, types.StorageUsageDelta{}.Marshal())
+	}
+}
+
+// FoldStorageUsageDeltas applies the block's per-transaction storage changes to the contracts'
+// usage records and stamps every contract a transaction touched with the block height.
+func (k *Keeper) FoldStorageUsageDeltas(ctx sdk.Context) {
+	if !k.ContractStorageUsageTracked(ctx) {
+		return
+	}
+	iter := prefix.NewStore(ctx.TransientStore(k.transientStoreKey), types.StorageUsageDeltaPrefix).Iterator(nil, nil)
+	deltas := map[common.Address]types.StorageUsageDelta{}
+	var order []common.Address
+	for ; iter.Valid(); iter.Next() {
+		addr := common.BytesToAddress(iter.Key()[8:])
+		if _, ok := deltas[addr]; !ok {
+			order = append(order, addr)
+		}
+		deltas[addr] = deltas[addr].Add(types.UnmarshalStorageUsageDelta(iter.Value()))
+	}
+	_ = iter.Close()
+	for _, addr := range order {
+		k.applyStorageUsageDelta(ctx, addr, deltas[addr], ctx.BlockHeight())
+	}
+}
+
+// BackfillContractStorageUsage counts up to limit storage slots that predate usage tracking,
+// resuming after the last slot counted, and returns how many it counted. A contract counted for the
+// first time has its last access height set to the current block.
+func (k *Keeper) BackfillContractStorageUsage(ctx sdk.Context, limit int) int {
+	if limit <= 0 {
+		return 0
+	}
+	store := ctx.KVStore(k.storeKey)
+	checkpoint := store.Get(types.ContractStorageUsageBackfillKey)
+	if len(checkpoint) == 0 || bytes.Equal(checkpoint, types.StorageUsageBackfillComplete) {
+		return 0
+	}
+	var start []byte
+	if !bytes.Equal(checkpoint, types.StorageUsageBackfillStarted) {
+		// The smallest key after the checkpoint.
+		start = append(append([]byte(nil), checkpoint...), 0)
+	}
+
+	stateStore := k.PrefixStore(ctx, types.StateKeyPrefix)
+	iter := stateStore.Iterator(start, nil)
+	counted := map[common.Address]types.StorageUsageDelta{}
+	var order []common.Address
+	var lastKey []byte
+	processed := 0
+	for ; iter.Valid() && processed < limit; iter.Next() {
+		key := append([]byte(nil), iter.Key()...)
+		processed++
+		lastKey = key
+		// As in PruneZeroStorageSlots, the routed read is authoritative over the iterator's value.
+		val := stateStore.Get(key)
+		if val == nil || len(key) < common.AddressLength {
+			continue
+		}
+		addr := common.BytesToAddress(key[:common.AddressLength])
+		if _, ok := counted[addr]; !ok {
+			order = append(order, addr)
+		}
+		counted[addr] = counted[addr].Add(types.StorageUsageDelta{Slots: 1, Bytes: types.StorageSlotBytes(val)})
+	}
+	more := iter.Valid()
+	_ = iter.Close()
+
+	for _, addr := range order {
+		accessHeight := int64(0)
+		if usage, found := k.GetContractStorageUsage(ctx, addr); !found || usage.LastAccessHeight == 0 {
+			accessHeight = ctx.BlockHeight()
+		}
+		k.applyStorageUsageDelta(ctx, addr, counted[addr], accessHeight)
+	}
+	if more {
+		store.Set(types.ContractStorageUsageBackfillKey, lastKey)
+	} else {
+		store.Set(types.ContractStorageUsageBackfillKey, types.StorageUsageBackfillComplete)
+		logger.Info("finished counting contract storage usage")
+	}
+	return processed
+}
+
+// IsContractStorageDormant reports whether no transaction has touched the contract's storage for
+// the governance-set ContractStorageDormancyBlocks. Contracts without storage are never dormant.
+func (k *Keeper) IsContractStorageDormant(ctx sdk.Context, usage types.ContractStorageUsage) bool {
+	threshold := k.GetParams(ctx).ContractStorageDormancyBlocks
+	if threshold == 0 || usage.Slots == 0 || ctx.BlockHeight() <= usage.LastAccessHeight {
+		return false
+	}
+	return uint64(ctx.BlockHeight()-usage.LastAccessHeight) >= threshold
+}
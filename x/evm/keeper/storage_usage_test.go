@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sei-protocol/sei-chain/app"
+	testkeeper "github.com/sei-protocol/sei-chain/testutil/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStorageUsage(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	addr := common.HexToAddress("0x0100000000000000000000000000000000000000")
+	slot1 := common.HexToHash("0x01")
+	slot2 := common.HexToHash("0x02")
+	val := common.HexToHash("0x1234")
+	slotBytes := uint64(1 + common.AddressLength + 2*common.HashLength)
+
+	// Storage written before tracking starts is left to the backfill.
+	k.SetState(ctx, addr, slot1, val)
+	k.FoldStorageUsageDeltas(ctx)
+	_, found := k.GetContractStorageUsage(ctx, addr)
+	require.False(t, found)
+	require.False(t, k.ContractStorageUsageComplete(ctx))
+
+	for !k.ContractStorageUsageComplete(ctx) {
+		k.BackfillContractStorageUsage(ctx, 1)
+	}
+	usage, found := k.GetContractStorageUsage(ctx, addr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), usage.Slots)
+	require.Equal(t, slotBytes, usage.Bytes)
+	require.Equal(t, ctx.BlockHeight(), usage.LastAccessHeight)
+	require.Zero(t, k.BackfillContractStorageUsage(ctx, 1))
+
+	// Once counted, writes are folded in at the end of the block.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.SetState(ctx, addr, slot2, val)
+	k.SetState(ctx, addr, slot1, common.Hash{})
+	k.SetState(ctx, addr, slot1, common.Hash{})
+	k.FoldStorageUsageDeltas(ctx)
+	usage, _ = k.GetContractStorageUsage(ctx, addr)
+	require.Equal(t, uint64(1), usage.Slots)
+	require.Equal(t, slotBytes, usage.Bytes)
+	require.Equal(t, ctx.BlockHeight(), usage.LastAccessHeight)
+
+	// A read alone moves the last access height.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5).WithTxIndex(1)
+	k.TouchContractStorage(ctx, addr)
+	k.FoldStorageUsageDeltas(ctx)
+	usage, _ = k.GetContractStorageUsage(ctx, addr)
+	require.Equal(t, uint64(1), usage.Slots)
+	require.Equal(t, ctx.BlockHeight(), usage.LastAccessHeight)
+
+	// So does a write that leaves the slot as it was.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5).WithTxIndex(0)
+	k.SetState(ctx, addr, slot2, val)
+	k.FoldStorageUsageDeltas(ctx)
+	usage, _ = k.GetContractStorageUsage(ctx, addr)
+	require.Equal(t, uint64(1), usage.Slots)
+	require.Equal(t, ctx.BlockHeight(), usage.LastAccessHeight)
+
+	// Removing the contract's storage removes its record.
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.DeleteAllState(ctx, addr)
+	k.FoldStorageUsageDeltas(ctx)
+	_, found = k.GetContractStorageUsage(ctx, addr)
+	require.False(t, found)
+}
+
+func TestContractStorageUsageUntracked(t *testing.T) {
+	testApp := app.Setup(t, false, false, false)
+	k := &testApp.EvmKeeper
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(8)
+	ctx.KVStore(testApp.GetKey(types.StoreKey)).Delete(types.ContractStorageUsageBackfillKey)
+	require.False(t, k.ContractStorageUsageTracked(ctx))
+
+	// Until tracking starts, storage accesses leave nothing behind for EndBlock to fold.
+	addr := common.HexToAddress("0x0100000000000000000000000000000000000000")
+	k.SetState(ctx, addr, common.HexToHash("0x01"), common.HexToHash("0x1234"))
+	k.TouchContractStorage(ctx, addr)
+	k.DeleteAllState(ctx, addr)
+	iter := ctx.TransientStore(testApp.GetTKey(types.TransientStoreKey)).Iterator(nil, nil)
+	defer func() { _ = iter.Close() }()
+	for ; iter.Valid(); iter.Next() {
+		require.False(t, bytes.HasPrefix(iter.Key(), types.StorageUsageDeltaPrefix), "unexpected storage usage delta %X", iter.Key())
+	}
+}
+
+func TestIsContractStorageDormant(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper(t)
+	usage := types.ContractStorageUsage{Slots: 1, Bytes: 85, LastAccessHeight: 2}
+	ctx = ctx.WithBlockHeight(12)
+
+	// Disabled by default.
+	require.False(t, k.IsContractStorageDormant(ctx, usage))
+
+	params := k.GetParams(ctx)
+	params.ContractStorageDormancyBlocks = 10
+	k.SetParams(ctx, params)
+	require.True(t, k.IsContractStorageDormant(ctx, usage))
+	require.False(t, k.IsContractStorageDormant(ctx.WithBlockHeight(11), usage))
+	require.False(t, k.IsContractStorageDormant(ctx, types.ContractStorageUsage{LastAccessHeight: 2}))
+}
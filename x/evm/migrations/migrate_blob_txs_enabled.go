@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// MigrateBlobTxsEnabled writes the BlobTxsEnabled parameter with its default value, so blob
+// transactions stay rejected until governance turns them on.
+func MigrateBlobTxsEnabled(ctx sdk.Context, k *keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.BlobTxsEnabled = types.DefaultBlobTxsEnabled
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,25 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateBlobTxsEnabled(t *testing.T) {
+	a := app.Setup(t, false, false, false)
+	k := a.EvmKeeper
+	ctx := a.GetContextForDeliverTx([]byte{})
+
+	params := k.GetParams(ctx)
+	params.BlobTxsEnabled = true
+	k.SetParams(ctx, params)
+
+	require.NoError(t, migrations.MigrateBlobTxsEnabled(ctx, &k))
+
+	updated := k.GetParams(ctx)
+	require.Equal(t, types.DefaultBlobTxsEnabled, updated.BlobTxsEnabled)
+}
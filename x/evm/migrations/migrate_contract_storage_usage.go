@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	sdk "github.com/sei-protocol/sei-chain/sei-cosmos/types"
+	"github.com/sei-protocol/sei-chain/x/evm/keeper"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+)
+
+// MigrateContractStorageUsage sets the ContractStorageDormancyBlocks parameter to the default value
+// and starts contract storage usage tracking, whose backfill counts existing storage from the next
+// block on.
+func MigrateContractStorageUsage(ctx sdk.Context, k *keeper.Keeper) error {
+	params := k.GetParams(ctx)
+	params.ContractStorageDormancyBlocks = types.DefaultContractStorageDormancyBlocks
+	k.SetParams(ctx, params)
+	k.StartContractStorageUsageTracking(ctx)
+	return nil
+}
@@ -0,0 +1,32 @@
+package migrations_test
+
+import (
+	"testing"
+
+	"github.com/sei-protocol/sei-chain/app"
+	"github.com/sei-protocol/sei-chain/x/evm/migrations"
+	"github.com/sei-protocol/sei-chain/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateContractStorageUsage(t *testing.T) {
+	a := app.Setup(t, false, false, false)
+	k := a.EvmKeeper
+	ctx := a.GetContextForDeliverTx([]byte{})
+
+	// A chain from before the upgrade: no param value and no tracking.
+	ctx.KVStore(a.GetKey(types.StoreKey)).Delete(types.ContractStorageUsageBackfillKey)
+	params := k.GetParams(ctx)
+	params.ContractStorageDormancyBlocks = 12345
+	k.SetParams(ctx, params)
+	require.False(t, k.ContractStorageUsageTracked(ctx))
+	k.FoldStorageUsageDeltas(ctx)
+	require.Zero(t, k.BackfillContractStorageUsage(ctx, 10))
+	require.False(t, k.ContractStorageUsageTracked(ctx))
+
+	require.NoError(t, migrations.MigrateContractStorageUsage(ctx, &k))
+
+	require.Equal(t, types.DefaultContractStorageDormancyBlocks, k.GetParams(ctx).ContractStorageDormancyBlocks)
+	require.True(t, k.ContractStorageUsageTracked(ctx))
+	require.False(t, k.ContractStorageUsageComplete(ctx))
+}
@@ -6,8 +6,12 @@ import (
 )
 
 type Config struct {
-	Enabled             bool   `mapstructure:"eth_replay_enabled"`
-	EthRPC              string `mapstructure:"eth_rpc"`
+	Enabled bool `mapstructure:"eth_replay_enabled"`
+	// EthRPC is the endpoint blocks are replayed from and checked against. Empty for an offline replay, which
+	// reads its blocks from a BlockSource instead.
+	EthRPC string `mapstructure:"eth_rpc"`
+	// EthDataDir is the geth chaindata the pre-state is read from. Empty for a replay whose pre-state is seeded
+	// from an alloc.
 	EthDataDir          string `mapstructure:"eth_data_dir"`
 	ContractStateChecks bool   `mapstructure:"contract_state_checks"`
 }
@@ -20,31 +24,31 @@ var DefaultConfig = Config{
 }
 
 const (
-	flagEnabled             = "eth_replay.eth_replay_enabled"
-	flagEthRPC              = "eth_replay.eth_rpc"
-	flagEthDataDir          = "eth_replay.eth_data_dir"
-	flagContractStateChecks = "eth_replay.contract_state_checks"
+	FlagEnabled             = "eth_replay.eth_replay_enabled"
+	FlagEthRPC              = "eth_replay.eth_rpc"
+	FlagEthDataDir          = "eth_replay.eth_data_dir"
+	FlagContractStateChecks = "eth_replay.contract_state_checks"
 )
 
 func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 	cfg := DefaultConfig // copy
 	var err error
-	if v := opts.Get(flagEnabled); v != nil {
+	if v := opts.Get(FlagEnabled); v != nil {
 		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
 		}
 	}
-	if v := opts.Get(flagEthRPC); v != nil {
+	if v := opts.Get(FlagEthRPC); v != nil {
 		if cfg.EthRPC, err = cast.ToStringE(v); err != nil {
 			return cfg, err
 		}
 	}
-	if v := opts.Get(flagEthDataDir); v != nil {
+	if v := opts.Get(FlagEthDataDir); v != nil {
 		if cfg.EthDataDir, err = cast.ToStringE(v); err != nil {
 			return cfg, err
 		}
 	}
-	if v := opts.Get(flagContractStateChecks); v != nil {
+	if v := opts.Get(FlagContractStateChecks); v != nil {
 		if cfg.ContractStateChecks, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
 		}
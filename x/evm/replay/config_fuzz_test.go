@@ -12,8 +12,8 @@ import (
 // are guarded and checked.
 //
 // Two properties here are load-bearing beyond the section. eth_replay_enabled
-// gates a path that dials EthRPC during app.New and panics if it is unreachable,
-// so the default must stay false on a production node. And the live key for the
+// gates a path that dials a non-empty EthRPC during app.New and panics if it is
+// unreachable, so the default must stay false on a production node. And the live key for the
 // state-check toggle is contract_state_checks, while the app.toml template
 // renders eth_replay_contract_state_checks — a name nothing reads. The template
 // key is therefore a silent no-op; this table pins the name that actually
@@ -28,7 +28,7 @@ var ethReplayKeys = []configtest.KeySpec{
 	{
 		Key: "eth_replay.eth_rpc", Path: "EthRPC", Cast: configtest.CastString,
 		Checked: true,
-		Why:     "default is a hardcoded third-party endpoint",
+		Why:     "default is a hardcoded third-party endpoint; empty replays offline without dialing",
 	},
 	{
 		Key: "eth_replay.eth_data_dir", Path: "EthDataDir", Cast: configtest.CastString,
@@ -0,0 +1,120 @@
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// The account fields a Mismatch can name.
+const (
+	FieldBalance = "balance"
+	FieldNonce   = "nonce"
+	FieldCode    = "code"
+	FieldStorage = "storage"
+)
+
+// Mismatch is an account field whose replayed value differs from the expected one.
+type Mismatch struct {
+	Address common.Address `json:"address"`
+	Field   string         `json:"field"`
+	// Slot is the storage slot of a storage mismatch.
+	Slot     *common.Hash `json:"slot,omitempty"`
+	Expected string       `json:"expected"`
+	Actual   string       `json:"actual"`
+}
+
+// AccountReader reads the replayed state of an account.
+type AccountReader interface {
+	GetBalance(addr common.Address) *big.Int
+	GetNonce(addr common.Address) uint64
+	GetCode(addr common.Address) []byte
+	GetState(addr common.Address, slot common.Hash) common.Hash
+}
+
+// CompareAccount returns the fields of addr whose replayed value differs from expected. Only the storage slots
+// expected lists are compared, in slot order.
+func CompareAccount(r AccountReader, addr common.Address, expected ethtypes.Account) []Mismatch {
+	var mismatches []Mismatch
+	expectedBalance := expected.Balance
+	if expectedBalance == nil {
+		expectedBalance = new(big.Int)
+	}
+	if actual := r.GetBalance(addr); actual.Cmp(expectedBalance) != 0 {
+		mismatches = append(mismatches, Mismatch{Address: addr, Field: FieldBalance, Expected: expectedBalance.String(), Actual: actual.String()})
+	}
+	if actual := r.GetNonce(addr); actual != expected.Nonce {
+		mismatches = append(mismatches, Mismatch{Address: addr, Field: FieldNonce, Expected: fmt.Sprint(expected.Nonce), Actual: fmt.Sprint(actual)})
+	}
+	if actual := r.GetCode(addr); !bytes.Equal(actual, expected.Code) {
+		mismatches = append(mismatches, Mismatch{Address: addr, Field: FieldCode, Expected: fmt.Sprintf("%X", expected.Code), Actual: fmt.Sprintf("%X", actual)})
+	}
+	slots := make([]common.Hash, 0, len(expected.Storage))
+	for slot := range expected.Storage {
+		slots = append(slots, slot)
+	}
+	sort.Slice(slots, func(i, j int) bool { return bytes.Compare(slots[i][:], slots[j][:]) < 0 })
+	for _, slot := range slots {
+		want := expected.Storage[slot]
+		if actual := r.GetState(addr, slot); actual != want {
+			mismatches = append(mismatches, Mismatch{Address: addr, Field: FieldStorage, Slot: &slot, Expected: want.Hex(), Actual: actual.Hex()})
+		}
+	}
+	return mismatches
+}
+
+// Report streams the mismatches a replay finds as JSON lines, one per mismatch, and closes with a summary line.
+type Report struct {
+	enc      *json.Encoder
+	accounts map[common.Address]struct{}
+	fields   map[string]int
+	total    int
+}
+
+// ReportSummary is the last line of a report.
+type ReportSummary struct {
+	Blocks     uint64         `json:"blocks"`
+	Accounts   int            `json:"accounts"`
+	Mismatches int            `json:"mismatches"`
+	Fields     map[string]int `json:"fields,omitempty"`
+}
+
+type reportLine struct {
+	Block uint64 `json:"block"`
+	Mismatch
+}
+
+// NewReport returns a report written to w.
+func NewReport(w io.Writer) *Report {
+	return &Report{
+		enc:      json.NewEncoder(w),
+		accounts: make(map[common.Address]struct{}),
+		fields:   make(map[string]int),
+	}
+}
+
+// Add writes a mismatch found after block.
+func (r *Report) Add(block uint64, m Mismatch) error {
+	r.accounts[m.Address] = struct{}{}
+	r.fields[m.Field]++
+	r.total++
+	return r.enc.Encode(reportLine{Block: block, Mismatch: m})
+}
+
+// Finish writes the summary line of a replay of the given number of blocks.
+func (r *Report) Finish(blocks uint64) error {
+	return r.enc.Encode(struct {
+		Summary ReportSummary `json:"summary"`
+	}{ReportSummary{Blocks: blocks, Accounts: len(r.accounts), Mismatches: r.total, Fields: r.fields}})
+}
+
+// Mismatches returns the number of mismatches added so far.
+func (r *Report) Mismatches() int {
+	return r.total
+}
@@ -0,0 +1,87 @@
+package replay_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/evm/replay"
+)
+
+type fakeAccounts struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[common.Hash]common.Hash
+}
+
+func (f fakeAccounts) GetBalance(common.Address) *big.Int { return f.balance }
+func (f fakeAccounts) GetNonce(common.Address) uint64     { return f.nonce }
+func (f fakeAccounts) GetCode(common.Address) []byte      { return f.code }
+func (f fakeAccounts) GetState(_ common.Address, slot common.Hash) common.Hash {
+	return f.storage[slot]
+}
+
+func TestCompareAccount(t *testing.T) {
+	addr := common.HexToAddress("0x01")
+	slotA, slotB := common.HexToHash("0x0a"), common.HexToHash("0x0b")
+	actual := fakeAccounts{
+		balance: big.NewInt(100),
+		nonce:   2,
+		code:    []byte{0x60},
+		storage: map[common.Hash]common.Hash{slotA: common.HexToHash("0x01")},
+	}
+
+	require.Empty(t, replay.CompareAccount(actual, addr, ethtypes.Account{
+		Balance: big.NewInt(100),
+		Nonce:   2,
+		Code:    []byte{0x60},
+		Storage: map[common.Hash]common.Hash{slotA: common.HexToHash("0x01")},
+	}))
+
+	mismatches := replay.CompareAccount(actual, addr, ethtypes.Account{
+		Balance: big.NewInt(99),
+		Nonce:   3,
+		Storage: map[common.Hash]common.Hash{slotB: common.HexToHash("0x02"), slotA: common.HexToHash("0x01")},
+	})
+	require.Equal(t, []replay.Mismatch{
+		{Address: addr, Field: replay.FieldBalance, Expected: "99", Actual: "100"},
+		{Address: addr, Field: replay.FieldNonce, Expected: "3", Actual: "2"},
+		{Address: addr, Field: replay.FieldCode, Expected: "", Actual: "60"},
+		{Address: addr, Field: replay.FieldStorage, Slot: &slotB, Expected: common.HexToHash("0x02").Hex(), Actual: common.Hash{}.Hex()},
+	}, mismatches)
+}
+
+func TestReport(t *testing.T) {
+	var buf bytes.Buffer
+	report := replay.NewReport(&buf)
+	a, b := common.HexToAddress("0x01"), common.HexToAddress("0x02")
+	require.NoError(t, report.Add(7, replay.Mismatch{Address: a, Field: replay.FieldBalance, Expected: "1", Actual: "2"}))
+	require.NoError(t, report.Add(7, replay.Mismatch{Address: a, Field: replay.FieldNonce, Expected: "1", Actual: "2"}))
+	require.NoError(t, report.Add(7, replay.Mismatch{Address: b, Field: replay.FieldBalance, Expected: "1", Actual: "2"}))
+	require.NoError(t, report.Finish(3))
+	require.Equal(t, 3, report.Mismatches())
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 4)
+	var first map[string]any
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.Equal(t, float64(7), first["block"])
+	require.Equal(t, "balance", first["field"])
+	var summary struct {
+		Summary replay.ReportSummary `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[3]), &summary))
+	require.Equal(t, replay.ReportSummary{
+		Blocks:     3,
+		Accounts:   2,
+		Mismatches: 3,
+		Fields:     map[string]int{replay.FieldBalance: 2, replay.FieldNonce: 1},
+	}, summary.Summary)
+}
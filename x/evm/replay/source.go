@@ -0,0 +1,202 @@
+package replay
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rlp"
+	ethtests "github.com/ethereum/go-ethereum/tests"
+)
+
+// ErrBlockNotFound is returned by a BlockSource asked for a block it does not hold.
+var ErrBlockNotFound = errors.New("block not found")
+
+// BlockSource supplies the Ethereum blocks a replay executes, and the headers BLOCKHASH resolves against.
+type BlockSource interface {
+	// LatestBlockNumber returns the number of the highest block the source holds.
+	LatestBlockNumber(ctx context.Context) (uint64, error)
+	// BlockByNumber returns the canonical block at number.
+	BlockByNumber(ctx context.Context, number uint64) (*ethtypes.Block, error)
+}
+
+// NewRPCBlockSource returns a BlockSource that reads blocks from an Ethereum JSON-RPC endpoint.
+func NewRPCBlockSource(client *ethclient.Client) BlockSource {
+	return rpcBlockSource{client: client}
+}
+
+type rpcBlockSource struct {
+	client *ethclient.Client
+}
+
+func (s rpcBlockSource) LatestBlockNumber(ctx context.Context) (uint64, error) {
+	return s.client.BlockNumber(ctx)
+}
+
+func (s rpcBlockSource) BlockByNumber(ctx context.Context, number uint64) (*ethtypes.Block, error) {
+	return s.client.BlockByNumber(ctx, new(big.Int).SetUint64(number))
+}
+
+// NewRLPBlockSource reads the blocks of a `geth export` file, gzip compressed if its name ends in .gz. The file
+// must hold one contiguous run of blocks; all of them are kept in memory. Era and era1 archives are a different
+// format and are rejected; convert them with `geth import` and `geth export` first.
+func NewRLPBlockSource(path string) (BlockSource, error) {
+	if ext := filepath.Ext(path); ext == ".era" || ext == ".era1" {
+		return nil, fmt.Errorf("%s is an %s archive; only `geth export` RLP files are supported", path, ext)
+	}
+	f, err := os.Open(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		defer func() { _ = gz.Close() }()
+		r = gz
+	}
+	stream := rlp.NewStream(r, 0)
+	var blocks []*ethtypes.Block
+	for {
+		var b ethtypes.Block
+		if err := stream.Decode(&b); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to decode block %d of %s: %w", len(blocks), path, err)
+		}
+		blocks = append(blocks, &b)
+	}
+	return newMemoryBlockSource(blocks)
+}
+
+// NewFixtureBlockSource returns the blocks of an ethtests blockchain test. Tests that reorganize the chain hold
+// more than one block per number and are not supported.
+func NewFixtureBlockSource(bt *ethtests.BlockTest) (BlockSource, error) {
+	blocks := make([]*ethtypes.Block, 0, len(bt.Json.Blocks))
+	for i, btBlock := range bt.Json.Blocks {
+		b, err := btBlock.Decode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode fixture block %d: %w", i, err)
+		}
+		blocks = append(blocks, b)
+	}
+	return newMemoryBlockSource(blocks)
+}
+
+// LoadFixture reads the blockchain test called name from an ethtests fixture file. Filled fixtures key their
+// tests as "<source file>::<name>", so name may omit the source file. An empty name selects the file's only test.
+func LoadFixture(path string, name string) (*ethtests.BlockTest, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var tests map[string]*ethtests.BlockTest
+	if err := json.Unmarshal(bz, &tests); err != nil {
+		return nil, fmt.Errorf("failed to decode fixture %s: %w", path, err)
+	}
+	if name == "" {
+		if len(tests) != 1 {
+			return nil, fmt.Errorf("fixture %s holds %d tests; name the one to replay", path, len(tests))
+		}
+		for _, bt := range tests {
+			return bt, nil
+		}
+	}
+	if bt, ok := tests[name]; ok {
+		return bt, nil
+	}
+	var matches []string
+	for key := range tests {
+		if strings.HasSuffix(key, "::"+name) {
+			matches = append(matches, key)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("fixture %s has no test named %s", path, name)
+	case 1:
+		return tests[matches[0]], nil
+	default:
+		sort.Strings(matches)
+		return nil, fmt.Errorf("fixture %s has several tests named %s: %s", path, name, strings.Join(matches, ", "))
+	}
+}
+
+// fixtureForks are the Cancun and Prague activation times, -1 for inactive, that select the fork set of each
+// supported ethtests network. Every earlier fork is active from genesis on Sei.
+var fixtureForks = map[string][2]int64{
+	"Shanghai": {-1, -1},
+	"Cancun":   {0, -1},
+	"Prague":   {0, 0},
+}
+
+// FixtureForks returns the Cancun and Prague activation times that replay a fixture filled for network. Networks
+// that transition between forks mid-test, and forks Sei does not run, are rejected.
+func FixtureForks(network string) (cancunTime int64, pragueTime int64, err error) {
+	forks, ok := fixtureForks[network]
+	if !ok {
+		supported := make([]string, 0, len(fixtureForks))
+		for name := range fixtureForks {
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+		return 0, 0, fmt.Errorf("fixture network %q is not supported; supported networks are %s", network, strings.Join(supported, ", "))
+	}
+	return forks[0], forks[1], nil
+}
+
+// LoadAlloc reads a state in the genesis alloc format, which is also the format of a fixture's postState.
+func LoadAlloc(path string) (ethtypes.GenesisAlloc, error) {
+	bz, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	var alloc ethtypes.GenesisAlloc
+	if err := json.Unmarshal(bz, &alloc); err != nil {
+		return nil, fmt.Errorf("failed to decode alloc %s: %w", path, err)
+	}
+	return alloc, nil
+}
+
+// memoryBlockSource serves a contiguous run of blocks held in memory.
+type memoryBlockSource struct {
+	blocks []*ethtypes.Block
+}
+
+func newMemoryBlockSource(blocks []*ethtypes.Block) (*memoryBlockSource, error) {
+	if len(blocks) == 0 {
+		return nil, errors.New("no blocks to replay")
+	}
+	first := blocks[0].NumberU64()
+	for i, b := range blocks {
+		if b.NumberU64() != first+uint64(i) { //nolint:gosec
+			return nil, fmt.Errorf("block %d follows block %d; the blocks must form one contiguous chain", b.NumberU64(), blocks[i-1].NumberU64())
+		}
+	}
+	return &memoryBlockSource{blocks: blocks}, nil
+}
+
+func (s *memoryBlockSource) LatestBlockNumber(context.Context) (uint64, error) {
+	return s.blocks[len(s.blocks)-1].NumberU64(), nil
+}
+
+func (s *memoryBlockSource) BlockByNumber(_ context.Context, number uint64) (*ethtypes.Block, error) {
+	first := s.blocks[0].NumberU64()
+	if number < first || number-first >= uint64(len(s.blocks)) {
+		return nil, fmt.Errorf("%w: %d is outside %d to %d", ErrBlockNotFound, number, first, first+uint64(len(s.blocks))-1)
+	}
+	return s.blocks[number-first], nil
+}
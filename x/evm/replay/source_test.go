@@ -0,0 +1,108 @@
+package replay_test
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sei-protocol/sei-chain/x/evm/replay"
+)
+
+// writeExport writes blocks with the given numbers the way `geth export` does.
+func writeExport(t *testing.T, path string, numbers ...int64) []*ethtypes.Block {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, f.Close()) }()
+	var w io.Writer = f
+	if filepath.Ext(path) == ".gz" {
+		gz := gzip.NewWriter(f)
+		defer func() { require.NoError(t, gz.Close()) }()
+		w = gz
+	}
+	var blocks []*ethtypes.Block
+	for _, n := range numbers {
+		b := ethtypes.NewBlockWithHeader(&ethtypes.Header{Number: big.NewInt(n), Difficulty: big.NewInt(0)})
+		require.NoError(t, rlp.Encode(w, b))
+		blocks = append(blocks, b)
+	}
+	return blocks
+}
+
+func TestRLPBlockSource(t *testing.T) {
+	for _, name := range []string{"blocks.rlp", "blocks.rlp.gz"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			blocks := writeExport(t, path, 5, 6, 7)
+
+			src, err := replay.NewRLPBlockSource(path)
+			require.NoError(t, err)
+			latest, err := src.LatestBlockNumber(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, uint64(7), latest)
+			for _, want := range blocks {
+				got, err := src.BlockByNumber(context.Background(), want.NumberU64())
+				require.NoError(t, err)
+				require.Equal(t, want.Hash(), got.Hash())
+			}
+			_, err = src.BlockByNumber(context.Background(), 4)
+			require.True(t, errors.Is(err, replay.ErrBlockNotFound))
+			_, err = src.BlockByNumber(context.Background(), 8)
+			require.True(t, errors.Is(err, replay.ErrBlockNotFound))
+		})
+	}
+}
+
+func TestRLPBlockSourceRejectsGaps(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocks.rlp")
+	writeExport(t, path, 5, 7)
+	_, err := replay.NewRLPBlockSource(path)
+	require.ErrorContains(t, err, "block 7 follows block 5")
+}
+
+func TestRLPBlockSourceRejectsEra(t *testing.T) {
+	_, err := replay.NewRLPBlockSource(filepath.Join(t.TempDir(), "mainnet-00000-5ec1ffb8.era1"))
+	require.ErrorContains(t, err, "only `geth export` RLP files are supported")
+}
+
+func TestFixtureForks(t *testing.T) {
+	cancun, prague, err := replay.FixtureForks("Cancun")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cancun)
+	require.Equal(t, int64(-1), prague)
+	cancun, prague, err = replay.FixtureForks("Prague")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cancun)
+	require.Equal(t, int64(0), prague)
+	_, _, err = replay.FixtureForks("ShanghaiToCancunAtTime15k")
+	require.ErrorContains(t, err, "supported networks are Cancun, Prague, Shanghai")
+	_, _, err = replay.FixtureForks("London")
+	require.Error(t, err)
+}
+
+func TestLoadFixture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"a.json::first": {"blocks": [], "pre": {}, "postState": {}, "network": "Cancun"},
+		"b.json::second": {"blocks": [], "pre": {}, "postState": {}, "network": "Prague"}
+	}`), 0o600))
+
+	bt, err := replay.LoadFixture(path, "second")
+	require.NoError(t, err)
+	require.Equal(t, "Prague", bt.Json.Network)
+	bt, err = replay.LoadFixture(path, "a.json::first")
+	require.NoError(t, err)
+	require.Equal(t, "Cancun", bt.Json.Network)
+	_, err = replay.LoadFixture(path, "third")
+	require.ErrorContains(t, err, "no test named third")
+	_, err = replay.LoadFixture(path, "")
+	require.ErrorContains(t, err, "holds 2 tests")
+}
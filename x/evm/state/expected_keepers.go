@@ -24,6 +24,8 @@ type EVMKeeper interface {
 	GetCodeSize(sdk.Context, common.Address) int
 	GetState(sdk.Context, common.Address, common.Hash) common.Hash
 	SetState(sdk.Context, common.Address, common.Hash, common.Hash)
+	DeleteAllState(sdk.Context, common.Address)
+	TouchContractStorage(sdk.Context, common.Address)
 	AccountKeeper() *authkeeper.AccountKeeper
 	GetFeeCollectorAddress(sdk.Context) (common.Address, error)
 	GetNonce(sdk.Context, common.Address) uint64
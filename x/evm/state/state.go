@@ -34,6 +34,7 @@ func (s *DBImpl) GetState(addr common.Address, hash common.Hash) common.Hash {
 	if ov, ok := s.tempState.storageOverrides[addr]; ok {
 		return ov.current[hash.Hex()]
 	}
+	s.k.TouchContractStorage(s.ctx, addr)
 	return s.getState(s.ctx, addr, hash)
 }
 
@@ -48,7 +49,7 @@ func (s *DBImpl) SetState(addr common.Address, key common.Hash, val common.Hash)
 	}
 	s.k.PrepareReplayedAddr(s.ctx, addr)
 
-	old := s.GetState(addr, key)
+	old := s.getState(s.ctx, addr, key)
 	if s.logger != nil && s.logger.OnStorageChange != nil {
 		s.logger.OnStorageChange(addr, key, old, val)
 	}
@@ -208,7 +209,7 @@ func (s *DBImpl) clearAccountState(acc common.Address) {
 		delete(s.tempState.storageOverrides, acc)
 	}
 	if deleteIfExists(s.k.PrefixStore(s.ctx, types.CodeHashKeyPrefix), acc[:]) {
-		s.k.PurgePrefix(s.ctx, types.StateKey(acc))
+		s.k.DeleteAllState(s.ctx, acc)
 		s.clearAccountCodeAndNonce(acc)
 	}
 }
@@ -0,0 +1,26 @@
+package types
+
+import "github.com/ethereum/go-ethereum/params"
+
+// BlobConfig is the blob schedule of the chain. Prague is active from genesis (PragueTime), so the
+// blob base fee is priced with the Prague entry of the chain config's blob schedule, which is this
+// config; the per-block limits and the excess blob gas below follow it too. Only enforced while the
+// BlobTxsEnabled param is on.
+var BlobConfig = params.DefaultBlobSchedule.Prague
+
+var (
+	TargetBlobsPerBlock = BlobConfig.Target
+	MaxBlobsPerBlock    = BlobConfig.Max
+
+	TargetBlobGasPerBlock = uint64(TargetBlobsPerBlock) * params.BlobTxBlobGasPerBlob //nolint:gosec
+	MaxBlobGasPerBlock    = uint64(MaxBlobsPerBlock) * params.BlobTxBlobGasPerBlob    //nolint:gosec
+)
+
+// NextExcessBlobGas returns the excess blob gas carried into the next block given this block's
+// excess and the blob gas it used, as in EIP-4844's calc_excess_blob_gas.
+func NextExcessBlobGas(excess, used uint64) uint64 {
+	if excess+used < TargetBlobGasPerBlock {
+		return 0
+	}
+	return excess + used - TargetBlobGasPerBlock
+}
@@ -10,6 +10,42 @@ import (
 // ErrAssociateDeprecated is returned by the MsgAssociate handler.
 var ErrAssociateDeprecated = sdkerrors.Register(ModuleName, 2, "MsgAssociate is deprecated")
 
+// Blob (EIP-4844) transaction rejections. Each has its own code so RPC clients can tell
+// them apart; see BlobRejectionReason. While blob transactions are not enabled they are
+// rejected with the root ErrUnsupportedTxType instead, as before blob support existed.
+var (
+	ErrBlobSidecarMissing   = sdkerrors.Register(ModuleName, 3, "blob transaction is missing its sidecar")
+	ErrBlobTxNoBlobs        = sdkerrors.Register(ModuleName, 4, "blob transaction has no blob hashes")
+	ErrBlobTxTooManyBlobs   = sdkerrors.Register(ModuleName, 5, "blob transaction has too many blobs")
+	ErrBlobSidecarMismatch  = sdkerrors.Register(ModuleName, 6, "blob sidecar does not match the blob hashes")
+	ErrBlobInvalidProof     = sdkerrors.Register(ModuleName, 7, "invalid blob KZG proof")
+	ErrBlockBlobGasExceeded = sdkerrors.Register(ModuleName, 8, "block blob gas limit exceeded")
+)
+
+var blobRejectionReasons = map[uint32]string{
+	ErrBlobSidecarMissing.ABCICode():   "missing_sidecar",
+	ErrBlobTxNoBlobs.ABCICode():        "no_blobs",
+	ErrBlobTxTooManyBlobs.ABCICode():   "too_many_blobs",
+	ErrBlobSidecarMismatch.ABCICode():  "sidecar_mismatch",
+	ErrBlobInvalidProof.ABCICode():     "invalid_kzg_proof",
+	ErrBlockBlobGasExceeded.ABCICode(): "block_blob_gas_exceeded",
+}
+
+// BlobRejectionReason returns the stable reason string for an ABCI result carrying one of the
+// blob rejection errors, or false if the codespace and code are not one of them. Only blob
+// transactions are rejected with the root ErrUnsupportedTxType, so it reads as disabled blob
+// transactions.
+func BlobRejectionReason(codespace string, code uint32) (string, bool) {
+	if codespace == sdkerrors.RootCodespace && code == sdkerrors.ErrUnsupportedTxType.ABCICode() {
+		return "blob_txs_disabled", true
+	}
+	if codespace != ModuleName {
+		return "", false
+	}
+	reason, ok := blobRejectionReasons[code]
+	return reason, ok
+}
+
 type AssociationMissingErr struct {
 	Address string
 }
@@ -64,6 +64,18 @@ var (
 	ZeroStorageCleanupCheckpointKey = []byte{0x1e}
 	NonceBumpPrefix                 = []byte{0x1f} // transient
 	BlockHashPrefix                 = []byte{0x20}
+
+	ContractStorageUsagePrefix      = []byte{0x21}
+	ContractStorageUsageBackfillKey = []byte{0x22}
+	StorageUsageDeltaPrefix         = []byte{0x23} // transient
+
+	// EvmOnlyBalancePrefix holds EVM-only execution balances in FlatKV (see
+	// giga/evmonly/flatkvstate); the keeper does not read or write it.
+	EvmOnlyBalancePrefix = []byte{0x24}
+
+	ExcessBlobGasKey  = []byte{0x25}
+	BlobGasUsedPrefix = []byte{0x26} // transient
+	BlobSidecarPrefix = []byte{0x27} // transient
 )
 
 var (
@@ -88,6 +100,10 @@ func StateKey(evmAddress common.Address) []byte {
 	return append(StateKeyPrefix, evmAddress[:]...)
 }
 
+func ContractStorageUsageKey(evmAddress common.Address) []byte {
+	return append(ContractStorageUsagePrefix, evmAddress[:]...)
+}
+
 func ReceiptKey(txHash common.Hash) []byte {
 	return append(ReceiptKeyPrefix, txHash[:]...)
 }
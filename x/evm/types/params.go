@@ -18,6 +18,8 @@ var (
 	KeyMaxDynamicBaseFeeDownwardAdjustment = []byte("KeyMaxDynamicBaseFeeDownwardAdjustment")
 	KeyTargetGasUsedPerBlock               = []byte("KeyTargetGasUsedPerBlock")
 	KeySeiSstoreSetGasEIP2200              = []byte("KeySeiSstoreSetGasEIP2200")
+	KeyContractStorageDormancyBlocks       = []byte("KeyContractStorageDormancyBlocks")
+	KeyBlobTxsEnabled                      = []byte("KeyBlobTxsEnabled")
 	// deprecated
 	KeyBaseFeePerGas                          = []byte("KeyBaseFeePerGas")
 	KeyWhitelistedCwCodeHashesForDelegateCall = []byte("KeyWhitelistedCwCodeHashesForDelegateCall")
@@ -40,7 +42,9 @@ var DefaultMaxDynamicBaseFeeDownwardAdjustment = sdk.NewDecWithPrec(39, 4) // .3
 var DefaultTargetGasUsedPerBlock = uint64(250000)                          // 250k
 var DefaultMaxFeePerGas = sdk.NewDec(1000000000000)                        // 1,000gwei
 var DefaultRegisterPointerDisabled = false
-var DefaultSeiSstoreSetGasEIP2200 = uint64(20000) // 20k
+var DefaultSeiSstoreSetGasEIP2200 = uint64(20000)    // 20k
+var DefaultContractStorageDormancyBlocks = uint64(0) // dormancy flag off
+var DefaultBlobTxsEnabled = false                    // blob txs rejected
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -61,6 +65,8 @@ func DefaultParams() Params {
 		MaximumFeePerGas:                       DefaultMaxFeePerGas,
 		RegisterPointerDisabled:                DefaultRegisterPointerDisabled,
 		SeiSstoreSetGasEip2200:                 DefaultSeiSstoreSetGasEIP2200,
+		ContractStorageDormancyBlocks:          DefaultContractStorageDormancyBlocks,
+		BlobTxsEnabled:                         DefaultBlobTxsEnabled,
 	}
 }
 
@@ -77,6 +83,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySeiSstoreSetGasEIP2200, &p.SeiSstoreSetGasEip2200, validateSeiSstoreSetGasEIP2200),
 		paramtypes.NewParamSetPair(KeyMaxFeePerGas, &p.MaximumFeePerGas, validateMaxFeePerGas),
 		paramtypes.NewParamSetPair(KeyRegisterPointerDisabled, &p.RegisterPointerDisabled, validateRegisterPointerDisabled),
+		paramtypes.NewParamSetPair(KeyContractStorageDormancyBlocks, &p.ContractStorageDormancyBlocks, validateContractStorageDormancyBlocks),
+		paramtypes.NewParamSetPair(KeyBlobTxsEnabled, &p.BlobTxsEnabled, validateBlobTxsEnabled),
 	}
 }
 
@@ -288,6 +296,22 @@ func validateSeiSstoreSetGasEIP2200(i interface{}) error {
 	return nil
 }
 
+func validateContractStorageDormancyBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateBlobTxsEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func generateDefaultWhitelistedCwCodeHashesForDelegateCall() [][]byte {
 	return [][]byte(nil)
 }
@@ -52,6 +52,12 @@ type Params struct {
 	MaximumFeePerGas                       github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,13,opt,name=maximum_fee_per_gas,json=maximumFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"maximum_fee_per_gas" yaml:"maximum_fee_per_gas"`
 	RegisterPointerDisabled                bool                                                   `protobuf:"varint,14,opt,name=register_pointer_disabled,json=registerPointerDisabled,proto3" json:"register_pointer_disabled" yaml:"register_pointer_disabled"`
 	SeiSstoreSetGasEip2200                 uint64                                                 `protobuf:"varint,15,opt,name=sei_sstore_set_gas_eip2200,json=seiSstoreSetGasEip2200,proto3" json:"sei_sstore_set_gas_eip2200,omitempty"`
+	// Number of blocks without a transaction touching a contract's storage after
+	// which the contract is reported as dormant. 0 disables the dormancy flag.
+	ContractStorageDormancyBlocks uint64 `protobuf:"varint,16,opt,name=contract_storage_dormancy_blocks,json=contractStorageDormancyBlocks,proto3" json:"contract_storage_dormancy_blocks,omitempty"`
+	// Experimental: accept EIP-4844 blob transactions, charge blob gas and keep
+	// their sidecars. Blob transactions are rejected when false.
+	BlobTxsEnabled bool `protobuf:"varint,17,opt,name=blob_txs_enabled,json=blobTxsEnabled,proto3" json:"blob_txs_enabled,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -121,30 +127,38 @@ func (m *Params) GetSeiSstoreSetGasEip2200() uint64 {
 	return 0
 }
 
+func (m *Params) GetContractStorageDormancyBlocks() uint64 {
+	if m != nil {
+		return m.ContractStorageDormancyBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBlobTxsEnabled() bool {
+	if m != nil {
+		return m.BlobTxsEnabled
+	}
+	return false
+}
+
 type ParamsPreV580 struct {
 	// string base_denom = 1 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"base_denom\"",
-	//	(gogoproto.jsontag) = "base_denom"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"base_denom\"",
+	//   (gogoproto.jsontag) = "base_denom"
 	// ];
 	PriorityNormalizer github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,2,opt,name=priority_normalizer,json=priorityNormalizer,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"priority_normalizer" yaml:"priority_normalizer"`
 	BaseFeePerGas      github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,3,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"base_fee_per_gas" yaml:"base_fee_per_gas"`
 	MinimumFeePerGas   github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,4,opt,name=minimum_fee_per_gas,json=minimumFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"minimum_fee_per_gas" yaml:"minimum_fee_per_gas"`
 	// ChainConfig chain_config = 5 [(gogoproto.moretags) = "yaml:\"chain_config\"", (gogoproto.nullable) = false];
-	//
-	//	string chain_id = 6 [
-	//	(gogoproto.moretags)   = "yaml:\"chain_id\"",
-	//	(gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
-	//	(gogoproto.nullable)   = false,
-	//	(gogoproto.jsontag) = "chain_id"
-	//
+	//   string chain_id = 6 [
+	//   (gogoproto.moretags)   = "yaml:\"chain_id\"",
+	//   (gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
+	//   (gogoproto.nullable)   = false,
+	//   (gogoproto.jsontag) = "chain_id"
 	// ];
 	// repeated string whitelisted_codehashes_bank_send = 7 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
-	//	(gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
+	//   (gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
 	// ];
 	WhitelistedCwCodeHashesForDelegateCall [][]byte `protobuf:"bytes,8,rep,name=whitelisted_cw_code_hashes_for_delegate_call,json=whitelistedCwCodeHashesForDelegateCall,proto3" json:"whitelisted_cw_code_hashes_for_delegate_call" yaml:"whitelisted_cw_code_hashes_for_delegate_call"`
 }
@@ -190,29 +204,23 @@ func (m *ParamsPreV580) GetWhitelistedCwCodeHashesForDelegateCall() [][]byte {
 
 type ParamsPreV600 struct {
 	// string base_denom = 1 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"base_denom\"",
-	//	(gogoproto.jsontag) = "base_denom"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"base_denom\"",
+	//   (gogoproto.jsontag) = "base_denom"
 	// ];
 	PriorityNormalizer        github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,2,opt,name=priority_normalizer,json=priorityNormalizer,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"priority_normalizer" yaml:"priority_normalizer"`
 	BaseFeePerGas             github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,3,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"base_fee_per_gas" yaml:"base_fee_per_gas"`
 	MinimumFeePerGas          github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,4,opt,name=minimum_fee_per_gas,json=minimumFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"minimum_fee_per_gas" yaml:"minimum_fee_per_gas"`
 	DeliverTxHookWasmGasLimit uint64                                                 `protobuf:"varint,5,opt,name=deliver_tx_hook_wasm_gas_limit,json=deliverTxHookWasmGasLimit,proto3" json:"deliver_tx_hook_wasm_gas_limit,omitempty"`
 	// ChainConfig chain_config = 5 [(gogoproto.moretags) = "yaml:\"chain_config\"", (gogoproto.nullable) = false];
-	//
-	//	string chain_id = 6 [
-	//	(gogoproto.moretags)   = "yaml:\"chain_id\"",
-	//	(gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
-	//	(gogoproto.nullable)   = false,
-	//	(gogoproto.jsontag) = "chain_id"
-	//
+	//   string chain_id = 6 [
+	//   (gogoproto.moretags)   = "yaml:\"chain_id\"",
+	//   (gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
+	//   (gogoproto.nullable)   = false,
+	//   (gogoproto.jsontag) = "chain_id"
 	// ];
 	// repeated string whitelisted_codehashes_bank_send = 7 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
-	//	(gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
+	//   (gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
 	// ];
 	WhitelistedCwCodeHashesForDelegateCall [][]byte `protobuf:"bytes,8,rep,name=whitelisted_cw_code_hashes_for_delegate_call,json=whitelistedCwCodeHashesForDelegateCall,proto3" json:"whitelisted_cw_code_hashes_for_delegate_call" yaml:"whitelisted_cw_code_hashes_for_delegate_call"`
 }
@@ -265,28 +273,22 @@ func (m *ParamsPreV600) GetWhitelistedCwCodeHashesForDelegateCall() [][]byte {
 
 type ParamsPreV601 struct {
 	// string base_denom = 1 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"base_denom\"",
-	//	(gogoproto.jsontag) = "base_denom"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"base_denom\"",
+	//   (gogoproto.jsontag) = "base_denom"
 	// ];
 	PriorityNormalizer github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,2,opt,name=priority_normalizer,json=priorityNormalizer,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"priority_normalizer" yaml:"priority_normalizer"`
 	BaseFeePerGas      github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,3,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"base_fee_per_gas" yaml:"base_fee_per_gas"`
 	MinimumFeePerGas   github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,4,opt,name=minimum_fee_per_gas,json=minimumFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"minimum_fee_per_gas" yaml:"minimum_fee_per_gas"`
 	// ChainConfig chain_config = 5 [(gogoproto.moretags) = "yaml:\"chain_config\"", (gogoproto.nullable) = false];
-	//
-	//	string chain_id = 6 [
-	//	(gogoproto.moretags)   = "yaml:\"chain_id\"",
-	//	(gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
-	//	(gogoproto.nullable)   = false,
-	//	(gogoproto.jsontag) = "chain_id"
-	//
+	//   string chain_id = 6 [
+	//   (gogoproto.moretags)   = "yaml:\"chain_id\"",
+	//   (gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
+	//   (gogoproto.nullable)   = false,
+	//   (gogoproto.jsontag) = "chain_id"
 	// ];
 	// repeated string whitelisted_codehashes_bank_send = 7 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
-	//	(gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
+	//   (gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
 	// ];
 	WhitelistedCwCodeHashesForDelegateCall [][]byte                                               `protobuf:"bytes,8,rep,name=whitelisted_cw_code_hashes_for_delegate_call,json=whitelistedCwCodeHashesForDelegateCall,proto3" json:"whitelisted_cw_code_hashes_for_delegate_call" yaml:"whitelisted_cw_code_hashes_for_delegate_call"`
 	DeliverTxHookWasmGasLimit              uint64                                                 `protobuf:"varint,9,opt,name=deliver_tx_hook_wasm_gas_limit,json=deliverTxHookWasmGasLimit,proto3" json:"deliver_tx_hook_wasm_gas_limit,omitempty"`
@@ -350,28 +352,22 @@ func (m *ParamsPreV601) GetTargetGasUsedPerBlock() uint64 {
 
 type ParamsPreV606 struct {
 	// string base_denom = 1 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"base_denom\"",
-	//	(gogoproto.jsontag) = "base_denom"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"base_denom\"",
+	//   (gogoproto.jsontag) = "base_denom"
 	// ];
 	PriorityNormalizer github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,2,opt,name=priority_normalizer,json=priorityNormalizer,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"priority_normalizer" yaml:"priority_normalizer"`
 	BaseFeePerGas      github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,3,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"base_fee_per_gas" yaml:"base_fee_per_gas"`
 	MinimumFeePerGas   github_com_sei_protocol_sei_chain_sei_cosmos_types.Dec `protobuf:"bytes,4,opt,name=minimum_fee_per_gas,json=minimumFeePerGas,proto3,customtype=github.com/sei-protocol/sei-chain/sei-cosmos/types.Dec" json:"minimum_fee_per_gas" yaml:"minimum_fee_per_gas"`
 	// ChainConfig chain_config = 5 [(gogoproto.moretags) = "yaml:\"chain_config\"", (gogoproto.nullable) = false];
-	//
-	//	string chain_id = 6 [
-	//	(gogoproto.moretags)   = "yaml:\"chain_id\"",
-	//	(gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
-	//	(gogoproto.nullable)   = false,
-	//	(gogoproto.jsontag) = "chain_id"
-	//
+	//   string chain_id = 6 [
+	//   (gogoproto.moretags)   = "yaml:\"chain_id\"",
+	//   (gogoproto.customtype) = "github.com/sei-protocol/sei-chain/sei-cosmos/types.Int",
+	//   (gogoproto.nullable)   = false,
+	//   (gogoproto.jsontag) = "chain_id"
 	// ];
 	// repeated string whitelisted_codehashes_bank_send = 7 [
-	//
-	//	(gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
-	//	(gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
-	//
+	//   (gogoproto.moretags)   = "yaml:\"whitelisted_codehashes_bank_send\"",
+	//   (gogoproto.jsontag) = "whitelisted_codehashes_bank_send"
 	// ];
 	WhitelistedCwCodeHashesForDelegateCall [][]byte                                               `protobuf:"bytes,8,rep,name=whitelisted_cw_code_hashes_for_delegate_call,json=whitelistedCwCodeHashesForDelegateCall,proto3" json:"whitelisted_cw_code_hashes_for_delegate_call" yaml:"whitelisted_cw_code_hashes_for_delegate_call"`
 	DeliverTxHookWasmGasLimit              uint64                                                 `protobuf:"varint,9,opt,name=deliver_tx_hook_wasm_gas_limit,json=deliverTxHookWasmGasLimit,proto3" json:"deliver_tx_hook_wasm_gas_limit,omitempty"`
@@ -445,59 +441,63 @@ func init() {
 func init() { proto.RegisterFile("evm/params.proto", fileDescriptor_9272f3679901ea94) }
 
 var fileDescriptor_9272f3679901ea94 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x4f, 0x3b, 0x45,
-	0x14, 0xef, 0xfa, 0xe5, 0x4b, 0x60, 0xa1, 0x4a, 0x16, 0x95, 0x85, 0x43, 0xb7, 0xae, 0x09, 0xe9,
-	0x41, 0xda, 0x0a, 0x91, 0x10, 0x6e, 0x94, 0x4a, 0x39, 0x18, 0xd3, 0x2c, 0xa2, 0x89, 0x97, 0xc9,
-	0x74, 0xf7, 0xb1, 0x1d, 0xd9, 0xe9, 0x6c, 0x66, 0xb6, 0xbf, 0x4c, 0x4c, 0xbc, 0x78, 0xf7, 0x64,
-	0xf4, 0x60, 0x62, 0x8c, 0x89, 0xff, 0x87, 0x89, 0x09, 0x07, 0x0f, 0x1c, 0x8d, 0x87, 0x8d, 0x81,
-	0x1b, 0x89, 0x97, 0x26, 0xde, 0xcd, 0xce, 0x6e, 0xa1, 0xd0, 0x22, 0x10, 0xb8, 0xa0, 0x7b, 0x62,
-	0x98, 0xcf, 0x67, 0xde, 0xbc, 0xf7, 0xe6, 0x7d, 0xde, 0xec, 0x54, 0x5d, 0x80, 0x0e, 0x2d, 0xf9,
-	0x98, 0x63, 0x2a, 0x8a, 0x3e, 0x67, 0x01, 0xd3, 0x74, 0x01, 0x44, 0x8e, 0x6c, 0xe6, 0x15, 0x05,
-	0x10, 0xbb, 0x89, 0x49, 0xab, 0x08, 0x1d, 0xba, 0xf2, 0xba, 0xcb, 0x5c, 0x26, 0xa1, 0x52, 0x34,
-	0x8a, 0xf9, 0xe6, 0x97, 0x59, 0x75, 0xba, 0x2e, 0x0d, 0x68, 0x3f, 0x29, 0xea, 0xa2, 0xcf, 0x09,
-	0xe3, 0x24, 0xe8, 0xa3, 0x16, 0xe3, 0x14, 0x7b, 0xe4, 0x73, 0xe0, 0xfa, 0x2b, 0x79, 0xa5, 0x30,
-	0x5b, 0x11, 0x27, 0xa1, 0x91, 0xf9, 0x23, 0x34, 0x36, 0x5d, 0x12, 0x34, 0xdb, 0x8d, 0xa2, 0xcd,
-	0x68, 0x49, 0x00, 0x59, 0x1b, 0x6e, 0x26, 0xff, 0x91, 0xbb, 0xc5, 0x23, 0x26, 0x28, 0x13, 0xa5,
-	0xa0, 0xef, 0x83, 0x28, 0x56, 0xc1, 0xbe, 0x08, 0x8d, 0x49, 0xc6, 0x07, 0xa1, 0xb1, 0xd2, 0xc7,
-	0xd4, 0xdb, 0x36, 0x27, 0x80, 0xa6, 0xa5, 0x0d, 0x67, 0x3f, 0xbc, 0x9c, 0xd4, 0xbe, 0x51, 0xd4,
-	0x85, 0x06, 0x16, 0x80, 0x8e, 0x00, 0x90, 0x0f, 0x1c, 0xb9, 0x58, 0xe8, 0x2f, 0xa4, 0x8f, 0xf4,
-	0xd1, 0x3e, 0x8e, 0x59, 0x1e, 0x84, 0xc6, 0x52, 0xec, 0xe0, 0x4d, 0xc4, 0xb4, 0xb2, 0xd1, 0xd4,
-	0x1e, 0x40, 0x1d, 0x78, 0x0d, 0x0b, 0xed, 0x47, 0x45, 0x5d, 0xa4, 0xa4, 0x45, 0x68, 0x9b, 0x5e,
-	0xf3, 0x6d, 0xea, 0xa9, 0xf2, 0x37, 0xc1, 0xf8, 0x55, 0xfe, 0x26, 0x80, 0xa6, 0xb5, 0x90, 0xcc,
-	0x5e, 0x39, 0xf9, 0xab, 0xa2, 0xbe, 0xd3, 0x6d, 0x92, 0x00, 0x3c, 0x22, 0x02, 0x70, 0x90, 0xdd,
-	0x45, 0x36, 0x73, 0x00, 0x35, 0xb1, 0x68, 0x82, 0x40, 0x47, 0x8c, 0x23, 0x07, 0x3c, 0x70, 0x71,
-	0x00, 0xc8, 0xc6, 0x9e, 0xa7, 0xcf, 0xe4, 0x5f, 0x14, 0xe6, 0x2b, 0xee, 0x45, 0x68, 0x3c, 0x68,
-	0xdd, 0x20, 0x34, 0x36, 0x62, 0xc7, 0x1e, 0xb2, 0xca, 0xb4, 0x56, 0x47, 0xe8, 0xbb, 0xdd, 0x5d,
-	0xe6, 0xc0, 0xbe, 0xe4, 0xee, 0x31, 0x5e, 0x4d, 0x98, 0xbb, 0xd8, 0xf3, 0xb4, 0x1d, 0x35, 0xe7,
-	0x80, 0x47, 0x3a, 0xc0, 0x51, 0xd0, 0x43, 0x4d, 0xc6, 0x8e, 0x51, 0x17, 0x0b, 0x1a, 0x85, 0x8d,
-	0x3c, 0x42, 0x49, 0xa0, 0xcf, 0xe6, 0x95, 0xc2, 0x94, 0xb5, 0x9c, 0xb0, 0x3e, 0xea, 0xed, 0x33,
-	0x76, 0xfc, 0x09, 0x16, 0xb4, 0x86, 0xc5, 0x07, 0x11, 0x41, 0xfb, 0x4b, 0x51, 0x57, 0x29, 0xee,
-	0x21, 0xa7, 0xdf, 0xc2, 0x94, 0xd8, 0xe8, 0xf2, 0x80, 0xdb, 0x7e, 0x17, 0x73, 0x07, 0x61, 0xe7,
-	0xb3, 0xb6, 0x08, 0x28, 0xb4, 0x02, 0x5d, 0x95, 0x47, 0xf8, 0x9d, 0xf2, 0xe8, 0x33, 0xbc, 0xe7,
-	0x86, 0x83, 0xd0, 0x58, 0x4b, 0x8e, 0xf5, 0x5e, 0x7c, 0xd3, 0x7a, 0x8b, 0xe2, 0x5e, 0x35, 0xe6,
-	0x55, 0xe2, 0xaa, 0x3c, 0x94, 0xa4, 0x9d, 0x4b, 0x8e, 0xf6, 0xb7, 0xa2, 0x16, 0x26, 0x9a, 0x73,
-	0x58, 0xb7, 0x75, 0x33, 0xe2, 0x39, 0x19, 0xf1, 0xf7, 0x8f, 0x8f, 0xf8, 0xde, 0x5b, 0x0e, 0x42,
-	0xa3, 0xf4, 0x2f, 0x31, 0x4f, 0x58, 0x61, 0x5a, 0x6f, 0x8f, 0x45, 0x5d, 0x4d, 0x68, 0x23, 0x71,
-	0x6f, 0xa9, 0xcb, 0x01, 0xe6, 0x2e, 0x04, 0xb2, 0x38, 0xda, 0x02, 0x1c, 0x29, 0x90, 0x86, 0xc7,
-	0xec, 0x63, 0x7d, 0x5e, 0x56, 0xc9, 0x1b, 0x31, 0xa1, 0x86, 0xc5, 0xa1, 0x00, 0xa7, 0x0e, 0xbc,
-	0x12, 0x81, 0xb1, 0xa2, 0x71, 0x6f, 0x4c, 0xd1, 0xd9, 0x27, 0x53, 0xf4, 0xb8, 0xf1, 0x11, 0x45,
-	0x8f, 0x83, 0x91, 0xa2, 0xe3, 0xd9, 0x2b, 0x45, 0x7f, 0xa1, 0x2e, 0x73, 0x70, 0x23, 0xc1, 0x70,
-	0xe4, 0x33, 0xd2, 0x8a, 0xfe, 0x3a, 0x44, 0xe0, 0x86, 0x07, 0x8e, 0xfe, 0x6a, 0x5e, 0x29, 0xcc,
-	0x54, 0x76, 0x2e, 0x42, 0xe3, 0x76, 0xd2, 0x20, 0x34, 0xf2, 0xf1, 0x8e, 0xb7, 0x52, 0x4c, 0x6b,
-	0x69, 0x88, 0xd5, 0x63, 0xa8, 0x9a, 0x20, 0xda, 0xb6, 0xba, 0x22, 0x80, 0x20, 0x21, 0x02, 0xc6,
-	0x01, 0x89, 0x24, 0xcb, 0x40, 0xfc, 0xf5, 0xf5, 0x72, 0x59, 0x7f, 0x4d, 0xa6, 0xf7, 0x4d, 0x01,
-	0xe4, 0x40, 0x12, 0x0e, 0x64, 0x92, 0xdf, 0x8f, 0xd1, 0xed, 0xa9, 0x6f, 0x7f, 0x30, 0x32, 0xe6,
-	0x2f, 0x2f, 0xd5, 0x6c, 0x7c, 0x05, 0xd5, 0x39, 0x7c, 0xfc, 0xde, 0x56, 0x39, 0xbd, 0x89, 0xd2,
-	0x9b, 0xe8, 0xd9, 0xdc, 0x44, 0x49, 0x11, 0x7f, 0x35, 0x3d, 0x5a, 0xc4, 0x9b, 0xe5, 0xb4, 0x88,
-	0xff, 0x17, 0x45, 0x7c, 0xf7, 0x67, 0xc8, 0xcb, 0xbb, 0x3e, 0x43, 0xfe, 0x5b, 0x3a, 0xf8, 0x59,
-	0xbd, 0xae, 0x83, 0x77, 0x53, 0x1d, 0xa4, 0xcd, 0x3c, 0x7d, 0x56, 0xa4, 0xcf, 0x8a, 0xf4, 0x59,
-	0x31, 0x7c, 0x56, 0x24, 0x9d, 0xf2, 0xb7, 0xb9, 0xeb, 0x9d, 0x72, 0x33, 0xed, 0x94, 0x69, 0xa7,
-	0x4c, 0x3b, 0x65, 0xda, 0x29, 0xd3, 0x4e, 0xf9, 0xac, 0x7e, 0x80, 0x89, 0xdb, 0x79, 0xa5, 0x76,
-	0x72, 0x96, 0x53, 0x4e, 0xcf, 0x72, 0xca, 0x9f, 0x67, 0x39, 0xe5, 0xeb, 0xf3, 0x5c, 0xe6, 0xf4,
-	0x3c, 0x97, 0xf9, 0xfd, 0x3c, 0x97, 0xf9, 0x74, 0xed, 0x6e, 0xf7, 0x7a, 0x25, 0xe8, 0xd0, 0xd8,
-	0xb3, 0xc6, 0xb4, 0xc4, 0x37, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x08, 0x71, 0xbc, 0xbd, 0xdc,
-	0x17, 0x00, 0x00,
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xd2, 0x36, 0x6a, 0xa7, 0x4d, 0x31, 0x5b, 0xa0, 0x9b, 0x48, 0x78, 0xcd, 0x22, 0x55,
+	0x3e, 0x10, 0x3b, 0xb4, 0x22, 0xaa, 0x72, 0x8b, 0xe3, 0xd6, 0x3d, 0x20, 0x64, 0x6d, 0x5a, 0x90,
+	0xb8, 0x8c, 0xc6, 0xbb, 0xaf, 0xeb, 0x21, 0x3b, 0x3b, 0xd6, 0xcc, 0x38, 0x5e, 0x23, 0x71, 0xe4,
+	0xce, 0x09, 0xc1, 0x01, 0x09, 0x21, 0x24, 0xfe, 0x07, 0x12, 0x52, 0x0f, 0x1c, 0x7a, 0x44, 0x1c,
+	0x56, 0x28, 0xb9, 0x45, 0xe2, 0x62, 0xc4, 0x1d, 0xed, 0xcc, 0x3a, 0x4d, 0x1a, 0xb7, 0x49, 0x95,
+	0x5e, 0x0a, 0x7b, 0xf2, 0x7a, 0xbe, 0x6f, 0xde, 0xbc, 0x37, 0xef, 0x7d, 0x6f, 0x76, 0x6c, 0x54,
+	0x85, 0x1d, 0xd6, 0x1a, 0x12, 0x41, 0x98, 0x6c, 0x0e, 0x05, 0x57, 0xdc, 0x76, 0x24, 0x50, 0xfd,
+	0x14, 0xf0, 0xb8, 0x29, 0x81, 0x06, 0x03, 0x42, 0x93, 0x26, 0xec, 0xb0, 0xe5, 0x37, 0x23, 0x1e,
+	0x71, 0x0d, 0xb5, 0xf2, 0x27, 0xc3, 0xf7, 0xfe, 0x5e, 0x44, 0x0b, 0x3d, 0x6d, 0xc0, 0xfe, 0xc9,
+	0x42, 0xd7, 0x86, 0x82, 0x72, 0x41, 0xd5, 0x04, 0x27, 0x5c, 0x30, 0x12, 0xd3, 0x2f, 0x40, 0x38,
+	0xaf, 0xd5, 0xad, 0xc6, 0xa5, 0xb6, 0x7c, 0x94, 0xb9, 0x95, 0x3f, 0x32, 0x77, 0x2d, 0xa2, 0x6a,
+	0x30, 0xea, 0x37, 0x03, 0xce, 0x5a, 0x12, 0xe8, 0xca, 0x6c, 0x31, 0xfd, 0x45, 0xaf, 0x66, 0x9e,
+	0xb8, 0x64, 0x5c, 0xb6, 0xd4, 0x64, 0x08, 0xb2, 0xd9, 0x81, 0x60, 0x3f, 0x73, 0xe7, 0x19, 0x9f,
+	0x66, 0xee, 0xf2, 0x84, 0xb0, 0x78, 0xdd, 0x9b, 0x03, 0x7a, 0xbe, 0x3d, 0x1b, 0xfd, 0xf8, 0x60,
+	0xd0, 0xfe, 0xc6, 0x42, 0xd5, 0x3e, 0x91, 0x80, 0x1f, 0x02, 0xe0, 0x21, 0x08, 0x1c, 0x11, 0xe9,
+	0x9c, 0xd3, 0x3e, 0xb2, 0x33, 0xfb, 0x78, 0xcc, 0xf2, 0x34, 0x73, 0xaf, 0x1b, 0x07, 0x9f, 0x46,
+	0x3c, 0x7f, 0x31, 0x1f, 0xba, 0x0b, 0xd0, 0x03, 0xd1, 0x25, 0xd2, 0xfe, 0xd1, 0x42, 0xd7, 0x18,
+	0x4d, 0x28, 0x1b, 0xb1, 0x23, 0xbe, 0x9d, 0x7f, 0x59, 0xfb, 0x37, 0xc7, 0xf8, 0x93, 0xfd, 0x9b,
+	0x03, 0x7a, 0x7e, 0xb5, 0x18, 0x7d, 0xe2, 0xe4, 0xaf, 0x16, 0x7a, 0x7f, 0x3c, 0xa0, 0x0a, 0x62,
+	0x2a, 0x15, 0x84, 0x38, 0x18, 0xe3, 0x80, 0x87, 0x80, 0x07, 0x44, 0x0e, 0x40, 0xe2, 0x87, 0x5c,
+	0xe0, 0x10, 0x62, 0x88, 0x88, 0x02, 0x1c, 0x90, 0x38, 0x76, 0x2e, 0xd6, 0xcf, 0x35, 0xae, 0xb4,
+	0xa3, 0xfd, 0xcc, 0x7d, 0xa1, 0x79, 0xd3, 0xcc, 0xbd, 0x65, 0x1c, 0x7b, 0x91, 0x59, 0x9e, 0x7f,
+	0xe3, 0x10, 0x7d, 0x73, 0xbc, 0xc9, 0x43, 0xb8, 0xa7, 0xb9, 0x77, 0xb9, 0xe8, 0x14, 0xcc, 0x4d,
+	0x12, 0xc7, 0xf6, 0x06, 0xaa, 0x85, 0x10, 0xd3, 0x1d, 0x10, 0x58, 0xa5, 0x78, 0xc0, 0xf9, 0x36,
+	0x1e, 0x13, 0xc9, 0xf2, 0xb0, 0x71, 0x4c, 0x19, 0x55, 0xce, 0xa5, 0xba, 0xd5, 0x38, 0xef, 0x2f,
+	0x15, 0xac, 0xfb, 0xe9, 0x3d, 0xce, 0xb7, 0x3f, 0x25, 0x92, 0x75, 0x89, 0xfc, 0x28, 0x27, 0xd8,
+	0x7f, 0x59, 0xe8, 0x06, 0x23, 0x29, 0x0e, 0x27, 0x09, 0x61, 0x34, 0xc0, 0x07, 0x09, 0x1e, 0x0d,
+	0xc7, 0x44, 0x84, 0x98, 0x84, 0x9f, 0x8f, 0xa4, 0x62, 0x90, 0x28, 0x07, 0xe9, 0x14, 0x7e, 0x67,
+	0x9d, 0x39, 0x87, 0xa7, 0x5c, 0x70, 0x9a, 0xb9, 0x2b, 0x45, 0x5a, 0x4f, 0xc5, 0xf7, 0xfc, 0x77,
+	0x19, 0x49, 0x3b, 0x86, 0xd7, 0x36, 0x55, 0xf9, 0x40, 0x93, 0x36, 0x0e, 0x38, 0xf6, 0x3f, 0x16,
+	0x6a, 0xcc, 0x35, 0x17, 0xf2, 0x71, 0xf2, 0x74, 0xc4, 0x97, 0x75, 0xc4, 0xdf, 0x9f, 0x3d, 0xe2,
+	0x53, 0x2f, 0x39, 0xcd, 0xdc, 0xd6, 0x73, 0x62, 0x9e, 0x33, 0xc3, 0xf3, 0xdf, 0x3b, 0x16, 0x75,
+	0xa7, 0xa0, 0x1d, 0x8a, 0xfb, 0x36, 0x5a, 0x52, 0x44, 0x44, 0xa0, 0x74, 0x71, 0x8c, 0x24, 0x84,
+	0x5a, 0x20, 0xfd, 0x98, 0x07, 0xdb, 0xce, 0x15, 0x5d, 0x25, 0x6f, 0x19, 0x42, 0x97, 0xc8, 0x07,
+	0x12, 0xc2, 0x1e, 0x88, 0x76, 0x0e, 0x1a, 0x45, 0x93, 0xf4, 0x98, 0xa2, 0x17, 0x5f, 0x9a, 0xa2,
+	0x49, 0xfa, 0x1c, 0x45, 0x93, 0x74, 0x9e, 0xa2, 0x49, 0x7a, 0x54, 0xd1, 0x5f, 0xa2, 0x25, 0x01,
+	0x51, 0x2e, 0x18, 0x81, 0x87, 0x9c, 0x26, 0xf9, 0x67, 0x48, 0x25, 0xe9, 0xc7, 0x10, 0x3a, 0x57,
+	0xeb, 0x56, 0xe3, 0x62, 0x7b, 0x63, 0x3f, 0x73, 0x9f, 0x4d, 0x9a, 0x66, 0x6e, 0xdd, 0xac, 0xf8,
+	0x4c, 0x8a, 0xe7, 0x5f, 0x9f, 0x61, 0x3d, 0x03, 0x75, 0x0a, 0xc4, 0x5e, 0x47, 0xcb, 0x12, 0x28,
+	0x96, 0x52, 0x71, 0x01, 0x58, 0x16, 0xbb, 0x0c, 0x74, 0x78, 0xf3, 0xe6, 0xea, 0xaa, 0xf3, 0xba,
+	0xde, 0xde, 0xb7, 0x25, 0xd0, 0x2d, 0x4d, 0xd8, 0xd2, 0x9b, 0x7c, 0xc7, 0xa0, 0x76, 0x17, 0xd5,
+	0x03, 0x9e, 0x28, 0x41, 0x02, 0x85, 0x73, 0x98, 0x44, 0x79, 0x9e, 0x05, 0x23, 0x49, 0x30, 0x31,
+	0xf9, 0x91, 0x4e, 0x55, 0x5b, 0x78, 0x67, 0xc6, 0xdb, 0x32, 0xb4, 0x4e, 0xc1, 0xd2, 0x79, 0x92,
+	0x76, 0x03, 0x55, 0xfb, 0x31, 0xef, 0x63, 0x95, 0x4a, 0x0c, 0x89, 0x09, 0xfd, 0x8d, 0x3c, 0x74,
+	0xff, 0x6a, 0x3e, 0x7e, 0x3f, 0x95, 0x77, 0xcc, 0xe8, 0xfa, 0xf9, 0x6f, 0x7f, 0x70, 0x2b, 0xde,
+	0x2f, 0x17, 0xd0, 0xa2, 0x39, 0xf5, 0x7a, 0x02, 0x3e, 0xf9, 0xf0, 0xf6, 0x6a, 0x79, 0xf8, 0x95,
+	0x87, 0xdf, 0x2b, 0x73, 0xf8, 0x15, 0x45, 0xfc, 0xd5, 0xc2, 0xe1, 0x22, 0x5e, 0x5b, 0x2d, 0x8b,
+	0xf8, 0x7f, 0x51, 0xc4, 0x27, 0xbf, 0xf9, 0x5c, 0x38, 0xe9, 0xcd, 0xe7, 0xbf, 0xa5, 0x83, 0x9f,
+	0xd1, 0x51, 0x1d, 0x7c, 0x50, 0xea, 0xa0, 0x6c, 0xe6, 0xe5, 0x4d, 0xa6, 0xbc, 0xc9, 0x94, 0x37,
+	0x99, 0xd9, 0x4d, 0xa6, 0xe8, 0x94, 0xbf, 0x5d, 0x3e, 0xda, 0x29, 0xd7, 0xca, 0x4e, 0x59, 0x76,
+	0xca, 0xb2, 0x53, 0x96, 0x9d, 0xb2, 0xec, 0x94, 0xaf, 0xd4, 0x6f, 0x3e, 0xa6, 0x9d, 0xb7, 0xbb,
+	0x8f, 0x76, 0x6b, 0xd6, 0xe3, 0xdd, 0x9a, 0xf5, 0xe7, 0x6e, 0xcd, 0xfa, 0x7a, 0xaf, 0x56, 0x79,
+	0xbc, 0x57, 0xab, 0xfc, 0xbe, 0x57, 0xab, 0x7c, 0xb6, 0x72, 0xb2, 0x7b, 0x69, 0x2b, 0xff, 0xef,
+	0x40, 0x7b, 0xd6, 0x5f, 0xd0, 0xf8, 0xad, 0x7f, 0x07, 0x00, 0x4c, 0xd0, 0x7d, 0x04, 0x4f, 0x18,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -520,6 +520,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlobTxsEnabled {
+		i--
+		if m.BlobTxsEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.ContractStorageDormancyBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ContractStorageDormancyBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.SeiSstoreSetGasEip2200 != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SeiSstoreSetGasEip2200))
 		i--
@@ -987,6 +1006,12 @@ func (m *Params) Size() (n int) {
 	if m.SeiSstoreSetGasEip2200 != 0 {
 		n += 1 + sovParams(uint64(m.SeiSstoreSetGasEip2200))
 	}
+	if m.ContractStorageDormancyBlocks != 0 {
+		n += 2 + sovParams(uint64(m.ContractStorageDormancyBlocks))
+	}
+	if m.BlobTxsEnabled {
+		n += 3
+	}
 	return n
 }
 
@@ -1447,6 +1472,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractStorageDormancyBlocks", wireType)
+			}
+			m.ContractStorageDormancyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractStorageDormancyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobTxsEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlobTxsEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
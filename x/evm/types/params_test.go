@@ -21,6 +21,8 @@ func TestDefaultParams(t *testing.T) {
 		MaxDynamicBaseFeeDownwardAdjustment:    types.DefaultMaxDynamicBaseFeeDownwardAdjustment,
 		TargetGasUsedPerBlock:                  types.DefaultTargetGasUsedPerBlock,
 		SeiSstoreSetGasEip2200:                 types.DefaultSeiSstoreSetGasEIP2200,
+		ContractStorageDormancyBlocks:          types.DefaultContractStorageDormancyBlocks,
+		BlobTxsEnabled:                         types.DefaultBlobTxsEnabled,
 	}, types.DefaultParams())
 	require.Nil(t, types.DefaultParams().Validate())
 }
@@ -636,6 +636,128 @@ func (m *QueryPointeeResponse) GetExists() bool {
 	return false
 }
 
+type QueryContractStorageUsageRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryContractStorageUsageRequest) Reset()         { *m = QueryContractStorageUsageRequest{} }
+func (m *QueryContractStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageUsageRequest) ProtoMessage()    {}
+func (*QueryContractStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{12}
+}
+func (m *QueryContractStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageUsageRequest.Merge(m, src)
+}
+func (m *QueryContractStorageUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageUsageRequest proto.InternalMessageInfo
+
+func (m *QueryContractStorageUsageRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryContractStorageUsageResponse struct {
+	Slots            uint64 `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
+	Bytes            uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	LastAccessHeight int64  `protobuf:"varint,3,opt,name=last_access_height,json=lastAccessHeight,proto3" json:"last_access_height,omitempty"`
+	// False while existing storage is still being counted, in which case slots
+	// and bytes may be lower than the contract's actual usage.
+	Complete bool `protobuf:"varint,4,opt,name=complete,proto3" json:"complete,omitempty"`
+	Dormant  bool `protobuf:"varint,5,opt,name=dormant,proto3" json:"dormant,omitempty"`
+}
+
+func (m *QueryContractStorageUsageResponse) Reset()         { *m = QueryContractStorageUsageResponse{} }
+func (m *QueryContractStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageUsageResponse) ProtoMessage()    {}
+func (*QueryContractStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{13}
+}
+func (m *QueryContractStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageUsageResponse.Merge(m, src)
+}
+func (m *QueryContractStorageUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageUsageResponse proto.InternalMessageInfo
+
+func (m *QueryContractStorageUsageResponse) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *QueryContractStorageUsageResponse) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *QueryContractStorageUsageResponse) GetLastAccessHeight() int64 {
+	if m != nil {
+		return m.LastAccessHeight
+	}
+	return 0
+}
+
+func (m *QueryContractStorageUsageResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *QueryContractStorageUsageResponse) GetDormant() bool {
+	if m != nil {
+		return m.Dormant
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QuerySeiAddressByEVMAddressRequest)(nil), "seiprotocol.seichain.evm.QuerySeiAddressByEVMAddressRequest")
 	proto.RegisterType((*QuerySeiAddressByEVMAddressResponse)(nil), "seiprotocol.seichain.evm.QuerySeiAddressByEVMAddressResponse")
@@ -649,54 +771,65 @@ func init() {
 	proto.RegisterType((*QueryPointerVersionResponse)(nil), "seiprotocol.seichain.evm.QueryPointerVersionResponse")
 	proto.RegisterType((*QueryPointeeRequest)(nil), "seiprotocol.seichain.evm.QueryPointeeRequest")
 	proto.RegisterType((*QueryPointeeResponse)(nil), "seiprotocol.seichain.evm.QueryPointeeResponse")
+	proto.RegisterType((*QueryContractStorageUsageRequest)(nil), "seiprotocol.seichain.evm.QueryContractStorageUsageRequest")
+	proto.RegisterType((*QueryContractStorageUsageResponse)(nil), "seiprotocol.seichain.evm.QueryContractStorageUsageResponse")
 }
 
 func init() { proto.RegisterFile("evm/query.proto", fileDescriptor_11c0d37eed5339f7) }
 
 var fileDescriptor_11c0d37eed5339f7 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0xae, 0xf3, 0xeb, 0xaf, 0x7f, 0xa6, 0xa5, 0x48, 0x0b, 0x2a, 0x91, 0xa9, 0x4c, 0x65, 0xfe,
-	0xa8, 0xaa, 0x88, 0x03, 0x05, 0x6e, 0xe5, 0x40, 0xab, 0x0a, 0x38, 0x20, 0x81, 0x81, 0x1e, 0xb8,
-	0x44, 0xae, 0x3d, 0x6d, 0x57, 0x8a, 0xbd, 0xae, 0x77, 0x93, 0x36, 0x57, 0x9e, 0x00, 0x09, 0xae,
-	0x1c, 0x78, 0x04, 0x1e, 0x02, 0x89, 0x63, 0x25, 0x2e, 0x1c, 0x51, 0xcb, 0x83, 0x20, 0xaf, 0xd7,
-	0xb1, 0x9d, 0xa6, 0x76, 0x52, 0xc1, 0xcd, 0xb3, 0x3b, 0xf3, 0xcd, 0x37, 0xdf, 0xac, 0x3f, 0xb8,
-	0x8c, 0x5d, 0xbf, 0x79, 0xd0, 0xc1, 0xa8, 0x67, 0x85, 0x11, 0x13, 0x8c, 0xd4, 0x39, 0x52, 0xf9,
-	0xe5, 0xb2, 0xb6, 0xc5, 0x91, 0xba, 0xfb, 0x0e, 0x0d, 0x2c, 0xec, 0xfa, 0xba, 0x4c, 0xc5, 0xa0,
-	0xe3, 0xf3, 0x24, 0x55, 0x5f, 0xda, 0x63, 0x6c, 0xaf, 0x8d, 0x4d, 0x27, 0xa4, 0x4d, 0x27, 0x08,
-	0x98, 0x70, 0x04, 0x65, 0x81, 0xba, 0x35, 0xb7, 0xc0, 0x7c, 0x15, 0xe3, 0xbe, 0x46, 0xfa, 0xc4,
-	0xf3, 0x22, 0xe4, 0x7c, 0xa3, 0xb7, 0xb5, 0xfd, 0x42, 0x7d, 0xdb, 0x78, 0xd0, 0x41, 0x2e, 0xc8,
-	0x0d, 0x98, 0xc3, 0xae, 0xdf, 0x72, 0x92, 0xd3, 0xba, 0xb6, 0xac, 0xad, 0xcc, 0xda, 0x80, 0x5d,
-	0x5f, 0xe5, 0x99, 0xbb, 0x70, 0xb3, 0x14, 0x86, 0x87, 0x2c, 0xe0, 0x18, 0xe3, 0x70, 0xa4, 0x83,
-	0x38, 0xbc, 0x5f, 0x44, 0x0c, 0x00, 0x87, 0x73, 0xe6, 0x52, 0x47, 0xa0, 0x57, 0xaf, 0x2d, 0x6b,
-	0x2b, 0x33, 0x76, 0xee, 0xa4, 0x4f, 0x37, 0xc3, 0xde, 0xc8, 0xf5, 0xcc, 0xd1, 0x2d, 0x6d, 0xd3,
-	0xa7, 0x7b, 0x1e, 0x4c, 0x46, 0xb7, 0x74, 0xec, 0x4a, 0xba, 0xeb, 0xb0, 0x98, 0xc8, 0x12, 0x8b,
-	0xee, 0x6e, 0x3a, 0xed, 0x76, 0x4a, 0x91, 0xc0, 0xa4, 0xe7, 0x08, 0x47, 0x62, 0xce, 0xdb, 0xf2,
-	0x9b, 0x2c, 0x40, 0x4d, 0x30, 0x89, 0x32, 0x6b, 0xd7, 0x04, 0x33, 0x1b, 0x70, 0xed, 0x4c, 0xb5,
-	0x62, 0x36, 0xa4, 0xdc, 0xec, 0xc1, 0x15, 0x99, 0xfe, 0x92, 0xd1, 0x40, 0x60, 0x94, 0x76, 0x7a,
-	0x06, 0xf3, 0x61, 0x72, 0xd2, 0x12, 0xbd, 0x10, 0x65, 0xc9, 0xc2, 0xda, 0x6d, 0xeb, 0xbc, 0x17,
-	0x64, 0xa9, 0xfa, 0x37, 0xbd, 0x10, 0xed, 0xb9, 0x30, 0x0b, 0x48, 0x1d, 0xa6, 0x93, 0x10, 0x15,
-	0xc9, 0x34, 0x34, 0x77, 0xe0, 0x6a, 0xb1, 0xb5, 0xa2, 0xd9, 0xaf, 0x88, 0x94, 0x78, 0x69, 0x18,
-	0xdf, 0x74, 0x31, 0xe2, 0x94, 0x05, 0x12, 0xeb, 0x92, 0x9d, 0x86, 0x64, 0x11, 0xa6, 0xf0, 0x88,
-	0x72, 0xc1, 0xeb, 0xff, 0x49, 0x3d, 0x55, 0x64, 0xee, 0x82, 0x9e, 0xef, 0xb1, 0x9d, 0xa4, 0xff,
-	0xf5, 0x29, 0xcd, 0xb7, 0x70, 0x7d, 0x68, 0x9f, 0x6c, 0xa4, 0x94, 0xb8, 0x56, 0x24, 0xbe, 0x04,
-	0xe0, 0x1e, 0xb6, 0x5c, 0xe6, 0x61, 0x8b, 0x26, 0x8f, 0x61, 0xd2, 0x9e, 0x71, 0x0f, 0x37, 0x99,
-	0x87, 0xcf, 0xbd, 0x81, 0xed, 0xe0, 0x3f, 0xdc, 0x4e, 0x54, 0xdc, 0x4e, 0x34, 0xb0, 0x1d, 0x3c,
-	0xbb, 0x1d, 0x2c, 0x6e, 0x07, 0xc7, 0xdf, 0xce, 0xda, 0xe7, 0x19, 0xf8, 0x5f, 0x36, 0x21, 0xdf,
-	0x34, 0x58, 0x1c, 0x6e, 0x03, 0x64, 0xfd, 0xfc, 0xb1, 0xaa, 0x4d, 0x48, 0x7f, 0x7c, 0xc1, 0xea,
-	0x64, 0x5a, 0xd3, 0x7a, 0xff, 0xe3, 0xf7, 0xc7, 0xda, 0x0a, 0xb9, 0xd3, 0xe4, 0x48, 0x1b, 0x29,
-	0x4e, 0x33, 0xc5, 0x69, 0xc6, 0xbe, 0x99, 0x73, 0x0d, 0x39, 0xc7, 0x70, 0x7f, 0xa8, 0x9c, 0xa3,
-	0xd4, 0x9d, 0x2a, 0xe7, 0x28, 0x37, 0xa5, 0x91, 0xe6, 0xc8, 0xb9, 0x16, 0xf9, 0xa2, 0x01, 0x64,
-	0x0e, 0x42, 0xee, 0x55, 0xa9, 0x38, 0x68, 0x55, 0xfa, 0xfd, 0x31, 0x2a, 0xc6, 0xd1, 0x5a, 0x96,
-	0xb5, 0xdc, 0x98, 0xd4, 0x27, 0x0d, 0xa6, 0xd5, 0xc3, 0x26, 0x8d, 0x8a, 0x76, 0x45, 0x7b, 0xd3,
-	0xad, 0x51, 0xd3, 0x15, 0xb5, 0x55, 0x49, 0xed, 0x16, 0x31, 0x4b, 0xa8, 0xa5, 0x26, 0xf5, 0x55,
-	0x83, 0x85, 0xa2, 0x0d, 0x90, 0x87, 0xa3, 0xb5, 0x2b, 0xba, 0x93, 0xfe, 0x68, 0xcc, 0x2a, 0xc5,
-	0x75, 0x4d, 0x72, 0xbd, 0x4b, 0x56, 0xab, 0xb9, 0xb6, 0xd2, 0x1f, 0x34, 0x93, 0x12, 0x47, 0x94,
-	0x12, 0xc7, 0x93, 0x12, 0x2f, 0x20, 0x25, 0x6e, 0x3c, 0xfd, 0x7e, 0x62, 0x68, 0xc7, 0x27, 0x86,
-	0xf6, 0xeb, 0xc4, 0xd0, 0x3e, 0x9c, 0x1a, 0x13, 0xc7, 0xa7, 0xc6, 0xc4, 0xcf, 0x53, 0x63, 0xe2,
-	0x5d, 0x63, 0x8f, 0x8a, 0xfd, 0xce, 0x8e, 0xe5, 0x32, 0xff, 0x0c, 0x4e, 0x23, 0x01, 0x3a, 0x92,
-	0x50, 0xb1, 0x3f, 0xf2, 0x9d, 0x29, 0x79, 0xff, 0xe0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xad,
-	0xc5, 0xe2, 0x1a, 0x13, 0x09, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0xc6, 0x21, 0xfc, 0x1d, 0x28, 0xad, 0xa6, 0x88, 0x5a, 0x2e, 0x4a, 0xa9, 0xfb, 0x23, 0x84,
+	0x88, 0x53, 0xa0, 0x5d, 0xb4, 0x85, 0x05, 0x20, 0x54, 0xba, 0xa8, 0xd4, 0x9a, 0xc2, 0xa2, 0x1b,
+	0x6b, 0x62, 0x1f, 0x12, 0x4b, 0xb1, 0xc7, 0x78, 0x26, 0x81, 0x6c, 0xfb, 0x04, 0x95, 0xda, 0x17,
+	0xe8, 0x23, 0x54, 0x7d, 0x86, 0x4a, 0x55, 0x57, 0x48, 0xdd, 0x74, 0x59, 0xc1, 0x5d, 0xdd, 0xa7,
+	0xb8, 0xf2, 0x78, 0x9c, 0xc4, 0x21, 0x38, 0x24, 0xba, 0x77, 0xe7, 0x33, 0x73, 0xbe, 0xef, 0x7c,
+	0xe7, 0x1c, 0xfb, 0x33, 0xbc, 0x8d, 0x9d, 0xa0, 0x76, 0xdd, 0xc6, 0xb8, 0x6b, 0x45, 0x31, 0x13,
+	0x8c, 0xe8, 0x1c, 0x7d, 0xf9, 0xe4, 0xb2, 0x96, 0xc5, 0xd1, 0x77, 0x9b, 0xd4, 0x0f, 0x2d, 0xec,
+	0x04, 0x86, 0x4c, 0xc5, 0xb0, 0x1d, 0xf0, 0x34, 0xd5, 0xd8, 0x68, 0x30, 0xd6, 0x68, 0x61, 0x8d,
+	0x46, 0x7e, 0x8d, 0x86, 0x21, 0x13, 0x54, 0xf8, 0x2c, 0x54, 0xb7, 0xe6, 0x29, 0x98, 0x3f, 0x24,
+	0xbc, 0xe7, 0xe8, 0x1f, 0x79, 0x5e, 0x8c, 0x9c, 0x1f, 0x77, 0x4f, 0x2f, 0xbf, 0x53, 0xcf, 0x36,
+	0x5e, 0xb7, 0x91, 0x0b, 0xf2, 0x01, 0x2c, 0x63, 0x27, 0x70, 0x68, 0x7a, 0xaa, 0x6b, 0x9b, 0xda,
+	0xd6, 0x92, 0x0d, 0xd8, 0x09, 0x54, 0x9e, 0x79, 0x05, 0x1f, 0x15, 0xd2, 0xf0, 0x88, 0x85, 0x1c,
+	0x13, 0x1e, 0x8e, 0xfe, 0x30, 0x0f, 0xef, 0x81, 0x48, 0x05, 0x80, 0x72, 0xce, 0x5c, 0x9f, 0x0a,
+	0xf4, 0xf4, 0xd2, 0xa6, 0xb6, 0xb5, 0x68, 0x0f, 0x9c, 0xf4, 0xe4, 0xf6, 0xb9, 0x8f, 0x07, 0x6a,
+	0x0e, 0xc8, 0x2d, 0x2c, 0xd3, 0x93, 0xfb, 0x14, 0x4d, 0x5f, 0x6e, 0x61, 0xdb, 0x63, 0xe5, 0x1e,
+	0xc0, 0x7a, 0x3a, 0x96, 0x64, 0xe8, 0xee, 0x09, 0x6d, 0xb5, 0x32, 0x89, 0x04, 0xca, 0x1e, 0x15,
+	0x54, 0x72, 0xae, 0xd8, 0xf2, 0x99, 0xac, 0x42, 0x49, 0x30, 0xc9, 0xb2, 0x64, 0x97, 0x04, 0x33,
+	0xab, 0xf0, 0xde, 0x23, 0xb4, 0x52, 0x36, 0x02, 0x6e, 0x76, 0xe1, 0x5d, 0x99, 0xfe, 0x3d, 0xf3,
+	0x43, 0x81, 0x71, 0x56, 0xe9, 0x0c, 0x56, 0xa2, 0xf4, 0xc4, 0x11, 0xdd, 0x08, 0x25, 0x64, 0x75,
+	0xef, 0x13, 0xeb, 0xa9, 0x37, 0xc8, 0x52, 0xf8, 0x1f, 0xbb, 0x11, 0xda, 0xcb, 0x51, 0x3f, 0x20,
+	0x3a, 0x2c, 0xa4, 0x21, 0x2a, 0x91, 0x59, 0x68, 0xd6, 0x61, 0x2d, 0x5f, 0x5a, 0xc9, 0xec, 0x21,
+	0x62, 0x35, 0xbc, 0x2c, 0x4c, 0x6e, 0x3a, 0x18, 0x73, 0x9f, 0x85, 0x92, 0xeb, 0x2d, 0x3b, 0x0b,
+	0xc9, 0x3a, 0xcc, 0xe3, 0xad, 0xcf, 0x05, 0xd7, 0x67, 0xe5, 0x3c, 0x55, 0x64, 0x5e, 0x81, 0x31,
+	0x58, 0xe3, 0x32, 0x4d, 0x7f, 0xed, 0x5d, 0x9a, 0x17, 0xf0, 0xfe, 0xc8, 0x3a, 0xfd, 0x96, 0x32,
+	0xe1, 0x5a, 0x5e, 0xf8, 0x06, 0x80, 0x7b, 0xe3, 0xb8, 0xcc, 0x43, 0xc7, 0x4f, 0x5f, 0x86, 0xb2,
+	0xbd, 0xe8, 0xde, 0x9c, 0x30, 0x0f, 0xbf, 0xf5, 0x86, 0xb6, 0x83, 0x6f, 0x70, 0x3b, 0x71, 0x7e,
+	0x3b, 0xf1, 0xd0, 0x76, 0xf0, 0xf1, 0x76, 0x30, 0xbf, 0x1d, 0x9c, 0x62, 0x3b, 0x07, 0xb0, 0x29,
+	0x6b, 0x9c, 0xb0, 0x50, 0xc4, 0xd4, 0x15, 0xe7, 0x82, 0xc5, 0xb4, 0x81, 0x17, 0x9c, 0x36, 0x7a,
+	0xbd, 0xea, 0xb0, 0x90, 0xff, 0x94, 0xb2, 0xd0, 0xfc, 0x53, 0x83, 0x0f, 0x0b, 0xe0, 0x4a, 0xef,
+	0x1a, 0xcc, 0xf1, 0x16, 0x13, 0x29, 0xba, 0x6c, 0xa7, 0x41, 0x72, 0x5a, 0xef, 0x0a, 0xe4, 0x6a,
+	0xe2, 0x69, 0x40, 0x76, 0x80, 0xb4, 0x28, 0x17, 0x0e, 0x75, 0x5d, 0xe4, 0xdc, 0x69, 0xa2, 0xdf,
+	0x68, 0x0a, 0xa9, 0x79, 0xd6, 0x7e, 0x27, 0xb9, 0x39, 0x92, 0x17, 0x67, 0xf2, 0x9c, 0x18, 0xb0,
+	0xe8, 0xb2, 0x20, 0x6a, 0xa1, 0x40, 0xbd, 0x2c, 0xfb, 0xea, 0xc5, 0x89, 0x6a, 0x8f, 0xc5, 0x01,
+	0x0d, 0x85, 0x3e, 0x27, 0xaf, 0xb2, 0x70, 0xef, 0xe5, 0x12, 0xcc, 0x49, 0xd5, 0xe4, 0x2f, 0x0d,
+	0xd6, 0x47, 0x5b, 0x1f, 0x39, 0x78, 0x7a, 0x95, 0xe3, 0x8d, 0xd7, 0x38, 0x9c, 0x12, 0x9d, 0x4e,
+	0xcc, 0xb4, 0x7e, 0xfe, 0xf7, 0xc5, 0xaf, 0xa5, 0x2d, 0xf2, 0x69, 0x8d, 0xa3, 0x5f, 0xcd, 0x78,
+	0x6a, 0x19, 0x4f, 0x2d, 0xf9, 0x57, 0x0c, 0x38, 0xa5, 0xec, 0x63, 0xb4, 0x27, 0x8e, 0xed, 0xa3,
+	0xd0, 0x91, 0x8d, 0xc3, 0x29, 0xd1, 0x13, 0xf4, 0x31, 0xe0, 0xd4, 0xe4, 0x77, 0x0d, 0xa0, 0xef,
+	0x9a, 0xe4, 0xb3, 0x71, 0x53, 0x1c, 0xb6, 0x67, 0x63, 0x77, 0x02, 0xc4, 0x24, 0xb3, 0x96, 0x30,
+	0xc7, 0x4d, 0x44, 0xfd, 0xa6, 0xc1, 0x82, 0xfa, 0x98, 0x49, 0x75, 0x4c, 0xb9, 0xbc, 0xa5, 0x1b,
+	0xd6, 0x73, 0xd3, 0x95, 0xb4, 0x6d, 0x29, 0xed, 0x63, 0x62, 0x16, 0x48, 0xcb, 0x8c, 0xf9, 0x0f,
+	0x0d, 0x56, 0xf3, 0xd6, 0x47, 0x3e, 0x7f, 0x5e, 0xb9, 0xbc, 0x23, 0x1b, 0x5f, 0x4c, 0x88, 0x52,
+	0x5a, 0xf7, 0xa4, 0xd6, 0x1d, 0xb2, 0x3d, 0x5e, 0xab, 0x93, 0x99, 0x52, 0x7f, 0x94, 0xf8, 0xcc,
+	0x51, 0xe2, 0x64, 0xa3, 0xc4, 0x29, 0x46, 0x89, 0xe4, 0x1f, 0x0d, 0xd6, 0x46, 0x19, 0x1a, 0xf9,
+	0x6a, 0x4c, 0xd1, 0x02, 0x13, 0x35, 0xbe, 0x9e, 0x0a, 0xab, 0xd4, 0x7f, 0x29, 0xd5, 0xef, 0x93,
+	0xdd, 0x02, 0xf5, 0xae, 0x22, 0x70, 0x78, 0xca, 0xe0, 0xb4, 0x13, 0x8a, 0xe3, 0x6f, 0xfe, 0xbe,
+	0xaf, 0x68, 0x77, 0xf7, 0x15, 0xed, 0xff, 0xfb, 0x8a, 0xf6, 0xcb, 0x43, 0x65, 0xe6, 0xee, 0xa1,
+	0x32, 0xf3, 0xdf, 0x43, 0x65, 0xe6, 0xa7, 0x6a, 0xc3, 0x17, 0xcd, 0x76, 0xdd, 0x72, 0x59, 0xf0,
+	0x88, 0xb6, 0x9a, 0xf2, 0xde, 0x4a, 0xe6, 0xe4, 0x07, 0xc7, 0xeb, 0xf3, 0xf2, 0x7e, 0xff, 0xd5,
+	0x00, 0x11, 0x36, 0x98, 0xea, 0xd4, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -717,6 +850,7 @@ type QueryClient interface {
 	Pointer(ctx context.Context, in *QueryPointerRequest, opts ...grpc.CallOption) (*QueryPointerResponse, error)
 	PointerVersion(ctx context.Context, in *QueryPointerVersionRequest, opts ...grpc.CallOption) (*QueryPointerVersionResponse, error)
 	Pointee(ctx context.Context, in *QueryPointeeRequest, opts ...grpc.CallOption) (*QueryPointeeResponse, error)
+	ContractStorageUsage(ctx context.Context, in *QueryContractStorageUsageRequest, opts ...grpc.CallOption) (*QueryContractStorageUsageResponse, error)
 }
 
 type queryClient struct {
@@ -781,6 +915,15 @@ func (c *queryClient) Pointee(ctx context.Context, in *QueryPointeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ContractStorageUsage(ctx context.Context, in *QueryContractStorageUsageRequest, opts ...grpc.CallOption) (*QueryContractStorageUsageResponse, error) {
+	out := new(QueryContractStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/seiprotocol.seichain.evm.Query/ContractStorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	SeiAddressByEVMAddress(context.Context, *QuerySeiAddressByEVMAddressRequest) (*QuerySeiAddressByEVMAddressResponse, error)
@@ -789,6 +932,7 @@ type QueryServer interface {
 	Pointer(context.Context, *QueryPointerRequest) (*QueryPointerResponse, error)
 	PointerVersion(context.Context, *QueryPointerVersionRequest) (*QueryPointerVersionResponse, error)
 	Pointee(context.Context, *QueryPointeeRequest) (*QueryPointeeResponse, error)
+	ContractStorageUsage(context.Context, *QueryContractStorageUsageRequest) (*QueryContractStorageUsageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -813,6 +957,9 @@ func (*UnimplementedQueryServer) PointerVersion(ctx context.Context, req *QueryP
 func (*UnimplementedQueryServer) Pointee(ctx context.Context, req *QueryPointeeRequest) (*QueryPointeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pointee not implemented")
 }
+func (*UnimplementedQueryServer) ContractStorageUsage(ctx context.Context, req *QueryContractStorageUsageRequest) (*QueryContractStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorageUsage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -926,6 +1073,24 @@ func _Query_Pointee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/seiprotocol.seichain.evm.Query/ContractStorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorageUsage(ctx, req.(*QueryContractStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "seiprotocol.seichain.evm.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -954,6 +1119,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Pointee",
 			Handler:    _Query_Pointee_Handler,
 		},
+		{
+			MethodName: "ContractStorageUsage",
+			Handler:    _Query_ContractStorageUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "evm/query.proto",
@@ -1387,6 +1556,94 @@ func (m *QueryPointeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryContractStorageUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractStorageUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractStorageUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractStorageUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Dormant {
+		i--
+		if m.Dormant {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastAccessHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastAccessHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slots != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1583,6 +1840,43 @@ func (m *QueryPointeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContractStorageUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractStorageUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slots != 0 {
+		n += 1 + sovQuery(uint64(m.Slots))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovQuery(uint64(m.Bytes))
+	}
+	if m.LastAccessHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastAccessHeight))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.Dormant {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2758,6 +3052,235 @@ func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContractStorageUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractStorageUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccessHeight", wireType)
+			}
+			m.LastAccessHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAccessHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dormant", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Dormant = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
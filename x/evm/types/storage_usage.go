@@ -0,0 +1,89 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contract storage usage encoding, shared by x/evm and giga's xevm keeper: both record per-transaction
+// deltas that x/evm folds at EndBlock.
+
+var (
+	// StorageUsageBackfillStarted is the backfill checkpoint once tracking has started and before any
+	// slot has been counted. Tracking is off while ContractStorageUsageBackfillKey is unset.
+	StorageUsageBackfillStarted = []byte("started")
+	// StorageUsageBackfillComplete is the backfill checkpoint once every slot has been counted. Real
+	// checkpoints are address+slot keys, which are never this short.
+	StorageUsageBackfillComplete = []byte("complete")
+)
+
+type StorageUsageDelta struct {
+	Slots int64
+	Bytes int64
+}
+
+func (d StorageUsageDelta) IsZero() bool {
+	return d.Slots == 0 && d.Bytes == 0
+}
+
+func (d StorageUsageDelta) Add(o StorageUsageDelta) StorageUsageDelta {
+	return StorageUsageDelta{Slots: d.Slots + o.Slots, Bytes: d.Bytes + o.Bytes}
+}
+
+func (d StorageUsageDelta) Marshal() []byte {
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz, uint64(d.Slots))     //nolint:gosec
+	binary.BigEndian.PutUint64(bz[8:], uint64(d.Bytes)) //nolint:gosec
+	return bz
+}
+
+func UnmarshalStorageUsageDelta(bz []byte) StorageUsageDelta {
+	if len(bz) != 16 {
+		return StorageUsageDelta{}
+	}
+	return StorageUsageDelta{
+		Slots: int64(binary.BigEndian.Uint64(bz)),     //nolint:gosec
+		Bytes: int64(binary.BigEndian.Uint64(bz[8:])), //nolint:gosec
+	}
+}
+
+// StorageWriteDelta is the usage change of a slot going from old to val (nil meaning absent).
+func StorageWriteDelta(old, val []byte) StorageUsageDelta {
+	d := StorageUsageDelta{}
+	if old != nil {
+		d.Slots--
+		d.Bytes -= StorageSlotBytes(old)
+	}
+	if val != nil {
+		d.Slots++
+		d.Bytes += StorageSlotBytes(val)
+	}
+	return d
+}
+
+// StorageSlotBytes is the size of a storage entry: its full store key plus its value.
+func StorageSlotBytes(value []byte) int64 {
+	return int64(len(StateKeyPrefix) + common.AddressLength + common.HashLength + len(value))
+}
+
+// StorageUsageDeltaKey is the key, under StorageUsageDeltaPrefix, of addr's delta in transaction txIndex.
+func StorageUsageDeltaKey(txIndex int, addr common.Address) []byte {
+	key := make([]byte, 8, 8+common.AddressLength)
+	binary.BigEndian.PutUint64(key, uint64(txIndex)) //nolint:gosec
+	return append(key, addr[:]...)
+}
+
+// StorageUsageCovered reports whether the backfill at checkpoint has counted the slot at stateKey
+// (address+slot), and so whether changes to it must be accounted.
+func StorageUsageCovered(checkpoint, stateKey []byte) bool {
+	switch {
+	case len(checkpoint) == 0, bytes.Equal(checkpoint, StorageUsageBackfillStarted):
+		return false
+	case bytes.Equal(checkpoint, StorageUsageBackfillComplete):
+		return true
+	default:
+		return bytes.Compare(stateKey, checkpoint) <= 0
+	}
+}
@@ -137,36 +137,105 @@ func (m *DeferredInfo) GetError() string {
 	return ""
 }
 
+// ContractStorageUsage is the storage a contract occupies in the EVM store.
+type ContractStorageUsage struct {
+	Slots uint64 `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
+	// Bytes of the contract's storage entries, keys included.
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// Last height at which a transaction read or wrote the contract's storage,
+	// or the height tracking began for contracts untouched since.
+	LastAccessHeight int64 `protobuf:"varint,3,opt,name=last_access_height,json=lastAccessHeight,proto3" json:"last_access_height,omitempty"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eba926c274d8fd0, []int{2}
+}
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+func (m *ContractStorageUsage) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *ContractStorageUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *ContractStorageUsage) GetLastAccessHeight() int64 {
+	if m != nil {
+		return m.LastAccessHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Whitelist)(nil), "seiprotocol.seichain.evm.Whitelist")
 	proto.RegisterType((*DeferredInfo)(nil), "seiprotocol.seichain.evm.DeferredInfo")
+	proto.RegisterType((*ContractStorageUsage)(nil), "seiprotocol.seichain.evm.ContractStorageUsage")
 }
 
 func init() { proto.RegisterFile("evm/types.proto", fileDescriptor_6eba926c274d8fd0) }
 
 var fileDescriptor_6eba926c274d8fd0 = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbf, 0x6e, 0xf2, 0x30,
-	0x14, 0xc5, 0xe3, 0x8f, 0x0f, 0x52, 0x2c, 0x50, 0xd5, 0x08, 0xa9, 0x6e, 0x87, 0x10, 0x65, 0x4a,
-	0x07, 0x92, 0xa1, 0x12, 0x43, 0x87, 0x0e, 0x51, 0xa5, 0x96, 0x35, 0x4b, 0xab, 0x2e, 0x28, 0xc0,
-	0x85, 0x58, 0x4a, 0x62, 0xe4, 0x6b, 0x90, 0x79, 0x8b, 0x3e, 0x16, 0x4b, 0x25, 0xc6, 0xaa, 0x03,
-	0xaa, 0xe0, 0x0d, 0xfa, 0x04, 0x55, 0xfe, 0xd0, 0xb5, 0xdb, 0xf9, 0xf9, 0xdc, 0x7b, 0xac, 0x63,
-	0xd3, 0x73, 0x58, 0x67, 0x81, 0xda, 0x2c, 0x01, 0xfd, 0xa5, 0x14, 0x4a, 0x58, 0x0c, 0x81, 0x97,
-	0x6a, 0x2a, 0x52, 0x1f, 0x81, 0x4f, 0x93, 0x98, 0xe7, 0x3e, 0xac, 0xb3, 0xeb, 0xde, 0x42, 0x2c,
-	0x44, 0x69, 0x05, 0x85, 0xaa, 0xe6, 0xdd, 0x21, 0x6d, 0x3f, 0x27, 0x5c, 0x41, 0xca, 0x51, 0x59,
-	0x37, 0xb4, 0x95, 0xc4, 0x98, 0x00, 0x32, 0xe2, 0x34, 0xbc, 0x76, 0x78, 0xf1, 0xbd, 0xef, 0x77,
-	0x37, 0x71, 0x96, 0xde, 0xb9, 0xd5, 0xb9, 0x1b, 0xd5, 0x03, 0xee, 0x3b, 0xa1, 0x9d, 0x07, 0x98,
-	0x83, 0x94, 0x30, 0x1b, 0xe5, 0x73, 0x61, 0x5d, 0xd1, 0x33, 0xa5, 0xc7, 0x3c, 0x9f, 0x81, 0x66,
-	0xc4, 0x21, 0x5e, 0x37, 0x32, 0x95, 0x1e, 0x15, 0x68, 0x5d, 0x52, 0x53, 0xe9, 0x71, 0xb1, 0xc8,
-	0xfe, 0x39, 0xc4, 0xeb, 0x44, 0x2d, 0xa5, 0x9f, 0x62, 0x4c, 0xea, 0x9d, 0x49, 0x2a, 0x44, 0xc6,
-	0x1a, 0xa5, 0x63, 0x2a, 0x1d, 0x16, 0x68, 0xbd, 0x50, 0x13, 0x57, 0x72, 0x99, 0xae, 0x90, 0xfd,
-	0x77, 0x88, 0xd7, 0x0e, 0xef, 0xb7, 0xfb, 0xbe, 0xf1, 0xb9, 0xef, 0x0f, 0x17, 0x5c, 0x25, 0xab,
-	0x89, 0x3f, 0x15, 0x59, 0x80, 0xc0, 0x07, 0xa7, 0xb2, 0x25, 0x94, 0x6d, 0x2b, 0x25, 0x30, 0x13,
-	0x58, 0x3f, 0xcd, 0x28, 0x57, 0xd1, 0x29, 0xce, 0xea, 0xd1, 0x26, 0x48, 0x29, 0x24, 0x6b, 0x16,
-	0xb9, 0x51, 0x05, 0xe1, 0xe3, 0xf6, 0x60, 0x93, 0xdd, 0xc1, 0x26, 0x5f, 0x07, 0x9b, 0xbc, 0x1d,
-	0x6d, 0x63, 0x77, 0xb4, 0x8d, 0x8f, 0xa3, 0x6d, 0xbc, 0x0e, 0xfe, 0xbe, 0x50, 0x07, 0xbf, 0xdf,
-	0x30, 0x69, 0x95, 0xfe, 0xed, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x01, 0xcc, 0xc3, 0xe3, 0x9a,
-	0x01, 0x00, 0x00,
+	// 385 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x8a, 0x13, 0x41,
+	0x10, 0x86, 0xd3, 0x26, 0x9b, 0x98, 0x66, 0x17, 0xb5, 0x09, 0x38, 0x7a, 0x98, 0x84, 0x39, 0x45,
+	0x70, 0x93, 0x83, 0xb0, 0x07, 0x0f, 0x82, 0x51, 0x70, 0x73, 0x6d, 0x11, 0xc5, 0xcb, 0xd0, 0x33,
+	0x5b, 0x3b, 0xdd, 0x30, 0x33, 0x3d, 0x74, 0x55, 0x96, 0xc9, 0x5b, 0xf8, 0x58, 0x7b, 0x11, 0xf6,
+	0x28, 0x1e, 0x82, 0x24, 0x6f, 0xe0, 0x13, 0x48, 0xf7, 0x64, 0xbd, 0xee, 0xad, 0xbe, 0xfa, 0xab,
+	0x0a, 0xbe, 0x6e, 0xfe, 0x04, 0x6e, 0xaa, 0x25, 0x6d, 0x1b, 0xc0, 0x45, 0xe3, 0x2c, 0x59, 0x11,
+	0x21, 0x98, 0x50, 0xe5, 0xb6, 0x5c, 0x20, 0x98, 0x5c, 0x2b, 0x53, 0x2f, 0xe0, 0xa6, 0x7a, 0x39,
+	0x29, 0x6c, 0x61, 0x43, 0xb4, 0xf4, 0x55, 0x37, 0x9f, 0x5c, 0xf0, 0xf1, 0x57, 0x6d, 0x08, 0x4a,
+	0x83, 0x24, 0x5e, 0xf1, 0xa1, 0x56, 0xa8, 0x01, 0x23, 0x36, 0xeb, 0xcf, 0xc7, 0xab, 0x67, 0x7f,
+	0x77, 0xd3, 0xb3, 0xad, 0xaa, 0xca, 0xb7, 0x49, 0xd7, 0x4f, 0xe4, 0x71, 0x20, 0xf9, 0xc9, 0xf8,
+	0xe9, 0x47, 0xb8, 0x06, 0xe7, 0xe0, 0x6a, 0x5d, 0x5f, 0x5b, 0xf1, 0x82, 0x3f, 0xa6, 0x36, 0x35,
+	0xf5, 0x15, 0xb4, 0x11, 0x9b, 0xb1, 0xf9, 0x99, 0x1c, 0x51, 0xbb, 0xf6, 0x28, 0x9e, 0xf3, 0x11,
+	0xb5, 0xa9, 0x5f, 0x8c, 0x1e, 0xcd, 0xd8, 0xfc, 0x54, 0x0e, 0xa9, 0xbd, 0x54, 0xa8, 0x8f, 0x3b,
+	0x59, 0x69, 0x6d, 0x15, 0xf5, 0x43, 0x32, 0xa2, 0x76, 0xe5, 0x51, 0x7c, 0xe3, 0x23, 0xdc, 0xb8,
+	0xa6, 0xdc, 0x60, 0x34, 0x98, 0xb1, 0xf9, 0x78, 0xf5, 0xee, 0x76, 0x37, 0xed, 0xfd, 0xde, 0x4d,
+	0x2f, 0x0a, 0x43, 0x7a, 0x93, 0x2d, 0x72, 0x5b, 0x2d, 0x11, 0xcc, 0xf9, 0xbd, 0x6c, 0x80, 0x60,
+	0xdb, 0x55, 0x16, 0x2b, 0x8b, 0xc7, 0xa7, 0x59, 0xd7, 0x24, 0xef, 0xcf, 0x89, 0x09, 0x3f, 0x01,
+	0xe7, 0xac, 0x8b, 0x4e, 0xfc, 0x5d, 0xd9, 0x41, 0xd2, 0xf0, 0xc9, 0x07, 0x5b, 0x93, 0x53, 0x39,
+	0x7d, 0x26, 0xeb, 0x54, 0x01, 0x5f, 0x50, 0x15, 0xe0, 0xa7, 0xb1, 0xb4, 0x84, 0xc1, 0x69, 0x20,
+	0x3b, 0xf0, 0xdd, 0x6c, 0x4b, 0x80, 0xc1, 0x67, 0x20, 0x3b, 0x10, 0xaf, 0xb9, 0x28, 0x15, 0x52,
+	0xaa, 0xf2, 0x1c, 0x10, 0x53, 0x0d, 0xa6, 0xd0, 0x14, 0xc4, 0xfa, 0xf2, 0xa9, 0x4f, 0xde, 0x87,
+	0xe0, 0x32, 0xf4, 0x57, 0x9f, 0x6e, 0xf7, 0x31, 0xbb, 0xdb, 0xc7, 0xec, 0xcf, 0x3e, 0x66, 0x3f,
+	0x0e, 0x71, 0xef, 0xee, 0x10, 0xf7, 0x7e, 0x1d, 0xe2, 0xde, 0xf7, 0xf3, 0x87, 0x15, 0xdb, 0xe5,
+	0xff, 0x8f, 0xcf, 0x86, 0x21, 0x7f, 0xf3, 0x6f, 0x00, 0x11, 0x58, 0x4d, 0x62, 0x0c, 0x02, 0x00,
+	0x00,
 }
 
 func (m *Whitelist) Marshal() (dAtA []byte, err error) {
@@ -260,6 +329,44 @@ func (m *DeferredInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAccessHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastAccessHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slots != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Slots))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -312,6 +419,24 @@ func (m *DeferredInfo) Size() (n int) {
 	return n
 }
 
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slots != 0 {
+		n += 1 + sovTypes(uint64(m.Slots))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovTypes(uint64(m.Bytes))
+	}
+	if m.LastAccessHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastAccessHeight))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -603,6 +728,113 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slots", wireType)
+			}
+			m.Slots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccessHeight", wireType)
+			}
+			m.LastAccessHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastAccessHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0